@@ -37,12 +37,17 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
-	anteDecorators := []sdk.AnteDecorator{
+	var anteDecorators []sdk.AnteDecorator
+	if options.WasmConfig != nil && options.WasmConfig.QueryNodeMode {
+		anteDecorators = append(anteDecorators, compute.NewQueryNodeDecorator())
+	}
+	anteDecorators = append(anteDecorators,
 		compute.NewCountTXDecorator(options.TXCounterStoreKey),
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
 		ante.NewMempoolFeeDecorator(),
 		ante.NewValidateBasicDecorator(),
+		compute.NewEncryptedMsgDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.HandlerOptions.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.HandlerOptions.AccountKeeper),
@@ -51,8 +56,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewValidateSigCountDecorator(options.HandlerOptions.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.HandlerOptions.AccountKeeper, sigGasConsumer),
 		ante.NewSigVerificationDecorator(options.HandlerOptions.AccountKeeper, options.HandlerOptions.SignModeHandler),
+		compute.NewSignerDataDecorator(options.HandlerOptions.AccountKeeper), // must be called before the sequences are incremented
 		ante.NewIncrementSequenceDecorator(options.HandlerOptions.AccountKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
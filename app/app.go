@@ -10,6 +10,7 @@ import (
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v4/router/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	ibcswitchtypes "github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+	faucettypes "github.com/scrtlabs/SecretNetwork/x/faucet/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -36,6 +37,7 @@ import (
 	v1_11 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.11"
 	v1_12 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.12"
 	v1_13 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.13"
+	v1_14 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.14"
 	v1_3 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.3"
 	v1_4 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.4"
 	v1_5 "github.com/scrtlabs/SecretNetwork/app/upgrades/v1.5"
@@ -67,9 +69,11 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
+	computeratelimit "github.com/scrtlabs/SecretNetwork/x/compute/client/ratelimit"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -94,7 +98,8 @@ var (
 
 	// Module accounts that are allowed to receive tokens
 	allowedReceivingModAcc = map[string]bool{
-		distrtypes.ModuleName: true,
+		distrtypes.ModuleName:  true,
+		faucettypes.ModuleName: true,
 	}
 
 	Upgrades = []upgrades.Upgrade{
@@ -109,6 +114,7 @@ var (
 		v1_11.Upgrade,
 		v1_12.Upgrade,
 		v1_13.Upgrade,
+		v1_14.Upgrade,
 	}
 )
 
@@ -128,6 +134,10 @@ type SecretNetworkApp struct {
 
 	invCheckPeriod uint
 	bootstrap      bool
+	computeConfig  *compute.WasmConfig
+	// queryRateLimiter limits the contract queries of each client through the REST API and gRPC,
+	// nil if rate limiting is disabled
+	queryRateLimiter *computeratelimit.QueryRateLimiter
 
 	// keepers
 	AppKeepers keepers.SecretAppKeepers
@@ -185,6 +195,14 @@ func (app *SecretNetworkApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
 }
 
+// RegisterGRPCServer registers the gRPC services, throttling the contract queries of each client
+func (app *SecretNetworkApp) RegisterGRPCServer(server gogogrpc.Server) {
+	if app.queryRateLimiter != nil {
+		server = app.queryRateLimiter.GRPCServer(server)
+	}
+	app.BaseApp.RegisterGRPCServer(server)
+}
+
 // WasmWrapper allows us to use namespacing in the config file
 // This is only used for parsing in the app, x/compute expects WasmConfig
 type WasmWrapper struct {
@@ -223,6 +241,10 @@ func NewSecretNetworkApp(
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
 		bootstrap:         bootstrap,
+		computeConfig:     computeConfig,
+	}
+	if computeConfig != nil && computeConfig.QueryRateLimit > 0 {
+		app.queryRateLimiter = computeratelimit.NewQueryRateLimiter(computeConfig.QueryRateLimit, computeConfig.QueryRateBurst, computeConfig.QueryAPIKeys)
 	}
 
 	app.AppKeepers.InitKeys()
@@ -362,6 +384,13 @@ func (app *SecretNetworkApp) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *SecretNetworkApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	clientCtx := apiSvr.ClientCtx
+
+	// Throttle contract queries per client, this also covers the grpc-gateway routes
+	// which are mounted on the same router
+	if app.queryRateLimiter != nil {
+		apiSvr.Router.Use(app.queryRateLimiter.Middleware)
+	}
+
 	rpc.RegisterRoutes(clientCtx, apiSvr.Router)
 	// Register legacy tx routes
 	authrest.RegisterTxRoutes(clientCtx, apiSvr.Router)
@@ -442,7 +471,7 @@ func (app *SecretNetworkApp) LegacyAmino() *codec.LegacyAmino {
 }
 
 func SetOrderBeginBlockers(app *SecretNetworkApp) {
-	app.mm.SetOrderBeginBlockers(
+	app.mm.SetOrderBeginBlockers(withFaucet(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
 		minttypes.ModuleName,
@@ -469,11 +498,19 @@ func SetOrderBeginBlockers(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
-	)
+	)...)
+}
+
+// withFaucet appends the faucet module to moduleNames if it's wired into the app, see keepers.FaucetEnabled
+func withFaucet(moduleNames ...string) []string {
+	if keepers.FaucetEnabled {
+		return append(moduleNames, faucettypes.ModuleName)
+	}
+	return moduleNames
 }
 
 func SetOrderInitGenesis(app *SecretNetworkApp) {
-	app.mm.SetOrderInitGenesis(
+	app.mm.SetOrderInitGenesis(withFaucet(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -503,11 +540,11 @@ func SetOrderInitGenesis(app *SecretNetworkApp) {
 
 		ibcfeetypes.ModuleName,
 		feegrant.ModuleName,
-	)
+	)...)
 }
 
 func SetOrderEndBlockers(app *SecretNetworkApp) {
-	app.mm.SetOrderEndBlockers(
+	app.mm.SetOrderEndBlockers(withFaucet(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		authz.ModuleName,
@@ -533,5 +570,5 @@ func SetOrderEndBlockers(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
-	)
+	)...)
 }
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+)
+
+// bankModule is the x/bank AppModule, with a MsgServer that calls the receive hooks of contracts
+type bankModule struct {
+	bank.AppModule
+	keeper        bankkeeper.BaseKeeper
+	computeKeeper compute.Keeper
+}
+
+func newBankModule(appModule bank.AppModule, keeper bankkeeper.BaseKeeper, computeKeeper compute.Keeper) bankModule {
+	return bankModule{
+		AppModule:     appModule,
+		keeper:        keeper,
+		computeKeeper: computeKeeper,
+	}
+}
+
+// RegisterServices registers the same services as x/bank, only wrapping its MsgServer
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), am.computeKeeper.BankMsgServer(bankkeeper.NewMsgServerImpl(am.keeper)))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+}
@@ -32,9 +32,12 @@ import (
 	ibc "github.com/cosmos/ibc-go/v4/modules/core"
 	ibcclient "github.com/cosmos/ibc-go/v4/modules/core/02-client/client"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	"github.com/scrtlabs/SecretNetwork/x/faucet"
 
 	packetforwardrouter "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v4/router"
+	"github.com/scrtlabs/SecretNetwork/app/keepers"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
+	computeclient "github.com/scrtlabs/SecretNetwork/x/compute/client"
 	icaauth "github.com/scrtlabs/SecretNetwork/x/mauth"
 	"github.com/scrtlabs/SecretNetwork/x/registration"
 )
@@ -56,6 +59,8 @@ var mbasics = module.NewBasicManager(
 			upgradeclient.CancelProposalHandler,
 			ibcclient.UpdateClientProposalHandler,
 			ibcclient.UpgradeProposalHandler,
+			computeclient.RecoverContractFundsProposalHandler,
+			computeclient.FlagBrokenCodeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -86,12 +91,16 @@ func customKVStoreKeys() []string {
 }
 
 func customModuleBasics() []module.AppModuleBasic {
-	return []module.AppModuleBasic{
+	basics := []module.AppModuleBasic{
 		compute.AppModuleBasic{},
 		registration.AppModuleBasic{},
 		icaauth.AppModuleBasic{},
 		ibcswitch.AppModuleBasic{},
 	}
+	if keepers.FaucetEnabled {
+		basics = append(basics, faucet.AppModuleBasic{})
+	}
+	return basics
 }
 
 // ModuleBasics returns all app modules basics
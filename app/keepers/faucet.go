@@ -0,0 +1,7 @@
+//go:build faucet
+
+package keepers
+
+// FaucetEnabled wires the faucet module and its store into the app. Only testnet nodes enable it,
+// by building with the faucet build tag, e.g. `make build-linux BUILD_TAGS=faucet`.
+const FaucetEnabled = true
@@ -67,6 +67,8 @@ import (
 
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
 	ibcswitchtypes "github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+	"github.com/scrtlabs/SecretNetwork/x/faucet"
+	faucettypes "github.com/scrtlabs/SecretNetwork/x/faucet/types"
 
 	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 	ibchookskeeper "github.com/scrtlabs/SecretNetwork/x/ibc-hooks/keeper"
@@ -99,6 +101,8 @@ type SecretAppKeepers struct {
 	PacketForwardKeeper *ibcpacketforwardkeeper.Keeper
 	IbcSwitchKeeper     *ibcswitch.Keeper
 
+	FaucetKeeper *faucet.Keeper
+
 	ICAControllerKeeper *icacontrollerkeeper.Keeper
 	ICAHostKeeper       *icahostkeeper.Keeper
 	ICAAuthKeeper       *icaauthkeeper.Keeper
@@ -219,7 +223,11 @@ func (ak *SecretAppKeepers) InitSdkKeepers(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(*ak.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(*ak.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(*ak.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(ak.IbcKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(ak.IbcKeeper.ClientKeeper)).
+		// The compute keeper is created after the gov keeper seals the router, so it is resolved when a proposal passes
+		AddRoute(compute.RouterKey, func(ctx sdk.Context, content govtypes.Content) error {
+			return compute.NewProposalHandler(*ak.ComputeKeeper)(ctx, content)
+		})
 
 	govKeeper := govkeeper.NewKeeper(
 		appCodec,
@@ -283,9 +291,18 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	regRouter := app.Router()
 
 	// Replace with bootstrap flag when we figure out how to test properly and everything works
-	regKeeper := reg.NewKeeper(appCodec, ak.keys[reg.StoreKey], regRouter, reg.EnclaveApi{}, homePath, bootstrap)
+	regKeeper := reg.NewKeeper(appCodec, ak.keys[reg.StoreKey], ak.GetSubspace(reg.ModuleName), regRouter, reg.EnclaveApi{}, homePath, bootstrap)
 	ak.RegKeeper = &regKeeper
 
+	// Enclave evidence is handled by the registration module, which revokes the node
+	evidenceRouter := evidencetypes.NewRouter().AddRoute(reg.RouterKey, reg.NewEvidenceHandler(regKeeper))
+	ak.EvidenceKeeper.SetRouter(evidenceRouter)
+
+	if FaucetEnabled {
+		faucetKeeper := faucet.NewKeeper(appCodec, ak.keys[faucet.StoreKey], *ak.BankKeeper, ak.GetSubspace(faucet.ModuleName))
+		ak.FaucetKeeper = &faucetKeeper
+	}
+
 	// Assaf:
 	// Rules:
 	// 1. Everything should go through our IBC Switch middleware
@@ -378,7 +395,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	icaAuthKeeper := icaauthkeeper.NewKeeper(appCodec, ak.keys[icaauthtypes.StoreKey], *ak.ICAControllerKeeper, ak.ScopedICAAuthKeeper)
 	ak.ICAAuthKeeper = &icaAuthKeeper
 
-	icaAuthIBCModule := icaauth.NewIBCModule(*ak.ICAAuthKeeper)
+	icaAuthIBCModule := icaauth.NewIBCModule(ak.ICAAuthKeeper)
 
 	icaHostIBCModule := icahost.NewIBCModule(*ak.ICAHostKeeper)
 
@@ -431,6 +448,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		appCodec,
 		*legacyAmino,
 		ak.keys[compute.StoreKey],
+		ak.GetSubspace(compute.ModuleName),
 		*ak.AccountKeeper,
 		ak.BankKeeper,
 		*ak.GovKeeper,
@@ -454,6 +472,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 	)
 	ak.ComputeKeeper = &computeKeeper
 	wasmHooks.ContractKeeper = ak.ComputeKeeper
+	ak.ICAAuthKeeper.SetContractKeeper(ak.ComputeKeeper)
 
 	// Compute receive: Switch -> Fee -> Packet Forward -> WASM Hooks
 	var computeStack porttypes.IBCModule
@@ -506,6 +525,9 @@ func (ak *SecretAppKeepers) InitKeys() {
 		ibcswitch.StoreKey,
 		ibchookstypes.StoreKey,
 	)
+	if FaucetEnabled {
+		ak.keys[faucet.StoreKey] = sdk.NewKVStoreKey(faucet.StoreKey)
+	}
 
 	ak.tKeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	ak.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -531,6 +553,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(reg.ModuleName)
 	paramsKeeper.Subspace(ibcpacketforwardtypes.ModuleName).WithKeyTable(ibcpacketforwardtypes.ParamKeyTable())
 	paramsKeeper.Subspace(ibcswitch.ModuleName).WithKeyTable(ibcswitchtypes.ParamKeyTable())
+	paramsKeeper.Subspace(faucet.ModuleName).WithKeyTable(faucettypes.ParamKeyTable())
 
 	return paramsKeeper
 }
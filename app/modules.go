@@ -32,8 +32,10 @@ import (
 	"github.com/cosmos/ibc-go/v4/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v4/modules/core"
+	"github.com/scrtlabs/SecretNetwork/app/keepers"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	"github.com/scrtlabs/SecretNetwork/x/faucet"
 	icaauth "github.com/scrtlabs/SecretNetwork/x/mauth"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
 )
@@ -52,6 +54,12 @@ var ModuleAccountPermissions = map[string][]string{
 	compute.ModuleName:             {authtypes.Burner},
 }
 
+func init() {
+	if keepers.FaucetEnabled {
+		ModuleAccountPermissions[faucet.ModuleName] = nil
+	}
+}
+
 func Modules(
 	app *SecretNetworkApp,
 	encodingConfig EncodingConfig,
@@ -59,11 +67,11 @@ func Modules(
 ) []module.AppModule {
 	appCodec := encodingConfig.Marshaler
 
-	return []module.AppModule{
+	modules := []module.AppModule{
 		genutil.NewAppModule(app.AppKeepers.AccountKeeper, app.AppKeepers.StakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
 		auth.NewAppModule(appCodec, *app.AppKeepers.AccountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(*app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper),
-		bank.NewAppModule(appCodec, *app.AppKeepers.BankKeeper, app.AppKeepers.AccountKeeper),
+		newBankModule(bank.NewAppModule(appCodec, *app.AppKeepers.BankKeeper, app.AppKeepers.AccountKeeper), *app.AppKeepers.BankKeeper, *app.AppKeepers.ComputeKeeper),
 		capability.NewAppModule(appCodec, *app.AppKeepers.CapabilityKeeper),
 		crisis.NewAppModule(app.AppKeepers.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper, *app.AppKeepers.FeegrantKeeper, app.GetInterfaceRegistry()), gov.NewAppModule(app.GetCodec(), *app.AppKeepers.GovKeeper, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper),
@@ -73,7 +81,7 @@ func Modules(
 		staking.NewAppModule(appCodec, *app.AppKeepers.StakingKeeper, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper),
 		upgrade.NewAppModule(*app.AppKeepers.UpgradeKeeper),
 		evidence.NewAppModule(*app.AppKeepers.EvidenceKeeper),
-		compute.NewAppModule(*app.AppKeepers.ComputeKeeper),
+		compute.NewAppModule(*app.AppKeepers.ComputeKeeper, app.AppKeepers.AccountKeeper, app.AppKeepers.BankKeeper, app.AppKeepers.RegKeeper),
 		params.NewAppModule(*app.AppKeepers.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, *app.AppKeepers.AuthzKeeper, app.AppKeepers.AccountKeeper, *app.AppKeepers.BankKeeper, app.GetInterfaceRegistry()),
 		reg.NewAppModule(*app.AppKeepers.RegKeeper),
@@ -85,4 +93,8 @@ func Modules(
 		ibcswitch.NewAppModule(app.AppKeepers.IbcSwitchKeeper),
 		icaauth.NewAppModule(appCodec, *app.AppKeepers.ICAAuthKeeper),
 	}
+	if keepers.FaucetEnabled {
+		modules = append(modules, faucet.NewAppModule(*app.AppKeepers.FaucetKeeper))
+	}
+	return modules
 }
@@ -0,0 +1,51 @@
+package v1_14
+
+import (
+	"fmt"
+
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/scrtlabs/SecretNetwork/app/keepers"
+	"github.com/scrtlabs/SecretNetwork/app/upgrades"
+	faucettypes "github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+const upgradeName = "v1.14"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          upgradeName,
+	CreateUpgradeHandler: createUpgradeHandler,
+	StoreUpgrades:        storeUpgrades(),
+}
+
+// storeUpgrades only adds the faucet store on testnets, mainnet builds don't wire the faucet in
+func storeUpgrades() store.StoreUpgrades {
+	if !keepers.FaucetEnabled {
+		return store.StoreUpgrades{}
+	}
+	return store.StoreUpgrades{
+		Added: []string{
+			faucettypes.StoreKey,
+		},
+	}
+}
+
+func createUpgradeHandler(mm *module.Manager, _ *keepers.SecretAppKeepers, configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info(` _    _ _____   _____ _____            _____  ______ `)
+		ctx.Logger().Info(`| |  | |  __ \ / ____|  __ \     /\   |  __ \|  ____|`)
+		ctx.Logger().Info(`| |  | | |__) | |  __| |__) |   /  \  | |  | | |__   `)
+		ctx.Logger().Info(`| |  | |  ___/| | |_ |  _  /   / /\ \ | |  | |  __|  `)
+		ctx.Logger().Info(`| |__| | |    | |__| | | \ \  / ____ \| |__| | |____ `)
+		ctx.Logger().Info(` \____/|_|     \_____|_|  \_\/_/    \_\_____/|______|`)
+
+		// On testnets, the faucet is initialized with its default genesis by the migrations, which
+		// keeps it disabled. They enable it with a param change proposal.
+
+		ctx.Logger().Info(fmt.Sprintf("Running module migrations for %s...", upgradeName))
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
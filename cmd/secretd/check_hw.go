@@ -0,0 +1,343 @@
+//go:build !secretcli
+// +build !secretcli
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCheckHwJSON        = "json"
+	flagCheckHwSkipEnclave = "skip-enclave"
+)
+
+// Exit codes of check-hw. 1 is left to the generic command failure.
+const (
+	hwExitOK           = 0
+	hwExitWarnings     = 2
+	hwExitIncompatible = 3
+)
+
+const (
+	hwStatusOK   = "ok"
+	hwStatusWarn = "warn"
+	hwStatusFail = "fail"
+	hwStatusSkip = "skip"
+)
+
+const (
+	cpuInfoPath     = "/proc/cpuinfo"
+	aesmSocketPath  = "/var/run/aesmd/aesm.socket"
+	sysNodeGlob     = "/sys/devices/system/node/node*/x86/sgx_total_bytes"
+	minEpcSizeBytes = 64 << 20
+)
+
+// sgxDevices are the device nodes of the known SGX drivers, in order of preference
+var sgxDevices = []struct {
+	path   string
+	driver string
+	legacy bool
+}{
+	{"/dev/sgx_enclave", "in-kernel (linux >= 5.11)", false},
+	{"/dev/sgx/enclave", "out-of-tree DCAP", false},
+	{"/dev/isgx", "out-of-tree legacy (isgx)", true},
+}
+
+// hwPlatform holds everything that was probed on the local machine
+type hwPlatform struct {
+	cpuFlags    map[string]bool
+	cpuModel    string
+	microcode   string
+	device      string
+	driver      string
+	legacy      bool
+	aesmErr     error
+	epcBytes    uint64
+	epcKnown    bool
+	enclaveRes  string
+	enclaveErr  error
+	enclaveSkip bool
+}
+
+// hwCheckResult is the result of a single hardware check
+type hwCheckResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// hwReport is the output of check-hw
+type hwReport struct {
+	Compatible bool            `json:"compatible"`
+	ExitCode   int             `json:"exit_code"`
+	Checks     []hwCheckResult `json:"checks"`
+}
+
+// hwCheckError makes secretd exit with the code of the report
+type hwCheckError struct {
+	code int
+}
+
+func (e hwCheckError) Error() string {
+	if e.code == hwExitWarnings {
+		return "hardware check passed with warnings"
+	}
+	return "hardware check failed"
+}
+
+// ExitCode implements tendermint's cli.ExitCoder
+func (e hwCheckError) ExitCode() int {
+	return e.code
+}
+
+// knownBadPlatforms are configurations that are known to break or degrade a node
+var knownBadPlatforms = []struct {
+	name   string
+	status string
+	detail string
+	match  func(p *hwPlatform) bool
+}{
+	{
+		name:   "legacy_driver_without_flc",
+		status: hwStatusFail,
+		detail: "the legacy isgx driver is used on a CPU without Flexible Launch Control, DCAP attestation is not possible",
+		match:  func(p *hwPlatform) bool { return p.legacy && !p.cpuFlags["sgx_lc"] },
+	},
+	{
+		name:   "legacy_driver_with_flc",
+		status: hwStatusWarn,
+		detail: "the legacy isgx driver is used although the CPU supports Flexible Launch Control, switch to the in-kernel driver",
+		match:  func(p *hwPlatform) bool { return p.legacy && p.cpuFlags["sgx_lc"] },
+	},
+	{
+		name:   "virtualized",
+		status: hwStatusWarn,
+		detail: "running under a hypervisor, make sure enough EPC is assigned to the guest and that the TCB is reported by the host",
+		match:  func(p *hwPlatform) bool { return p.cpuFlags["hypervisor"] },
+	},
+	{
+		name:   "small_epc",
+		status: hwStatusWarn,
+		detail: fmt.Sprintf("less than %d MiB of EPC, contract execution will page heavily", minEpcSizeBytes>>20),
+		match:  func(p *hwPlatform) bool { return p.epcKnown && p.epcBytes < minEpcSizeBytes },
+	},
+}
+
+// probeCPUInfo reads the flags, model name and microcode revision of the first CPU
+func probeCPUInfo(p *hwPlatform) error {
+	f, err := os.Open(cpuInfoPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	p.cpuFlags = make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			// an empty line ends the first CPU
+			if len(p.cpuFlags) > 0 {
+				break
+			}
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "flags":
+			for _, flag := range strings.Fields(value) {
+				p.cpuFlags[flag] = true
+			}
+		case "model name":
+			p.cpuModel = value
+		case "microcode":
+			p.microcode = value
+		}
+	}
+	return scanner.Err()
+}
+
+// probeEpcSize sums the EPC reported by the in-kernel driver over all NUMA nodes
+func probeEpcSize(p *hwPlatform) {
+	files, err := filepath.Glob(sysNodeGlob)
+	if err != nil || len(files) == 0 {
+		return
+	}
+	for _, file := range files {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return
+		}
+		size, err := strconv.ParseUint(strings.TrimSpace(string(bz)), 10, 64)
+		if err != nil {
+			return
+		}
+		p.epcBytes += size
+	}
+	p.epcKnown = true
+}
+
+func probePlatform(skipEnclave bool) (*hwPlatform, error) {
+	p := &hwPlatform{}
+	if err := probeCPUInfo(p); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", cpuInfoPath, err)
+	}
+
+	for _, dev := range sgxDevices {
+		if _, err := os.Stat(dev.path); err == nil {
+			p.device, p.driver, p.legacy = dev.path, dev.driver, dev.legacy
+			break
+		}
+	}
+
+	conn, err := net.DialTimeout("unix", aesmSocketPath, 2*time.Second)
+	if err == nil {
+		conn.Close()
+	}
+	p.aesmErr = err
+
+	probeEpcSize(p)
+
+	p.enclaveSkip = skipEnclave || p.device == ""
+	if !p.enclaveSkip {
+		res, err := api.HealthCheck()
+		p.enclaveRes, p.enclaveErr = string(res), err
+	}
+
+	return p, nil
+}
+
+func runHwChecks(p *hwPlatform) hwReport {
+	var checks []hwCheckResult
+	add := func(name, status, detail string) {
+		checks = append(checks, hwCheckResult{Name: name, Status: status, Detail: detail})
+	}
+
+	if p.cpuFlags["sgx"] {
+		add("cpu_sgx", hwStatusOK, p.cpuModel)
+	} else {
+		add("cpu_sgx", hwStatusFail, "the CPU does not report SGX, make sure it is enabled in the BIOS")
+	}
+
+	if p.cpuFlags["sgx_lc"] {
+		add("flc", hwStatusOK, "Flexible Launch Control is supported")
+	} else {
+		add("flc", hwStatusWarn, "Flexible Launch Control is not supported or not enabled")
+	}
+
+	if p.microcode != "" {
+		add("microcode", hwStatusOK, p.microcode)
+	} else {
+		add("microcode", hwStatusWarn, "the microcode revision is not reported by the kernel")
+	}
+
+	if p.device != "" {
+		add("sgx_driver", hwStatusOK, fmt.Sprintf("%s driver at %s", p.driver, p.device))
+	} else {
+		add("sgx_driver", hwStatusFail, "no SGX device found, install the SGX driver or upgrade to linux >= 5.11")
+	}
+
+	if p.aesmErr == nil {
+		add("aesm", hwStatusOK, fmt.Sprintf("aesmd is listening on %s", aesmSocketPath))
+	} else {
+		add("aesm", hwStatusFail, fmt.Sprintf("aesmd is not reachable: %s", p.aesmErr))
+	}
+
+	if p.epcKnown {
+		add("epc_size", hwStatusOK, fmt.Sprintf("%d MiB", p.epcBytes>>20))
+	} else {
+		add("epc_size", hwStatusWarn, "the EPC size is not reported by the kernel")
+	}
+
+	for _, bad := range knownBadPlatforms {
+		if bad.match(p) {
+			add(bad.name, bad.status, bad.detail)
+		}
+	}
+
+	switch {
+	case p.enclaveSkip:
+		add("enclave", hwStatusSkip, "the enclave was not started")
+	case p.enclaveErr != nil:
+		add("enclave", hwStatusFail, fmt.Sprintf("failed to start enclave: %s", p.enclaveErr))
+	default:
+		add("enclave", hwStatusOK, p.enclaveRes)
+	}
+
+	report := hwReport{Compatible: true, ExitCode: hwExitOK, Checks: checks}
+	for _, check := range checks {
+		switch check.Status {
+		case hwStatusFail:
+			report.Compatible = false
+			report.ExitCode = hwExitIncompatible
+		case hwStatusWarn:
+			if report.ExitCode == hwExitOK {
+				report.ExitCode = hwExitWarnings
+			}
+		}
+	}
+	return report
+}
+
+func CheckHardware() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-hw",
+		Short: "Check that this machine can run a Secret node",
+		Long: fmt.Sprintf(`Probe the SGX driver, Flexible Launch Control, aesmd, CPU microcode and EPC size,
+look for known-bad platform configurations, and start the enclave.
+
+Exits with %d if all checks pass, %d if some checks returned warnings and %d if the
+platform is not compatible.`, hwExitOK, hwExitWarnings, hwExitIncompatible),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asJSON, err := cmd.Flags().GetBool(flagCheckHwJSON)
+			if err != nil {
+				return err
+			}
+			skipEnclave, err := cmd.Flags().GetBool(flagCheckHwSkipEnclave)
+			if err != nil {
+				return err
+			}
+
+			platform, err := probePlatform(skipEnclave)
+			if err != nil {
+				return err
+			}
+			report := runHwChecks(platform)
+
+			if asJSON {
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(bz))
+			} else {
+				for _, check := range report.Checks {
+					fmt.Printf("[%-4s] %-26s %s\n", strings.ToUpper(check.Status), check.Name, check.Detail)
+				}
+			}
+
+			if report.ExitCode != hwExitOK {
+				return hwCheckError{code: report.ExitCode}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagCheckHwJSON, false, "Print the report as JSON")
+	cmd.Flags().Bool(flagCheckHwSkipEnclave, false, "Do not start the enclave")
+
+	return cmd
+}
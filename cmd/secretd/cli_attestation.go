@@ -123,6 +123,21 @@ func HealthCheck() *cobra.Command {
 	return cmd
 }
 
+func CheckHardware() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-hw",
+		Short: "Check that this machine can run a Secret node",
+		Long:  "Probe the SGX driver, Flexible Launch Control, aesmd, CPU microcode and EPC size of this machine",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			println("This is a secretd only function, yo")
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 func ResetEnclave() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reset-enclave",
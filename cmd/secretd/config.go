@@ -1,10 +1,18 @@
 package main
 
 import (
+	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/rosetta"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/app"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
 )
 
+// rosettaBlockchain is the blockchain identifier served by rosetta
+const rosettaBlockchain = "secret"
+
 // SecretAppConfig terra specify app config
 type SecretAppConfig struct {
 	serverconfig.Config
@@ -40,6 +48,10 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.GRPCWeb.Enable = true
 	srvCfg.GRPCWeb.EnableUnsafeCORS = true
 
+	// Compute messages are exposed by rosetta as generic operations, with the raw
+	// (encrypted) message fields as the operation metadata
+	srvCfg.Rosetta.Blockchain = rosettaBlockchain
+
 	// defaulting this to false until we can verify it's amazballs
 	srvCfg.GRPC.Concurrency = false
 
@@ -55,3 +67,22 @@ func initAppConfig() (string, interface{}) {
 
 	return secretAppTemplate, secretAppConfig
 }
+
+// applyRosettaDefaults serves rosetta as rosettaBlockchain when app.toml leaves the SDK's default
+// blockchain identifier, as the app.toml files written before it was set do
+func applyRosettaDefaults(cmd *cobra.Command) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	if blockchain := serverCtx.Viper.GetString("rosetta.blockchain"); blockchain == "" || blockchain == rosetta.DefaultBlockchain {
+		serverCtx.Viper.Set("rosetta.blockchain", rosettaBlockchain)
+	}
+}
+
+// rosettaCommand is the SDK's standalone rosetta command, serving rosettaBlockchain by default
+func rosettaCommand(encodingConfig app.EncodingConfig) *cobra.Command {
+	cmd := server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler)
+	if f := cmd.Flags().Lookup(rosetta.FlagBlockchain); f != nil {
+		f.DefValue = rosettaBlockchain
+		_ = f.Value.Set(rosettaBlockchain)
+	}
+	return cmd
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+)
+
+const (
+	flagContractStateHeight = "height"
+	flagContractStateOutput = "output"
+)
+
+// contractStateDump is the file format written by export-contract-state.
+// Values are the raw (encrypted) blobs as they are kept in the store.
+type contractStateDump struct {
+	ContractAddress string          `json:"contract_address"`
+	Height          int64           `json:"height"`
+	Checksum        string          `json:"checksum"`
+	State           []compute.Model `json:"state"`
+}
+
+// contractStateChecksum hashes the length-prefixed keys and values of the given models, in order
+func contractStateChecksum(models []compute.Model) string {
+	h := sha256.New()
+	lenBz := make([]byte, 8)
+	for _, model := range models {
+		binary.BigEndian.PutUint64(lenBz, uint64(len(model.Key)))
+		h.Write(lenBz)
+		h.Write(model.Key)
+		binary.BigEndian.PutUint64(lenBz, uint64(len(model.Value)))
+		h.Write(lenBz)
+		h.Write(model.Value)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ExportContractStateCmd dumps a contract's store from the local node's application database
+func ExportContractStateCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-contract-state [contract_address]",
+		Short: "Export the encrypted key/value store of a contract to a file",
+		Long: `Export the encrypted key/value store of a contract from the local node's
+application database to a file, along with the height and a checksum of its content.
+The node must not be running while the state is exported.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			contractAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := cmd.Flags().GetInt64(flagContractStateHeight)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flagContractStateOutput)
+			if err != nil {
+				return err
+			}
+			if outputFile == "" {
+				outputFile = fmt.Sprintf("%s.json", contractAddress.String())
+			}
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("failed to open application database: %w", err)
+			}
+			defer db.Close()
+
+			storeKey := sdk.NewKVStoreKey(compute.StoreKey)
+			cms := store.NewCommitMultiStore(db)
+			cms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, nil)
+			if err := cms.LoadLatestVersion(); err != nil {
+				return fmt.Errorf("failed to load application state: %w", err)
+			}
+
+			if height == 0 {
+				height = cms.LastCommitID().Version
+			}
+			cacheMs, err := cms.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load state at height %d: %w", height, err)
+			}
+
+			kvStore := cacheMs.GetKVStore(storeKey)
+			if !kvStore.Has(compute.GetContractAddressKey(contractAddress)) {
+				return fmt.Errorf("contract %s does not exist at height %d", contractAddress, height)
+			}
+
+			prefixStore := prefix.NewStore(kvStore, compute.GetContractStorePrefixKey(contractAddress))
+			iter := prefixStore.Iterator(nil, nil)
+			defer iter.Close()
+
+			models := []compute.Model{}
+			for ; iter.Valid(); iter.Next() {
+				models = append(models, compute.Model{
+					Key:   iter.Key(),
+					Value: iter.Value(),
+				})
+			}
+
+			dump := contractStateDump{
+				ContractAddress: contractAddress.String(),
+				Height:          height,
+				Checksum:        contractStateChecksum(models),
+				State:           models,
+			}
+
+			dumpBz, err := json.MarshalIndent(dump, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(outputFile, dumpBz, 0o600); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("exported %d entries of %s at height %d to %s\n", len(models), contractAddress, height, outputFile))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagContractStateHeight, 0, "Export the state at this height (defaults to the latest height)")
+	cmd.Flags().String(flagContractStateOutput, "", "Output file (defaults to <contract_address>.json)")
+
+	return cmd
+}
+
+// ImportContractStateCmd replaces a contract's state in genesis.json with a file written by
+// export-contract-state. This is meant for bootstrapping devnets from mainnet contract state.
+func ImportContractStateCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-contract-state [file]",
+		Short: "Import a contract's state exported by export-contract-state into genesis.json",
+		Long: `Import a contract's state exported by export-contract-state into genesis.json.
+The contract must already be part of the genesis compute state, its store is replaced by
+the content of the file. Only useful for devnets: the state is encrypted with the
+contract's key, which must also be available to the devnet's enclave.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := clientCtx.Codec
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			dumpBz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dump contractStateDump
+			if err := json.Unmarshal(dumpBz, &dump); err != nil {
+				return fmt.Errorf("failed to parse contract state file: %w", err)
+			}
+
+			if checksum := contractStateChecksum(dump.State); checksum != dump.Checksum {
+				return fmt.Errorf("checksum mismatch: file says %s, content hashes to %s", dump.Checksum, checksum)
+			}
+
+			contractAddress, err := sdk.AccAddressFromBech32(dump.ContractAddress)
+			if err != nil {
+				return err
+			}
+
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var computeGenState compute.GenesisState
+			if err := cdc.UnmarshalJSON(appState[compute.ModuleName], &computeGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal compute genesis state: %w", err)
+			}
+
+			found := false
+			for i, contract := range computeGenState.Contracts {
+				if bytes.Equal(contract.ContractAddress, contractAddress) {
+					computeGenState.Contracts[i].ContractState = dump.State
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("contract %s is not part of the genesis state", contractAddress)
+			}
+
+			if err := computeGenState.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid compute genesis state: %w", err)
+			}
+
+			computeGenStateBz, err := cdc.MarshalJSON(&computeGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal compute genesis state: %w", err)
+			}
+
+			appState[compute.ModuleName] = computeGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+
+			genDoc.AppState = appStateJSON
+			return genutil.ExportGenesisFile(genDoc, genFile)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/spf13/cobra"
+	tmcfg "github.com/tendermint/tendermint/config"
+	tmos "github.com/tendermint/tendermint/libs/os"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+)
+
+const (
+	flagContractsDir = "contracts-dir"
+	flagFastBlocks   = "fast-blocks"
+
+	devDenom         = "uscrt"
+	devAccountFunds  = "1000000000000000000" + devDenom
+	devValidatorBond = "1000000" + devDenom
+)
+
+// devAccounts are the pre-funded accounts of the dev chain, the same as in LocalSecret.
+// The first one is the validator.
+var devAccounts = []struct {
+	name     string
+	mnemonic string
+}{
+	{"a", "grant rice replace explain federal release fix clever romance raise often wild taxi quarter soccer fiber love must tape steak together observe swap guitar"},
+	{"b", "jelly shadow frog dirt dragon use armed praise universe win jungle close inmate rain oil canvas beauty pioneer chef soccer icon dizzy thunder meadow"},
+	{"c", "chair love bleak wonder skirt permit say assist aunt credit roast size obtain minute throw sand usual age smart exact enough room shadow charge"},
+	{"d", "word twist toast cloth movie predict advance crumble escape whale sail such angry muffin balcony keen move employ cook valve hurt glimpse breeze brick"},
+}
+
+// DevCmd returns the dev command, which boots a single validator chain for local development
+func DevCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Start a single node chain for local development",
+		Long: `Start a single node chain for local development, like LocalSecret.
+
+On the first run, the node home is initialized with a genesis that has a single validator,
+4 pre-funded accounts in the test keyring (a, b, c and d), short unbonding and voting periods,
+and the node is bootstrapped so no registration is needed. The wasm files in --contracts-dir
+are stored at genesis. Later runs restart the existing chain.
+
+Never use it for a real network: the account keys are public.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			config := server.GetServerContextFromCmd(cmd).Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			if !tmos.FileExists(config.GenesisFile()) {
+				chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+				contractsDir, _ := cmd.Flags().GetString(flagContractsDir)
+				if err := initDevChain(cmd, chainID, contractsDir); err != nil {
+					return err
+				}
+			}
+
+			if fastBlocks, _ := cmd.Flags().GetBool(flagFastBlocks); fastBlocks {
+				config.Consensus.TimeoutPropose = 200 * time.Millisecond
+				config.Consensus.TimeoutPrevote = 200 * time.Millisecond
+				config.Consensus.TimeoutPrecommit = 200 * time.Millisecond
+				config.Consensus.TimeoutCommit = 200 * time.Millisecond
+				tmcfg.WriteConfigFile(filepath.Join(config.RootDir, "config", "config.toml"), config)
+			}
+
+			if err := cmd.Flags().Set(flagIsBootstrap, "true"); err != nil {
+				return err
+			}
+			return runSubcommand(cmd, "start")
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "secretdev-1", "chain id of the dev chain")
+	cmd.Flags().String(flagContractsDir, "", "directory of wasm files to store at genesis")
+	cmd.Flags().Bool(flagFastBlocks, true, "produce blocks every ~200ms")
+
+	return cmd
+}
+
+// initDevChain initializes the node home with the genesis of a dev chain, by running the same
+// steps as the LocalSecret bootstrap script
+func initDevChain(cmd *cobra.Command, chainID string, contractsDir string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	if err := runSubcommand(cmd, "init", "dev", "--chain-id", chainID); err != nil {
+		return err
+	}
+	if err := updateGenesis(cmd, setDevParams); err != nil {
+		return err
+	}
+
+	kr, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, clientCtx.HomeDir, nil)
+	if err != nil {
+		return err
+	}
+	for _, account := range devAccounts {
+		info, err := kr.Key(account.name)
+		if err != nil {
+			info, err = kr.NewAccount(account.name, account.mnemonic, "", sdk.GetConfig().GetFullFundraiserPath(), hd.Secp256k1)
+			if err != nil {
+				return err
+			}
+		}
+		if err := runSubcommand(cmd, "add-genesis-account", info.GetAddress().String(), devAccountFunds); err != nil {
+			return err
+		}
+	}
+
+	validator := devAccounts[0].name
+	if err := runSubcommand(cmd, "gentx", validator, devValidatorBond, "--chain-id", chainID, "--keyring-backend", keyring.BackendTest); err != nil {
+		return err
+	}
+	if err := runSubcommand(cmd, "collect-gentxs"); err != nil {
+		return err
+	}
+
+	if contractsDir != "" {
+		creator, err := kr.Key(validator)
+		if err != nil {
+			return err
+		}
+		err = updateGenesis(cmd, func(clientCtx client.Context, appState map[string]json.RawMessage) error {
+			return addGenesisCodes(clientCtx, appState, contractsDir, creator.GetAddress())
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return runSubcommand(cmd, "init-bootstrap")
+}
+
+// setDevParams uses uscrt as the staking and fee denom, and shortens the unbonding and voting periods
+func setDevParams(clientCtx client.Context, appState map[string]json.RawMessage) error {
+	cdc := clientCtx.Codec
+
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	stakingGenState.Params.BondDenom = devDenom
+	stakingGenState.Params.UnbondingTime = 90 * time.Second
+	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+	var mintGenState minttypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[minttypes.ModuleName], &mintGenState)
+	mintGenState.Params.MintDenom = devDenom
+	appState[minttypes.ModuleName] = cdc.MustMarshalJSON(&mintGenState)
+
+	var crisisGenState crisistypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[crisistypes.ModuleName], &crisisGenState)
+	crisisGenState.ConstantFee.Denom = devDenom
+	appState[crisistypes.ModuleName] = cdc.MustMarshalJSON(&crisisGenState)
+
+	var govGenState govtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[govtypes.ModuleName], &govGenState)
+	govGenState.DepositParams.MinDeposit = sdk.NewCoins(sdk.NewCoin(devDenom, govGenState.DepositParams.MinDeposit.AmountOf(sdk.DefaultBondDenom)))
+	govGenState.DepositParams.MinExpeditedDeposit = sdk.NewCoins(sdk.NewCoin(devDenom, govGenState.DepositParams.MinExpeditedDeposit.AmountOf(sdk.DefaultBondDenom)))
+	govGenState.VotingParams.VotingPeriod = 90 * time.Second
+	govGenState.VotingParams.ExpeditedVotingPeriod = 15 * time.Second
+	appState[govtypes.ModuleName] = cdc.MustMarshalJSON(&govGenState)
+
+	return nil
+}
+
+// addGenesisCodes stores the wasm files of dir in the compute genesis, in the order of their names
+func addGenesisCodes(clientCtx client.Context, appState map[string]json.RawMessage, dir string, creator sdk.AccAddress) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.wasm"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	var computeGenState compute.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appState[compute.ModuleName], &computeGenState)
+
+	codeID := uint64(len(computeGenState.Codes))
+	for _, path := range paths {
+		wasmCode, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		codeID++
+		codeHash := sha256.Sum256(wasmCode)
+		computeGenState.Codes = append(computeGenState.Codes, compute.Code{
+			CodeID:    codeID,
+			CodeInfo:  compute.NewCodeInfo(codeHash[:], creator, "", ""),
+			CodeBytes: wasmCode,
+		})
+		fmt.Fprintf(os.Stderr, "storing %s as code %d\n", filepath.Base(path), codeID)
+	}
+
+	sequences := []compute.Sequence{{IDKey: compute.KeyLastCodeID, Value: codeID + 1}}
+	for _, seq := range computeGenState.Sequences {
+		if !bytes.Equal(seq.IDKey, compute.KeyLastCodeID) {
+			sequences = append(sequences, seq)
+		}
+	}
+	computeGenState.Sequences = sequences
+
+	appState[compute.ModuleName] = clientCtx.Codec.MustMarshalJSON(&computeGenState)
+	return nil
+}
+
+// updateGenesis applies update to the app state in the genesis file of the node
+func updateGenesis(cmd *cobra.Command, update func(client.Context, map[string]json.RawMessage) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	genFile := server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+	if err := update(clientCtx, appState); err != nil {
+		return err
+	}
+
+	genDoc.AppState, err = json.Marshal(appState)
+	if err != nil {
+		return err
+	}
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+// runSubcommand runs another secretd command in the context of cmd, as if it was invoked as
+// `secretd <args>` with the same home
+func runSubcommand(cmd *cobra.Command, args ...string) error {
+	sub, subArgs, err := cmd.Root().Find(args)
+	if err != nil {
+		return err
+	}
+	if err := sub.ParseFlags(subArgs); err != nil {
+		return err
+	}
+	sub.SetContext(cmd.Context())
+
+	if sub.PreRunE != nil {
+		if err := sub.PreRunE(sub, sub.Flags().Args()); err != nil {
+			return err
+		}
+	}
+	return sub.RunE(sub, sub.Flags().Args())
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -123,7 +124,11 @@ func NewRootCmd() (*cobra.Command, app.EncodingConfig) {
 
 			// bindFlags(cmd, ctx.Viper)
 
-			return server.InterceptConfigsPreRunHandler(cmd, secretAppTemplate, secretAppConfig)
+			if err := server.InterceptConfigsPreRunHandler(cmd, secretAppTemplate, secretAppConfig); err != nil {
+				return err
+			}
+			applyRosettaDefaults(cmd)
+			return nil
 			// return initConfig(&initClientCtx, cmd)
 		},
 		SilenceUsage: true,
@@ -163,6 +168,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		genutilcli.GenTxCmd(app.ModuleBasics(), encodingConfig.TxConfig, banktypes.GenesisBalancesIterator{}, app.DefaultNodeHome),
 		genutilcli.ValidateGenesisCmd(app.ModuleBasics()),
 		AddGenesisAccountCmd(app.DefaultNodeHome),
+		ExportContractStateCmd(app.DefaultNodeHome),
+		ImportContractStateCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		// testnetCmd(app.ModuleBasics, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
@@ -182,14 +189,16 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig) {
 		MigrateSealings(),
 		ConfigureSecret(),
 		HealthCheck(),
+		CheckHardware(),
 		ResetEnclave(),
 		AutoRegisterNode(),
+		DevCmd(),
 		keys.Commands(app.DefaultNodeHome),
 		clientconfig.Cmd(),
 	)
 
 	// add rosetta commands
-	rootCmd.AddCommand(server.RosettaCommand(encodingConfig.InterfaceRegistry, encodingConfig.Marshaler))
+	rootCmd.AddCommand(rosettaCommand(encodingConfig))
 
 	// This is needed for `newApp` and `exportAppStateAndTMValidators`
 	rootCmd.PersistentFlags().BoolVar(&bootstrap, flagIsBootstrap,
@@ -274,6 +283,14 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 	if err != nil {
 		panic(err)
 	}
+	minRetainBlocks := cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))
+
+	computeConfig := compute.GetConfig(appOpts)
+	if computeConfig.ArchiveMode {
+		// an archive node keeps every store version and block, whatever the pruning config says
+		pruningOpts = storetypes.PruneNothing
+		minRetainBlocks = 0
+	}
 
 	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
 	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
@@ -294,12 +311,12 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts serverty
 		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
 		bootstrap,
 		appOpts,
-		compute.GetConfig(appOpts),
+		computeConfig,
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(server.FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
-		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetMinRetainBlocks(minRetainBlocks),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
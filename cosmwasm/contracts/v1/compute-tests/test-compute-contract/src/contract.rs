@@ -417,6 +417,11 @@ pub fn execute(deps: DepsMut, env: Env, info: MessageInfo, msg: ExecuteMsg) -> S
         }
         ExecuteMsg::WasmMsg { ty } => wasm_msg(ty),
         ExecuteMsg::Increment { addition } => increment(env, deps, addition),
+        ExecuteMsg::ScheduledExecute { msg } => execute(deps, env, info, from_binary(&msg)?),
+        ExecuteMsg::ReceiveNative {} => {
+            let new_count = increment_simple(deps)?;
+            Ok(Response::new().add_attribute("received_count", new_count.to_string()))
+        }
         ExecuteMsg::SendFundsWithErrorWithReply {} => Ok(Response::new()
             .add_submessage(SubMsg {
                 id: 8000,
@@ -119,6 +119,10 @@ pub enum ExecuteMsg {
     IncrementTimes {
         times: u64,
     },
+    ScheduledExecute {
+        msg: Binary,
+    },
+    ReceiveNative {},
     LastMsgMarkerNop {},
     LastMsgMarker {},
     WasmMsg {
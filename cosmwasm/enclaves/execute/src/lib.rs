@@ -17,6 +17,9 @@ mod ecalls;
 pub mod registration;
 mod tests;
 
+#[cfg(all(feature = "software-dev", feature = "SGX_MODE_HW"))]
+compile_error!("the software-dev feature requires SGX_MODE=SW");
+
 #[allow(unused_imports)]
 #[cfg(feature = "SGX_MODE_HW")]
 use crate::registration::check_patch_level::ecall_check_patch_level;
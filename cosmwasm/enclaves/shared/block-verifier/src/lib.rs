@@ -8,7 +8,7 @@ pub mod r#const;
 
 pub mod wasm_messages;
 
-pub use wasm_messages::VERIFIED_BLOCK_MESSAGES;
+pub use wasm_messages::{VerifiedBlockMessages, VERIFIED_BLOCK_MESSAGES};
 
 mod txs;
 
@@ -54,6 +54,7 @@ pub mod tests {
             crate::wasm_messages::tests::check_parse_reg_from_tx();
             crate::wasm_messages::tests::test_wasm_msg_tracker();
             crate::wasm_messages::tests::test_mix_wasm_bank_msg_tracker_multiple_msgs();
+            crate::wasm_messages::tests::test_verified_app_hash_window();
             crate::validator_whitelist::tests::test_parse_validators();
         });
 
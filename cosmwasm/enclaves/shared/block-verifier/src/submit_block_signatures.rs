@@ -110,6 +110,7 @@ pub unsafe fn submit_block_signatures_impl(
     message_verifier.set_block_info(
         header.header.height.value(),
         header.header.time.unix_timestamp_nanos(),
+        header.header.app_hash.as_bytes().to_vec(),
     );
 
     #[cfg(feature = "random")]
@@ -11,11 +11,36 @@ pub fn message_is_reg(msg: &protobuf::well_known_types::Any) -> bool {
     )
 }
 
+/// Counts the instances (field 3) of a MsgBatchInstantiate, by reading its wire format
+fn batch_instance_count(bytes: &[u8]) -> usize {
+    let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+    let mut count = 0;
+    while let Ok(false) = is.eof() {
+        let (field_number, wire_type) = match is.read_tag_unpack() {
+            Ok(tag) => tag,
+            Err(_) => break,
+        };
+        if field_number == 3 {
+            count += 1;
+        }
+        if is.skip_field(wire_type).is_err() {
+            break;
+        }
+    }
+    count
+}
+
+/// How many of the last verified blocks queries may be committed to
+pub const VERIFIED_APP_HASH_WINDOW: usize = 100;
+
 #[derive(Debug, Clone, Default)]
 pub struct VerifiedBlockMessages {
     messages: VecDeque<Vec<u8>>,
     height: u64,
     time: i128,
+    app_hash: Vec<u8>,
+    // (height, app_hash) of the last verified blocks, oldest first
+    app_hashes: VecDeque<(u64, Vec<u8>)>,
 }
 
 impl VerifiedBlockMessages {
@@ -29,13 +54,27 @@ impl VerifiedBlockMessages {
 
     pub fn append_msg_from_tx(&mut self, mut tx: Tx) {
         for msg in tx.take_body().messages {
+            // every instance of a batch is verified against the whole msg
+            if msg.type_url == "/secret.compute.v1beta1.MsgBatchInstantiate" {
+                for _ in 1..batch_instance_count(&msg.value) {
+                    self.messages.push_back(msg.value.clone());
+                }
+            }
             self.messages.push_back(msg.value);
         }
     }
 
-    pub fn set_block_info(&mut self, height: u64, time: i128) {
+    pub fn set_block_info(&mut self, height: u64, time: i128, app_hash: Vec<u8>) {
         self.height = height;
         self.time = time;
+        self.app_hash = app_hash.clone();
+
+        // a node that restarts or rolls back may verify a height again
+        self.app_hashes.retain(|(h, _)| *h < height);
+        self.app_hashes.push_back((height, app_hash));
+        while self.app_hashes.len() > VERIFIED_APP_HASH_WINDOW {
+            self.app_hashes.pop_front();
+        }
     }
 
     pub fn height(&self) -> u64 {
@@ -44,6 +83,16 @@ impl VerifiedBlockMessages {
     pub fn time(&self) -> i128 {
         self.time
     }
+    pub fn app_hash(&self) -> &[u8] {
+        &self.app_hash
+    }
+    /// The app hash verified for height, if it is one of the last verified blocks
+    pub fn verified_app_hash(&self, height: u64) -> Option<&[u8]> {
+        self.app_hashes
+            .iter()
+            .find(|(h, _)| *h == height)
+            .map(|(_, app_hash)| app_hash.as_slice())
+    }
 
     pub fn clear(&mut self) {
         self.messages.clear()
@@ -218,4 +267,32 @@ pub mod tests {
         );
     }
     //
+
+    pub fn test_verified_app_hash_window() {
+        let mut verified = super::VerifiedBlockMessages::default();
+        assert_eq!(verified.verified_app_hash(1), None);
+
+        let window = super::VERIFIED_APP_HASH_WINDOW as u64;
+        for height in 1..=window + 10 {
+            verified.set_block_info(height, 0, height.to_be_bytes().to_vec());
+        }
+
+        // only the last blocks are kept
+        assert_eq!(verified.verified_app_hash(10), None);
+        assert_eq!(
+            verified.verified_app_hash(11),
+            Some(11u64.to_be_bytes().as_slice())
+        );
+        assert_eq!(
+            verified.verified_app_hash(window + 10),
+            Some((window + 10).to_be_bytes().as_slice())
+        );
+        assert_eq!(verified.verified_app_hash(window + 11), None);
+
+        // verifying a height again replaces it and everything after it
+        verified.set_block_info(window, 0, vec![1]);
+        assert_eq!(verified.verified_app_hash(window), Some([1u8].as_slice()));
+        assert_eq!(verified.verified_app_hash(window + 1), None);
+        assert_eq!(verified.height(), window);
+    }
 }
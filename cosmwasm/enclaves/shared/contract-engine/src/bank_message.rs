@@ -0,0 +1,24 @@
+use crate::types::{ParsedMessage, SecretMessage};
+use enclave_ffi_types::EnclaveError;
+
+/// Parse the plaintext message that the compute module sends to a contract that
+/// registered a bank receive hook, or to a SNIP-20 wrapper contract when converting coins.
+/// The message is not encrypted, but the MsgSend/MsgWrapCoin/MsgUnwrapCoin that
+/// triggered it must be part of the signed tx.
+pub fn parse_plaintext_verified_message(
+    plaintext_message: &[u8],
+) -> Result<ParsedMessage, EnclaveError> {
+    Ok(ParsedMessage {
+        should_verify_sig_info: true,
+        should_verify_input: true,
+        was_msg_encrypted: false,
+        should_encrypt_output: false,
+        secret_msg: SecretMessage {
+            nonce: [0; 32],
+            user_public_key: [0; 32],
+            msg: plaintext_message.into(),
+        },
+        decrypted_msg: plaintext_message.into(),
+        data_for_validation: None,
+    })
+}
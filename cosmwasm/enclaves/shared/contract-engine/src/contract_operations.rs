@@ -16,7 +16,7 @@ use log::*;
 use crate::cosmwasm_config::ContractOperation;
 
 #[cfg(feature = "light-client-validation")]
-use crate::contract_validation::verify_block_info;
+use crate::contract_validation::{verify_block_info, verify_query_block_commitment};
 
 use crate::contract_validation::{
     generate_admin_proof, generate_contract_key_proof, ReplyParams, ValidatedMessage,
@@ -24,15 +24,20 @@ use crate::contract_validation::{
 use crate::external::results::{
     HandleSuccess, InitSuccess, MigrateSuccess, QuerySuccess, UpdateAdminSuccess,
 };
+use crate::key_epoch::{enter_key_epoch, KeyEpoch};
 use crate::message::{is_ibc_msg, parse_message};
 use crate::types::ParsedMessage;
 
+#[cfg(feature = "light-client-validation")]
+use crate::types::BlockCommitment;
+
 use crate::random::update_msg_counter;
 
 #[cfg(feature = "random")]
 use crate::random::derive_random;
 #[cfg(feature = "random")]
 use crate::wasm3::Engine;
+use crate::wasm3::MAX_MEMORY_PAGES;
 
 use crate::hardcoded_admins::is_hardcoded_contract_admin;
 
@@ -86,6 +91,7 @@ pub fn init(
 
     //let start = Instant::now();
     let base_env: BaseEnv = extract_base_env(env)?;
+    let _key_epoch = enter_key_epoch(extract_key_epoch(env)?)?;
 
     #[cfg(feature = "light-client-validation")]
     verify_block_info(&base_env)?;
@@ -93,6 +99,7 @@ pub fn init(
     // let duration = start.elapsed();
     // trace!("Time elapsed in extract_base_env is: {:?}", duration);
     let query_depth = extract_query_depth(env)?;
+    let max_memory_pages = extract_max_memory_pages(env)?;
 
     //let start = Instant::now();
     let (sender, contract_address, block_height, sent_funds) = base_env.get_verification_params();
@@ -164,6 +171,7 @@ pub fn init(
         secret_msg.nonce,
         secret_msg.user_public_key,
         base_env.0.block.time,
+        max_memory_pages,
     )?;
     // let duration = start.elapsed();
     // trace!("Time elapsed in start_engine: {:?}", duration);
@@ -284,6 +292,7 @@ pub fn migrate(
 
     //let start = Instant::now();
     let base_env: BaseEnv = extract_base_env(env)?;
+    let _key_epoch = enter_key_epoch(extract_key_epoch(env)?)?;
 
     #[cfg(feature = "light-client-validation")]
     verify_block_info(&base_env)?;
@@ -291,6 +300,7 @@ pub fn migrate(
     // let duration = start.elapsed();
     // trace!("Time elapsed in extract_base_env is: {:?}", duration);
     let query_depth = extract_query_depth(env)?;
+    let max_memory_pages = extract_max_memory_pages(env)?;
 
     //let start = Instant::now();
     let (sender, contract_address, block_height, sent_funds) = base_env.get_verification_params();
@@ -370,6 +380,7 @@ pub fn migrate(
         secret_msg.nonce,
         secret_msg.user_public_key,
         base_env.0.block.time,
+        max_memory_pages,
     )?;
     // let duration = start.elapsed();
     // trace!("Time elapsed in start_engine: {:?}", duration);
@@ -533,11 +544,13 @@ pub fn handle(
     );
 
     let base_env: BaseEnv = extract_base_env(env)?;
+    let _key_epoch = enter_key_epoch(extract_key_epoch(env)?)?;
 
     #[cfg(feature = "light-client-validation")]
     verify_block_info(&base_env)?;
 
     let query_depth = extract_query_depth(env)?;
+    let max_memory_pages = extract_max_memory_pages(env)?;
 
     let (sender, contract_address, block_height, sent_funds) = base_env.get_verification_params();
 
@@ -616,6 +629,7 @@ pub fn handle(
         secret_msg.nonce,
         secret_msg.user_public_key,
         base_env.0.block.time,
+        max_memory_pages,
     )?;
 
     let mut versioned_env = base_env
@@ -627,8 +641,16 @@ pub fn handle(
     // But we don't want malicious actors using this enclave setting to fake any sender they want.
     // Therefore we'll use a null sender if it cannot be verified.
     match parsed_handle_type {
-        // Execute: msg.sender was already verified
-        HandleType::HANDLE_TYPE_EXECUTE => {}
+        // Execute, bank receive hooks & SNIP-20 conversions: msg.sender was already verified
+        HandleType::HANDLE_TYPE_EXECUTE
+        | HandleType::HANDLE_TYPE_BANK_RECEIVE
+        | HandleType::HANDLE_TYPE_SNIP20_CONVERSION => {}
+        // Scheduled calls: executed by the EndBlocker without a tx, so neither the sender nor
+        // the funds can be verified, set them to null
+        HandleType::HANDLE_TYPE_SCHEDULED_EXECUTE => {
+            versioned_env.set_msg_sender("");
+            versioned_env.clear_sent_funds();
+        }
         // Reply & IBC stuff: no msg.sender, set it to null just in case
         // WASM Hooks: cannot verify sender, set it to null
         HandleType::HANDLE_TYPE_REPLY
@@ -745,7 +767,12 @@ pub fn query(
     let contract_hash = contract_code.hash();
 
     let base_env: BaseEnv = extract_base_env(env)?;
+    let _key_epoch = enter_key_epoch(extract_key_epoch(env)?)?;
     let query_depth = extract_query_depth(env)?;
+    let max_memory_pages = extract_max_memory_pages(env)?;
+
+    #[cfg(feature = "light-client-validation")]
+    verify_query_block_commitment(extract_block_commitment(env)?.as_ref())?;
 
     let (_, contract_address, _, _) = base_env.get_verification_params();
 
@@ -776,6 +803,7 @@ pub fn query(
         secret_msg.nonce,
         secret_msg.user_public_key,
         base_env.0.block.time,
+        max_memory_pages,
     )?;
 
     let mut versioned_env = base_env
@@ -813,6 +841,7 @@ fn start_engine(
     nonce: IoNonce,
     user_public_key: Ed25519PublicKey,
     timestamp: u64,
+    max_memory_pages: u32,
 ) -> Result<crate::wasm3::Engine, EnclaveError> {
     crate::wasm3::Engine::new(
         context,
@@ -825,6 +854,7 @@ fn start_engine(
         user_public_key,
         query_depth,
         timestamp,
+        max_memory_pages,
     )
 }
 
@@ -869,3 +899,70 @@ fn extract_query_depth(env: &[u8]) -> Result<u32, EnclaveError> {
             env.query_depth
         })
 }
+
+#[derive(Debug, Serialize, Deserialize)]
+struct EnvWithMemoryLimit {
+    #[serde(default)]
+    max_memory_pages: u32,
+}
+
+/// Extract the memory limit of the contract instance, which the chain sets with the
+/// max_memory_pages param. It can only lower the limit of the engine.
+fn extract_max_memory_pages(env: &[u8]) -> Result<u32, EnclaveError> {
+    serde_json::from_slice::<EnvWithMemoryLimit>(env)
+        .map_err(|err| {
+            warn!(
+                "error while deserializing env into json {:?}: {}",
+                String::from_utf8_lossy(env),
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })
+        .map(|env| match env.max_memory_pages {
+            0 => MAX_MEMORY_PAGES,
+            pages => pages.min(MAX_MEMORY_PAGES),
+        })
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+struct EnvWithKeyEpoch {
+    #[serde(default)]
+    key_epoch: Option<KeyEpoch>,
+}
+
+/// Extract the key epoch the chain tags the call with. Calls without one run on the current
+/// keys.
+fn extract_key_epoch(env: &[u8]) -> Result<Option<KeyEpoch>, EnclaveError> {
+    serde_json::from_slice::<EnvWithKeyEpoch>(env)
+        .map_err(|err| {
+            warn!(
+                "error while deserializing env into json {:?}: {}",
+                String::from_utf8_lossy(env),
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })
+        .map(|env| env.key_epoch)
+}
+
+#[cfg(feature = "light-client-validation")]
+#[derive(Debug, Serialize, Deserialize)]
+struct EnvWithBlockCommitment {
+    #[serde(default)]
+    block_commitment: Option<BlockCommitment>,
+}
+
+/// Extract the header commitment the host passes along with queries
+#[cfg(feature = "light-client-validation")]
+fn extract_block_commitment(env: &[u8]) -> Result<Option<BlockCommitment>, EnclaveError> {
+    serde_json::from_slice::<EnvWithBlockCommitment>(env)
+        .map_err(|err| {
+            warn!(
+                "error while deserializing env into json {:?}: {}",
+                String::from_utf8_lossy(env),
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })
+        .map(|env| env.block_commitment)
+}
@@ -25,7 +25,10 @@ use crate::message::is_ibc_msg;
 use crate::types::SecretMessage;
 
 #[cfg(feature = "light-client-validation")]
-use block_verifier::VERIFIED_BLOCK_MESSAGES;
+use crate::types::BlockCommitment;
+
+#[cfg(feature = "light-client-validation")]
+use block_verifier::{VerifiedBlockMessages, VERIFIED_BLOCK_MESSAGES};
 
 extern crate hex;
 
@@ -52,6 +55,71 @@ fn is_subslice(larger: &[u8], smaller: &[u8]) -> bool {
     false
 }
 
+/// Verify that a query is pinned to a block the enclave verified.
+///
+/// The app hashes of the last `VERIFIED_APP_HASH_WINDOW` verified blocks are kept, queries
+/// for any other height are rejected, and so are all queries until the first block is verified
+/// after startup.
+///
+/// This only binds the claimed (height, app hash) of the query to a verified header. The store
+/// reads of the query are not proven against that app hash, so a host that serves forged store
+/// data is not detected.
+#[cfg(feature = "light-client-validation")]
+pub fn verify_query_block_commitment(
+    commitment: Option<&BlockCommitment>,
+) -> Result<(), EnclaveError> {
+    #[cfg(feature = "go-tests")]
+    {
+        let is_skip_light_client_validation = std::env::var("SKIP_LIGHT_CLIENT_VALIDATION");
+
+        if is_skip_light_client_validation
+            .unwrap_or_default()
+            .to_uppercase()
+            == "TRUE"
+        {
+            return Ok(());
+        }
+    }
+
+    check_query_block_commitment(&VERIFIED_BLOCK_MESSAGES.lock().unwrap(), commitment)
+}
+
+#[cfg(feature = "light-client-validation")]
+fn check_query_block_commitment(
+    verified_msgs: &VerifiedBlockMessages,
+    commitment: Option<&BlockCommitment>,
+) -> Result<(), EnclaveError> {
+    if verified_msgs.height() == 0 {
+        error!("query before the first verified block - 0xF6B4");
+        return Err(EnclaveError::ValidationFailure);
+    }
+
+    let commitment = match commitment {
+        Some(commitment) => commitment,
+        None => {
+            error!("query without block commitment - 0xF6B0");
+            return Err(EnclaveError::ValidationFailure);
+        }
+    };
+
+    if commitment.height > verified_msgs.height() {
+        error!("query for an unverified height - 0xF6B1");
+        return Err(EnclaveError::ValidationFailure);
+    }
+
+    match verified_msgs.verified_app_hash(commitment.height) {
+        Some(app_hash) if app_hash == commitment.app_hash.as_slice() => Ok(()),
+        Some(_) => {
+            error!("wrong app hash for this block - 0xF6B2");
+            Err(EnclaveError::ValidationFailure)
+        }
+        None => {
+            error!("query for a height outside of the verified window - 0xF6B3");
+            Err(EnclaveError::ValidationFailure)
+        }
+    }
+}
+
 #[cfg(feature = "light-client-validation")]
 pub fn verify_block_info(base_env: &BaseEnv) -> Result<(), EnclaveError> {
     #[cfg(feature = "go-tests")]
@@ -553,13 +621,55 @@ pub fn verify_params(
     current_admin: Option<&CanonicalAddr>,
     new_admin: Option<&CanonicalAddr>,
 ) -> Result<(), EnclaveError> {
+    if let Some(module) = &sig_info.module {
+        // We return here if the sender is a module account.
+        // Module accounts have no keys, so the signature is the one of the tx signer that made the module call.
+        // The module name comes from the host, so the msg, the funds and the contract are still verified against the signed tx.
+        // The host picks the first signer, any other signer of the tx whose sign doc verifies the call is as good.
+        verify_module_sender(module, sender)?;
+        let verify_module_call = |sig_info: &SigInfo| {
+            verify_module_call_signature(sig_info)?;
+            verify_input(
+                sig_info,
+                sent_funds,
+                sender,
+                contract_address,
+                secret_msg,
+                verify_params_type,
+                current_admin,
+                new_admin,
+            )
+        };
+        let result = verify_module_call(sig_info);
+        if result.is_err()
+            && sig_info
+                .signers
+                .iter()
+                .any(|signer| verify_module_call(&sig_info.for_signer(signer)).is_ok())
+        {
+            return Ok(());
+        }
+        return result;
+    }
+
+    // If the tx has multiple signers, verify against the sign doc of the sender
+    // rather than the one picked by the host
+    let sender_sig_info = get_sender_sig_info(sig_info, sender);
+    let sig_info = sender_sig_info.as_ref().unwrap_or(sig_info);
+
     if should_verify_sig_info {
         debug!("Verifying message signatures for: {:?}", sig_info);
 
         if let Some(callback_sig) = &sig_info.callback_sig {
             // We return here if there's a callback signature.
             // The sender is another contract in the same transaction, so there aren't any signed_bytes to verify or tx_bytes to check in the signed block.
-            return verify_callback_sig(callback_sig.as_slice(), sender, secret_msg, sent_funds);
+            return verify_callback_sig(
+                callback_sig.as_slice(),
+                sig_info.callback_sig_algorithm.as_deref(),
+                sender,
+                secret_msg,
+                sent_funds,
+            );
         }
 
         verify_signature(sig_info, sender)?;
@@ -583,6 +693,28 @@ pub fn verify_params(
     Ok(())
 }
 
+/// Finds the signer matching `sender` in the tx signer set and returns a SigInfo for it.
+///
+/// Returns None for callbacks, or if the signer set is empty or doesn't contain the sender.
+fn get_sender_sig_info(sig_info: &SigInfo, sender: &CanonicalAddr) -> Option<SigInfo> {
+    use protobuf::well_known_types::Any as AnyProto;
+
+    if sig_info.callback_sig.is_some() {
+        return None;
+    }
+
+    sig_info
+        .signers
+        .iter()
+        .find(|signer| {
+            AnyProto::parse_from_bytes(&signer.public_key.0)
+                .ok()
+                .and_then(|any_pub_key| CosmosPubKey::from_proto(&any_pub_key).ok())
+                .map_or(false, |public_key| &public_key.get_address() == sender)
+        })
+        .map(|signer| sig_info.for_signer(signer))
+}
+
 fn verify_signature(sig_info: &SigInfo, sender: &CanonicalAddr) -> Result<(), EnclaveError> {
     let sender_public_key = get_signer(sig_info, sender)?;
 
@@ -724,12 +856,12 @@ fn get_sdk_messages_from_sign_bytes(
                     EnclaveError::FailedTxVerification
                 })?;
             trace!("amino sign doc: {:?}", sign_doc);
-            let messages: Result<Vec<DirectSdkMsg>, _> = sign_doc
+            let messages: Result<Vec<Vec<DirectSdkMsg>>, _> = sign_doc
                 .msgs
                 .iter()
-                .map(|x| x.clone().into_direct_msg())
+                .map(|x| x.clone().into_direct_msgs())
                 .collect();
-            Ok(messages?)
+            Ok(messages?.into_iter().flatten().collect())
         }
         SIGN_MODE_EIP_191 => {
             let sign_bytes_as_string = String::from_utf8_lossy(&sign_info.sign_bytes.0).to_string();
@@ -763,12 +895,12 @@ fn get_sdk_messages_from_sign_bytes(
 
             trace!("eip191 sign doc: {:?}", sign_doc);
 
-            let messages: Result<Vec<DirectSdkMsg>, _> = sign_doc
+            let messages: Result<Vec<Vec<DirectSdkMsg>>, _> = sign_doc
                 .msgs
                 .iter()
-                .map(|x| x.clone().into_direct_msg())
+                .map(|x| x.clone().into_direct_msgs())
                 .collect();
-            Ok(messages?)
+            Ok(messages?.into_iter().flatten().collect())
         }
         _ => {
             warn!(
@@ -815,15 +947,74 @@ fn verify_tx_bytes(
     }
 }
 
+/// The modules whose accounts may be the sender of a contract call, see verify_module_sender.
+/// Keep in sync with ModuleSenders in x/compute/internal/types.
+const MODULE_SENDERS: &[&str] = &["gov"];
+
+/// Verifies that the sender is the account of the given module. The address of a module account is
+/// derived from the module name, like in the sdk, and nobody holds its key. So the proof doesn't let
+/// the chain claim the address of a user or of a contract as the sender, only of the modules in
+/// MODULE_SENDERS.
+///
+/// The module name comes from the host, so this alone doesn't prove that the module made the call.
+/// verify_params also requires a msg of the signed tx with the module account as its sender, and
+/// with the same msg, funds and contract, see verify_module_call_signature.
+fn verify_module_sender(module: &str, sender: &CanonicalAddr) -> Result<(), EnclaveError> {
+    if !MODULE_SENDERS.contains(&module) {
+        warn!("Module {:?} may not be the sender of a contract call", module);
+        return Err(EnclaveError::FailedTxVerification);
+    }
+
+    let module_address = &sha_256(module.as_bytes())[..20];
+    if sender.as_slice() != module_address {
+        warn!(
+            "Sender {:?} is not the account of module {}",
+            sender.as_slice(),
+            module
+        );
+        return Err(EnclaveError::FailedTxVerification);
+    }
+
+    info!("Message verified! msg.sender is the {} module", module);
+    Ok(())
+}
+
+/// Verifies the signature of the tx signer that made a module call. The module account has no key, so
+/// it's the signer whose signed msg has the module account as its sender and the module executes.
+fn verify_module_call_signature(sig_info: &SigInfo) -> Result<(), EnclaveError> {
+    use protobuf::well_known_types::Any as AnyProto;
+
+    let any_pub_key = AnyProto::parse_from_bytes(&sig_info.public_key.0).map_err(|err| {
+        warn!("failed to parse public key as Any: {:?}", err);
+        EnclaveError::FailedTxVerification
+    })?;
+    let signer_public_key = CosmosPubKey::from_proto(&any_pub_key).map_err(|err| {
+        warn!("failure to parse pubkey: {:?}", err);
+        EnclaveError::FailedTxVerification
+    })?;
+
+    verify_signature(sig_info, &signer_public_key.get_address())
+}
+
 /// Verify that the callback sig is appropriate.
 ///
 ///This is used when contracts send callbacks to each other.
 fn verify_callback_sig(
     callback_signature: &[u8],
+    callback_sig_algorithm: Option<&str>,
     sender: &CanonicalAddr,
     secret_msg: &SecretMessage,
     sent_funds: &[Coin],
 ) -> Result<(), EnclaveError> {
+    // Only sha256 callback signatures are produced by this enclave so far
+    match callback_sig_algorithm {
+        None | Some("sha256") => {}
+        Some(algorithm) => {
+            warn!("Unsupported callback signature algorithm: {}", algorithm);
+            return Err(EnclaveError::FailedTxVerification);
+        }
+    }
+
     if verify_callback_sig_impl(callback_signature, sender, secret_msg, sent_funds) {
         info!("Message verified! msg.sender is the calling contract");
         return Ok(());
@@ -921,3 +1112,70 @@ fn verify_input_params(
 
     Ok(true)
 }
+
+#[cfg(feature = "test")]
+pub mod tests {
+    use super::verify_module_sender;
+    use cw_types_v010::types::CanonicalAddr;
+    use enclave_crypto::sha_256;
+
+    fn module_address(module: &str) -> CanonicalAddr {
+        CanonicalAddr::from_vec(sha_256(module.as_bytes())[..20].to_vec())
+    }
+
+    pub fn test_verify_module_sender() {
+        assert!(verify_module_sender("gov", &module_address("gov")).is_ok());
+
+        // the sender must be the account of the module
+        assert!(verify_module_sender("gov", &module_address("distribution")).is_err());
+        assert!(verify_module_sender("gov", &CanonicalAddr::from_vec(vec![1; 20])).is_err());
+
+        // only the allowed modules may be senders, even with their own account
+        assert!(verify_module_sender("faucet", &module_address("faucet")).is_err());
+        assert!(verify_module_sender("", &module_address("")).is_err());
+    }
+
+    pub fn test_check_query_block_commitment() {
+        // the commitment is only checked with light client validation
+        #[cfg(feature = "light-client-validation")]
+        {
+            use super::check_query_block_commitment;
+            use crate::types::BlockCommitment;
+            use block_verifier::wasm_messages::VERIFIED_APP_HASH_WINDOW;
+            use block_verifier::VerifiedBlockMessages;
+            use cw_types_v010::encoding::Binary;
+
+            let commitment = |height: u64, app_hash: &[u8]| BlockCommitment {
+                height,
+                app_hash: Binary(app_hash.to_vec()),
+            };
+
+            // nothing is accepted before the first verified block
+            let mut verified = VerifiedBlockMessages::default();
+            assert!(check_query_block_commitment(&verified, None).is_err());
+            assert!(
+                check_query_block_commitment(&verified, Some(&commitment(0, &[0; 32]))).is_err()
+            );
+
+            let window = VERIFIED_APP_HASH_WINDOW as u64;
+            for height in 1..=window + 10 {
+                verified.set_block_info(height, 0, height.to_be_bytes().to_vec());
+            }
+            let head = window + 10;
+
+            let check = |height: u64, app_hash: &[u8]| {
+                check_query_block_commitment(&verified, Some(&commitment(height, app_hash))).is_ok()
+            };
+
+            assert!(check_query_block_commitment(&verified, None).is_err());
+            assert!(check(head, &head.to_be_bytes()));
+            assert!(check(11, &11u64.to_be_bytes()));
+
+            // a future height, a wrong app hash, or a height that is no longer kept
+            assert!(!check(head + 1, &(head + 1).to_be_bytes()));
+            assert!(!check(head, &[1; 32]));
+            assert!(!check(11, &12u64.to_be_bytes()));
+            assert!(!check(10, &10u64.to_be_bytes()));
+        }
+    }
+}
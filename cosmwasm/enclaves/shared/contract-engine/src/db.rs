@@ -439,6 +439,10 @@ fn encrypt_value_new(
     contract_key: &ContractKey,
     encryption_salt: &[u8],
 ) -> Result<Vec<u8>, WasmEngineError> {
+    if cfg!(feature = "plaintext-state") {
+        return Ok(plaintext_state_value.to_vec());
+    }
+
     let encryption_key = get_symmetrical_key_new(contract_key);
 
     encryption_key
@@ -460,6 +464,10 @@ fn decrypt_value_new(
     contract_key: &ContractKey,
     encryption_salt: &[u8],
 ) -> Result<Vec<u8>, WasmEngineError> {
+    if cfg!(feature = "plaintext-state") {
+        return Ok(encrypted_value.to_vec());
+    }
+
     let decryption_key = get_symmetrical_key_new(contract_key);
 
     decryption_key.decrypt_siv(encrypted_value, Some(&[encrypted_key, encryption_salt])).map_err(|err| {
@@ -477,6 +485,10 @@ fn encrypt_key_new(
     plaintext_state_key: &[u8],
     contract_key: &ContractKey,
 ) -> Result<Vec<u8>, WasmEngineError> {
+    if cfg!(feature = "plaintext-state") {
+        return Ok(plaintext_state_key.to_vec());
+    }
+
     let encryption_key = get_symmetrical_key_new(contract_key);
 
     encryption_key
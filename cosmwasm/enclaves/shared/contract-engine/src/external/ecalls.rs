@@ -108,6 +108,47 @@ fn ecall_configure_runtime_impl(config: RuntimeConfiguration) -> sgx_status_t {
     sgx_status_t::SGX_SUCCESS
 }
 
+/// # Safety
+/// Always use protection
+#[no_mangle]
+pub unsafe extern "C" fn ecall_warm_module_cache(
+    contract: *const u8,
+    contract_len: usize,
+) -> sgx_status_t {
+    ecall_warm_module_cache_impl(contract, contract_len)
+}
+
+/// This function prepares a contract's module and stores it in the module cache,
+/// so the first call to the contract after the node starts doesn't pay for it.
+///
+/// # Safety
+/// Always use protection
+unsafe fn ecall_warm_module_cache_impl(contract: *const u8, contract_len: usize) -> sgx_status_t {
+    validate_const_ptr!(contract, contract_len, sgx_status_t::SGX_ERROR_INVALID_PARAMETER);
+    validate_input_length!(
+        contract_len,
+        "contract",
+        MAX_WASM_LENGHT,
+        sgx_status_t::SGX_ERROR_INVALID_PARAMETER
+    );
+
+    let contract = std::slice::from_raw_parts(contract, contract_len);
+    let result =
+        panic::catch_unwind(|| crate::wasm3::module_cache::warm_module_cache(contract));
+
+    match result {
+        Ok(Ok(())) => sgx_status_t::SGX_SUCCESS,
+        Ok(Err(err)) => {
+            debug!("failed to warm the module cache: {:?}", err);
+            sgx_status_t::SGX_ERROR_UNEXPECTED
+        }
+        Err(_) => {
+            warn!("panic while warming the module cache");
+            sgx_status_t::SGX_ERROR_UNEXPECTED
+        }
+    }
+}
+
 /// Take a pointer as returned by `ecall_allocate` and recover the Vec<u8> inside of it.
 /// # Safety
 ///  This is a text
@@ -51,6 +51,8 @@ pub struct WasmCosts {
     pub external_secp256k1_sign: u32,
     /// Cost invoking ed25519_sign from WASM
     pub external_ed25519_sign: u32,
+    /// Cost invoking check_permit from WASM
+    pub external_check_permit: u32,
     pub external_check_gas_used: u32,
     pub external_minimum_gas_evaporate: u32,
 }
@@ -80,6 +82,7 @@ impl Default for WasmCosts {
             external_ed25519_batch_verify_each: 70000,
             external_secp256k1_sign: 100000,
             external_ed25519_sign: 75000,
+            external_check_permit: 120000,
             external_check_gas_used: 8192,
             external_minimum_gas_evaporate: 8000,
         }
@@ -12,12 +12,17 @@ pub fn verify_contract_address(msg: &DirectSdkMsg, contract_address: &HumanAddr)
         DirectSdkMsg::MsgExecuteContract { contract, .. }
         | DirectSdkMsg::MsgMigrateContract { contract, .. }
         | DirectSdkMsg::MsgUpdateAdmin { contract, .. }
-        | DirectSdkMsg::MsgClearAdmin { contract, .. } => {
-            verify_msg_execute_or_migrate_contract_address(contract_address, contract)
-        }
+        | DirectSdkMsg::MsgClearAdmin { contract, .. }
+        | DirectSdkMsg::MsgSend {
+            to_address: contract,
+            ..
+        } => verify_msg_execute_or_migrate_contract_address(contract_address, contract),
         // During sending an instantiate message the contract address is not yet known
         // so we cannot extract it from the message and compare it to the one in env
         DirectSdkMsg::MsgInstantiateContract { .. } => true,
+        // The wrapper contract of a denom is looked up in the SNIP-20 registry of the compute module,
+        // it is not part of the signed message
+        DirectSdkMsg::MsgWrapCoin { .. } | DirectSdkMsg::MsgUnwrapCoin { .. } => true,
         DirectSdkMsg::MsgRecvPacket {
             packet:
                 Packet {
@@ -67,6 +72,10 @@ fn verify_contract_address_msg_ack_or_timeout(
     if source_port == "transfer" {
         // Packet was sent from a contract via the transfer port.
         verify_contract_address_ibc_wasm_hooks_outgoing_transfer(data, contract_address)
+    } else if let Some(owner) = source_port.strip_prefix("icacontroller-") {
+        // Packet was sent from an interchain account owned by a contract.
+        // The controller port is derived from the owner's address.
+        owner == contract_address.as_str()
     } else {
         // Packet was sent from an IBC enabled contract
         verify_contract_address_ibc_contract(source_port, contract_address)
@@ -92,6 +92,39 @@ pub fn verify_and_get_sdk_msg<'sd>(
                 && sent_contract_address == contract
                 && sent_new_admin == Some(empty_canon)
         }
+        DirectSdkMsg::MsgSend {
+            from_address,
+            to_address,
+            ..
+        } => match verify_params_types {
+            VerifyParamsType::HandleType(HandleType::HANDLE_TYPE_BANK_RECEIVE) => {
+                verify_bank_receive(
+                    sent_wasm_input,
+                    sent_sender,
+                    sent_contract_address,
+                    from_address,
+                    to_address,
+                )
+            }
+            _ => false,
+        },
+        DirectSdkMsg::MsgWrapCoin { sender, .. } => match verify_params_types {
+            VerifyParamsType::HandleType(HandleType::HANDLE_TYPE_SNIP20_CONVERSION) => {
+                sent_sender == sender
+                    && verify_fixed_json_msg(sent_wasm_input, &serde_json::json!({"deposit": {}}))
+            }
+            _ => false,
+        },
+        DirectSdkMsg::MsgUnwrapCoin { sender, amount } => match verify_params_types {
+            VerifyParamsType::HandleType(HandleType::HANDLE_TYPE_SNIP20_CONVERSION) => {
+                sent_sender == sender
+                    && verify_fixed_json_msg(
+                        sent_wasm_input,
+                        &serde_json::json!({"redeem": {"amount": amount.amount, "denom": amount.denom}}),
+                    )
+            }
+            _ => false,
+        },
         DirectSdkMsg::MsgRecvPacket { packet, .. } => match verify_params_types {
             VerifyParamsType::HandleType(HandleType::HANDLE_TYPE_IBC_PACKET_RECEIVE) => {
                 verify_ibc_packet_recv(sent_wasm_input, packet)
@@ -131,6 +164,31 @@ pub fn verify_and_get_sdk_msg<'sd>(
     })
 }
 
+/// The message of a bank receive hook is fixed, only the coins and the sender vary
+pub fn verify_bank_receive(
+    sent_msg: &SecretMessage,
+    sent_sender: &CanonicalAddr,
+    sent_contract_address: &HumanAddr,
+    from_address: &CanonicalAddr,
+    to_address: &HumanAddr,
+) -> bool {
+    sent_sender == from_address
+        && sent_contract_address == to_address
+        && verify_fixed_json_msg(sent_msg, &serde_json::json!({"receive_native": {}}))
+}
+
+/// Messages that the compute module sends on behalf of a signed SDK message are fixed
+pub fn verify_fixed_json_msg(sent_msg: &SecretMessage, expected: &serde_json::Value) -> bool {
+    let sent_msg_value: Result<serde_json::Value, serde_json::Error> =
+        serde_json::from_slice(&sent_msg.msg);
+    if sent_msg_value.is_err() {
+        trace!("get_verified_msg: sent_msg.msg cannot be parsed as serde_json::Value: {:?} Error: {:?}", String::from_utf8_lossy(&sent_msg.msg), sent_msg_value.err());
+        return false;
+    }
+
+    sent_msg_value.unwrap() == *expected
+}
+
 pub fn verify_ibc_packet_recv(sent_msg: &SecretMessage, packet: &Packet) -> bool {
     let Packet {
         sequence,
@@ -10,7 +10,11 @@ pub fn verify_sent_funds(msg: &DirectSdkMsg, sent_funds_msg: &[Coin]) -> bool {
         | DirectSdkMsg::MsgInstantiateContract {
             init_funds: sent_funds,
             ..
+        }
+        | DirectSdkMsg::MsgSend {
+            amount: sent_funds, ..
         } => sent_funds_msg == sent_funds,
+        DirectSdkMsg::MsgWrapCoin { amount, .. } => sent_funds_msg == [amount.clone()],
         DirectSdkMsg::Other => false,
         DirectSdkMsg::MsgRecvPacket {
             packet:
@@ -44,7 +48,8 @@ pub fn verify_sent_funds(msg: &DirectSdkMsg, sent_funds_msg: &[Coin]) -> bool {
         | DirectSdkMsg::MsgTimeout { .. }
         | DirectSdkMsg::MsgMigrateContract { .. }
         | DirectSdkMsg::MsgUpdateAdmin { .. }
-        | DirectSdkMsg::MsgClearAdmin { .. } => sent_funds_msg.is_empty(),
+        | DirectSdkMsg::MsgClearAdmin { .. }
+        | DirectSdkMsg::MsgUnwrapCoin { .. } => sent_funds_msg.is_empty(),
     }
 }
 
@@ -15,6 +15,9 @@ pub fn verify_sender(sdk_msg: &DirectSdkMsg, sent_sender: &CanonicalAddr) -> Opt
         | DirectSdkMsg::MsgMigrateContract { .. }
         | DirectSdkMsg::MsgUpdateAdmin { .. }
         | DirectSdkMsg::MsgClearAdmin { .. }
+        | DirectSdkMsg::MsgSend { .. }
+        | DirectSdkMsg::MsgWrapCoin { .. }
+        | DirectSdkMsg::MsgUnwrapCoin { .. }
         | DirectSdkMsg::Other => {
             if sdk_msg.sender() != Some(sent_sender) {
                 trace!(
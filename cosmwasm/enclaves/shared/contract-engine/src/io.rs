@@ -5,6 +5,7 @@ use core::fmt;
 /// the consensus_io_exchange_keypair and a user-generated key to create a symmetric key
 /// that is unique to the user and the enclave
 ///
+use super::key_epoch::{io_exchange_keypair, selected_key_epoch};
 use super::types::{IoNonce, SecretMessage};
 use cw_types_v010::encoding::Binary;
 use cw_types_v010::types::{CanonicalAddr, Coin, LogAttribute};
@@ -124,9 +125,16 @@ pub struct WasmOutput {
 }
 
 pub fn calc_encryption_key(nonce: &IoNonce, user_public_key: &Ed25519PublicKey) -> AESKey {
-    let enclave_io_key = KEY_MANAGER.get_consensus_io_exchange_keypair().unwrap();
+    calc_encryption_key_in_epoch(nonce, user_public_key, selected_key_epoch())
+}
 
-    let tx_encryption_ikm = enclave_io_key.current.diffie_hellman(user_public_key);
+/// The encryption key of a user with the io exchange keys of the given key epoch
+pub fn calc_encryption_key_in_epoch(
+    nonce: &IoNonce,
+    user_public_key: &Ed25519PublicKey,
+    epoch: u32,
+) -> AESKey {
+    let tx_encryption_ikm = io_exchange_keypair(epoch).diffie_hellman(user_public_key);
 
     AESKey::new_from_slice(&tx_encryption_ikm).derive_key_from_this(nonce)
 }
@@ -0,0 +1,213 @@
+//! Selection of the enclave keys a contract call runs under.
+//!
+//! The chain schedules key epochs in the compute params, and tags every contract call with the
+//! active epoch and the epochs accepted at its height (`env.key_epoch`). Each epoch is a
+//! generation of sealed io exchange keys. Inputs encrypted to any accepted epoch are decrypted,
+//! and the outputs of the call are encrypted with the keys of the epoch that decrypted the
+//! input, so clients that haven't switched to the new keys keep working during the transition.
+
+use std::cell::RefCell;
+
+use log::*;
+use serde::{Deserialize, Serialize};
+
+use enclave_crypto::{KeyPair, KEY_MANAGER};
+use enclave_ffi_types::EnclaveError;
+
+/// The epoch of the io exchange keys derived from the genesis consensus seed
+pub const GENESIS_KEY_EPOCH: u32 = 0;
+/// The epoch of the io exchange keys derived from the current consensus seed
+pub const CURRENT_KEY_EPOCH: u32 = 1;
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct KeyEpoch {
+    pub active: u32,
+    pub accepted: Vec<u32>,
+}
+
+#[derive(Clone, Debug, PartialEq)]
+struct CallKeyEpochs {
+    // the accepted epochs, the active one first
+    accepted: Vec<u32>,
+    // the epoch whose keys encrypt the outputs of the call
+    selected: u32,
+}
+
+impl Default for CallKeyEpochs {
+    fn default() -> Self {
+        // calls that aren't tagged with an epoch run on the current keys, as they always did
+        CallKeyEpochs {
+            accepted: vec![CURRENT_KEY_EPOCH],
+            selected: CURRENT_KEY_EPOCH,
+        }
+    }
+}
+
+thread_local! {
+    static CALL_KEY_EPOCHS: RefCell<CallKeyEpochs> = RefCell::new(CallKeyEpochs::default());
+}
+
+/// Restores the key epochs of the enclosing call when dropped. Queries of other contracts
+/// enter the enclave again from within a call, on the same thread.
+pub struct KeyEpochGuard {
+    previous: CallKeyEpochs,
+}
+
+impl Drop for KeyEpochGuard {
+    fn drop(&mut self) {
+        let previous = std::mem::take(&mut self.previous);
+        CALL_KEY_EPOCHS.with(|epochs| *epochs.borrow_mut() = previous);
+    }
+}
+
+/// Run the call under the given key epoch for as long as the returned guard lives
+pub fn enter_key_epoch(key_epoch: Option<KeyEpoch>) -> Result<KeyEpochGuard, EnclaveError> {
+    let epochs = match key_epoch {
+        None => CallKeyEpochs::default(),
+        Some(key_epoch) => call_key_epochs(key_epoch)?,
+    };
+
+    let previous = CALL_KEY_EPOCHS.with(|current| current.replace(epochs));
+    Ok(KeyEpochGuard { previous })
+}
+
+fn call_key_epochs(key_epoch: KeyEpoch) -> Result<CallKeyEpochs, EnclaveError> {
+    if !key_epoch.accepted.contains(&key_epoch.active) {
+        error!(
+            "active key epoch {} is not accepted - 0xF6C0",
+            key_epoch.active
+        );
+        return Err(EnclaveError::ValidationFailure);
+    }
+
+    if let Some(unknown) = key_epoch
+        .accepted
+        .iter()
+        .find(|epoch| !is_known_key_epoch(**epoch))
+    {
+        error!("unknown key epoch {} - 0xF6C1", unknown);
+        return Err(EnclaveError::ValidationFailure);
+    }
+
+    let mut accepted = vec![key_epoch.active];
+    accepted.extend(
+        key_epoch
+            .accepted
+            .into_iter()
+            .filter(|epoch| *epoch != key_epoch.active),
+    );
+
+    Ok(CallKeyEpochs {
+        accepted,
+        selected: key_epoch.active,
+    })
+}
+
+fn is_known_key_epoch(epoch: u32) -> bool {
+    epoch == GENESIS_KEY_EPOCH || epoch == CURRENT_KEY_EPOCH
+}
+
+/// The io exchange keypair of a known key epoch
+pub fn io_exchange_keypair(epoch: u32) -> KeyPair {
+    let keypairs = KEY_MANAGER.get_consensus_io_exchange_keypair().unwrap();
+    match epoch {
+        GENESIS_KEY_EPOCH => keypairs.genesis,
+        _ => keypairs.current,
+    }
+}
+
+/// The epoch whose keys encrypt the outputs of the current call
+pub fn selected_key_epoch() -> u32 {
+    CALL_KEY_EPOCHS.with(|epochs| epochs.borrow().selected)
+}
+
+/// Try to decrypt with the keys of every accepted epoch, the active one first. The epoch that
+/// decrypts is selected to encrypt the outputs of the call.
+pub fn decrypt_in_accepted_epochs<T>(decrypt: impl Fn(u32) -> Option<T>) -> Option<T> {
+    let accepted = CALL_KEY_EPOCHS.with(|epochs| epochs.borrow().accepted.clone());
+
+    for epoch in accepted {
+        if let Some(decrypted) = decrypt(epoch) {
+            CALL_KEY_EPOCHS.with(|epochs| epochs.borrow_mut().selected = epoch);
+            return Some(decrypted);
+        }
+    }
+
+    None
+}
+
+#[cfg(feature = "test")]
+pub mod tests {
+    use super::*;
+
+    fn key_epoch(active: u32, accepted: &[u32]) -> Option<KeyEpoch> {
+        Some(KeyEpoch {
+            active,
+            accepted: accepted.to_vec(),
+        })
+    }
+
+    pub fn test_enter_key_epoch() {
+        assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+
+        {
+            let _outer =
+                enter_key_epoch(key_epoch(GENESIS_KEY_EPOCH, &[GENESIS_KEY_EPOCH])).unwrap();
+            assert_eq!(selected_key_epoch(), GENESIS_KEY_EPOCH);
+
+            {
+                // a query of another contract runs under its own env
+                let _inner = enter_key_epoch(None).unwrap();
+                assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+            }
+            assert_eq!(selected_key_epoch(), GENESIS_KEY_EPOCH);
+        }
+        assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+
+        // the active epoch must be accepted, and the enclave must have the keys of every epoch
+        assert!(enter_key_epoch(key_epoch(CURRENT_KEY_EPOCH, &[GENESIS_KEY_EPOCH])).is_err());
+        assert!(enter_key_epoch(key_epoch(CURRENT_KEY_EPOCH, &[CURRENT_KEY_EPOCH, 2])).is_err());
+        assert!(enter_key_epoch(key_epoch(2, &[CURRENT_KEY_EPOCH, 2])).is_err());
+        assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+    }
+
+    pub fn test_decrypt_in_accepted_epochs() {
+        // an input that only the genesis keys decrypt
+        let decrypt = |epoch: u32| match epoch {
+            GENESIS_KEY_EPOCH => Some(epoch),
+            _ => None,
+        };
+
+        {
+            // the keys of the previous epoch are accepted during the transition window
+            let _transition = enter_key_epoch(key_epoch(
+                CURRENT_KEY_EPOCH,
+                &[GENESIS_KEY_EPOCH, CURRENT_KEY_EPOCH],
+            ))
+            .unwrap();
+            assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+            assert_eq!(decrypt_in_accepted_epochs(decrypt), Some(GENESIS_KEY_EPOCH));
+            assert_eq!(selected_key_epoch(), GENESIS_KEY_EPOCH);
+        }
+
+        {
+            // but not after it
+            let _after =
+                enter_key_epoch(key_epoch(CURRENT_KEY_EPOCH, &[CURRENT_KEY_EPOCH])).unwrap();
+            assert_eq!(decrypt_in_accepted_epochs(decrypt), None);
+            assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+        }
+
+        // the active epoch is tried first
+        let _transition = enter_key_epoch(key_epoch(
+            CURRENT_KEY_EPOCH,
+            &[GENESIS_KEY_EPOCH, CURRENT_KEY_EPOCH],
+        ))
+        .unwrap();
+        assert_eq!(
+            decrypt_in_accepted_epochs(|epoch| Some(epoch)),
+            Some(CURRENT_KEY_EPOCH)
+        );
+        assert_eq!(selected_key_epoch(), CURRENT_KEY_EPOCH);
+    }
+}
@@ -8,6 +8,7 @@ extern crate sgx_tstd as std;
 extern crate sgx_rand;
 extern crate sgx_types;
 
+mod bank_message;
 mod contract_operations;
 mod contract_validation;
 mod cosmwasm_config;
@@ -20,11 +21,14 @@ mod ibc_denom_utils;
 mod ibc_message;
 mod input_validation;
 mod io;
+mod key_epoch;
 mod message;
 mod message_utils;
 mod query_chain;
+mod query_permit;
 mod random;
 mod reply_message;
+mod scheduled_message;
 mod hardcoded_admins;
 pub(crate) mod types;
 #[cfg(feature = "wasm3")]
@@ -36,6 +40,10 @@ pub use contract_validation::{check_cert_in_current_block, check_tx_in_current_b
 
 #[cfg(feature = "test")]
 pub mod tests {
+    use crate::contract_validation;
+    use crate::key_epoch;
+    use crate::query_permit;
+    use crate::scheduled_message;
     use crate::types;
 
     /// Catch failures like the standard test runner, and print similar information per test.
@@ -62,6 +70,16 @@ pub mod tests {
 
         count_failures!(failures, {
             types::tests::test_new_from_slice();
+            scheduled_message::tests::test_scheduled_message_is_wrapped();
+            contract_validation::tests::test_verify_module_sender();
+            contract_validation::tests::test_check_query_block_commitment();
+            key_epoch::tests::test_enter_key_epoch();
+            key_epoch::tests::test_decrypt_in_accepted_epochs();
+            query_permit::tests::test_verify_permit();
+            query_permit::tests::test_verify_tampered_permit();
+            query_permit::tests::test_verify_permit_high_s_signature();
+            query_permit::tests::test_verify_permit_pub_key_type();
+            query_permit::tests::test_verify_permit_too_long();
         });
 
         if failures != 0 {
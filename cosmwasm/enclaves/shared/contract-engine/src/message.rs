@@ -3,12 +3,14 @@ use log::trace;
 use enclave_cosmos_types::types::HandleType;
 use enclave_ffi_types::EnclaveError;
 
+use crate::bank_message::parse_plaintext_verified_message;
 use crate::execute_message::parse_execute_message;
 use crate::ibc_message::{
     parse_ibc_receive_message, parse_plaintext_ibc_protocol_message,
     parse_plaintext_ibc_validated_message,
 };
 use crate::reply_message::parse_reply_message;
+use crate::scheduled_message::parse_plaintext_scheduled_message;
 use crate::types::ParsedMessage;
 
 // Parse the message that was passed to handle (Based on the assumption that it might be a reply or IBC as well)
@@ -38,6 +40,10 @@ pub fn parse_message(
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => {
             parse_plaintext_ibc_validated_message(message)
         }
+        HandleType::HANDLE_TYPE_BANK_RECEIVE | HandleType::HANDLE_TYPE_SNIP20_CONVERSION => {
+            parse_plaintext_verified_message(message)
+        }
+        HandleType::HANDLE_TYPE_SCHEDULED_EXECUTE => parse_plaintext_scheduled_message(message),
     };
 }
 
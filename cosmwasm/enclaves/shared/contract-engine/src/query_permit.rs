@@ -0,0 +1,238 @@
+//! Verification of SNIP-24 query permits, exposed to contracts by the `check_permit` host function.
+//!
+//! A permit is signed by a wallet as an amino JSON sign doc that holds a single `query_permit` msg,
+//! with a zero account number and sequence and a zero fee, so that it can never be a valid tx.
+//! See https://github.com/SecretFoundation/SNIPs/blob/master/SNIP-24.md
+
+use bech32::ToBase32;
+use serde::{Deserialize, Serialize};
+
+use cw_types_v010::consts::BECH32_PREFIX_ACC_ADDR;
+use enclave_crypto::hash::ripemd::ripemd160;
+use enclave_crypto::sha_256;
+
+const PERMIT_MSG_TYPE: &str = "query_permit";
+const PERMIT_PUBKEY_TYPE: &str = "tendermint/PubKeySecp256k1";
+
+/// The largest permit that is verified, since the gas of check_permit doesn't depend on its size
+pub const MAX_PERMIT_SIZE: usize = 16 * 1024;
+
+#[derive(Deserialize)]
+pub struct Permit {
+    pub params: PermitParams,
+    pub signature: PermitSignature,
+}
+
+#[derive(Deserialize)]
+pub struct PermitParams {
+    pub allowed_tokens: Vec<String>,
+    pub permit_name: String,
+    pub chain_id: String,
+    pub permissions: Vec<String>,
+}
+
+#[derive(Deserialize)]
+pub struct PermitSignature {
+    pub pub_key: PubKey,
+    pub signature: String,
+}
+
+#[derive(Deserialize)]
+pub struct PubKey {
+    #[serde(rename = "type")]
+    pub r#type: String,
+    pub value: String,
+}
+
+// The fields of the sign doc types are declared in alphabetical order,
+// because the amino JSON that wallets sign has its keys sorted.
+
+#[derive(Serialize)]
+struct SignDoc<'a> {
+    account_number: &'static str,
+    chain_id: &'a str,
+    fee: Fee,
+    memo: &'static str,
+    msgs: [PermitMsg<'a>; 1],
+    sequence: &'static str,
+}
+
+#[derive(Serialize)]
+struct Fee {
+    amount: [Coin; 1],
+    gas: &'static str,
+}
+
+#[derive(Serialize)]
+struct Coin {
+    amount: &'static str,
+    denom: &'static str,
+}
+
+#[derive(Serialize)]
+struct PermitMsg<'a> {
+    #[serde(rename = "type")]
+    r#type: &'static str,
+    value: PermitContent<'a>,
+}
+
+#[derive(Serialize)]
+struct PermitContent<'a> {
+    allowed_tokens: &'a [String],
+    permissions: &'a [String],
+    permit_name: &'a str,
+}
+
+/// Verifies the signature of a SNIP-24 permit and returns the bech32 address of its signer.
+///
+/// Only the signature is checked. The contract still has to check the chain id, that it is one
+/// of the allowed tokens, that the permissions cover the query, and that the permit wasn't revoked.
+pub fn verify_permit(permit_json: &[u8]) -> Result<String, String> {
+    if permit_json.len() > MAX_PERMIT_SIZE {
+        return Err(format!("permit is longer than {} bytes", MAX_PERMIT_SIZE));
+    }
+    let permit: Permit =
+        serde_json::from_slice(permit_json).map_err(|err| format!("invalid permit: {}", err))?;
+
+    if permit.signature.pub_key.r#type != PERMIT_PUBKEY_TYPE {
+        return Err(format!(
+            "unsupported public key type {}",
+            permit.signature.pub_key.r#type
+        ));
+    }
+    let public_key = base64::decode(&permit.signature.pub_key.value)
+        .map_err(|err| format!("invalid public key: {}", err))?;
+    let signature = base64::decode(&permit.signature.signature)
+        .map_err(|err| format!("invalid signature: {}", err))?;
+
+    let sign_doc = SignDoc {
+        account_number: "0",
+        chain_id: &permit.params.chain_id,
+        fee: Fee {
+            amount: [Coin {
+                amount: "0",
+                denom: "uscrt",
+            }],
+            gas: "1",
+        },
+        memo: "",
+        msgs: [PermitMsg {
+            r#type: PERMIT_MSG_TYPE,
+            value: PermitContent {
+                allowed_tokens: &permit.params.allowed_tokens,
+                permissions: &permit.params.permissions,
+                permit_name: &permit.params.permit_name,
+            },
+        }],
+        sequence: "0",
+    };
+    let sign_bytes = serde_json::to_vec(&sign_doc)
+        .map_err(|err| format!("failed to serialize the signed permit: {}", err))?;
+
+    let message = secp256k1::Message::from_slice(&sha_256(&sign_bytes))
+        .map_err(|err| format!("invalid message hash: {}", err))?;
+    let signature = secp256k1::ecdsa::Signature::from_compact(&signature)
+        .map_err(|err| format!("invalid signature: {}", err))?;
+    let key = secp256k1::PublicKey::from_slice(&public_key)
+        .map_err(|err| format!("invalid public key: {}", err))?;
+    secp256k1::Secp256k1::verification_only()
+        .verify_ecdsa(&message, &signature, &key)
+        .map_err(|_| "failed to verify the signature of the permit".to_string())?;
+
+    // the address of the signer is derived from the compressed public key, like in the sdk
+    let address = ripemd160(&sha_256(&key.serialize()));
+    bech32::encode(BECH32_PREFIX_ACC_ADDR, address.to_base32())
+        .map_err(|err| format!("failed to encode the address of the signer: {}", err))
+}
+
+#[cfg(feature = "test")]
+pub mod tests {
+    use super::*;
+
+    use serde_json::{json, Value};
+
+    // signed with the secp256k1 key of the secret "query permit test"
+    const SIGNER: &str = "secret1mgq956rjkz3e0rcvtqwqwk3vs3e9ue5w49ptzn";
+    const PUB_KEY: &str = "A8b4R8tdzT/keqLSAjwUNlQWYJOCGsMSn3NFK8l6EoHe";
+    const SIGNATURE: &str =
+        "a4iCBdl1EQmAUHxlj6IrbbPCe6t0jgb9aIrK/mqmHzofsnRFtoXkO/WDANorvu7jeL+fdVAOq+0o/tPPwy4GgQ==";
+    // the same signature with s replaced by n - s, which is just as valid in plain ECDSA
+    const HIGH_S_SIGNATURE: &str =
+        "a4iCBdl1EQmAUHxlj6IrbbPCe6t0jgb9aIrK/mqmHzrgTYu6SXobxAp8/yXUQREbQe89cV859E6W04q9DQg6wA==";
+    // the compressed generator point, a valid key that didn't sign the permit
+    const OTHER_PUB_KEY: &str = "Anm+Zn753LusVaBilc6HCwcCm/zbLc4o2VnygVsW+BeY";
+
+    fn permit() -> Value {
+        json!({
+            "params": {
+                "allowed_tokens": ["secret18vd8fpwxzck93qlwghaj6arh4p7c5n8978vsyg"],
+                "permit_name": "test",
+                "chain_id": "secret-4",
+                "permissions": ["balance"]
+            },
+            "signature": {
+                "pub_key": {
+                    "type": PERMIT_PUBKEY_TYPE,
+                    "value": PUB_KEY
+                },
+                "signature": SIGNATURE
+            }
+        })
+    }
+
+    fn verify_modified_permit(pointer: &str, value: Value) -> Result<String, String> {
+        let mut permit = permit();
+        *permit.pointer_mut(pointer).unwrap() = value;
+        verify_permit(&serde_json::to_vec(&permit).unwrap())
+    }
+
+    pub fn test_verify_permit() {
+        let permit = serde_json::to_vec(&permit()).unwrap();
+        assert_eq!(verify_permit(&permit), Ok(SIGNER.to_string()));
+    }
+
+    pub fn test_verify_tampered_permit() {
+        let tampered = [
+            (
+                "/params/allowed_tokens",
+                json!(["secret1mgq956rjkz3e0rcvtqwqwk3vs3e9ue5w49ptzn"]),
+            ),
+            ("/params/permissions", json!(["balance", "history"])),
+            ("/params/chain_id", json!("pulsar-3")),
+            ("/params/permit_name", json!("other")),
+            ("/signature/pub_key/value", json!(OTHER_PUB_KEY)),
+        ];
+
+        for (pointer, value) in tampered.iter() {
+            assert_eq!(
+                verify_modified_permit(pointer, value.clone()),
+                Err("failed to verify the signature of the permit".to_string()),
+                "{}",
+                pointer
+            );
+        }
+    }
+
+    pub fn test_verify_permit_high_s_signature() {
+        // the signature must be normalized, otherwise it could be malleated into a second valid one
+        assert_eq!(
+            verify_modified_permit("/signature/signature", json!(HIGH_S_SIGNATURE)),
+            Err("failed to verify the signature of the permit".to_string())
+        );
+    }
+
+    pub fn test_verify_permit_pub_key_type() {
+        assert_eq!(
+            verify_modified_permit("/signature/pub_key/type", json!("tendermint/PubKeyEd25519")),
+            Err("unsupported public key type tendermint/PubKeyEd25519".to_string())
+        );
+    }
+
+    pub fn test_verify_permit_too_long() {
+        let mut permit = permit();
+        *permit.pointer_mut("/params/permit_name").unwrap() = json!("a".repeat(MAX_PERMIT_SIZE));
+        assert!(verify_permit(&serde_json::to_vec(&permit).unwrap())
+            .unwrap_err()
+            .starts_with("permit is longer than"));
+    }
+}
@@ -0,0 +1,52 @@
+use log::warn;
+
+use crate::types::{ParsedMessage, SecretMessage};
+use cw_types_v010::encoding::Binary;
+use enclave_ffi_types::EnclaveError;
+
+/// Parse the plaintext message of a call that was scheduled with MsgScheduleExecute, or of a cron.
+/// Scheduled calls are executed by the EndBlocker and are not part of any tx, so there is
+/// nothing to verify the message, its sender or its funds against: the host may call this with any
+/// message. So the contract only gets the message wrapped as `{"scheduled_execute":{"msg":<base64>}}`,
+/// which only contracts that opted in to scheduled calls handle, and the sender and the sent funds in
+/// its env are always empty.
+pub fn parse_plaintext_scheduled_message(
+    plaintext_message: &[u8],
+) -> Result<ParsedMessage, EnclaveError> {
+    let wrapped_message = serde_json::to_vec(&serde_json::json!({
+        "scheduled_execute": { "msg": Binary(plaintext_message.to_vec()) }
+    }))
+    .map_err(|err| {
+        warn!("failed to wrap the scheduled message: {}", err);
+        EnclaveError::FailedToSerialize
+    })?;
+
+    Ok(ParsedMessage {
+        should_verify_sig_info: false,
+        should_verify_input: false,
+        was_msg_encrypted: false,
+        should_encrypt_output: false,
+        secret_msg: SecretMessage {
+            nonce: [0; 32],
+            user_public_key: [0; 32],
+            msg: plaintext_message.into(),
+        },
+        decrypted_msg: wrapped_message,
+        data_for_validation: None,
+    })
+}
+
+#[cfg(feature = "test")]
+pub mod tests {
+    use super::parse_plaintext_scheduled_message;
+
+    pub fn test_scheduled_message_is_wrapped() {
+        let parsed = parse_plaintext_scheduled_message(br#"{"transfer":{}}"#).unwrap();
+        assert_eq!(
+            parsed.decrypted_msg,
+            br#"{"scheduled_execute":{"msg":"eyJ0cmFuc2ZlciI6e319"}}"#.to_vec()
+        );
+        assert!(!parsed.should_verify_sig_info);
+        assert!(!parsed.should_encrypt_output);
+    }
+}
@@ -1,10 +1,13 @@
 use log::*;
 use serde::{Deserialize, Serialize};
 
+#[cfg(feature = "light-client-validation")]
+use cw_types_v010::encoding::Binary;
 use enclave_crypto::{AESKey, Ed25519PublicKey, SIVEncryptable};
 use enclave_ffi_types::EnclaveError;
 
-use super::io::calc_encryption_key;
+use super::io::{calc_encryption_key, calc_encryption_key_in_epoch};
+use super::key_epoch::decrypt_in_accepted_epochs;
 
 pub type IoNonce = [u8; 32];
 #[derive(Serialize, Deserialize, PartialEq, Debug)]
@@ -25,6 +28,14 @@ pub struct ParsedMessage {
     pub data_for_validation: Option<Vec<u8>>,
 }
 
+/// The header the host claims a query runs against, checked against the verified headers
+#[cfg(feature = "light-client-validation")]
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct BlockCommitment {
+    pub height: u64,
+    pub app_hash: Binary,
+}
+
 pub struct DecryptedSecretMessage {
     pub secret_msg: SecretMessage,
     pub decrypted_msg: Vec<u8>,
@@ -45,18 +56,19 @@ impl SecretMessage {
 
     pub fn try_decrypt(&self) -> Option<Vec<u8>> {
         trace!("input before decryption: {:?}", base64::encode(&self.msg));
-        let key = self.encryption_key();
-
-        if let Ok(msg) = key.decrypt_siv(self.msg.as_slice(), None) {
-            trace!(
-                "input after decryption: {:?}",
-                String::from_utf8_lossy(&msg)
-            );
+        // the input may be encrypted to the keys of any accepted key epoch
+        let msg = decrypt_in_accepted_epochs(|epoch| {
+            calc_encryption_key_in_epoch(&self.nonce, &self.user_public_key, epoch)
+                .decrypt_siv(self.msg.as_slice(), None)
+                .ok()
+        })?;
 
-            return Some(msg.to_vec());
-        }
+        trace!(
+            "input after decryption: {:?}",
+            String::from_utf8_lossy(&msg)
+        );
 
-        None
+        Some(msg.to_vec())
     }
 
     pub fn decrypt(&self) -> Result<Vec<u8>, EnclaveError> {
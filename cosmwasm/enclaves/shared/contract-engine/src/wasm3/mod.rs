@@ -24,11 +24,13 @@ use crate::db::{remove_from_encrypted_state, write_multiple_keys};
 use crate::errors::{ToEnclaveError, ToEnclaveResult, WasmEngineError, WasmEngineResult};
 use crate::gas::{WasmCosts, READ_BASE_GAS, WRITE_BASE_GAS};
 use crate::query_chain::encrypt_and_query_chain;
+use crate::query_permit::verify_permit;
 use crate::random::MSG_COUNTER;
 use crate::types::IoNonce;
 
 use gas::{get_exhausted_amount, get_remaining_gas, use_gas};
 use module_cache::create_module_instance;
+pub use validation::MAX_MEMORY_PAGES;
 
 mod gas;
 pub mod module_cache;
@@ -224,6 +226,7 @@ pub struct Engine {
     api_version: CosmWasmApiVersion,
     #[allow(dead_code)]
     features: Vec<ContractFeature>,
+    max_memory_pages: u32,
 }
 
 impl Engine {
@@ -239,8 +242,16 @@ impl Engine {
         user_public_key: Ed25519PublicKey,
         query_depth: u32,
         timestamp: u64,
+        max_memory_pages: u32,
     ) -> Result<Engine, EnclaveError> {
         let versioned_code = create_module_instance(contract_code, &gas_costs, operation)?;
+        if versioned_code.initial_memory_pages > max_memory_pages {
+            error!(
+                "WASM Requested to initialize with {} pages, maximum allowed is {}",
+                versioned_code.initial_memory_pages, max_memory_pages
+            );
+            return Err(EnclaveError::CannotInitializeWasmMemory);
+        }
         let kv_cache = KvCache::new();
         let context = Context {
             context,
@@ -273,6 +284,7 @@ impl Engine {
             code: versioned_code.code,
             api_version: versioned_code.version,
             features: versioned_code.features,
+            max_memory_pages,
         })
     }
 
@@ -283,7 +295,7 @@ impl Engine {
         // let start = Instant::now();
         let runtime = self
             .environment
-            .new_runtime::<Context>(1024 * 60, Some(192 /* 12 MiB */))
+            .new_runtime::<Context>(1024 * 60, Some(self.max_memory_pages))
             .to_enclave_result()?;
         // let duration = start.elapsed();
         // trace!("Time elapsed in environment.new_runtime is: {:?}", duration);
@@ -356,6 +368,7 @@ impl Engine {
         link_fn(instance, "ed25519_batch_verify", host_ed25519_batch_verify)?;
         link_fn(instance, "secp256k1_sign", host_secp256k1_sign)?;
         link_fn(instance, "ed25519_sign", host_ed25519_sign)?;
+        link_fn(instance, "check_permit", host_check_permit)?;
         link_fn_no_args(instance, "check_gas", host_check_gas_used)?;
         link_fn(instance, "gas_evaporate", host_gas_evaporate)?;
 
@@ -1863,6 +1876,41 @@ fn host_ed25519_sign(
     Ok(to_low_half(ptr_to_region_in_wasm_vm) as i64)
 }
 
+/// Verifies the signature of a SNIP-24 permit for a fixed gas cost, and writes the address of its
+/// signer to the destination region. Returns 0 on success, or a pointer to an error message.
+fn host_check_permit(
+    context: &mut Context,
+    instance: &wasm3::Instance<Context>,
+    (permit_ptr, signer_region_ptr): (i32, i32),
+) -> WasmEngineResult<i32> {
+    let used_gas = context.gas_costs.external_check_permit as u64;
+    use_gas(instance, used_gas)?;
+
+    let permit = read_from_memory(instance, permit_ptr as u32).map_err(
+        debug_err!(err => "check_permit error while trying to read the permit from wasm memory: {err}"),
+    )?;
+
+    trace!(
+        "check_permit() was called from WASM code with {:?}",
+        String::from_utf8_lossy(&permit)
+    );
+
+    let signer = match verify_permit(&permit) {
+        Ok(signer) => signer,
+        Err(err) => {
+            debug!("check_permit() failed to verify the permit: {}", err);
+            return write_to_memory(instance, err.as_bytes())
+                .map(|n| n as i32)
+                .map_err(debug_err!("failed to write error message to contract"));
+        }
+    };
+
+    write_to_allocated_memory(instance, signer_region_ptr as u32, signer.as_bytes())?;
+
+    // return 0 == ok
+    Ok(0)
+}
+
 fn get_encryption_salt(timestamp: u64) -> Vec<u8> {
     let mut encryption_salt: Vec<u8> = vec![];
 
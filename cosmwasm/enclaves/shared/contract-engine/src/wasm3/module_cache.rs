@@ -20,14 +20,22 @@ pub struct VersionedCode {
     pub code: Vec<u8>,
     pub version: CosmWasmApiVersion,
     pub features: Vec<ContractFeature>,
+    /// The number of memory pages the module is initialized with
+    pub initial_memory_pages: u32,
 }
 
 impl VersionedCode {
-    pub fn new(code: Vec<u8>, version: CosmWasmApiVersion, features: Vec<ContractFeature>) -> Self {
+    pub fn new(
+        code: Vec<u8>,
+        version: CosmWasmApiVersion,
+        features: Vec<ContractFeature>,
+        initial_memory_pages: u32,
+    ) -> Self {
         Self {
             code,
             version,
             features,
+            initial_memory_pages,
         }
     }
 }
@@ -42,6 +50,21 @@ pub fn configure_module_cache(cap: usize) {
     MODULE_CACHE.write().unwrap().resize(cap)
 }
 
+/// Analyzes a contract's module and stores it in the cache, unless it's already there
+pub fn warm_module_cache(code: &[u8]) -> Result<(), EnclaveError> {
+    if MODULE_CACHE.read().unwrap().cap() == 0 {
+        return Ok(());
+    }
+
+    let contract_code = ContractCode::new(code);
+    create_module_instance(
+        &contract_code,
+        &WasmCosts::default(),
+        ContractOperation::Handle,
+    )?;
+    Ok(())
+}
+
 pub fn create_module_instance(
     contract_code: &ContractCode,
     gas_costs: &WasmCosts,
@@ -61,18 +84,21 @@ pub fn create_module_instance(
     let mut code = None;
     let mut api_version = CosmWasmApiVersion::Invalid;
     let mut features = vec![];
+    let mut initial_memory_pages = 0;
     trace!("peeking in cache");
     let peek_result = cache.peek(&contract_code.hash());
     if let Some(VersionedCode {
         code: cached_code,
         version: cached_ver,
         features: cached_features,
+        initial_memory_pages: cached_initial_memory_pages,
     }) = peek_result
     {
         trace!("found instance in cache!");
         code = Some(cached_code.clone());
         api_version = *cached_ver;
         features = cached_features.clone();
+        initial_memory_pages = *cached_initial_memory_pages;
     }
 
     drop(cache); // Release read lock
@@ -84,6 +110,7 @@ pub fn create_module_instance(
         code = Some(versioned_code.code);
         api_version = versioned_code.version;
         features = versioned_code.features;
+        initial_memory_pages = versioned_code.initial_memory_pages;
     }
 
     // If we analyzed the code in the previous step, insert it to the LRU cache
@@ -93,7 +120,7 @@ pub fn create_module_instance(
         trace!("storing code in cache");
         cache.put(
             contract_code.hash(),
-            VersionedCode::new(code, api_version, features.clone()),
+            VersionedCode::new(code, api_version, features.clone(), initial_memory_pages),
         );
     } else {
         // Touch the cache to update the LRU value
@@ -104,7 +131,12 @@ pub fn create_module_instance(
     let code = code.unwrap();
 
     trace!("returning built instance");
-    Ok(VersionedCode::new(code, api_version, features))
+    Ok(VersionedCode::new(
+        code,
+        api_version,
+        features,
+        initial_memory_pages,
+    ))
 }
 
 pub fn analyze_module(
@@ -151,7 +183,7 @@ pub fn analyze_module(
     };
     drop(exports);
 
-    validation::validate_memory(&mut module)?;
+    let initial_memory_pages = validation::validate_memory(&mut module)?;
 
     if let ContractOperation::Init = operation {
         if module.has_floats() {
@@ -164,5 +196,10 @@ pub fn analyze_module(
 
     let code = module.emit_wasm();
 
-    Ok(VersionedCode::new(code, cosmwasm_api_version, features))
+    Ok(VersionedCode::new(
+        code,
+        cosmwasm_api_version,
+        features,
+        initial_memory_pages,
+    ))
 }
@@ -4,7 +4,13 @@ use walrus::Module;
 
 use enclave_ffi_types::EnclaveError;
 
-pub fn validate_memory(module: &mut Module) -> Result<(), EnclaveError> {
+/// The most 64KiB pages of memory a contract instance may ever use (12 MiB).
+/// The chain may lower it with the max_memory_pages param.
+pub const MAX_MEMORY_PAGES: u32 = 192;
+
+/// Caps the memory of the module at MAX_MEMORY_PAGES, and returns the number of pages
+/// it requests to be initialized with.
+pub fn validate_memory(module: &mut Module) -> Result<u32, EnclaveError> {
     // Verify that there is no start function defined.
     if module.start.is_some() {
         return Err(EnclaveError::WasmModuleWithStart);
@@ -15,9 +21,10 @@ pub fn validate_memory(module: &mut Module) -> Result<(), EnclaveError> {
         return Err(EnclaveError::CannotInitializeWasmMemory);
     }
 
+    let mut initial_pages = 0;
     for memory in module.memories.iter_mut() {
         let requested_initial_pages: u32 = memory.initial;
-        let maximum_allowed_pages: u32 = 192; // 12 MiB
+        let maximum_allowed_pages: u32 = MAX_MEMORY_PAGES;
 
         if requested_initial_pages > maximum_allowed_pages {
             error!(
@@ -28,7 +35,8 @@ pub fn validate_memory(module: &mut Module) -> Result<(), EnclaveError> {
         }
 
         memory.maximum = Some(maximum_allowed_pages);
+        initial_pages = requested_initial_pages;
     }
 
-    Ok(())
+    Ok(initial_pages)
 }
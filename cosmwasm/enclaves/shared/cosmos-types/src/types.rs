@@ -162,6 +162,9 @@ pub enum HandleType {
     HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER = 8,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK = 9,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT = 10,
+    HANDLE_TYPE_BANK_RECEIVE = 11,
+    HANDLE_TYPE_SNIP20_CONVERSION = 12,
+    HANDLE_TYPE_SCHEDULED_EXECUTE = 13,
 }
 
 impl HandleType {
@@ -178,6 +181,9 @@ impl HandleType {
             8 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER),
             9 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK),
             10 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT),
+            11 => Ok(HandleType::HANDLE_TYPE_BANK_RECEIVE),
+            12 => Ok(HandleType::HANDLE_TYPE_SNIP20_CONVERSION),
+            13 => Ok(HandleType::HANDLE_TYPE_SCHEDULED_EXECUTE),
             _ => {
                 error!("unrecognized handle type: {}", value);
                 Err(EnclaveError::FailedToDeserialize)
@@ -198,6 +204,9 @@ impl HandleType {
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER => "execute",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK => "sudo",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => "sudo",
+            HandleType::HANDLE_TYPE_BANK_RECEIVE => "execute",
+            HandleType::HANDLE_TYPE_SNIP20_CONVERSION => "execute",
+            HandleType::HANDLE_TYPE_SCHEDULED_EXECUTE => "execute",
         }
     }
 }
@@ -213,6 +222,17 @@ pub enum VerifyParamsType {
     UpdateAdmin,
 }
 
+/// Verification info of a single signer of the tx
+#[derive(Deserialize, Clone, Debug, PartialEq)]
+pub struct TxSignerInfo {
+    pub sign_bytes: Binary,
+    #[serde(with = "SignModeDef")]
+    pub sign_mode: proto::tx::signing::SignMode,
+    pub mode_info: Binary,
+    pub public_key: Binary,
+    pub signature: Binary,
+}
+
 #[derive(Deserialize, Clone, Debug, PartialEq)]
 pub struct SigInfo {
     pub tx_bytes: Binary,
@@ -223,6 +243,39 @@ pub struct SigInfo {
     pub public_key: Binary,
     pub signature: Binary,
     pub callback_sig: Option<Binary>,
+    /// The algorithm `callback_sig` was produced with, as decoded from its envelope.
+    /// `None` for legacy (un-enveloped) sha256 signatures.
+    #[serde(default)]
+    pub callback_sig_algorithm: Option<String>,
+    /// The name of the module that sends the msg in place of a signer of the tx.
+    /// The sender must be the address of the module account, which is derived from the name, and
+    /// only the modules allowed by the enclave may be senders. The msg must still be a msg of the
+    /// signed tx with the module account as its sender.
+    #[serde(default)]
+    pub module: Option<String>,
+    /// Verification info of every signer of a tx with more than one signer, empty otherwise.
+    /// The sign doc a msg is verified against is picked from it by the enclave, instead of
+    /// relying on the signer picked by the host.
+    #[serde(default)]
+    pub signers: Vec<TxSignerInfo>,
+}
+
+impl SigInfo {
+    /// Returns this SigInfo with the sign doc and signature of the given signer
+    pub fn for_signer(&self, signer: &TxSignerInfo) -> SigInfo {
+        SigInfo {
+            tx_bytes: self.tx_bytes.clone(),
+            sign_bytes: signer.sign_bytes.clone(),
+            sign_mode: signer.sign_mode,
+            mode_info: signer.mode_info.clone(),
+            public_key: signer.public_key.clone(),
+            signature: signer.signature.clone(),
+            callback_sig: self.callback_sig.clone(),
+            callback_sig_algorithm: self.callback_sig_algorithm.clone(),
+            module: self.module.clone(),
+            signers: vec![],
+        }
+    }
 }
 
 // Should be in sync with https://github.com/cosmos/cosmos-sdk/blob/v0.38.3/x/auth/types/stdtx.go#L216
@@ -287,11 +340,15 @@ impl TxBody {
             EnclaveError::FailedToDeserialize
         })?;
 
-        let messages = tx_body
-            .messages
-            .into_iter()
-            .map(|any| DirectSdkMsg::from_bytes(&any.type_url, &any.value))
-            .collect::<Result<Vec<_>, _>>()?;
+        let mut messages = Vec::with_capacity(tx_body.messages.len());
+        for any in tx_body.messages.into_iter() {
+            // a batch is verified as one MsgInstantiateContract per instance
+            if any.type_url == "/secret.compute.v1beta1.MsgBatchInstantiate" {
+                messages.extend(DirectSdkMsg::try_parse_batch_instantiate(&any.value)?);
+            } else {
+                messages.push(DirectSdkMsg::from_bytes(&any.type_url, &any.value)?);
+            }
+        }
 
         Ok(TxBody {
             messages,
@@ -322,6 +379,23 @@ pub enum AminoSdkMsg {
         #[serde(default)]
         admin: HumanAddr,
     },
+    /// Expanded into one MsgInstantiateContract per instance by into_direct_msgs
+    #[serde(alias = "wasm/MsgBatchInstantiate")]
+    BatchInstantiate {
+        sender: HumanAddr,
+        code_id: String,
+        instances: Vec<AminoBatchInstance>,
+    },
+    /// The wasm code, source and builder are ignored
+    #[serde(alias = "wasm/MsgStoreCodeAndInstantiate")]
+    StoreCodeAndInstantiate {
+        sender: HumanAddr,
+        init_msg: String,
+        init_funds: Vec<Coin>,
+        label: String,
+        #[serde(default)]
+        admin: HumanAddr,
+    },
     #[serde(alias = "wasm/MsgMigrateContract")]
     Migrate {
         sender: HumanAddr,
@@ -340,11 +414,31 @@ pub enum AminoSdkMsg {
         sender: HumanAddr,
         contract: HumanAddr,
     },
+    #[serde(alias = "cosmos-sdk/MsgSend")]
+    MsgSend {
+        from_address: HumanAddr,
+        to_address: HumanAddr,
+        amount: Vec<Coin>,
+    },
+    #[serde(alias = "wasm/MsgWrapCoin")]
+    MsgWrapCoin { sender: HumanAddr, amount: Coin },
+    #[serde(alias = "wasm/MsgUnwrapCoin")]
+    MsgUnwrapCoin { sender: HumanAddr, amount: Coin },
     // The core IBC messages don't support Amino
     #[serde(other, deserialize_with = "deserialize_ignore_any")]
     Other,
 }
 
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct AminoBatchInstance {
+    pub label: String,
+    pub init_msg: String,
+    #[serde(default)]
+    pub init_funds: Vec<Coin>,
+    #[serde(default)]
+    pub admin: HumanAddr,
+}
+
 pub fn deserialize_ignore_any<'de, D: serde::Deserializer<'de>, T: Default>(
     deserializer: D,
 ) -> Result<T, D::Error> {
@@ -352,8 +446,37 @@ pub fn deserialize_ignore_any<'de, D: serde::Deserializer<'de>, T: Default>(
 }
 
 impl AminoSdkMsg {
+    /// Converts the msg into the direct msgs it is verified as. All msgs but batches convert to one.
+    pub fn into_direct_msgs(self) -> Result<Vec<DirectSdkMsg>, EnclaveError> {
+        match self {
+            Self::BatchInstantiate {
+                sender,
+                code_id,
+                instances,
+            } => instances
+                .into_iter()
+                .map(|instance| {
+                    Self::Instantiate {
+                        sender: sender.clone(),
+                        code_id: code_id.clone(),
+                        init_msg: instance.init_msg,
+                        init_funds: instance.init_funds,
+                        label: instance.label,
+                        admin: instance.admin,
+                    }
+                    .into_direct_msg()
+                })
+                .collect(),
+            msg => Ok(vec![msg.into_direct_msg()?]),
+        }
+    }
+
     pub fn into_direct_msg(self) -> Result<DirectSdkMsg, EnclaveError> {
         match self {
+            Self::BatchInstantiate { .. } => {
+                warn!("a batch converts to many direct msgs, use into_direct_msgs");
+                Err(EnclaveError::FailedToDeserialize)
+            }
             Self::Migrate {
                 sender,
                 msg,
@@ -450,6 +573,35 @@ impl AminoSdkMsg {
                     admin,
                 })
             }
+            Self::StoreCodeAndInstantiate {
+                sender,
+                init_msg,
+                init_funds,
+                label,
+                admin,
+            } => {
+                let sender = CanonicalAddr::from_human(&sender).map_err(|err| {
+                    warn!("failed to turn human addr to canonical addr when parsing DirectSdkMsg: {:?}", err);
+                    EnclaveError::FailedToDeserialize
+                })?;
+                let init_msg = Binary::from_base64(&init_msg).map_err(|err| {
+                    warn!(
+                        "failed to parse base64 init_msg when parsing DirectSdkMsg: {:?}",
+                        err
+                    );
+                    EnclaveError::FailedToDeserialize
+                })?;
+
+                // the code is stored in the same msg, so its code id is not known when signing
+                Ok(DirectSdkMsg::MsgInstantiateContract {
+                    sender,
+                    code_id: 0,
+                    init_msg: init_msg.0,
+                    init_funds,
+                    label,
+                    admin,
+                })
+            }
             AminoSdkMsg::MsgUpdateAdmin {
                 sender,
                 new_admin,
@@ -474,6 +626,38 @@ impl AminoSdkMsg {
 
                 Ok(DirectSdkMsg::MsgClearAdmin { sender, contract })
             }
+            AminoSdkMsg::MsgSend {
+                from_address,
+                to_address,
+                amount,
+            } => {
+                let from_address = CanonicalAddr::from_human(&from_address).map_err(|err| {
+                    warn!("failed to turn human addr to canonical addr when parsing DirectSdkMsg: {:?}", err);
+                    EnclaveError::FailedToDeserialize
+                })?;
+
+                Ok(DirectSdkMsg::MsgSend {
+                    from_address,
+                    to_address,
+                    amount,
+                })
+            }
+            AminoSdkMsg::MsgWrapCoin { sender, amount } => {
+                let sender = CanonicalAddr::from_human(&sender).map_err(|err| {
+                    warn!("failed to turn human addr to canonical addr when parsing DirectSdkMsg: {:?}", err);
+                    EnclaveError::FailedToDeserialize
+                })?;
+
+                Ok(DirectSdkMsg::MsgWrapCoin { sender, amount })
+            }
+            AminoSdkMsg::MsgUnwrapCoin { sender, amount } => {
+                let sender = CanonicalAddr::from_human(&sender).map_err(|err| {
+                    warn!("failed to turn human addr to canonical addr when parsing DirectSdkMsg: {:?}", err);
+                    EnclaveError::FailedToDeserialize
+                })?;
+
+                Ok(DirectSdkMsg::MsgUnwrapCoin { sender, amount })
+            }
             Self::Other => Ok(DirectSdkMsg::Other),
         }
     }
@@ -663,6 +847,20 @@ pub enum DirectSdkMsg {
         sender: CanonicalAddr,
         contract: HumanAddr,
     },
+    MsgWrapCoin {
+        sender: CanonicalAddr,
+        amount: Coin,
+    },
+    MsgUnwrapCoin {
+        sender: CanonicalAddr,
+        amount: Coin,
+    },
+    // Bank:
+    MsgSend {
+        from_address: CanonicalAddr,
+        to_address: HumanAddr,
+        amount: Vec<Coin>,
+    },
     // IBC:
     // MsgChannelOpenInit {}, // TODO
     // MsgChannelOpenTry {}, // TODO
@@ -698,10 +896,16 @@ impl DirectSdkMsg {
     pub fn from_bytes(type_url: &str, bytes: &[u8]) -> Result<Self, EnclaveError> {
         match type_url {
             "/secret.compute.v1beta1.MsgInstantiateContract" => Self::try_parse_instantiate(bytes),
+            "/secret.compute.v1beta1.MsgStoreCodeAndInstantiate" => {
+                Self::try_parse_store_code_and_instantiate(bytes)
+            }
             "/secret.compute.v1beta1.MsgExecuteContract" => Self::try_parse_execute(bytes),
             "/secret.compute.v1beta1.MsgMigrateContract" => Self::try_parse_migrate(bytes),
             "/secret.compute.v1beta1.MsgUpdateAdmin" => Self::try_parse_update_admin(bytes),
             "/secret.compute.v1beta1.MsgClearAdmin" => Self::try_parse_clear_admin(bytes),
+            "/secret.compute.v1beta1.MsgWrapCoin" => Self::try_parse_wrap_coin(bytes, false),
+            "/secret.compute.v1beta1.MsgUnwrapCoin" => Self::try_parse_wrap_coin(bytes, true),
+            "/cosmos.bank.v1beta1.MsgSend" => Self::try_parse_bank_send(bytes),
             "/ibc.core.channel.v1.MsgRecvPacket" => Self::try_parse_ibc_recv_packet(bytes),
             "/ibc.core.channel.v1.MsgAcknowledgement" => Self::try_parse_ibc_ack(bytes),
             "/ibc.core.channel.v1.MsgTimeout" => Self::try_parse_ibc_timeout(bytes),
@@ -880,6 +1084,227 @@ impl DirectSdkMsg {
         })
     }
 
+    /// cosmos.bank.v1beta1.MsgSend is not part of the generated protobuf types,
+    /// so its three fields are read directly from the wire format.
+    fn try_parse_bank_send(bytes: &[u8]) -> Result<Self, EnclaveError> {
+        fn read_msg_send(
+            bytes: &[u8],
+        ) -> protobuf::ProtobufResult<(String, String, Vec<proto::base::coin::Coin>)> {
+            let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+            let mut from_address = String::new();
+            let mut to_address = String::new();
+            let mut amount = vec![];
+            while !is.eof()? {
+                let (field_number, wire_type) = is.read_tag_unpack()?;
+                match field_number {
+                    1 => from_address = is.read_string()?,
+                    2 => to_address = is.read_string()?,
+                    3 => amount.push(is.read_message::<proto::base::coin::Coin>()?),
+                    _ => is.skip_field(wire_type)?,
+                }
+            }
+            Ok((from_address, to_address, amount))
+        }
+
+        let (from_address, to_address, amount) = read_msg_send(bytes).map_err(|err| {
+            warn!("Could not parse MsgSend from protobuf bytes: {:?}", err);
+            EnclaveError::FailedToDeserialize
+        })?;
+
+        trace!(
+            "try_parse_bank_send from_address: len={} val={:?}",
+            from_address.len(),
+            from_address
+        );
+
+        let from_address = CanonicalAddr::from_human(&HumanAddr(from_address))
+            .map_err(|_| EnclaveError::FailedToDeserialize)?;
+
+        let amount = Self::parse_funds(protobuf::RepeatedField::from_vec(amount))?;
+
+        Ok(DirectSdkMsg::MsgSend {
+            from_address,
+            to_address: HumanAddr(to_address),
+            amount,
+        })
+    }
+
+    /// MsgWrapCoin and MsgUnwrapCoin have the same fields and are not part of the generated
+    /// protobuf types, so they are read directly from the wire format.
+    fn try_parse_wrap_coin(bytes: &[u8], unwrap: bool) -> Result<Self, EnclaveError> {
+        fn read_msg_wrap_coin(
+            bytes: &[u8],
+        ) -> protobuf::ProtobufResult<(String, proto::base::coin::Coin)> {
+            let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+            let mut sender = String::new();
+            let mut amount = proto::base::coin::Coin::new();
+            while !is.eof()? {
+                let (field_number, wire_type) = is.read_tag_unpack()?;
+                match field_number {
+                    1 => sender = is.read_string()?,
+                    2 => amount = is.read_message::<proto::base::coin::Coin>()?,
+                    _ => is.skip_field(wire_type)?,
+                }
+            }
+            Ok((sender, amount))
+        }
+
+        let (sender, amount) = read_msg_wrap_coin(bytes).map_err(|err| {
+            warn!(
+                "Could not parse MsgWrapCoin/MsgUnwrapCoin from protobuf bytes: {:?}",
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })?;
+
+        let sender = CanonicalAddr::from_human(&HumanAddr(sender))
+            .map_err(|_| EnclaveError::FailedToDeserialize)?;
+
+        let mut amount = Self::parse_funds(protobuf::RepeatedField::from_vec(vec![amount]))?;
+        let amount = amount.remove(0);
+
+        if unwrap {
+            Ok(DirectSdkMsg::MsgUnwrapCoin { sender, amount })
+        } else {
+            Ok(DirectSdkMsg::MsgWrapCoin { sender, amount })
+        }
+    }
+
+    /// MsgStoreCodeAndInstantiate is not part of the generated protobuf types, so it is read directly
+    /// from the wire format. The code is stored in the same msg, so its code id is not known when
+    /// signing and is left as 0. Like with MsgInstantiateContract, the code hash is verified with
+    /// the init msg.
+    fn try_parse_store_code_and_instantiate(bytes: &[u8]) -> Result<Self, EnclaveError> {
+        struct RawMsg {
+            sender: Vec<u8>,
+            label: String,
+            init_msg: Vec<u8>,
+            init_funds: Vec<proto::base::coin::Coin>,
+            admin: String,
+        }
+
+        fn read_msg(bytes: &[u8]) -> protobuf::ProtobufResult<RawMsg> {
+            let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+            let mut raw_msg = RawMsg {
+                sender: vec![],
+                label: String::new(),
+                init_msg: vec![],
+                init_funds: vec![],
+                admin: String::new(),
+            };
+            while !is.eof()? {
+                let (field_number, wire_type) = is.read_tag_unpack()?;
+                match field_number {
+                    1 => raw_msg.sender = is.read_bytes()?,
+                    5 => raw_msg.label = is.read_string()?,
+                    6 => raw_msg.init_msg = is.read_bytes()?,
+                    7 => raw_msg
+                        .init_funds
+                        .push(is.read_message::<proto::base::coin::Coin>()?),
+                    8 => raw_msg.admin = is.read_string()?,
+                    // the wasm code, source and builder
+                    _ => is.skip_field(wire_type)?,
+                }
+            }
+            Ok(raw_msg)
+        }
+
+        let raw_msg = read_msg(bytes).map_err(|err| {
+            warn!(
+                "Could not parse MsgStoreCodeAndInstantiate from protobuf bytes: {:?}",
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })?;
+
+        let init_funds = Self::parse_funds(protobuf::RepeatedField::from_vec(raw_msg.init_funds))?;
+
+        Ok(DirectSdkMsg::MsgInstantiateContract {
+            sender: CanonicalAddr(Binary(raw_msg.sender)),
+            init_msg: raw_msg.init_msg,
+            init_funds,
+            label: raw_msg.label,
+            admin: HumanAddr(raw_msg.admin),
+            code_id: 0,
+        })
+    }
+
+    /// MsgBatchInstantiate is not part of the generated protobuf types, so it is read directly from
+    /// the wire format. Each instance is returned as a MsgInstantiateContract of the same sender and code.
+    fn try_parse_batch_instantiate(bytes: &[u8]) -> Result<Vec<Self>, EnclaveError> {
+        struct RawInstance {
+            label: String,
+            init_msg: Vec<u8>,
+            init_funds: Vec<proto::base::coin::Coin>,
+            admin: String,
+        }
+
+        fn read_instance(bytes: &[u8]) -> protobuf::ProtobufResult<RawInstance> {
+            let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+            let mut instance = RawInstance {
+                label: String::new(),
+                init_msg: vec![],
+                init_funds: vec![],
+                admin: String::new(),
+            };
+            while !is.eof()? {
+                let (field_number, wire_type) = is.read_tag_unpack()?;
+                match field_number {
+                    1 => instance.label = is.read_string()?,
+                    2 => instance.init_msg = is.read_bytes()?,
+                    3 => instance
+                        .init_funds
+                        .push(is.read_message::<proto::base::coin::Coin>()?),
+                    4 => instance.admin = is.read_string()?,
+                    _ => is.skip_field(wire_type)?,
+                }
+            }
+            Ok(instance)
+        }
+
+        fn read_msg(bytes: &[u8]) -> protobuf::ProtobufResult<(Vec<u8>, u64, Vec<RawInstance>)> {
+            let mut is = protobuf::CodedInputStream::from_bytes(bytes);
+            let mut sender = vec![];
+            let mut code_id = 0;
+            let mut instances = vec![];
+            while !is.eof()? {
+                let (field_number, wire_type) = is.read_tag_unpack()?;
+                match field_number {
+                    1 => sender = is.read_bytes()?,
+                    2 => code_id = is.read_uint64()?,
+                    3 => instances.push(read_instance(&is.read_bytes()?)?),
+                    _ => is.skip_field(wire_type)?,
+                }
+            }
+            Ok((sender, code_id, instances))
+        }
+
+        let (sender, code_id, instances) = read_msg(bytes).map_err(|err| {
+            warn!(
+                "Could not parse MsgBatchInstantiate from protobuf bytes: {:?}",
+                err
+            );
+            EnclaveError::FailedToDeserialize
+        })?;
+
+        instances
+            .into_iter()
+            .map(|instance| {
+                let init_funds =
+                    Self::parse_funds(protobuf::RepeatedField::from_vec(instance.init_funds))?;
+
+                Ok(DirectSdkMsg::MsgInstantiateContract {
+                    sender: CanonicalAddr(Binary(sender.clone())),
+                    init_msg: instance.init_msg,
+                    init_funds,
+                    label: instance.label,
+                    admin: HumanAddr(instance.admin),
+                    code_id,
+                })
+            })
+            .collect()
+    }
+
     fn try_parse_instantiate(bytes: &[u8]) -> Result<Self, EnclaveError> {
         use proto::cosmwasm::msg::MsgInstantiateContract;
 
@@ -971,6 +1396,9 @@ impl DirectSdkMsg {
             | DirectSdkMsg::MsgMigrateContract { sender, .. }
             | DirectSdkMsg::MsgUpdateAdmin { sender, .. }
             | DirectSdkMsg::MsgClearAdmin { sender, .. } => Some(sender),
+            DirectSdkMsg::MsgSend { from_address, .. } => Some(from_address),
+            DirectSdkMsg::MsgWrapCoin { sender, .. }
+            | DirectSdkMsg::MsgUnwrapCoin { sender, .. } => Some(sender),
             DirectSdkMsg::MsgRecvPacket { .. } => None,
             DirectSdkMsg::MsgAcknowledgement { .. } => None,
             DirectSdkMsg::MsgTimeout { .. } => None,
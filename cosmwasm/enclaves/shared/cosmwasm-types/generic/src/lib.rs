@@ -309,6 +309,17 @@ impl CwEnv {
         }
     }
 
+    pub fn clear_sent_funds(&mut self) {
+        match self {
+            CwEnv::V010Env { env } => {
+                env.message.sent_funds = vec![];
+            }
+            CwEnv::V1Env { msg_info, .. } => {
+                msg_info.funds = vec![];
+            }
+        }
+    }
+
     pub fn set_msg_sender(&mut self, msg_sender: &str) {
         match self {
             CwEnv::V010Env { env } => {
@@ -19,6 +19,7 @@ pub enum QueryRequest {
     Gov(GovQuery),
     Ibc(IbcQuery),
     Stargate { path: String, data: Binary },
+    Oracle(OracleQuery),
 }
 
 /// These are queries to the various IBC modules to see the state of the contract's
@@ -45,6 +46,27 @@ pub enum IbcQuery {
         channel_id: String,
         port_id: Option<String>,
     },
+    /// Gets the state of a (portID, channelID) pair in any state, and the client of its connection.
+    /// If port_id is omitted, it will default to the contract's own port.
+    ///
+    /// Returns a `ChannelStateResponse`.
+    ChannelState {
+        channel_id: String,
+        port_id: Option<String>,
+    },
+    /// Gets the status of a light client.
+    ///
+    /// Returns a `ClientStatusResponse`.
+    ClientStatus { client_id: String },
+    /// Gets the acknowledgement commitment of a received packet.
+    /// If port_id is omitted, it will default to the contract's own port.
+    ///
+    /// Returns a `PacketAcknowledgementResponse`.
+    PacketAcknowledgement {
+        channel_id: String,
+        port_id: Option<String>,
+        sequence: u64,
+    },
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
@@ -95,6 +117,24 @@ pub struct Proposal {
     pub voting_end_time: u64,
 }
 
+/// Queries to the price oracle registered by the chain, if any
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum OracleQuery {
+    /// Returns the price of `base` denominated in `quote`.
+    ///
+    /// Returns an `OraclePriceResponse`.
+    Price { base: String, quote: String },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub struct OraclePriceResponse {
+    pub rate: Decimal,
+    /// Unix time in seconds of the last update of the price
+    pub last_updated: u64,
+}
+
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
 #[serde(rename_all = "snake_case")]
 pub enum DistQuery {
@@ -160,6 +200,12 @@ impl From<StakingQuery> for QueryRequest {
     }
 }
 
+impl From<OracleQuery> for QueryRequest {
+    fn from(msg: OracleQuery) -> Self {
+        QueryRequest::Oracle(msg)
+    }
+}
+
 impl From<WasmQuery> for QueryRequest {
     fn from(msg: WasmQuery) -> Self {
         QueryRequest::Wasm(msg)
@@ -21,6 +21,7 @@ const SUPPORTED_IMPORTS_V010: &[&str] = &[
     "env.ed25519_verify",
     "env.ed25519_batch_verify",
     "env.ed25519_sign",
+    "env.check_permit",
     #[cfg(feature = "iterator")]
     "env.db_scan",
     #[cfg(feature = "iterator")]
@@ -44,6 +45,7 @@ const SUPPORTED_IMPORTS_V1: &[&str] = &[
     "env.ed25519_verify",
     "env.ed25519_batch_verify",
     "env.ed25519_sign",
+    "env.check_permit",
     "env.debug",
     "env.query_chain",
     #[cfg(feature = "iterator")]
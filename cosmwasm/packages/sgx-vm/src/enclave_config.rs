@@ -18,6 +18,13 @@ extern "C" {
         retval: *mut sgx_status_t,
         config: RuntimeConfiguration,
     ) -> sgx_status_t;
+
+    pub fn ecall_warm_module_cache(
+        eid: sgx_enclave_id_t,
+        retval: *mut sgx_status_t,
+        contract: *const u8,
+        contract_len: usize,
+    ) -> sgx_status_t;
 }
 
 pub struct EnclaveRuntimeConfig {
@@ -62,3 +69,29 @@ pub fn configure_enclave(config: EnclaveRuntimeConfig) -> SgxResult<()> {
 
     Ok(())
 }
+
+/// Prepares the module of a contract in the enclave's module cache, ahead of its first call
+pub fn warm_enclave_module_cache(code: &[u8]) -> SgxResult<()> {
+    // Bind the token to a local variable to ensure its
+    // destructor runs in the end of the function
+    let enclave_access_token = ENCLAVE_DOORBELL
+        .get_access(1) // This can never be recursive
+        .ok_or(sgx_status_t::SGX_ERROR_BUSY)?;
+    let enclave = (*enclave_access_token)?;
+
+    let mut retval = sgx_status_t::SGX_SUCCESS;
+
+    let status = unsafe {
+        ecall_warm_module_cache(enclave.geteid(), &mut retval, code.as_ptr(), code.len())
+    };
+
+    if status != sgx_status_t::SGX_SUCCESS {
+        return Err(status);
+    }
+
+    if retval != sgx_status_t::SGX_SUCCESS {
+        return Err(retval);
+    }
+
+    Ok(())
+}
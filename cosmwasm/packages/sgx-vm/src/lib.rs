@@ -39,7 +39,7 @@ pub use crate::errors::{
 pub use crate::features::features_from_csv;
 pub use crate::ffi::{FfiError, FfiResult, GasInfo};
 pub use crate::instance::{GasReport, Instance};
-pub use enclave_config::{configure_enclave, EnclaveRuntimeConfig};
+pub use enclave_config::{configure_enclave, warm_enclave_module_cache, EnclaveRuntimeConfig};
 /*
 pub use crate::modules::FileSystemCache;
 */
@@ -139,6 +139,18 @@ func InitEnclaveRuntime(moduleCacheSize uint16) error {
 	return nil
 }
 
+// WarmModuleCache prepares the module of a contract in the module cache of the enclave
+func WarmModuleCache(wasm []byte) error {
+	code := sendSlice(wasm)
+	defer freeAfterSend(code)
+	errmsg := C.Buffer{}
+	_, err := C.warm_module_cache(code, &errmsg)
+	if err != nil {
+		return errorWithMessage(err, errmsg)
+	}
+	return nil
+}
+
 func Create(cache Cache, wasm []byte) ([]byte, error) {
 	code := sendSlice(wasm)
 	defer freeAfterSend(code)
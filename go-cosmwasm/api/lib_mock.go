@@ -75,6 +75,10 @@ func InitEnclaveRuntime(ModuleCacheSize uint16) error {
 	return nil
 }
 
+func WarmModuleCache(wasm []byte) error {
+	return nil
+}
+
 func Create(cache Cache, wasm []byte) ([]byte, error) {
 	//code := sendSlice(wasm)
 	//defer freeAfterSend(code)
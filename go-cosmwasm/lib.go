@@ -72,6 +72,16 @@ func (w *Wasmer) Create(code WasmCode) (CodeHash, error) {
 	return api.Create(w.cache, code)
 }
 
+// WarmCache prepares the module of the given code in the module cache of the enclave,
+// so its first call doesn't pay for loading and instrumenting it
+func (w *Wasmer) WarmCache(code CodeHash) error {
+	wasm, err := api.GetCode(w.cache, code)
+	if err != nil {
+		return err
+	}
+	return api.WarmModuleCache(wasm)
+}
+
 // GetCode will load the original wasm code for the given code id.
 // This will only succeed if that code id was previously returned from
 // a call to Create.
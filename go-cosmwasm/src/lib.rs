@@ -396,6 +396,24 @@ pub extern "C" fn configure_enclave_runtime(
     }
 }
 
+#[no_mangle]
+pub extern "C" fn warm_module_cache(wasm: Buffer, err: Option<&mut Buffer>) {
+    let r = catch_unwind(AssertUnwindSafe(move || do_warm_module_cache(wasm)))
+        .unwrap_or_else(|_| Err(Error::panic()));
+
+    if let Err(e) = r {
+        set_error(e, err);
+    } else {
+        clear_error();
+    }
+}
+
+fn do_warm_module_cache(wasm: Buffer) -> Result<(), Error> {
+    let wasm = unsafe { wasm.read() }.ok_or_else(|| Error::empty_arg(WASM_ARG))?;
+    cosmwasm_sgx_vm::warm_enclave_module_cache(wasm)
+        .map_err(|err| Error::enclave_err(err.to_string()))
+}
+
 #[no_mangle]
 pub extern "C" fn create(cache: *mut cache_t, wasm: Buffer, err: Option<&mut Buffer>) -> Buffer {
     let r = match to_cache(cache) {
@@ -14,6 +14,27 @@ type Env struct {
 	Key         ContractKey      `json:"contract_key"`
 	QueryDepth  uint32           `json:"query_depth"`
 	Transaction *TransactionInfo `json:"transaction,omitempty"`
+	KeyEpoch    *KeyEpoch        `json:"key_epoch,omitempty"`
+	// BlockCommitment is only set for queries
+	BlockCommitment *BlockCommitment `json:"block_commitment,omitempty"`
+	// MaxMemoryPages is the most 64KiB pages of memory the contract instance may use,
+	// 0 for the limit of the engine
+	MaxMemoryPages uint32 `json:"max_memory_pages,omitempty"`
+}
+
+// BlockCommitment is the header a query claims to run against. The enclave only accepts it if
+// it's one of the headers it verified last. The store reads of the query are not proven
+// against the app hash.
+type BlockCommitment struct {
+	Height  uint64 `json:"height"`
+	AppHash []byte `json:"app_hash"`
+}
+
+// KeyEpoch is the generation of sealed enclave keys the call runs under. During an
+// enclave upgrade, the keys of the previous epoch are accepted alongside the active ones.
+type KeyEpoch struct {
+	Active   uint32   `json:"active"`
+	Accepted []uint32 `json:"accepted"`
 }
 
 type ContractKey struct {
@@ -73,6 +73,7 @@ type QueryRequest struct {
 	Gov      *GovQuery       `json:"gov,omitempty"`
 	IBC      *IBCQuery       `json:"ibc,omitempty"`
 	Stargate *StargateQuery  `json:"stargate,omitempty"`
+	Oracle   *OracleQuery    `json:"oracle,omitempty"`
 }
 
 type BankQuery struct {
@@ -290,6 +291,10 @@ type IBCQuery struct {
 	PortID       *PortIDQuery       `json:"port_id,omitempty"`
 	ListChannels *ListChannelsQuery `json:"list_channels,omitempty"`
 	Channel      *ChannelQuery      `json:"channel,omitempty"`
+	// Secret Network extensions, not part of upstream CosmWasm
+	ChannelState          *ChannelStateQuery          `json:"channel_state,omitempty"`
+	ClientStatus          *ClientStatusQuery          `json:"client_status,omitempty"`
+	PacketAcknowledgement *PacketAcknowledgementQuery `json:"packet_acknowledgement,omitempty"`
 }
 
 type PortIDQuery struct{}
@@ -424,6 +429,46 @@ type ChannelResponse struct {
 	Channel *IBCChannel `json:"channel,omitempty"`
 }
 
+// ChannelStateQuery is an IBCQuery that returns the state of a channel in any state, unlike
+// ChannelQuery which only returns open channels.
+// If `PortID` is unset, the contract's port is used.
+type ChannelStateQuery struct {
+	// optional argument
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+}
+
+type ChannelStateResponse struct {
+	// e.g. "STATE_OPEN" or "STATE_CLOSED", empty if there is no matching channel
+	State string `json:"state"`
+	// the client of the channel's connection, empty if there is no matching channel
+	ClientID string `json:"client_id"`
+}
+
+// ClientStatusQuery is an IBCQuery that returns the status of a light client.
+type ClientStatusQuery struct {
+	ClientID string `json:"client_id"`
+}
+
+type ClientStatusResponse struct {
+	// one of "Active", "Frozen", "Expired" or "Unknown"
+	Status string `json:"status"`
+}
+
+// PacketAcknowledgementQuery is an IBCQuery that returns the acknowledgement commitment of a received packet.
+// If `PortID` is unset, the contract's port is used.
+type PacketAcknowledgementQuery struct {
+	// optional argument
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+type PacketAcknowledgementResponse struct {
+	// the hash of the acknowledgement as committed by the channel, null if the packet wasn't acknowledged
+	Acknowledgement []byte `json:"acknowledgement"`
+}
+
 type MintQuery struct {
 	Inflation   *MintingInflationQuery   `json:"inflation,omitempty"`
 	BondedRatio *MintingBondedRatioQuery `json:"bonded_ratio,omitempty"`
@@ -442,6 +487,24 @@ type MintingBondedRatioResponse struct {
 	BondedRatio string `json:"bonded_ratio"`
 }
 
+// OracleQuery is served by the price oracle registered by the chain, if any
+type OracleQuery struct {
+	Price *OraclePriceQuery `json:"price,omitempty"`
+}
+
+// OraclePriceQuery returns the price of Base denominated in Quote
+type OraclePriceQuery struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+}
+
+type OraclePriceResponse struct {
+	// Decimal string, e.g. "1.25"
+	Rate string `json:"rate"`
+	// Unix time in seconds of the last update of the price
+	LastUpdated uint64 `json:"last_updated"`
+}
+
 type ProposalsQuery struct{}
 
 // DelegationResponse is the expected response to DelegationsQuery
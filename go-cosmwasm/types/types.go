@@ -58,6 +58,15 @@ func (o OutOfGasError) Error() string {
 	return "Out of gas"
 }
 
+// SignerInfo is the verification info of a single signer of the tx
+type SignerInfo struct {
+	SignBytes []byte `json:"sign_bytes"`
+	SignMode  string `json:"sign_mode"`
+	ModeInfo  []byte `json:"mode_info"`
+	PublicKey []byte `json:"public_key"`
+	Signature []byte `json:"signature"`
+}
+
 type SigInfo struct {
 	TxBytes           []byte `json:"tx_bytes"`
 	SignBytes         []byte `json:"sign_bytes"`
@@ -66,6 +75,16 @@ type SigInfo struct {
 	PublicKey         []byte `json:"public_key"`
 	Signature         []byte `json:"signature"`
 	CallbackSignature []byte `json:"callback_sig"` // Optional
+	// CallbackSigAlgorithm is the algorithm CallbackSignature was produced with, empty for legacy sha256 signatures
+	CallbackSigAlgorithm string `json:"callback_sig_algorithm,omitempty"`
+	// Module is the name of the module that sends the msg in place of a signer of the tx, the sender
+	// must be the address of its account. The enclave only accepts the modules of its allowlist, and
+	// verifies the msg against a msg of the tx with the module account as its sender.
+	Module string `json:"module,omitempty"`
+	// Signers is the verification info of every signer of a tx with more than one signer. The enclave
+	// picks the sign doc of the sender from it, or for a module call the sign doc that verifies it,
+	// instead of relying on the signer picked by the chain.
+	Signers []SignerInfo `json:"signers,omitempty"`
 }
 
 type HandleType int
@@ -82,6 +101,9 @@ const (
 	HandleTypeIbcWasmHooksIncomingTransfer
 	HandleTypeIbcWasmHooksOutgoingTransferAck
 	HandleTypeIbcWasmHooksOutgoingTransferTimeout
+	HandleTypeBankReceive
+	HandleTypeSnip20Conversion
+	HandleTypeScheduledExecute
 )
 
 type CosmosMsgVersion int
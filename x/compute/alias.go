@@ -48,6 +48,8 @@ var (
 	NewEnv                    = types.NewEnv
 	NewWasmCoins              = types.NewWasmCoins
 	DefaultWasmConfig         = types.DefaultWasmConfig
+	DefaultParams             = types.DefaultParams
+	ParamKeyTable             = types.ParamKeyTable
 	IsEncryptedError          = types.IsEncryptedErrorCode
 	ErrContainsQueryError     = types.ErrContainsQueryError
 	GetConfig                 = types.GetConfig
@@ -67,6 +69,7 @@ var (
 	NoCustomQuerier           = keeper.NoCustomQuerier
 	StakingQuerier            = keeper.StakingQuerier
 	WasmQuerier               = keeper.WasmQuerier
+	OracleQuerier             = keeper.OracleQuerier
 	MakeTestCodec             = keeper.MakeTestCodec
 	CreateTestInput           = keeper.CreateTestInput
 	CreateFakeFundedAccount   = keeper.CreateFakeFundedAccount
@@ -76,7 +79,11 @@ var (
 	NewWasmSnapshotter        = keeper.NewWasmSnapshotter
 	ContractFromPortID        = keeper.ContractFromPortID
 	NewCountTXDecorator       = keeper.NewCountTXDecorator
+	NewEncryptedMsgDecorator  = keeper.NewEncryptedMsgDecorator
+	NewQueryNodeDecorator     = keeper.NewQueryNodeDecorator
+	NewSignerDataDecorator    = keeper.NewSignerDataDecorator
 	NewMsgServerImpl          = keeper.NewMsgServerImpl
+	NewMultiComputeHooks      = types.NewMultiComputeHooks
 
 	// variable aliases
 	ModuleCdc            = types.ModuleCdc
@@ -101,7 +108,14 @@ var (
 type (
 	// ProposalType            = types.ProposalType
 	GenesisState               = types.GenesisState
+	ComputeKeeper              = types.ComputeKeeper
+	ComputeHooks               = types.ComputeHooks
+	MultiComputeHooks          = types.MultiComputeHooks
+	ExecutionTracer            = types.ExecutionTracer
+	ExecutionTrace             = types.ExecutionTrace
+	Params                     = types.Params
 	Code                       = types.Code
+	Sequence                   = types.Sequence
 	Contract                   = types.Contract
 	MsgStoreCode               = types.MsgStoreCode
 	MsgInstantiateContract     = types.MsgInstantiateContract
@@ -110,6 +124,9 @@ type (
 	MsgMigrateContract         = types.MsgMigrateContract
 	MsgUpdateAdmin             = types.MsgUpdateAdmin
 	MsgClearAdmin              = types.MsgClearAdmin
+	MsgSetContractReceiveHook  = types.MsgSetContractReceiveHook
+	MsgWrapCoin                = types.MsgWrapCoin
+	MsgUnwrapCoin              = types.MsgUnwrapCoin
 	Model                      = types.Model
 	CodeInfo                   = types.CodeInfo
 	ContractInfo               = types.ContractInfo
@@ -128,4 +145,6 @@ type (
 	QueryHandler               = keeper.QueryHandler
 	CustomQuerier              = keeper.CustomQuerier
 	QueryPlugins               = keeper.QueryPlugins
+	PriceSource                = types.PriceSource
+	WasmEngine                 = keeper.WasmEngine
 )
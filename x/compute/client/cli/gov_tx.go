@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ProposalRecoverContractFundsCmd returns the command that submits a proposal to recover the funds of an orphaned contract.
+// Fund recoveries are never expedited, so they get the full voting period.
+func ProposalRecoverContractFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-contract-funds [contract_addr_bech32] [recipient_addr_bech32]",
+		Short: "Submit a proposal to transfer the native balance of an orphaned contract",
+		Long: `Submit a proposal to transfer the whole native balance of an orphaned contract to a recipient.
+A contract is orphaned if it has no admin and its code was flagged broken, with the flag-broken-code proposal,
+at least the FundRecoveryDelay param blocks before. It must not be a registered SNIP-20 wrapper.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositArg, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRecoverContractFundsProposal(title, description, contract, recipient)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
+	return cmd
+}
+
+// ProposalFlagBrokenCodeCmd returns the command that submits a proposal to flag a code as broken,
+// the first step of the recovery of the funds of its contracts
+func ProposalFlagBrokenCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flag-broken-code [code_id]",
+		Short: "Submit a proposal to flag a code as broken",
+		Long: `Submit a proposal to flag a code as broken, e.g. because a bug strands the funds of its contracts.
+Once the FundRecoveryDelay param blocks have passed, the funds of its contracts without an admin may be recovered
+with the recover-contract-funds proposal. A code may be flagged only once.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositArg, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositArg)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewFlagBrokenCodeProposal(title, description, codeID)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of the proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of the proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of the proposal")
+	return cmd
+}
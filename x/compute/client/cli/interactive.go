@@ -0,0 +1,627 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// schemaDownloadTimeout is how long the download of a schema that is only referenced on chain may take
+const schemaDownloadTimeout = 30 * time.Second
+
+// jsonSchema is the subset of JSON schema generated by cosmwasm-schema that the interactive msg
+// builder understands. Anything else is entered as raw JSON.
+type jsonSchema struct {
+	Type                 schemaTypes            `json:"type,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties json.RawMessage        `json:"additionalProperties,omitempty"`
+	Items                json.RawMessage        `json:"items,omitempty"`
+	Enum                 []json.RawMessage      `json:"enum,omitempty"`
+	OneOf                []*jsonSchema          `json:"oneOf,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	AllOf                []*jsonSchema          `json:"allOf,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Definitions          map[string]*jsonSchema `json:"definitions,omitempty"`
+}
+
+// schemaTypes is the type of a schema, which is either a single type or a list of types
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(bz []byte) error {
+	var single string
+	if err := json.Unmarshal(bz, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(bz, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+func (t schemaTypes) has(name string) bool {
+	for _, typ := range t {
+		if typ == name {
+			return true
+		}
+	}
+	return false
+}
+
+// nonNull returns the only type of the schema besides null, e.g. the type of an Option<T> field
+func (t schemaTypes) nonNull() (string, bool) {
+	var found []string
+	for _, typ := range t {
+		if typ != "null" {
+			found = append(found, typ)
+		}
+	}
+	if len(found) != 1 {
+		return "", false
+	}
+	return found[0], true
+}
+
+func (s *jsonSchema) isRequired(property string) bool {
+	for _, required := range s.Required {
+		if required == property {
+			return true
+		}
+	}
+	return false
+}
+
+// itemsSchema returns the schema of the items of an array, nil for tuples
+func (s *jsonSchema) itemsSchema() *jsonSchema {
+	var items jsonSchema
+	if len(s.Items) == 0 || json.Unmarshal(s.Items, &items) != nil {
+		return nil
+	}
+	return &items
+}
+
+// msgBuilder prompts for the fields of a msg, following its JSON schema
+type msgBuilder struct {
+	definitions map[string]*jsonSchema
+	in          *bufio.Reader
+	out         io.Writer
+}
+
+// buildExecuteMsg loads the schema of the execute msg of a contract, prompts for the msg and validates it
+func buildExecuteMsg(cmd *cobra.Command, cliCtx client.Context, contractAddr sdk.AccAddress) ([]byte, error) {
+	schema, err := queryExecuteSchema(cliCtx, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	b := msgBuilder{
+		definitions: schema.Definitions,
+		in:          bufio.NewReader(cmd.InOrStdin()),
+		out:         cmd.ErrOrStderr(),
+	}
+	msg, err := b.build("msg", schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.validate("msg", msg, schema); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(b.out, "\nmsg: %s\n\n", bz)
+	return bz, nil
+}
+
+// queryExecuteSchema returns the schema of the execute msg from the schema attached to the code of a
+// contract. A schema that is only referenced on chain is downloaded and checked against its hash.
+func queryExecuteSchema(cliCtx client.Context, contractAddr sdk.AccAddress) (*jsonSchema, error) {
+	queryClient := types.NewQueryClient(cliCtx)
+	info, err := queryClient.ContractInfo(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: contractAddr.String()})
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryClient.CodeSchema(context.Background(), &types.QueryByCodeIdRequest{CodeId: info.CodeID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the schema of code %d: %w", info.CodeID, err)
+	}
+
+	schemaBz := res.Schema.Schema
+	if len(schemaBz) == 0 {
+		schemaBz, err = downloadSchema(res.Schema.SchemaURI, res.Schema.SchemaHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the schema is the API file of cosmwasm-schema, with a schema per msg type
+	var api struct {
+		Execute *jsonSchema `json:"execute"`
+	}
+	if err := json.Unmarshal(schemaBz, &api); err != nil {
+		return nil, fmt.Errorf("invalid schema of code %d: %w", info.CodeID, err)
+	}
+	if api.Execute == nil {
+		return nil, fmt.Errorf("the schema of code %d has no execute msg", info.CodeID)
+	}
+	return api.Execute, nil
+}
+
+func downloadSchema(uri string, hash []byte) ([]byte, error) {
+	httpClient := http.Client{Timeout: schemaDownloadTimeout}
+	resp, err := httpClient.Get(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the schema from %s: %w", uri, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download the schema from %s: %s", uri, resp.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(resp.Body, types.MaxCodeSchemaSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download the schema from %s: %w", uri, err)
+	}
+	if len(bz) > types.MaxCodeSchemaSize {
+		return nil, fmt.Errorf("the schema at %s is longer than %d bytes", uri, types.MaxCodeSchemaSize)
+	}
+	if sum := sha256.Sum256(bz); !bytes.Equal(sum[:], hash) {
+		return nil, fmt.Errorf("the schema at %s doesn't match the hash stored on chain", uri)
+	}
+	return bz, nil
+}
+
+// resolve follows the references of a schema to its definition. cosmwasm-schema wraps references
+// in a single allOf to attach a description.
+func (b *msgBuilder) resolve(s *jsonSchema) (*jsonSchema, error) {
+	seen := map[string]bool{}
+	for {
+		switch {
+		case s.Ref != "":
+			if seen[s.Ref] {
+				return nil, fmt.Errorf("schema has a reference cycle through %s", s.Ref)
+			}
+			seen[s.Ref] = true
+			def, ok := b.definitions[strings.TrimPrefix(s.Ref, "#/definitions/")]
+			if !ok {
+				return nil, fmt.Errorf("schema references the unknown definition %s", s.Ref)
+			}
+			s = def
+		case len(s.AllOf) == 1:
+			s = s.AllOf[0]
+		default:
+			return s, nil
+		}
+	}
+}
+
+// option is a choice of a oneOf, anyOf or enum schema: either a constant or a schema to prompt for
+type option struct {
+	label       string
+	description string
+	constant    json.RawMessage
+	schema      *jsonSchema
+}
+
+// options lists the choices of a oneOf or anyOf schema. cosmwasm-schema generates the variants of an
+// enum as objects with a single property, and its unit variants as an enum of strings.
+func (b *msgBuilder) options(choices []*jsonSchema) ([]option, error) {
+	var options []option
+	for _, choice := range choices {
+		resolved, err := b.resolve(choice)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case len(resolved.Enum) > 0:
+			for _, value := range resolved.Enum {
+				label := string(value)
+				var str string
+				if json.Unmarshal(value, &str) == nil {
+					label = str
+				}
+				options = append(options, option{label: label, constant: value})
+			}
+		case len(resolved.Properties) == 1 && len(resolved.Required) == 1:
+			options = append(options, option{label: resolved.Required[0], description: resolved.Description, schema: choice})
+		case resolved.Type.has("null") && len(resolved.Type) == 1:
+			options = append(options, option{label: "null", constant: json.RawMessage("null")})
+		default:
+			label := strings.TrimPrefix(choice.Ref, "#/definitions/")
+			if label == "" {
+				label = strings.Join(resolved.Type, "|")
+			}
+			options = append(options, option{label: label, schema: choice})
+		}
+	}
+	return options, nil
+}
+
+func (b *msgBuilder) choose(path string, options []option) (option, error) {
+	fmt.Fprintf(b.out, "%s:\n", path)
+	for i, opt := range options {
+		if opt.description != "" {
+			fmt.Fprintf(b.out, "  %d) %s - %s\n", i+1, opt.label, strings.SplitN(opt.description, "\n", 2)[0])
+		} else {
+			fmt.Fprintf(b.out, "  %d) %s\n", i+1, opt.label)
+		}
+	}
+	for {
+		line, err := b.prompt(fmt.Sprintf("choose %s [1-%d]", path, len(options)))
+		if err != nil {
+			return option{}, err
+		}
+		n, err := strconv.Atoi(line)
+		if err == nil && n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+		fmt.Fprintf(b.out, "enter a number between 1 and %d\n", len(options))
+	}
+}
+
+func (b *msgBuilder) prompt(text string) (string, error) {
+	fmt.Fprintf(b.out, "%s: ", text)
+	line, err := input.GetString("", b.in)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", text, err)
+	}
+	return line, nil
+}
+
+func (b *msgBuilder) confirm(text string) (bool, error) {
+	return input.GetConfirmation(text, b.in, b.out)
+}
+
+// build prompts for the value at path of a schema
+func (b *msgBuilder) build(path string, s *jsonSchema) (interface{}, error) {
+	s, err := b.resolve(s)
+	if err != nil {
+		return nil, err
+	}
+	if s.Description != "" {
+		fmt.Fprintf(b.out, "# %s: %s\n", path, strings.ReplaceAll(s.Description, "\n", " "))
+	}
+
+	var choices []*jsonSchema
+	switch {
+	case len(s.OneOf) > 0:
+		choices = s.OneOf
+	case len(s.AnyOf) > 0:
+		choices = s.AnyOf
+	case len(s.Enum) > 0:
+		choices = []*jsonSchema{s}
+	}
+	if choices != nil {
+		options, err := b.options(choices)
+		if err != nil {
+			return nil, err
+		}
+		choice, err := b.choose(path, options)
+		if err != nil {
+			return nil, err
+		}
+		if choice.constant != nil {
+			return decodeJSON(string(choice.constant))
+		}
+		return b.build(path, choice.schema)
+	}
+
+	typ, ok := s.Type.nonNull()
+	if !ok {
+		return b.buildRaw(path, "json")
+	}
+	if s.Type.has("null") {
+		set, err := b.confirm(fmt.Sprintf("set %s (optional)?", path))
+		if err != nil || !set {
+			return nil, err
+		}
+	}
+
+	switch typ {
+	case "object":
+		return b.buildObject(path, s)
+	case "array":
+		return b.buildArray(path, s)
+	case "string", "integer", "number", "boolean":
+		return b.buildScalar(path, typ, s)
+	default:
+		return b.buildRaw(path, typ)
+	}
+}
+
+func (b *msgBuilder) buildObject(path string, s *jsonSchema) (interface{}, error) {
+	if len(s.Properties) == 0 {
+		return b.buildRaw(path, "json object")
+	}
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	object := map[string]interface{}{}
+	for _, name := range names {
+		fieldPath := path + "." + name
+		// a nullable field is asked for by build
+		if !s.isRequired(name) && !b.isNullable(s.Properties[name]) {
+			set, err := b.confirm(fmt.Sprintf("set %s (optional)?", fieldPath))
+			if err != nil {
+				return nil, err
+			}
+			if !set {
+				continue
+			}
+		}
+		value, err := b.build(fieldPath, s.Properties[name])
+		if err != nil {
+			return nil, err
+		}
+		// unset optional fields are omitted rather than null
+		if value == nil && !s.isRequired(name) {
+			continue
+		}
+		object[name] = value
+	}
+	return object, nil
+}
+
+// isNullable returns true if null is a valid value of a schema, e.g. of an Option<T> field
+func (b *msgBuilder) isNullable(s *jsonSchema) bool {
+	resolved, err := b.resolve(s)
+	if err != nil {
+		return false
+	}
+	if resolved.Type.has("null") {
+		return true
+	}
+	for _, choice := range resolved.AnyOf {
+		if choice, err := b.resolve(choice); err == nil && choice.Type.has("null") {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *msgBuilder) buildArray(path string, s *jsonSchema) (interface{}, error) {
+	items := s.itemsSchema()
+	if items == nil {
+		return b.buildRaw(path, "json array")
+	}
+
+	var n int
+	for {
+		line, err := b.prompt(fmt.Sprintf("number of items of %s", path))
+		if err != nil {
+			return nil, err
+		}
+		if n, err = strconv.Atoi(line); err == nil && n >= 0 {
+			break
+		}
+		fmt.Fprintln(b.out, "enter a number of items")
+	}
+
+	array := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		value, err := b.build(fmt.Sprintf("%s[%d]", path, i), items)
+		if err != nil {
+			return nil, err
+		}
+		array = append(array, value)
+	}
+	return array, nil
+}
+
+func (b *msgBuilder) buildScalar(path string, typ string, s *jsonSchema) (interface{}, error) {
+	text := fmt.Sprintf("%s (%s)", path, typ)
+	if s.Format != "" {
+		text = fmt.Sprintf("%s (%s, %s)", path, typ, s.Format)
+	}
+	for {
+		line, err := b.prompt(text)
+		if err != nil {
+			return nil, err
+		}
+		value, err := parseScalar(line, typ)
+		if err == nil {
+			err = checkScalar(value, s)
+		}
+		if err == nil {
+			return value, nil
+		}
+		fmt.Fprintf(b.out, "invalid %s: %s\n", path, err)
+	}
+}
+
+func (b *msgBuilder) buildRaw(path string, description string) (interface{}, error) {
+	for {
+		line, err := b.prompt(fmt.Sprintf("%s (%s)", path, description))
+		if err != nil {
+			return nil, err
+		}
+		if value, err := decodeJSON(line); err == nil {
+			return value, nil
+		}
+		fmt.Fprintf(b.out, "invalid %s: not json\n", path)
+	}
+}
+
+// decodeJSON decodes a JSON value, keeping its numbers as json.Number
+func decodeJSON(text string) (interface{}, error) {
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func parseScalar(line string, typ string) (interface{}, error) {
+	switch typ {
+	case "integer":
+		if _, err := strconv.ParseInt(line, 10, 64); err != nil {
+			if _, err := strconv.ParseUint(line, 10, 64); err != nil {
+				return nil, fmt.Errorf("not an integer")
+			}
+		}
+		return json.Number(line), nil
+	case "number":
+		if _, err := strconv.ParseFloat(line, 64); err != nil {
+			return nil, fmt.Errorf("not a number")
+		}
+		return json.Number(line), nil
+	case "boolean":
+		value, err := strconv.ParseBool(line)
+		if err != nil {
+			return nil, fmt.Errorf("not a boolean")
+		}
+		return value, nil
+	default:
+		return line, nil
+	}
+}
+
+// checkScalar checks the minimum of a number, e.g. the 0 of the unsigned integers
+func checkScalar(value interface{}, s *jsonSchema) error {
+	number, ok := value.(json.Number)
+	if !ok || s.Minimum == nil {
+		return nil
+	}
+	if f, err := number.Float64(); err != nil || f < *s.Minimum {
+		return fmt.Errorf("must be at least %v", *s.Minimum)
+	}
+	return nil
+}
+
+// validate checks that a value matches its schema
+func (b *msgBuilder) validate(path string, value interface{}, s *jsonSchema) error {
+	s, err := b.resolve(s)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(s.OneOf) > 0:
+		matches := 0
+		for _, choice := range s.OneOf {
+			if b.validate(path, value, choice) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s matches %d of the variants of its schema instead of 1", path, matches)
+		}
+		return nil
+	case len(s.AnyOf) > 0:
+		for _, choice := range s.AnyOf {
+			if b.validate(path, value, choice) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s matches none of the variants of its schema", path)
+	case len(s.Enum) > 0:
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		for _, allowed := range s.Enum {
+			if jsonEqual(bz, allowed) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s is not one of the allowed values", path)
+	}
+
+	if len(s.Type) == 0 {
+		return nil
+	}
+	switch value := value.(type) {
+	case nil:
+		if !s.Type.has("null") {
+			return fmt.Errorf("%s is required", path)
+		}
+	case map[string]interface{}:
+		if !s.Type.has("object") {
+			return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, "|"))
+		}
+		for _, name := range s.Required {
+			if _, ok := value[name]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		for name, field := range value {
+			fieldSchema, ok := s.Properties[name]
+			if !ok {
+				if string(s.AdditionalProperties) == "false" {
+					return fmt.Errorf("%s has the unknown field %s", path, name)
+				}
+				continue
+			}
+			if err := b.validate(path+"."+name, field, fieldSchema); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if !s.Type.has("array") {
+			return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, "|"))
+		}
+		if items := s.itemsSchema(); items != nil {
+			for i, item := range value {
+				if err := b.validate(fmt.Sprintf("%s[%d]", path, i), item, items); err != nil {
+					return err
+				}
+			}
+		}
+	case json.Number:
+		if !s.Type.has("number") && !(s.Type.has("integer") && !strings.ContainsAny(value.String(), ".eE")) {
+			return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, "|"))
+		}
+		if err := checkScalar(value, s); err != nil {
+			return fmt.Errorf("%s %w", path, err)
+		}
+	case string:
+		if !s.Type.has("string") {
+			return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, "|"))
+		}
+	case bool:
+		if !s.Type.has("boolean") {
+			return fmt.Errorf("%s must be of type %s", path, strings.Join(s.Type, "|"))
+		}
+	}
+	return nil
+}
+
+func jsonEqual(a, b []byte) bool {
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+	xBz, _ := json.Marshal(x)
+	yBz, _ := json.Marshal(y)
+	return bytes.Equal(xBz, yBz)
+}
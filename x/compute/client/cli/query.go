@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -47,21 +48,39 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdGetContractInfo(),
 		GetCmdQuery(),
 		GetQueryDecryptTxCmd(),
+		GetQueryDecryptEventsCmd(),
 		GetCmdQueryLabel(),
+		GetCmdGetContractInfoByLabel(),
+		GetCmdQueryLabelByAddress(),
+		GetCmdQueryContractKey(),
 		GetCmdCodeHashByContractAddress(),
 		GetCmdCodeHashByCodeID(),
+		GetCmdVerifyCode(),
 		CmdDecryptText(),
 		GetCmdGetContractHistory(),
+		GetCmdQueryParams(),
+		GetCmdQuerySnip20Wrapper(),
+		GetCmdQueryScheduledCall(),
+		GetCmdQueryScheduledCalls(),
+		GetCmdQueryCron(),
+		GetCmdQueryCronsByContract(),
+		GetCmdQueryContractFeePolicy(),
+		GetCmdQueryCodeSchema(),
+		GetCmdQueryCodeVerifications(),
+		GetCmdQueryCodeAudits(),
+		GetCmdQueryContractAssets(),
+		GetCmdQueryTopContractsByGas(),
+		GetCmdQueryRawState(),
 	)
 	return queryCmd
 }
 
-// GetCmdListCode lists all wasm code uploaded
+// GetCmdListCode lists the wasm code uploaded, by page
 func GetCmdListCode() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-code",
-		Short: "List all wasm bytecode on the chain",
-		Long:  "List all wasm bytecode on the chain",
+		Short: "List the wasm bytecode on the chain",
+		Long:  "List the wasm bytecode on the chain by page, optionally only the code uploaded by --creator",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -69,17 +88,29 @@ func GetCmdListCode() *cobra.Command {
 				return err
 			}
 
-			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, keeper.QueryListCode)
-			res, _, err := clientCtx.Query(route)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(res))
-			return nil
+			creator, _ := cmd.Flags().GetString(flagCreator)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListCodes(context.Background(), &types.QueryListCodesRequest{
+				Creator:    creator,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
+		SilenceUsage: true,
 	}
 
+	cmd.Flags().String(flagCreator, "", "Only list the code uploaded by this bech32 address")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "codes")
 	return cmd
 }
 
@@ -185,12 +216,52 @@ func GetCmdCodeHashByCodeID() *cobra.Command {
 	return cmd
 }
 
-// GetCmdListContractByCode lists all wasm code uploaded for given code id
+// GetCmdVerifyCode checks that a local wasm file is the code stored with the given code id
+func GetCmdVerifyCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id] [wasm file]",
+		Short: "Verify that a local wasm file is the code stored with the given code id",
+		Long: `Verify that a local wasm file is the code stored with the given code id, by comparing its
+hash to the code hash on chain. Like on store, a gzipped file is hashed uncompressed.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			wasm, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			localHash, err := wasmCodeHash(wasm)
+			if err != nil {
+				return err
+			}
+
+			storedHash, err := GetCodeHashByCodeId(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !strings.EqualFold(hex.EncodeToString(localHash), string(storedHash)) {
+				return fmt.Errorf("code hash mismatch: %s has hash %s, code %s has hash %s", args[1], hex.EncodeToString(localHash), args[0], storedHash)
+			}
+			fmt.Printf("%s matches code %s (hash %s)\n", args[1], args[0], storedHash)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListContractByCode lists the contracts running the given code id
 func GetCmdListContractByCode() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-contract-by-code [code_id]",
-		Short: "List wasm all bytecode on the chain for given code id",
-		Long:  "List wasm all bytecode on the chain for given code id",
+		Short: "List the contracts running the given code id",
+		Long:  "List the address, label and creation height of the contracts running the given code id, by page",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -203,17 +274,27 @@ func GetCmdListContractByCode() *cobra.Command {
 				return err
 			}
 
-			route := fmt.Sprintf("custom/%s/%s/%d", types.QuerierRoute, keeper.QueryListContractByCode, codeID)
-			res, _, err := clientCtx.Query(route)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
 			if err != nil {
 				return err
 			}
-			fmt.Println(string(res))
-			return nil
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListContractsByCode(context.Background(), &types.QueryListContractsByCodeRequest{
+				CodeId:     codeID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
 		},
+		SilenceUsage: true,
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts")
 	return cmd
 }
 
@@ -294,6 +375,90 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryLabelByAddress prints out the label of a contract given its address
+func GetCmdQueryLabelByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label-by-address [bech32_address]",
+		Short: "Prints out the label of a contract given its address",
+		Long:  "Prints out the label of a contract given its address, and the entry of the label in the label index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LabelByAddress(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractKey prints out the enclave key of a contract given its address
+func GetCmdQueryContractKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-key [bech32_address]",
+		Short: "Prints out the enclave key of a contract given its address",
+		Long:  "Prints out the enclave key a contract got at instantiation, and the key it got at its last migration with its proof",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractKey(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetContractInfoByLabel prints out the metadata of a contract given its label
+func GetCmdGetContractInfoByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-by-label [label]",
+		Short: "Prints out metadata of a contract given its label",
+		Long:  "Prints out metadata of a contract given its label",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractInfoByLabel(context.Background(), &types.QueryByLabelRequest{Label: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdDecryptText() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "decrypt [encrypted_data]",
@@ -356,199 +521,260 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				return err
 			}
 
-			result, err := authtx.QueryTx(clientCtx, args[0])
+			answers, err := decryptTx(clientCtx, args[0])
 			if err != nil {
 				return err
 			}
 
-			if result.Empty() {
-				return fmt.Errorf("no transaction found with hash %s", args[0])
+			var output interface{} = answers
+			if eventsOnly, _ := cmd.Flags().GetBool(flagEvents); eventsOnly {
+				output = answers.OutputLogs
 			}
 
-			txInputs := result.GetTx().GetMsgs()
+			jsonBz, err := json.MarshalIndent(output, "", "    ")
+			if err != nil {
+				return err
+			}
 
-			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
-			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+			return clientCtx.PrintString(string(jsonBz))
+		},
+	}
+
+	cmd.Flags().Bool(flagEvents, false, "Only print the decrypted contract events of the transaction")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetQueryDecryptEventsCmd decrypts the contract events of a transaction if I'm the tx sender,
+// like `tx [hash] --events`
+func GetQueryDecryptEventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt-events [hash]",
+		Short: "Decrypt the contract events emitted by a transaction, if I'm the tx sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
-				return fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
+				return err
 			}
 
-			answers := types.DecryptedAnswers{
-				Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
-				OutputLogs:     []sdk.StringEvent{},
-				OutputError:    "",
-				PlaintextError: "",
+			answers, err := decryptTx(clientCtx, args[0])
+			if err != nil {
+				return err
 			}
-			nonces := make([][]byte, len(txInputs))
 
-			for i, tx := range txInputs {
-				var encryptedInput []byte
-				answers.Answers[i] = &types.DecryptedAnswer{}
+			jsonBz, err := json.MarshalIndent(answers.OutputLogs, "", "    ")
+			if err != nil {
+				return err
+			}
 
-				switch txInput := tx.(type) {
-				case *types.MsgExecuteContract:
-					{
-						encryptedInput = txInput.Msg
-						answers.Answers[i].Type = "execute"
-					}
-				case *types.MsgInstantiateContract:
-					{
-						encryptedInput = txInput.InitMsg
-						answers.Answers[i].Type = "instantiate"
-					}
+			return clientCtx.PrintString(string(jsonBz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// decryptTx queries a transaction by hash and decrypts its inputs, outputs, logs and errors.
+// Decryption only succeeds if the local key is the tx sender's.
+func decryptTx(clientCtx client.Context, hash string) (*types.DecryptedAnswers, error) {
+	result, err := authtx.QueryTx(clientCtx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Empty() {
+		return nil, fmt.Errorf("no transaction found with hash %s", hash)
+	}
+
+	txInputs := result.GetTx().GetMsgs()
+
+	wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+	_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
+	}
+
+	answers := types.DecryptedAnswers{
+		Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
+		OutputLogs:     []sdk.StringEvent{},
+		OutputError:    "",
+		PlaintextError: "",
+	}
+	nonces := make([][]byte, len(txInputs))
+
+	for i, tx := range txInputs {
+		var encryptedInput []byte
+		answers.Answers[i] = &types.DecryptedAnswer{}
+
+		switch txInput := tx.(type) {
+		case *types.MsgExecuteContract:
+			{
+				encryptedInput = txInput.Msg
+				answers.Answers[i].Type = "execute"
+			}
+		case *types.MsgInstantiateContract:
+			{
+				encryptedInput = txInput.InitMsg
+				answers.Answers[i].Type = "instantiate"
+			}
+		case *types.MsgStoreCodeAndInstantiate:
+			{
+				encryptedInput = txInput.InitMsg
+				answers.Answers[i].Type = "instantiate"
+			}
+		}
+
+		if encryptedInput != nil {
+			nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse encrypted blob: %w", err)
+			}
+
+			if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
+				return nil, fmt.Errorf("cannot decrypt, not original tx sender")
+			}
+
+			var plaintextInput []byte
+			if len(ciphertextInput) > 0 {
+				plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
+				if err != nil {
+					return nil, fmt.Errorf("error while trying to decrypt the tx input: %w", err)
 				}
+			}
+
+			answers.Answers[i].Input = string(plaintextInput)
+			nonces[i] = nonce
+		}
+	}
+
+	dataOutputHexB64 := result.Data
+	if dataOutputHexB64 != "" {
+		dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHexB64)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
+		}
 
-				if encryptedInput != nil {
-					nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
+		var txData sdk.TxMsgData
+		err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
+		if err != nil {
+			return nil, fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHexB64)
+		}
+
+		for i, msgData := range txData.Data {
+			if len(msgData.Data) != 0 {
+				var dataField []byte
+				switch {
+				case msgData.MsgType == "/secret.compute.v1beta1.MsgInstantiateContract":
+					var msgResponse types.MsgInstantiateContractResponse
+					err := proto.Unmarshal(msgData.Data, &msgResponse)
 					if err != nil {
-						return fmt.Errorf("can't parse encrypted blob: %w", err)
+						continue
 					}
 
-					if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
-						return fmt.Errorf("cannot decrypt, not original tx sender")
+					dataField = msgResponse.Data
+				case msgData.MsgType == "/secret.compute.v1beta1.MsgStoreCodeAndInstantiate":
+					var msgResponse types.MsgStoreCodeAndInstantiateResponse
+					err := proto.Unmarshal(msgData.Data, &msgResponse)
+					if err != nil {
+						continue
 					}
 
-					var plaintextInput []byte
-					if len(ciphertextInput) > 0 {
-						plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
-						if err != nil {
-							return fmt.Errorf("error while trying to decrypt the tx input: %w", err)
-						}
+					dataField = msgResponse.Data
+				case msgData.MsgType == "/secret.compute.v1beta1.MsgExecuteContract":
+					var msgResponse types.MsgExecuteContractResponse
+					err := proto.Unmarshal(msgData.Data, &msgResponse)
+					if err != nil {
+						continue
 					}
 
-					answers.Answers[i].Input = string(plaintextInput)
-					nonces[i] = nonce
+					dataField = msgResponse.Data
+				default:
+					continue
 				}
-			}
 
-			dataOutputHexB64 := result.Data
-			if dataOutputHexB64 != "" {
-				dataOutputAsProtobuf, err := hex.DecodeString(dataOutputHexB64)
+				dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
 				if err != nil {
-					return fmt.Errorf("error while trying to decode the encrypted output data from hex string: %w", err)
+					continue
 				}
+				dataPlaintextB64 := string(dataPlaintextB64Bz)
+				answers.Answers[i].OutputData = dataPlaintextB64
 
-				var txData sdk.TxMsgData
-				err = proto.Unmarshal(dataOutputAsProtobuf, &txData)
+				dataPlaintext, err := base64.StdEncoding.DecodeString(dataPlaintextB64)
 				if err != nil {
-					return fmt.Errorf("error while trying to parse data as protobuf: %w: %s", err, dataOutputHexB64)
+					continue
 				}
 
-				for i, msgData := range txData.Data {
-					if len(msgData.Data) != 0 {
-						var dataField []byte
-						switch {
-						case msgData.MsgType == "/secret.compute.v1beta1.MsgInstantiateContract":
-							var msgResponse types.MsgInstantiateContractResponse
-							err := proto.Unmarshal(msgData.Data, &msgResponse)
-							if err != nil {
-								continue
-							}
+				answers.Answers[i].OutputDataAsString = string(dataPlaintext)
+			}
+		}
+	}
 
-							dataField = msgResponse.Data
-						case msgData.MsgType == "/secret.compute.v1beta1.MsgExecuteContract":
-							var msgResponse types.MsgExecuteContractResponse
-							err := proto.Unmarshal(msgData.Data, &msgResponse)
+	// decrypt logs
+	answers.OutputLogs = []sdk.StringEvent{}
+	for _, l := range result.Logs {
+		for _, e := range l.Events {
+			if e.Type == "wasm" {
+				for i, a := range e.Attributes {
+					if a.Key != "contract_address" {
+						// key
+						if a.Key != "" {
+							// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
+							keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
 							if err != nil {
 								continue
 							}
 
-							dataField = msgResponse.Data
-						default:
-							continue
-						}
-
-						dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
-						if err != nil {
-							continue
-						}
-						dataPlaintextB64 := string(dataPlaintextB64Bz)
-						answers.Answers[i].OutputData = dataPlaintextB64
-
-						dataPlaintext, err := base64.StdEncoding.DecodeString(dataPlaintextB64)
-						if err != nil {
-							continue
-						}
-
-						answers.Answers[i].OutputDataAsString = string(dataPlaintext)
-					}
-				}
-			}
-
-			// decrypt logs
-			answers.OutputLogs = []sdk.StringEvent{}
-			for _, l := range result.Logs {
-				for _, e := range l.Events {
-					if e.Type == "wasm" {
-						for i, a := range e.Attributes {
-							if a.Key != "contract_address" {
-								// key
-								if a.Key != "" {
-									// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
-									keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
-									if err != nil {
-										continue
-									}
-
-									for _, nonce := range nonces {
-										keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
-										if err != nil {
-											continue
-										}
-										a.Key = string(keyPlaintext)
-										break
-									}
+							for _, nonce := range nonces {
+								keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
+								if err != nil {
+									continue
 								}
+								a.Key = string(keyPlaintext)
+								break
+							}
+						}
 
-								// value
-								if a.Value != "" {
-									// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
-									valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
-									if err != nil {
-										continue
-									}
-									for _, nonce := range nonces {
-										valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
-										if err != nil {
-											continue
-										}
-										a.Value = string(valuePlaintext)
-										break
-									}
+						// value
+						if a.Value != "" {
+							// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
+							valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
+							if err != nil {
+								continue
+							}
+							for _, nonce := range nonces {
+								valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
+								if err != nil {
+									continue
 								}
-
-								e.Attributes[i] = a
+								a.Value = string(valuePlaintext)
+								break
 							}
 						}
-						answers.OutputLogs = append(answers.OutputLogs, e)
-					}
-				}
-			}
 
-			if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
-				for i, nonce := range nonces {
-					stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
-					if err != nil {
-						continue
+						e.Attributes[i] = a
 					}
-					answers.OutputError = string(append(json.RawMessage(fmt.Sprintf("message index %d: ", i)), stdErr...))
-					break
 				}
-			} else if types.ContainsEnclaveError(result.RawLog) {
-				answers.PlaintextError = result.RawLog
+				answers.OutputLogs = append(answers.OutputLogs, e)
 			}
+		}
+	}
 
-			jsonBz, err := json.MarshalIndent(answers, "", "    ")
+	if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
+		for i, nonce := range nonces {
+			stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
 			if err != nil {
-				return err
+				continue
 			}
-
-			return clientCtx.PrintString(string(jsonBz))
-		},
+			answers.OutputError = string(append(json.RawMessage(fmt.Sprintf("message index %d: ", i)), stdErr...))
+			break
+		}
+	} else if types.ContainsEnclaveError(result.RawLog) {
+		answers.PlaintextError = result.RawLog
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
-	return cmd
+	return &answers, nil
 }
 
 func GetCmdQuery() *cobra.Command {
@@ -755,6 +981,429 @@ func GetCmdGetContractHistory() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryParams prints out the current compute module params
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Prints out the current compute module params",
+		Long:  "Prints out the current compute module params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySnip20Wrapper looks up the SNIP-20 wrapper registry by native denom or by contract address
+func GetCmdQuerySnip20Wrapper() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snip20-wrapper [denom|contract_address]",
+		Short: "Prints out the canonical SNIP-20 wrapper of a native denom, or the denom wrapped by a SNIP-20 contract",
+		Long:  "Prints out the canonical SNIP-20 wrapper of a native denom, or the denom wrapped by a SNIP-20 contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res *types.QuerySnip20WrapperResponse
+			if _, err := sdk.AccAddressFromBech32(args[0]); err == nil {
+				res, err = queryClient.Snip20WrapperByContract(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: args[0]})
+				if err != nil {
+					return err
+				}
+			} else {
+				res, err = queryClient.Snip20WrapperByDenom(context.Background(), &types.QueryByDenomRequest{Denom: args[0]})
+				if err != nil {
+					return err
+				}
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryScheduledCall prints out a scheduled contract call given its id
+func GetCmdQueryScheduledCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-call [id]",
+		Short: "Prints out a scheduled contract call given its id",
+		Long:  "Prints out a scheduled contract call given its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledCall(context.Background(), &types.QueryScheduledCallRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryScheduledCalls lists the pending scheduled contract calls, by execution height
+func GetCmdQueryScheduledCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-calls",
+		Short: "Lists the pending scheduled contract calls, by execution height",
+		Long:  "Lists the pending scheduled contract calls, by execution height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledCalls(context.Background(), &types.QueryScheduledCallsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled calls")
+	return cmd
+}
+
+// GetCmdQueryCron prints out a cron given its id
+func GetCmdQueryCron() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron [id]",
+		Short: "Prints out a cron given its id",
+		Long:  "Prints out a cron given its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Cron(context.Background(), &types.QueryCronRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCronsByContract lists the crons registered for a contract
+func GetCmdQueryCronsByContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "crons [bech32_address]",
+		Short: "Lists the crons registered for a contract",
+		Long:  "Lists the crons registered for a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronsByContract(context.Background(), &types.QueryCronsByContractRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "crons")
+	return cmd
+}
+
+// GetCmdQueryContractFeePolicy prints out the minimum fee for executing a contract
+func GetCmdQueryContractFeePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-policy [bech32_address]",
+		Short: "Prints out the minimum fee a tx must pay to execute a contract",
+		Long:  "Prints out the minimum fee a tx must pay to execute a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractFeePolicy(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractAssets prints out the balances, delegations and unbonding delegations of a contract
+func GetCmdQueryContractAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets [bech32_address]",
+		Short: "Prints out the balances, delegations and unbonding delegations of a contract",
+		Long:  "Prints out the bank balances of a contract along with its delegations and unbonding delegations",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractAssets(context.Background(), &types.QueryByContractAddressRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCodeSchema prints out the JSON schema of the messages of a code
+func GetCmdQueryCodeSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-schema [code_id]",
+		Short: "Prints out the JSON schema of the messages of a code",
+		Long: `Prints out the JSON schema of the messages of a code. If only the hash and URI of
+the schema are stored on chain, prints out those instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeSchema(context.Background(), &types.QueryByCodeIdRequest{CodeId: codeID})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Schema.Schema) != 0 {
+				return clientCtx.PrintBytes(res.Schema.Schema)
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCodeVerifications prints out the verification claims attached to a code hash
+func GetCmdQueryCodeVerifications() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-verifications [code_hash]",
+		Short: "Prints out the claims that a code hash is the reproducible build of a source",
+		Long: `Prints out the claims that a code hash is the reproducible build of a source, with the
+address of the verifier that made each claim. Anyone can make a claim, so only trust those of
+verifiers you know.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeVerifications(context.Background(), &types.QueryCodeVerificationsRequest{
+				CodeHash:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "code verifications")
+	return cmd
+}
+
+// GetCmdQueryCodeAudits prints out the audit attestations of a code
+func GetCmdQueryCodeAudits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-audits [code_id]",
+		Short: "Prints out the audit attestations of a code",
+		Long: `Prints out the audit attestations of a code. Attestations stay after their auditor is
+removed from the auditors param, so check the param for the auditors currently approved.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeAudits(context.Background(), &types.QueryByCodeIdRequest{CodeId: codeID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTopContractsByGas prints out the contracts that used the most gas recently
+func GetCmdQueryTopContractsByGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-contracts-by-gas",
+		Short: "Prints out the contracts that used the most gas over the last blocks or days",
+		Long: fmt.Sprintf(`Prints out the contracts that used the most gas over the last blocks (--blocks, at most %d)
+or UTC days (--days, at most %d), the current one included. Without any of them, the gas used in the
+current day is summed. Only the gas of calls whose tx succeeded is counted.`, types.GasUsageBlockRetention, types.GasUsageDayRetention),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := cmd.Flags().GetUint32(flagBlocks)
+			if err != nil {
+				return err
+			}
+			days, err := cmd.Flags().GetUint32(flagDays)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TopContractsByGas(context.Background(), &types.QueryTopContractsByGasRequest{
+				Blocks: blocks,
+				Days:   days,
+				Limit:  limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Uint32(flagBlocks, 0, "Sum the gas used in the last blocks")
+	cmd.Flags().Uint32(flagDays, 0, "Sum the gas used in the last UTC days")
+	cmd.Flags().Uint32(flags.FlagLimit, types.DefaultTopContractsLimit, "Number of contracts to print")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
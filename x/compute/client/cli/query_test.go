@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"golang.org/x/crypto/curve25519"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+// mockNode serves a single tx and the consensus IO key to the tx query command
+type mockNode struct {
+	rpcclient.Client
+
+	tx        []byte
+	log       string
+	ioPubkey  []byte
+	txHashHex string
+}
+
+func (n *mockNode) Tx(_ context.Context, hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	if hex.EncodeToString(hash) != n.txHashHex {
+		return nil, fmt.Errorf("tx %X not found", hash)
+	}
+	return &ctypes.ResultTx{Hash: hash, Height: 1, Tx: n.tx, TxResult: abci.ResponseDeliverTx{Log: n.log}}, nil
+}
+
+func (n *mockNode) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	return &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height}}}, nil
+}
+
+func (n *mockNode) ABCIQueryWithOptions(_ context.Context, path string, _ tmbytes.HexBytes, _ rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	if path != "/secret.registration.v1beta1.Query/TxKey" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+	bz, err := (&regtypes.Key{Key: n.ioPubkey}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+func randomBytes(t *testing.T, n int) []byte {
+	bz := make([]byte, n)
+	_, err := rand.Read(bz)
+	require.NoError(t, err)
+	return bz
+}
+
+// queryDecryptTx runs a tx decryption command against a tx executing a contract with an encrypted
+// msg, which emitted an encrypted wasm attribute
+func queryDecryptTx(t *testing.T, cmd *cobra.Command, extraArgs ...string) []byte {
+	ioPubkey, err := curve25519.X25519(randomBytes(t, 32), curve25519.Basepoint)
+	require.NoError(t, err)
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+
+	clientCtx := client.Context{}.
+		WithHomeDir(t.TempDir()).
+		WithTxConfig(txConfig).
+		WithInterfaceRegistry(interfaceRegistry)
+
+	txSenderPrivkey, _, err := wasmUtils.WASMContext{CLIContext: clientCtx}.GetTxSenderKeyPair()
+	require.NoError(t, err)
+
+	nonce := randomBytes(t, 32)
+	encrypt := func(plaintext string) []byte {
+		ciphertext, err := wasmUtils.EncryptWithKeys(ioPubkey, txSenderPrivkey, nonce, []byte(plaintext))
+		require.NoError(t, err)
+		return ciphertext
+	}
+	// attributes are encrypted with the msg's nonce, without the nonce and pubkey prefix of the msg
+	encryptAttribute := func(plaintext string) string {
+		return base64.StdEncoding.EncodeToString(encrypt(plaintext)[64:])
+	}
+
+	contract := sdk.AccAddress("contract____________")
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&types.MsgExecuteContract{
+		Sender:   sdk.AccAddress("sender______________"),
+		Contract: contract,
+		Msg:      encrypt(`{"transfer":{}}`),
+	}))
+	txBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	logs, err := json.Marshal(sdk.ABCIMessageLogs{{Events: sdk.StringEvents{
+		{Type: "message", Attributes: []sdk.Attribute{{Key: "action", Value: "execute"}}},
+		{Type: "wasm", Attributes: []sdk.Attribute{
+			{Key: "contract_address", Value: contract.String()},
+			{Key: encryptAttribute("amount"), Value: encryptAttribute("100")},
+			{Key: "plaintext", Value: "value"},
+		}},
+	}}})
+	require.NoError(t, err)
+
+	txHash := tmtypes.Tx(txBz).Hash()
+	node := &mockNode{tx: txBz, log: string(logs), ioPubkey: ioPubkey, txHashHex: hex.EncodeToString(txHash)}
+
+	out := &bytes.Buffer{}
+	cmd.SetArgs(append([]string{hex.EncodeToString(txHash)}, extraArgs...))
+	cmd.SetOut(out)
+	clientCtx = clientCtx.WithClient(node).WithOutput(out)
+	require.NoError(t, cmd.ExecuteContext(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)))
+
+	return out.Bytes()
+}
+
+func TestQueryDecryptTx(t *testing.T) {
+	var answers types.DecryptedAnswers
+	require.NoError(t, json.Unmarshal(queryDecryptTx(t, GetQueryDecryptTxCmd()), &answers))
+
+	require.Len(t, answers.Answers, 1)
+	require.Equal(t, "execute", answers.Answers[0].Type)
+	require.Equal(t, `{"transfer":{}}`, answers.Answers[0].Input)
+
+	require.Len(t, answers.OutputLogs, 1)
+	require.Equal(t, "wasm", answers.OutputLogs[0].Type)
+	require.Equal(t, []sdk.Attribute{
+		{Key: "contract_address", Value: sdk.AccAddress("contract____________").String()},
+		{Key: "amount", Value: "100"},
+		{Key: "plaintext", Value: "value"},
+	}, answers.OutputLogs[0].Attributes)
+}
+
+func TestQueryDecryptEvents(t *testing.T) {
+	expEvents := []sdk.StringEvent{{
+		Type: "wasm",
+		Attributes: []sdk.Attribute{
+			{Key: "contract_address", Value: sdk.AccAddress("contract____________").String()},
+			{Key: "amount", Value: "100"},
+			{Key: "plaintext", Value: "value"},
+		},
+	}}
+
+	var events []sdk.StringEvent
+	require.NoError(t, json.Unmarshal(queryDecryptTx(t, GetQueryDecryptEventsCmd()), &events))
+	require.Equal(t, expEvents, events)
+
+	// the tx query prints the same with --events
+	events = nil
+	require.NoError(t, json.Unmarshal(queryDecryptTx(t, GetQueryDecryptTxCmd(), "--"+flagEvents), &events))
+	require.Equal(t, expEvents, events)
+}
@@ -0,0 +1,220 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/light"
+	"github.com/tendermint/tendermint/light/provider"
+	lighthttp "github.com/tendermint/tendermint/light/provider/http"
+	lightdb "github.com/tendermint/tendermint/light/store/db"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const (
+	flagProve       = "prove"
+	flagVerify      = "verify"
+	flagLightNode   = "light-node"
+	flagWitnesses   = "witnesses"
+	flagTrustHeight = "trust-height"
+	flagTrustHash   = "trust-hash"
+	flagTrustPeriod = "trust-period"
+)
+
+// RawStateResponse is a raw key/value of a contract's storage. Both are encrypted by the enclave.
+type RawStateResponse struct {
+	// Key is the key in the compute store: the contract's storage prefix followed by the contract key
+	Key    []byte `json:"key"`
+	Value  []byte `json:"value"`
+	Height int64  `json:"height"`
+	// ProofOps are the ICS-23 merkle proofs of the key/value, from the compute store up to the app hash
+	ProofOps *tmcrypto.ProofOps `json:"proof_ops,omitempty"`
+}
+
+// GetCmdQueryRawState reads a raw key of a contract's storage, optionally with its merkle proofs
+func GetCmdQueryRawState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "raw-state [bech32_address] [key_hex]",
+		Aliases: []string{"raw"},
+		Short:   "Read a raw (encrypted) key of a contract's storage",
+		Long: `Read a raw key of a contract's storage. The key and the value are stored encrypted by the enclave,
+so key_hex is the encrypted key as stored on chain.
+
+With --prove, the response also has the ICS-23 merkle proofs of the key/value against the app hash
+of the next block, so light clients and bridges can verify them.
+
+With --verify, the proofs are also checked against the app hash of the next block, which a light
+client verifies from a trusted header: --trust-height and --trust-hash, e.g. from a block explorer
+or a node you run. The headers are fetched from --light-node (--node by default) and cross-checked
+with --witnesses (--light-node by default, use independent nodes to detect a lying one).`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("key must be hex encoded: %w", err)
+			}
+			if len(key) == 0 {
+				return fmt.Errorf("key must not be empty")
+			}
+			prove, _ := cmd.Flags().GetBool(flagProve)
+			verify, _ := cmd.Flags().GetBool(flagVerify)
+			var trust light.TrustOptions
+			if verify {
+				if !prove {
+					return fmt.Errorf("--%s requires --%s", flagVerify, flagProve)
+				}
+				trust, err = trustOptionsFromFlags(cmd)
+				if err != nil {
+					return err
+				}
+			}
+
+			storeKey := append(types.GetContractStorePrefixKey(contractAddr), key...)
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+				Data:   storeKey,
+				Height: clientCtx.Height,
+				Prove:  prove,
+			})
+			if err != nil {
+				return err
+			}
+			if !res.IsOK() {
+				return fmt.Errorf("query failed: %s", res.Log)
+			}
+
+			if verify {
+				primary, _ := cmd.Flags().GetString(flagLightNode)
+				if primary == "" {
+					primary = clientCtx.NodeURI
+				}
+				witnesses, _ := cmd.Flags().GetStringSlice(flagWitnesses)
+				if len(witnesses) == 0 {
+					witnesses = []string{primary}
+				}
+				if err := verifyRawState(cmd.Context(), clientCtx, primary, witnesses, trust, storeKey, res); err != nil {
+					return fmt.Errorf("VERIFICATION FAILED: %w", err)
+				}
+			}
+
+			jsonBz, err := json.MarshalIndent(RawStateResponse{
+				Key:      storeKey,
+				Value:    res.Value,
+				Height:   res.Height,
+				ProofOps: res.ProofOps,
+			}, "", "    ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(jsonBz))
+		},
+	}
+
+	cmd.Flags().Bool(flagProve, false, "return the merkle proofs of the key/value")
+	cmd.Flags().Bool(flagVerify, false, "verify the merkle proofs with a light client, requires --prove, --trust-height and --trust-hash")
+	cmd.Flags().String(flagLightNode, "", "<host>:<port> to the node serving the headers, defaults to --node")
+	cmd.Flags().StringSlice(flagWitnesses, nil, "<host>:<port> of the nodes to cross-check the headers with, defaults to --light-node")
+	cmd.Flags().Int64(flagTrustHeight, 0, "height of the trusted header the light client starts from")
+	cmd.Flags().String(flagTrustHash, "", "hex encoded hash of the trusted header")
+	cmd.Flags().Duration(flagTrustPeriod, 168*time.Hour, "trusting period of the headers, must be shorter than the unbonding period")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// trustOptionsFromFlags returns the trusted header the light client verifies the headers from
+func trustOptionsFromFlags(cmd *cobra.Command) (light.TrustOptions, error) {
+	height, _ := cmd.Flags().GetInt64(flagTrustHeight)
+	hashHex, _ := cmd.Flags().GetString(flagTrustHash)
+	period, _ := cmd.Flags().GetDuration(flagTrustPeriod)
+	if height <= 0 || hashHex == "" {
+		return light.TrustOptions{}, fmt.Errorf("--%s requires --%s and --%s", flagVerify, flagTrustHeight, flagTrustHash)
+	}
+	hash, err := hex.DecodeString(hashHex)
+	if err != nil {
+		return light.TrustOptions{}, fmt.Errorf("trust hash must be hex encoded: %w", err)
+	}
+
+	trust := light.TrustOptions{Period: period, Height: height, Hash: hash}
+	if err := trust.ValidateBasic(); err != nil {
+		return light.TrustOptions{}, err
+	}
+	return trust, nil
+}
+
+// verifyRawState verifies the proofs of a raw state query against the app hash of the next block. The header of
+// the next block is verified by a light client from the trusted header, with the light blocks of primary, which
+// are cross-checked with witnesses.
+func verifyRawState(
+	ctx context.Context, clientCtx client.Context, primary string, witnesses []string, trust light.TrustOptions, storeKey []byte, res abci.ResponseQuery,
+) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	chainID := clientCtx.ChainID
+	if chainID == "" {
+		// the trusted header is of a single chain, so a wrong chain id fails the verification
+		status, err := clientCtx.Client.Status(ctx)
+		if err != nil {
+			return err
+		}
+		chainID = status.NodeInfo.Network
+	}
+
+	primaryProvider, err := lighthttp.New(chainID, primary)
+	if err != nil {
+		return err
+	}
+	witnessProviders := make([]provider.Provider, len(witnesses))
+	for i, witness := range witnesses {
+		witnessProviders[i], err = lighthttp.New(chainID, witness)
+		if err != nil {
+			return err
+		}
+	}
+
+	lightClient, err := light.NewClient(ctx, chainID, trust, primaryProvider, witnessProviders, lightdb.New(dbm.NewMemDB(), chainID))
+	if err != nil {
+		return fmt.Errorf("failed to initialize the light client from the trusted header: %w", err)
+	}
+
+	// the app hash after a block is committed is in the header of the next block
+	height := res.Height + 1
+	lightBlock, err := lightClient.VerifyLightBlockAtHeight(ctx, height, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to verify the header of height %d, it may not be committed yet: %w", height, err)
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(storeKey, merkle.KeyEncodingURL).
+		String()
+	prt := rootmulti.DefaultProofRuntime()
+	if len(res.Value) == 0 {
+		return prt.VerifyAbsence(res.ProofOps, lightBlock.AppHash, keyPath)
+	}
+	return prt.VerifyValue(res.ProofOps, lightBlock.AppHash, keyPath, res.Value)
+}
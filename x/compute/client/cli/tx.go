@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
@@ -33,8 +38,36 @@ const (
 	flagIoMasterKey            = "enclave-key"
 	flagCodeHash               = "code-hash"
 	flagAdmin                  = "admin"
+	flagExecuteHeight          = "height"
+	flagGasLimit               = "gas-limit"
+	flagScheduleFee            = "execution-fee"
+	flagBudget                 = "budget"
+	flagInterval               = "interval"
+	flagExpiresAtHeight        = "expires-at-height"
+	flagSchemaHash             = "schema-hash"
+	flagSchemaURI              = "schema-uri"
+	flagReportURI              = "report-uri"
+	flagCreator                = "creator"
+	flagBlocks                 = "blocks"
+	flagDays                   = "days"
+	flagInteractive            = "interactive"
+	flagEvents                 = "events"
 )
 
+// defaultEncryptedGasAdjustment is applied to the simulated gas of encrypted compute txs with --gas=auto,
+// unless --gas-adjustment is set. The enclave gas of a contract call varies between the simulation and
+// the actual execution (e.g. storage of a different size), so the SDK's default of 1.0 is rarely enough.
+const defaultEncryptedGasAdjustment = 1.3
+
+// newEncryptedTxFactory returns a tx factory for an encrypted compute tx, see defaultEncryptedGasAdjustment
+func newEncryptedTxFactory(cmd *cobra.Command, cliCtx client.Context) tx.Factory {
+	txf := tx.NewFactoryCLI(cliCtx, cmd.Flags())
+	if txf.SimulateAndExecute() && !cmd.Flags().Changed(flags.FlagGasAdjustment) {
+		txf = txf.WithGasAdjustment(defaultEncryptedGasAdjustment)
+	}
+	return txf
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -47,11 +80,25 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		StoreCodeCmd(),
+		StoreCodeAndInstantiateCmd(),
 		InstantiateContractCmd(),
+		BatchInstantiateCmd(),
 		ExecuteContractCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		SetContractReceiveHookCmd(),
+		SetContractFeePolicyCmd(),
+		SetCodeSchemaCmd(),
+		UpdateInstantiateConfigCmd(),
+		AddCodeVerificationCmd(),
+		AddCodeAuditCmd(),
+		WrapCoinCmd(),
+		UnwrapCoinCmd(),
+		ScheduleExecuteCmd(),
+		CancelScheduledExecuteCmd(),
+		RegisterCronCmd(),
+		CancelCronCmd(),
 	)
 	return txCmd
 }
@@ -124,6 +171,104 @@ func parseStoreCodeArgs(args []string, cliCtx client.Context, flags *flag.FlagSe
 	return msg, nil
 }
 
+// StoreCodeAndInstantiateCmd uploads a WASM binary and instantiates it in the same msg
+func StoreCodeAndInstantiateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-and-instantiate [wasm file] [json_encoded_init_args] --label [text] --amount [coins,optional] --admin [admin_addr_bech32,optional]",
+		Short: "Upload a WASM binary and instantiate it",
+		Long: `Upload a WASM binary and instantiate it in the same msg, so that nobody can instantiate
+the code before you do.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			storeMsg, err := parseStoreCodeArgs(args[:1], clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			codeHash, err := wasmCodeHash(storeMsg.WASMByteCode)
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			initFunds, err := sdk.ParseCoinsNormalized(amount)
+			if err != nil {
+				return err
+			}
+			label, _ := cmd.Flags().GetString(flagLabel)
+			if label == "" {
+				return fmt.Errorf("label is required on all contracts")
+			}
+			admin, _ := cmd.Flags().GetString(flagAdmin)
+
+			initMsg := types.SecretMsg{
+				CodeHash: []byte(hex.EncodeToString(codeHash)),
+				Msg:      []byte(args[1]),
+			}
+			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+			var encryptedMsg []byte
+			if ioKeyPath, _ := cmd.Flags().GetString(flagIoMasterKey); ioKeyPath != "" {
+				encryptedMsg, err = wasmCtx.OfflineEncrypt(initMsg.Serialize(), ioKeyPath)
+			} else {
+				encryptedMsg, err = wasmCtx.Encrypt(initMsg.Serialize())
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgStoreCodeAndInstantiate{
+				Sender:       storeMsg.Sender,
+				WASMByteCode: storeMsg.WASMByteCode,
+				Source:       storeMsg.Source,
+				Builder:      storeMsg.Builder,
+				Label:        label,
+				InitMsg:      encryptedMsg,
+				InitFunds:    initFunds,
+				Admin:        admin,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, newEncryptedTxFactory(cmd, clientCtx), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagIoMasterKey, "", "For offline transactions, use this to specify the path to the "+
+		"io-master-key.txt file, which you can get using the command `secretcli q register secret-network-params` ")
+	cmd.Flags().String(flagSource, "", "A valid URI reference to the contract's source code, optional")
+	cmd.Flags().String(flagBuilder, "", "A valid docker tag for the build system, optional")
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
+	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().String(flagAdmin, "", "Optional: Bech32 address of the admin of the contract")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// wasmCodeHash returns the hash the code will be stored with, given its bytes. Like on store,
+// gzipped code is hashed uncompressed.
+func wasmCodeHash(code []byte) ([]byte, error) {
+	wasm := code
+	if len(code) >= 3 && wasmUtils.IsGzip(code) {
+		zr, err := gzip.NewReader(bytes.NewReader(code))
+		if err != nil {
+			return nil, err
+		}
+		wasm, err = io.ReadAll(zr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hash := sha256.Sum256(wasm)
+	return hash[:], nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -144,7 +289,7 @@ func InstantiateContractCmd() *cobra.Command {
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+			return tx.GenerateOrBroadcastTxWithFactory(cliCtx, newEncryptedTxFactory(cmd, cliCtx), &msg)
 		},
 	}
 
@@ -262,13 +407,119 @@ func parseInstantiateArgs(args []string, cliCtx client.Context, initFlags *flag.
 	return msg, nil
 }
 
+// batchInstance is an instance in the json file of the batch-instantiate command
+type batchInstance struct {
+	Label   string          `json:"label"`
+	InitMsg json.RawMessage `json:"init_msg"`
+	Amount  string          `json:"amount,omitempty"`
+	Admin   string          `json:"admin,omitempty"`
+}
+
+// BatchInstantiateCmd instantiates many contracts of the same code in a single msg
+func BatchInstantiateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-instantiate [code_id_int64] [instances_json_file]",
+		Short: "Instantiate many contracts of the same code",
+		Long: `Instantiate many contracts of the same code in a single msg. If any instance fails, none
+of them is created. The file has a json array of instances, e.g.
+[{"label": "pair-1", "init_msg": {...}, "amount": "10uscrt", "admin": "secret1..."}]
+where amount and admin are optional.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var batch []batchInstance
+			if err := json.Unmarshal(bz, &batch); err != nil {
+				return fmt.Errorf("instances file: %w", err)
+			}
+
+			var codeHash []byte
+			ioKeyPath, _ := cmd.Flags().GetString(flagIoMasterKey)
+			if ioKeyPath != "" {
+				codeHashHex, _ := cmd.Flags().GetString(flagCodeHash)
+				if codeHashHex == "" {
+					return fmt.Errorf("missing flag --%s. To create an offline transaction, you must set the code hash", flagCodeHash)
+				}
+				codeHash = []byte(codeHashHex)
+			} else {
+				codeHash, err = GetCodeHashByCodeId(clientCtx, args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+			msg := types.MsgBatchInstantiate{
+				Sender:    clientCtx.GetFromAddress(),
+				CodeID:    codeID,
+				Instances: make([]types.BatchInstance, len(batch)),
+			}
+			for i, instance := range batch {
+				initFunds, err := sdk.ParseCoinsNormalized(instance.Amount)
+				if err != nil {
+					return fmt.Errorf("instance %d amount: %w", i, err)
+				}
+
+				initMsg := types.SecretMsg{
+					CodeHash: codeHash,
+					Msg:      instance.InitMsg,
+				}
+				var encryptedMsg []byte
+				if ioKeyPath != "" {
+					encryptedMsg, err = wasmCtx.OfflineEncrypt(initMsg.Serialize(), ioKeyPath)
+				} else {
+					encryptedMsg, err = wasmCtx.Encrypt(initMsg.Serialize())
+				}
+				if err != nil {
+					return err
+				}
+
+				msg.Instances[i] = types.BatchInstance{
+					Label:     instance.Label,
+					InitMsg:   encryptedMsg,
+					InitFunds: initFunds,
+					Admin:     instance.Admin,
+				}
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, newEncryptedTxFactory(cmd, clientCtx), &msg)
+		},
+	}
+
+	cmd.Flags().String(flagCodeHash, "", "For offline transactions, use this to specify the code hash of the contracts")
+	cmd.Flags().String(flagIoMasterKey, "", "For offline transactions, use this to specify the path to the "+
+		"io-master-key.txt file, which you can get using the command `secretcli q register secret-network-params` ")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // ExecuteContractCmd will instantiate a contract from previously uploaded code.
 func ExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "execute [optional: contract_addr_bech32] [json_encoded_send_args]",
-		Short:   "Execute a command on a wasm contract",
+		Use:   "execute [optional: contract_addr_bech32] [json_encoded_send_args]",
+		Short: "Execute a command on a wasm contract",
+		Long: `Execute a command on a wasm contract. The contract is given by its address, or by its label
+with --label.
+
+With --interactive, json_encoded_send_args is omitted: the msg is built by prompting for its fields,
+following the JSON schema attached to the code of the contract, and validated against the schema
+before it is encrypted and broadcast.`,
 		Aliases: []string{"exec"},
-		Args:    cobra.MinimumNArgs(1),
+		Args:    cobra.RangeArgs(0, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -289,6 +540,21 @@ func ExecuteContractCmd() *cobra.Command {
 				return err
 			}
 
+			interactive, err := cmd.Flags().GetBool(flagInteractive)
+			if err != nil {
+				return err
+			}
+			if interactive {
+				if genOnly {
+					return fmt.Errorf("offline transactions can't be built interactively")
+				}
+				// the msg is built from the schema after the contract is known
+				args = append(args, "")
+			}
+			if len(args) == 0 || len(args) > 2 {
+				return fmt.Errorf("accepts between 1 and 2 arg(s), received %d", len(args))
+			}
+
 			if len(args) == 1 {
 
 				if genOnly {
@@ -322,6 +588,13 @@ func ExecuteContractCmd() *cobra.Command {
 				msg = []byte(args[1])
 			}
 
+			if interactive {
+				msg, err = buildExecuteMsg(cmd, cliCtx, contractAddr)
+				if err != nil {
+					return err
+				}
+			}
+
 			if genOnly {
 
 				ioKeyPath, err = cmd.Flags().GetString(flagIoMasterKey)
@@ -350,6 +623,8 @@ func ExecuteContractCmd() *cobra.Command {
 		"io-master-key.txt file, which you can get using the command `secretcli q register secret-network-params` ")
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
+	cmd.Flags().Int64(flagExpiresAtHeight, 0, "Optional: the last height at which the msg may be executed")
+	cmd.Flags().Bool(flagInteractive, false, "Build the msg by prompting for its fields, following the schema of the contract's code")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -365,6 +640,15 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		return err
 	}
 
+	// commands that wrap ExecuteWithData don't necessarily define the flag
+	var expiresAtHeight int64
+	if cmd.Flags().Lookup(flagExpiresAtHeight) != nil {
+		expiresAtHeight, err = cmd.Flags().GetInt64(flagExpiresAtHeight)
+		if err != nil {
+			return err
+		}
+	}
+
 	var encryptedMsg []byte
 	if genOnly {
 		execMsg.CodeHash = []byte(codeHash)
@@ -387,8 +671,9 @@ func ExecuteWithData(cmd *cobra.Command, contractAddress sdk.AccAddress, msg []b
 		CallbackCodeHash: "",
 		SentFunds:        coins,
 		Msg:              encryptedMsg,
+		ExpiresAtHeight:  expiresAtHeight,
 	}
-	return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msgExec)
+	return tx.GenerateOrBroadcastTxWithFactory(cliCtx, newEncryptedTxFactory(cmd, cliCtx), &msgExec)
 }
 
 func GetCodeHashByCodeId(cliCtx client.Context, codeID string) ([]byte, error) {
@@ -547,3 +832,533 @@ func ClearContractAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// SetContractReceiveHookCmd enables or disables the bank receive hook of a contract
+func SetContractReceiveHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-receive-hook [contract_addr_bech32] [true|false]",
+		Short: "Enable or disable calling a contract with {\"receive_native\":{}} when it receives coins via a bank send",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetContractReceiveHook{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Enabled:  enabled,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetContractFeePolicyCmd sets the minimum fee a tx must pay to execute a contract
+func SetContractFeePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-policy [contract_addr_bech32] [min_fee]",
+		Short: "Set the minimum fee a tx must pay to execute a contract, omit min_fee to remove it",
+		Long: `Set the minimum fee a tx must pay to execute a contract, omit min_fee to remove it.
+The fee of the tx must cover at least one of the min_fee coins, e.g. "1000uscrt,500ibc/..."
+accepts a fee of at least 1000uscrt or at least 500ibc/....`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var minFee sdk.Coins
+			if len(args) == 2 {
+				minFee, err = sdk.ParseCoinsNormalized(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.MsgSetContractFeePolicy{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				MinFee:   minFee,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetCodeSchemaCmd attaches the JSON schema of a code's messages to the code
+func SetCodeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-schema [code_id] [schema_file]",
+		Short: "Attach the JSON schema of the messages of a code to the code",
+		Long: `Attach the JSON schema of the instantiate, execute and query messages of a code to the code,
+so that wallets can render and validate the messages before encrypting them. Only the creator of the
+code may do that. Instead of storing the schema on chain, omit schema_file and reference it with
+--schema-hash and --schema-uri.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var schema types.CodeSchema
+			schema.SchemaURI, _ = cmd.Flags().GetString(flagSchemaURI)
+			if len(args) == 2 {
+				schema.Schema, err = os.ReadFile(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			if schemaHash, _ := cmd.Flags().GetString(flagSchemaHash); schemaHash != "" {
+				schema.SchemaHash, err = hex.DecodeString(schemaHash)
+				if err != nil {
+					return sdkerrors.Wrap(err, "schema hash")
+				}
+			}
+
+			msg := types.MsgSetCodeSchema{
+				Sender: clientCtx.GetFromAddress().String(),
+				CodeID: codeID,
+				Schema: schema,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSchemaHash, "", "Hex encoded sha256 hash of the schema, when it isn't stored on chain")
+	cmd.Flags().String(flagSchemaURI, "", "https URI of the schema")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateInstantiateConfigCmd changes who may instantiate a code
+func UpdateInstantiateConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-instantiate-config [code_id] [everybody|nobody|only_address] [address]",
+		Short: "Change who may instantiate a code",
+		Long: `Change who may instantiate a code: everybody, nobody, or only the given address.
+Only the creator of the code may do that. Codes may be instantiated by everybody until changed.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			permission, err := types.ParseAccessType(args[1])
+			if err != nil {
+				return err
+			}
+			config := types.AccessConfig{Permission: permission}
+			if len(args) == 3 {
+				config.Address = args[2]
+			}
+
+			msg := types.MsgUpdateInstantiateConfig{
+				Sender:                   clientCtx.GetFromAddress().String(),
+				CodeID:                   codeID,
+				NewInstantiatePermission: config,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddCodeVerificationCmd claims that a code hash is the reproducible build of a source
+func AddCodeVerificationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-code-verification [code_hash] [source_repo_url] [commit] [builder]",
+		Short: "Claim that a code hash is the reproducible build of a source",
+		Long: `Claim that a code hash is the reproducible build of a source repository at a commit,
+with the given optimizer docker image (e.g. enigmampc/secret-contract-optimizer:1.0.10).
+The claim is signed by the sender, and replaces the previous claim of the sender for the code hash.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return sdkerrors.Wrap(err, "code hash")
+			}
+
+			msg := types.MsgAddCodeVerification{
+				Sender:   clientCtx.GetFromAddress().String(),
+				CodeHash: codeHash,
+				Source:   args[1],
+				Commit:   args[2],
+				Builder:  args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddCodeAuditCmd publishes an attestation that a code was audited
+func AddCodeAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-code-audit [code_id] [report_file] [date] [scope]",
+		Short: "Publish an attestation that a code was audited",
+		Long: `Publish an attestation that a code was audited, referencing the audit report by its sha256 hash.
+The date of the report is in the YYYY-MM-DD format, and the scope describes what was audited, e.g. the
+commit of the source and the features covered. Only the auditors approved by governance may do that,
+and the attestation replaces the previous attestation of the sender for the code.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			report, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			reportHash := sha256.Sum256(report)
+			auditedAt, err := time.Parse("2006-01-02", args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "date")
+			}
+			reportURI, _ := cmd.Flags().GetString(flagReportURI)
+
+			msg := types.MsgAddCodeAudit{
+				Sender:     clientCtx.GetFromAddress().String(),
+				CodeID:     codeID,
+				ReportHash: reportHash[:],
+				ReportURI:  reportURI,
+				AuditedAt:  auditedAt,
+				Scope:      args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagReportURI, "", "https URI of the audit report")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// WrapCoinCmd converts coins using the SNIP-20 wrapper registered for their denom
+func WrapCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrap [amount]",
+		Short: "Wrap native coins into their registered SNIP-20 tokens",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgWrapCoin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UnwrapCoinCmd converts coins using the SNIP-20 wrapper registered for their denom
+func UnwrapCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwrap [amount]",
+		Short: "Unwrap registered SNIP-20 tokens back into native coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnwrapCoin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ScheduleExecuteCmd schedules a plaintext contract call for the end of a future block
+func ScheduleExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-execute [contract_address] [json_encoded_send_args] --height [height] --gas-limit [gas] --execution-fee [coin]",
+		Short: "Schedule a contract call for the end of a future block",
+		Long: `Schedule a contract call for the end of a future block. The sent funds and the fee are
+escrowed until the call is executed or cancelled. The fee is paid to the fee collector once the
+call is executed, whether it succeeds or not.
+
+The message is stored and executed in plaintext. As nothing can verify it at the execution height,
+the contract gets it wrapped as {"scheduled_execute":{"msg":"<base64 message>"}}, so only contracts that
+handle this message can be scheduled, and sees an empty sender and no funds. The sent funds are
+transferred to the contract right before the call.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amountStr, err := cmd.Flags().GetString(flagAmount)
+			if err != nil {
+				return err
+			}
+			sentFunds, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+
+			executeHeight, err := cmd.Flags().GetInt64(flagExecuteHeight)
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			feeStr, err := cmd.Flags().GetString(flagScheduleFee)
+			if err != nil {
+				return err
+			}
+			fee, err := sdk.ParseCoinNormalized(feeStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "fee")
+			}
+
+			msg := types.MsgScheduleExecute{
+				Sender:        clientCtx.GetFromAddress().String(),
+				Contract:      contractAddr.String(),
+				Msg:           []byte(args[1]),
+				SentFunds:     sentFunds,
+				ExecuteHeight: executeHeight,
+				GasLimit:      gasLimit,
+				Fee:           fee,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with the call")
+	cmd.Flags().Int64(flagExecuteHeight, 0, "The height at the end of which the call is executed")
+	cmd.Flags().Uint64(flagGasLimit, 0, fmt.Sprintf("The most gas the call may use, up to %d", types.MaxScheduledCallGasLimit))
+	cmd.Flags().String(flagScheduleFee, "", "The fee paid for executing the call, in the bond denom and at least the gas limit times the scheduled call gas price param")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelScheduledExecuteCmd cancels a scheduled contract call and refunds its escrow
+func CancelScheduledExecuteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-execute [id]",
+		Short: "Cancel a scheduled contract call and refund its sent funds and fee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelScheduledExecute{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterCronCmd registers a recurring execution of a contract
+func RegisterCronCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cron [contract_address] [json_encoded_send_args] --interval [blocks] --gas-limit [gas] --budget [coin]",
+		Short: "Register a recurring execution of a contract, every interval blocks",
+		Long: `Register a recurring execution of a contract, every interval blocks. Only the admin of the
+contract can register crons from the command line, contracts can also register their own crons.
+
+The budget is escrowed to pay for the executions, each at the gas limit times the scheduled call gas
+price param. Once it can't pay for another execution the cron is removed, and the rest of the budget
+is refunded.
+
+The message is stored and executed in plaintext. As nothing can verify it at the execution height,
+the contract gets it wrapped as {"scheduled_execute":{"msg":"<base64 message>"}}, so only contracts that
+handle this message can be scheduled, and sees an empty sender and no funds.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetInt64(flagInterval)
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+			budgetStr, err := cmd.Flags().GetString(flagBudget)
+			if err != nil {
+				return err
+			}
+			budget, err := sdk.ParseCoinNormalized(budgetStr)
+			if err != nil {
+				return sdkerrors.Wrap(err, "budget")
+			}
+
+			msg := types.MsgRegisterCron{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: contractAddr.String(),
+				Msg:      []byte(args[1]),
+				Interval: interval,
+				GasLimit: gasLimit,
+				Budget:   budget,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.Flags().Int64(flagInterval, 0, "The number of blocks between two executions")
+	cmd.Flags().Uint64(flagGasLimit, 0, "The most gas each execution may use, up to the max_cron_gas_limit param")
+	cmd.Flags().String(flagBudget, "", "The prepaid fees of the executions, in the bond denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelCronCmd removes a recurring execution of a contract
+func CancelCronCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-cron [id]",
+		Short: "Remove a recurring execution of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelCron{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
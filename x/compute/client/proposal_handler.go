@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/rest"
+)
+
+// RecoverContractFundsProposalHandler is the fund recovery proposal handler
+var RecoverContractFundsProposalHandler = govclient.NewProposalHandler(cli.ProposalRecoverContractFundsCmd, rest.RecoverContractFundsProposalHandler)
+
+// FlagBrokenCodeProposalHandler is the handler of proposals that flag a code as broken
+var FlagBrokenCodeProposalHandler = govclient.NewProposalHandler(cli.ProposalFlagBrokenCodeCmd, rest.FlagBrokenCodeProposalHandler)
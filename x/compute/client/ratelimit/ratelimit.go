@@ -0,0 +1,189 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader is the request header, or gRPC metadata key, used to identify a client for query rate
+// limiting. Only the API keys configured by the operator are used, other clients are identified by their
+// IP address.
+const APIKeyHeader = "X-API-Key"
+
+// rateLimitedPathPrefixes are the REST routes serving contract queries
+var rateLimitedPathPrefixes = []string{"/compute/", "/wasm/", "/cosmwasm/"}
+
+// rateLimitedService is the gRPC service serving contract queries
+const rateLimitedService = "secret.compute.v1beta1.Query"
+
+// how long a client may stay idle before its bucket is dropped
+const rateLimitBucketTTL = 10 * time.Minute
+
+type tokenBucket struct {
+	tokens   float64
+	lastSeen time.Time
+}
+
+// QueryRateLimiter limits the contract queries of each client, through the REST API and gRPC alike,
+// with a token bucket per client
+type QueryRateLimiter struct {
+	mtx       sync.Mutex
+	rate      float64
+	burst     float64
+	apiKeys   map[string]bool
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewQueryRateLimiter returns a limiter of contract queries to rate requests per second per client,
+// allowing bursts of up to burst requests. A client is identified by its API key if it's one of apiKeys,
+// or else by its IP address.
+func NewQueryRateLimiter(rate float64, burst uint64, apiKeys []string) *QueryRateLimiter {
+	return newQueryRateLimiter(rate, burst, apiKeys, time.Now)
+}
+
+func newQueryRateLimiter(rate float64, burst uint64, apiKeys []string, now func() time.Time) *QueryRateLimiter {
+	if burst == 0 {
+		burst = 1
+	}
+	keys := make(map[string]bool, len(apiKeys))
+	for _, key := range apiKeys {
+		if key != "" {
+			keys[key] = true
+		}
+	}
+	return &QueryRateLimiter{
+		rate:      rate,
+		burst:     float64(burst),
+		apiKeys:   keys,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: now(),
+		now:       now,
+	}
+}
+
+// Middleware limits the REST routes of contract queries
+func (l *QueryRateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isRateLimitedPath(r.URL.Path) && !l.allow(l.clientID(r.Header.Get(APIKeyHeader), r.RemoteAddr)) {
+			rest.WriteErrorResponse(w, http.StatusTooManyRequests, "query rate limit exceeded")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GRPCServer wraps server so that the methods of the contract query service it registers are limited
+func (l *QueryRateLimiter) GRPCServer(server gogogrpc.Server) gogogrpc.Server {
+	return rateLimitedGRPCServer{Server: server, limiter: l}
+}
+
+type rateLimitedGRPCServer struct {
+	gogogrpc.Server
+	limiter *QueryRateLimiter
+}
+
+func (s rateLimitedGRPCServer) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if sd.ServiceName != rateLimitedService {
+		s.Server.RegisterService(sd, ss)
+		return
+	}
+
+	limited := *sd
+	limited.Methods = make([]grpc.MethodDesc, len(sd.Methods))
+	for i, method := range sd.Methods {
+		handler := method.Handler
+		limited.Methods[i] = grpc.MethodDesc{
+			MethodName: method.MethodName,
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				if !s.limiter.allow(s.limiter.grpcClientID(ctx)) {
+					return nil, status.Error(codes.ResourceExhausted, "query rate limit exceeded")
+				}
+				return handler(srv, ctx, dec, interceptor)
+			},
+		}
+	}
+	s.Server.RegisterService(&limited, ss)
+}
+
+// allow takes a token from the client's bucket, returning false if it's empty
+func (l *QueryRateLimiter) allow(client string) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) > rateLimitBucketTTL {
+		for id, b := range l.buckets {
+			if now.Sub(b.lastSeen) > rateLimitBucketTTL {
+				delete(l.buckets, id)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[client]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, lastSeen: now}
+		l.buckets[client] = b
+	}
+
+	b.tokens += now.Sub(b.lastSeen).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.lastSeen = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func isRateLimitedPath(path string) bool {
+	for _, prefix := range rateLimitedPathPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientID identifies a client by its API key if it's a configured one, so that an unknown key can't
+// get a fresh bucket, or else by its IP address
+func (l *QueryRateLimiter) clientID(apiKey, remoteAddr string) string {
+	if l.apiKeys[apiKey] {
+		return "key:" + apiKey
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return "ip:" + host
+}
+
+func (l *QueryRateLimiter) grpcClientID(ctx context.Context) string {
+	var apiKey, remoteAddr string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(APIKeyHeader); len(keys) > 0 {
+			apiKey = keys[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		remoteAddr = p.Addr.String()
+	}
+	return l.clientID(apiKey, remoteAddr)
+}
@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newQueryRateLimiter(1, 2, []string{"known-key"}, func() time.Time { return now })
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	do := func(path, apiKey, remoteAddr string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			req.Header.Set(APIKeyHeader, apiKey)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	const query = "/compute/v1beta1/query/secret1xyz"
+
+	// burst is allowed, then the client is throttled
+	assert.Equal(t, http.StatusOK, do(query, "", "1.2.3.4:1000"))
+	assert.Equal(t, http.StatusOK, do(query, "", "1.2.3.4:1001"))
+	assert.Equal(t, http.StatusTooManyRequests, do(query, "", "1.2.3.4:1002"))
+
+	// unknown API keys don't get a bucket of their own
+	assert.Equal(t, http.StatusTooManyRequests, do(query, "some-key", "1.2.3.4:1000"))
+	assert.Equal(t, http.StatusTooManyRequests, do(query, "other-key", "1.2.3.4:1000"))
+
+	// other clients, configured API keys and non query routes are not affected
+	assert.Equal(t, http.StatusOK, do(query, "", "5.6.7.8:1000"))
+	assert.Equal(t, http.StatusOK, do(query, "known-key", "1.2.3.4:1000"))
+	assert.Equal(t, http.StatusOK, do("/cosmos/bank/v1beta1/balances/secret1xyz", "", "1.2.3.4:1000"))
+
+	// tokens refill over time
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, do(query, "", "1.2.3.4:1000"))
+	assert.Equal(t, http.StatusTooManyRequests, do(query, "", "1.2.3.4:1000"))
+}
+
+func TestQueryRateLimiterEvictsIdleBuckets(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newQueryRateLimiter(1, 1, nil, func() time.Time { return now })
+
+	require.True(t, l.allow("ip:1.2.3.4"))
+	require.True(t, l.allow("ip:5.6.7.8"))
+	require.Len(t, l.buckets, 2)
+
+	now = now.Add(rateLimitBucketTTL / 2)
+	require.True(t, l.allow("ip:5.6.7.8"))
+
+	now = now.Add(rateLimitBucketTTL/2 + time.Second)
+	require.True(t, l.allow("ip:5.6.7.8"))
+	require.Len(t, l.buckets, 1)
+	require.Contains(t, l.buckets, "ip:5.6.7.8")
+}
+
+// mockGRPCServer records the services registered on it
+type mockGRPCServer struct {
+	services map[string]*grpc.ServiceDesc
+}
+
+func (s *mockGRPCServer) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	s.services[sd.ServiceName] = sd
+}
+
+func TestQueryRateLimiterGRPC(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newQueryRateLimiter(1, 1, []string{"known-key"}, func() time.Time { return now })
+
+	handler := func(_ interface{}, _ context.Context, _ func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		return "ok", nil
+	}
+	server := &mockGRPCServer{services: make(map[string]*grpc.ServiceDesc)}
+	limited := l.GRPCServer(server)
+	limited.RegisterService(&grpc.ServiceDesc{
+		ServiceName: rateLimitedService,
+		Methods:     []grpc.MethodDesc{{MethodName: "QuerySecretContract", Handler: handler}},
+	}, nil)
+	limited.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "cosmos.bank.v1beta1.Query",
+		Methods:     []grpc.MethodDesc{{MethodName: "Balance", Handler: handler}},
+	}, nil)
+
+	call := func(service, apiKey, remoteAddr string) error {
+		addr, err := net.ResolveTCPAddr("tcp", remoteAddr)
+		require.NoError(t, err)
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+		if apiKey != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyHeader, apiKey))
+		}
+		_, err = server.services[service].Methods[0].Handler(nil, ctx, nil, nil)
+		return err
+	}
+
+	require.NoError(t, call(rateLimitedService, "", "1.2.3.4:1000"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(rateLimitedService, "", "1.2.3.4:1001")))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(rateLimitedService, "some-key", "1.2.3.4:1000")))
+
+	require.NoError(t, call(rateLimitedService, "known-key", "1.2.3.4:1000"))
+	require.NoError(t, call(rateLimitedService, "", "5.6.7.8:1000"))
+	require.NoError(t, call("cosmos.bank.v1beta1.Query", "", "1.2.3.4:1000"))
+}
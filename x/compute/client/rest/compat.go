@@ -0,0 +1,477 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// registerWasmdCompatRoutes registers the query routes served by wasmd, so generic CosmWasm tooling
+// can talk to a Secret node. Payloads keep the Secret semantics: smart queries must be encrypted for
+// the contract and their results are returned encrypted.
+func registerWasmdCompatRoutes(cliCtx client.Context, r *mux.Router) {
+	// wasmd legacy REST routes
+	r.HandleFunc("/wasm/contract/{contractAddr}/smart/{query}", queryContractStateHandlerFn(cliCtx)).Queries("encoding", "{encoding}").Methods("GET")
+	r.HandleFunc("/wasm/contract/{contractAddr}/history", queryContractHistoryHandlerFn(cliCtx)).Methods("GET")
+
+	// wasmd gRPC gateway routes, answered in the JSON schema of the wasmd gateway
+	r.HandleFunc("/cosmwasm/wasm/v1/code", wasmdCodesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/cosmwasm/wasm/v1/code/{code_id}", wasmdCodeHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/cosmwasm/wasm/v1/code/{code_id}/contracts", wasmdContractsByCodeHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/cosmwasm/wasm/v1/contract/{address}", wasmdContractInfoHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/cosmwasm/wasm/v1/contract/{address}/history", wasmdContractHistoryHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/cosmwasm/wasm/v1/contract/{address}/smart/{query_data}", wasmdSmartQueryHandlerFn(cliCtx)).Methods("GET")
+}
+
+func queryContractHistoryHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["contractAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(cliCtx)
+		res, err := queryClient.ContractHistory(
+			context.Background(),
+			&types.QueryContractHistoryRequest{ContractAddress: addr.String()},
+		)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(res)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, json.RawMessage(bz))
+	}
+}
+
+// The wasmd gateway schema. Integers are strings and bytes are base64, as in the proto3 JSON mapping.
+
+type wasmdPageResponse struct {
+	NextKey []byte `json:"next_key"`
+	Total   string `json:"total"`
+}
+
+type wasmdAccessConfig struct {
+	Permission string   `json:"permission"`
+	Address    string   `json:"address"`
+	Addresses  []string `json:"addresses"`
+}
+
+type wasmdCodeInfo struct {
+	CodeID                string            `json:"code_id"`
+	Creator               string            `json:"creator"`
+	DataHash              string            `json:"data_hash"`
+	InstantiatePermission wasmdAccessConfig `json:"instantiate_permission"`
+}
+
+type wasmdCodesResponse struct {
+	CodeInfos  []wasmdCodeInfo    `json:"code_infos"`
+	Pagination *wasmdPageResponse `json:"pagination"`
+}
+
+type wasmdCodeResponse struct {
+	CodeInfo wasmdCodeInfo `json:"code_info"`
+	Data     []byte        `json:"data"`
+}
+
+type wasmdContractsByCodeResponse struct {
+	Contracts  []string           `json:"contracts"`
+	Pagination *wasmdPageResponse `json:"pagination"`
+}
+
+type wasmdAbsoluteTxPosition struct {
+	BlockHeight string `json:"block_height"`
+	TxIndex     string `json:"tx_index"`
+}
+
+type wasmdContractInfo struct {
+	CodeID    string                   `json:"code_id"`
+	Creator   string                   `json:"creator"`
+	Admin     string                   `json:"admin"`
+	Label     string                   `json:"label"`
+	Created   *wasmdAbsoluteTxPosition `json:"created"`
+	IBCPortID string                   `json:"ibc_port_id"`
+	Extension json.RawMessage          `json:"extension"`
+}
+
+type wasmdContractInfoResponse struct {
+	Address      string            `json:"address"`
+	ContractInfo wasmdContractInfo `json:"contract_info"`
+}
+
+// wasmdContractHistoryEntry carries the msg of the operation as base64, since the Secret msgs are
+// encrypted and so aren't the raw JSON wasmd returns
+type wasmdContractHistoryEntry struct {
+	Operation string                   `json:"operation"`
+	CodeID    string                   `json:"code_id"`
+	Updated   *wasmdAbsoluteTxPosition `json:"updated"`
+	Msg       []byte                   `json:"msg"`
+}
+
+type wasmdContractHistoryResponse struct {
+	Entries    []wasmdContractHistoryEntry `json:"entries"`
+	Pagination *wasmdPageResponse          `json:"pagination"`
+}
+
+// wasmdSmartQueryResponse carries the encrypted result of the query as base64
+type wasmdSmartQueryResponse struct {
+	Data []byte `json:"data"`
+}
+
+// wasmdGatewayError is the error body of the gRPC gateway
+type wasmdGatewayError struct {
+	Error   string        `json:"error"`
+	Code    int32         `json:"code"`
+	Message string        `json:"message"`
+	Details []interface{} `json:"details"`
+}
+
+func toWasmdPageResponse(res *query.PageResponse) *wasmdPageResponse {
+	if res == nil {
+		return nil
+	}
+	return &wasmdPageResponse{NextKey: res.NextKey, Total: strconv.FormatUint(res.Total, 10)}
+}
+
+// toWasmdAccessConfig maps the instantiate permission of a code. Secret codes without one may be
+// instantiated by everybody, and wasmd expresses a single allowed address with AnyOfAddresses.
+func toWasmdAccessConfig(config types.AccessConfig) wasmdAccessConfig {
+	res := wasmdAccessConfig{Addresses: []string{}}
+	switch config.Permission {
+	case types.AccessTypeNobody:
+		res.Permission = "Nobody"
+	case types.AccessTypeOnlyAddress:
+		res.Permission = "AnyOfAddresses"
+		res.Addresses = []string{config.Address}
+	default:
+		res.Permission = "Everybody"
+	}
+	return res
+}
+
+func toWasmdCodeInfo(info types.CodeInfoResponse) wasmdCodeInfo {
+	return wasmdCodeInfo{
+		CodeID:                strconv.FormatUint(info.CodeId, 10),
+		Creator:               info.Creator,
+		DataHash:              strings.ToUpper(info.CodeHash),
+		InstantiatePermission: toWasmdAccessConfig(info.InstantiatePermission),
+	}
+}
+
+func toWasmdAbsoluteTxPosition(pos *types.AbsoluteTxPosition) *wasmdAbsoluteTxPosition {
+	if pos == nil {
+		return nil
+	}
+	return &wasmdAbsoluteTxPosition{
+		BlockHeight: strconv.FormatInt(pos.BlockHeight, 10),
+		TxIndex:     strconv.FormatUint(pos.TxIndex, 10),
+	}
+}
+
+func toWasmdContractInfo(info types.ContractInfo) wasmdContractInfo {
+	return wasmdContractInfo{
+		CodeID:    strconv.FormatUint(info.CodeID, 10),
+		Creator:   info.Creator.String(),
+		Admin:     info.Admin,
+		Label:     info.Label,
+		Created:   toWasmdAbsoluteTxPosition(info.Created),
+		IBCPortID: info.IBCPortID,
+		Extension: json.RawMessage("null"),
+	}
+}
+
+func toWasmdContractHistoryEntry(entry types.ContractCodeHistoryEntry) wasmdContractHistoryEntry {
+	return wasmdContractHistoryEntry{
+		Operation: entry.Operation.String(),
+		CodeID:    strconv.FormatUint(entry.CodeID, 10),
+		Updated:   toWasmdAbsoluteTxPosition(entry.Updated),
+		Msg:       entry.Msg,
+	}
+}
+
+// parseWasmdPageRequest reads the pagination query params the way the gRPC gateway does,
+// e.g. ?pagination.limit=10&pagination.key=...
+func parseWasmdPageRequest(r *http.Request) (*query.PageRequest, error) {
+	values := r.URL.Query()
+	pageReq := &query.PageRequest{}
+	var err error
+	if key := values.Get("pagination.key"); key != "" {
+		if pageReq.Key, err = runtime.Bytes(key); err != nil {
+			return nil, err
+		}
+	}
+	if offset := values.Get("pagination.offset"); offset != "" {
+		if pageReq.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if limit := values.Get("pagination.limit"); limit != "" {
+		if pageReq.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if countTotal := values.Get("pagination.count_total"); countTotal != "" {
+		if pageReq.CountTotal, err = strconv.ParseBool(countTotal); err != nil {
+			return nil, err
+		}
+	}
+	if reverse := values.Get("pagination.reverse"); reverse != "" {
+		if pageReq.Reverse, err = strconv.ParseBool(reverse); err != nil {
+			return nil, err
+		}
+	}
+	return pageReq, nil
+}
+
+// wasmdQueryContext applies the block height requested with the header of the gRPC gateway
+func wasmdQueryContext(cliCtx client.Context, r *http.Request) (client.Context, error) {
+	if h := r.Header.Get(grpctypes.GRPCBlockHeightHeader); h != "" {
+		height, err := strconv.ParseInt(h, 10, 64)
+		if err != nil || height < 0 {
+			return cliCtx, status.Errorf(codes.InvalidArgument, "invalid height %q", h)
+		}
+		cliCtx = cliCtx.WithHeight(height)
+	}
+	return cliCtx, nil
+}
+
+func writeWasmdResponse(w http.ResponseWriter, res interface{}) {
+	bz, err := json.Marshal(res)
+	if err != nil {
+		writeWasmdError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// writeWasmdError writes err the way the gRPC gateway does, with the HTTP status of its gRPC code
+func writeWasmdError(w http.ResponseWriter, err error) {
+	s, _ := status.FromError(err)
+	bz, _ := json.Marshal(wasmdGatewayError{
+		Error:   s.Message(),
+		Code:    int32(s.Code()),
+		Message: s.Message(),
+		Details: []interface{}{},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+	_, _ = w.Write(bz)
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func wasmdCodesHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parseWasmdPageRequest(r)
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).ListCodes(
+			context.Background(),
+			&types.QueryListCodesRequest{Pagination: pageReq},
+		)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		out := wasmdCodesResponse{CodeInfos: make([]wasmdCodeInfo, len(res.CodeInfos)), Pagination: toWasmdPageResponse(res.Pagination)}
+		for i, info := range res.CodeInfos {
+			out.CodeInfos[i] = toWasmdCodeInfo(info)
+		}
+		writeWasmdResponse(w, out)
+	}
+}
+
+func wasmdCodeHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		codeID, err := strconv.ParseUint(mux.Vars(r)["code_id"], 10, 64)
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).Code(context.Background(), &types.QueryByCodeIdRequest{CodeId: codeID})
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+		if res.CodeInfoResponse == nil {
+			writeWasmdError(w, status.Errorf(codes.NotFound, "no code with id %d", codeID))
+			return
+		}
+
+		writeWasmdResponse(w, wasmdCodeResponse{CodeInfo: toWasmdCodeInfo(*res.CodeInfoResponse), Data: res.Wasm})
+	}
+}
+
+func wasmdContractsByCodeHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		codeID, err := strconv.ParseUint(mux.Vars(r)["code_id"], 10, 64)
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		pageReq, err := parseWasmdPageRequest(r)
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).ListContractsByCode(
+			context.Background(),
+			&types.QueryListContractsByCodeRequest{CodeId: codeID, Pagination: pageReq},
+		)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		out := wasmdContractsByCodeResponse{Contracts: make([]string, len(res.Contracts)), Pagination: toWasmdPageResponse(res.Pagination)}
+		for i, contract := range res.Contracts {
+			out.Contracts[i] = contract.ContractAddress
+		}
+		writeWasmdResponse(w, out)
+	}
+}
+
+func wasmdContractInfoHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).ContractInfo(
+			context.Background(),
+			&types.QueryByContractAddressRequest{ContractAddress: addr.String()},
+		)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+		if res.ContractInfo == nil {
+			writeWasmdError(w, status.Errorf(codes.NotFound, "no contract at %s", addr))
+			return
+		}
+
+		writeWasmdResponse(w, wasmdContractInfoResponse{Address: addr.String(), ContractInfo: toWasmdContractInfo(*res.ContractInfo)})
+	}
+}
+
+func wasmdContractHistoryHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).ContractHistory(
+			context.Background(),
+			&types.QueryContractHistoryRequest{ContractAddress: addr.String()},
+		)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		// the history isn't paginated, so it all fits in one page
+		out := wasmdContractHistoryResponse{
+			Entries:    make([]wasmdContractHistoryEntry, len(res.Entries)),
+			Pagination: &wasmdPageResponse{Total: strconv.Itoa(len(res.Entries))},
+		}
+		for i, entry := range res.Entries {
+			out.Entries[i] = toWasmdContractHistoryEntry(entry)
+		}
+		writeWasmdResponse(w, out)
+	}
+}
+
+// wasmdSmartQueryHandlerFn serves smart queries the way the wasmd gRPC gateway does, with the
+// (encrypted) query passed base64 encoded in the path
+func wasmdSmartQueryHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		queryData, err := runtime.Bytes(mux.Vars(r)["query_data"])
+		if err != nil {
+			writeWasmdError(w, invalidArgument(err))
+			return
+		}
+		cliCtx, err := wasmdQueryContext(cliCtx, r)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		res, err := types.NewQueryClient(cliCtx).QuerySecretContract(
+			context.Background(),
+			&types.QuerySecretContractRequest{ContractAddress: addr.String(), Query: queryData},
+		)
+		if err != nil {
+			writeWasmdError(w, err)
+			return
+		}
+
+		writeWasmdResponse(w, wasmdSmartQueryResponse{Data: res.Data})
+	}
+}
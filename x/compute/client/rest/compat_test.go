@@ -0,0 +1,276 @@
+package rest
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// mockNode answers the ABCI queries of the gRPC query client with canned responses by method
+type mockNode struct {
+	rpcclient.Client
+
+	responses map[string]codec.ProtoMarshaler
+	errors    map[string]*sdkerrors.Error
+
+	// the data and height of the last query
+	data   []byte
+	height int64
+}
+
+func (n *mockNode) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	n.data, n.height = data, opts.Height
+	if err, ok := n.errors[path]; ok {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: err.ABCICode(), Codespace: err.Codespace(), Log: err.Error()}}, nil
+	}
+	res, ok := n.responses[path]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz, Height: 1}}, nil
+}
+
+func queryPath(method string) string {
+	return "/secret.compute.v1beta1.Query/" + method
+}
+
+func doWasmdQuery(node *mockNode, path string, header http.Header) *httptest.ResponseRecorder {
+	r := mux.NewRouter()
+	registerWasmdCompatRoutes(client.Context{}.WithClient(node), r)
+
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for k, values := range header {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWasmdCodes(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________")
+	allowed := sdk.AccAddress("allowed_____________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("ListCodes"): &types.QueryListCodesResponse{
+			CodeInfos: []types.CodeInfoResponse{
+				{CodeId: 1, Creator: creator.String(), CodeHash: "0a1b", Source: "https://source", Builder: "builder"},
+				{
+					CodeId: 2, Creator: creator.String(), CodeHash: "2c3d",
+					InstantiatePermission: types.AccessConfig{Permission: types.AccessTypeOnlyAddress, Address: allowed.String()},
+				},
+			},
+			Pagination: &query.PageResponse{NextKey: []byte{0x1}, Total: 3},
+		},
+	}}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/code?pagination.limit=2&pagination.count_total=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, fmt.Sprintf(`{
+		"code_infos": [
+			{"code_id": "1", "creator": %q, "data_hash": "0A1B", "instantiate_permission": {"permission": "Everybody", "address": "", "addresses": []}},
+			{"code_id": "2", "creator": %q, "data_hash": "2C3D", "instantiate_permission": {"permission": "AnyOfAddresses", "address": "", "addresses": [%q]}}
+		],
+		"pagination": {"next_key": "AQ==", "total": "3"}
+	}`, creator.String(), creator.String(), allowed.String()), rec.Body.String())
+
+	var req types.QueryListCodesRequest
+	require.NoError(t, req.Unmarshal(node.data))
+	require.Equal(t, &query.PageRequest{Limit: 2, CountTotal: true}, req.Pagination)
+}
+
+func TestWasmdCode(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("Code"): &types.QueryCodeResponse{
+			CodeInfoResponse: &types.CodeInfoResponse{
+				CodeId: 7, Creator: creator.String(), CodeHash: "abcd",
+				InstantiatePermission: types.AccessConfig{Permission: types.AccessTypeNobody},
+			},
+			Wasm: []byte("wasm"),
+		},
+	}}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/code/7", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, fmt.Sprintf(`{
+		"code_info": {"code_id": "7", "creator": %q, "data_hash": "ABCD", "instantiate_permission": {"permission": "Nobody", "address": "", "addresses": []}},
+		"data": %q
+	}`, creator.String(), base64.StdEncoding.EncodeToString([]byte("wasm"))), rec.Body.String())
+
+	var req types.QueryByCodeIdRequest
+	require.NoError(t, req.Unmarshal(node.data))
+	require.Equal(t, uint64(7), req.CodeId)
+
+	rec = doWasmdQuery(node, "/cosmwasm/wasm/v1/code/seven", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), `"code":3`)
+}
+
+func TestWasmdContractsByCode(t *testing.T) {
+	first := sdk.AccAddress("first_______________")
+	second := sdk.AccAddress("second______________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("ListContractsByCode"): &types.QueryListContractsByCodeResponse{
+			Contracts: []types.ContractByCode{
+				{ContractAddress: first.String(), Label: "first", CreatedHeight: 10},
+				{ContractAddress: second.String(), Label: "second", CreatedHeight: 11},
+			},
+			Pagination: &query.PageResponse{Total: 2},
+		},
+	}}
+
+	key := base64.URLEncoding.EncodeToString([]byte{0xfb, 0xff})
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/code/3/contracts?pagination.key="+key+"&pagination.reverse=true", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, fmt.Sprintf(`{
+		"contracts": [%q, %q],
+		"pagination": {"next_key": null, "total": "2"}
+	}`, first.String(), second.String()), rec.Body.String())
+
+	var req types.QueryListContractsByCodeRequest
+	require.NoError(t, req.Unmarshal(node.data))
+	require.Equal(t, uint64(3), req.CodeId)
+	require.Equal(t, &query.PageRequest{Key: []byte{0xfb, 0xff}, Reverse: true}, req.Pagination)
+
+	rec = doWasmdQuery(node, "/cosmwasm/wasm/v1/code/3/contracts?pagination.limit=-1", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWasmdContractInfo(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	creator := sdk.AccAddress("creator_____________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("ContractInfo"): &types.QueryContractInfoResponse{
+			ContractAddress: contract.String(),
+			ContractInfo: &types.ContractInfo{
+				CodeID:    4,
+				Creator:   creator,
+				Label:     "my contract",
+				Created:   &types.AbsoluteTxPosition{BlockHeight: 100, TxIndex: 2},
+				IBCPortID: "wasm." + contract.String(),
+				Admin:     creator.String(),
+			},
+		},
+	}}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String(), http.Header{grpctypes.GRPCBlockHeightHeader: {"42"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, fmt.Sprintf(`{
+		"address": %q,
+		"contract_info": {
+			"code_id": "4",
+			"creator": %q,
+			"admin": %q,
+			"label": "my contract",
+			"created": {"block_height": "100", "tx_index": "2"},
+			"ibc_port_id": "wasm.%s",
+			"extension": null
+		}
+	}`, contract.String(), creator.String(), creator.String(), contract.String()), rec.Body.String())
+	require.Equal(t, int64(42), node.height)
+
+	rec = doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String(), http.Header{grpctypes.GRPCBlockHeightHeader: {"latest"}})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/not-an-address", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWasmdContractHistory(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("ContractHistory"): &types.QueryContractHistoryResponse{
+			Entries: []types.ContractCodeHistoryEntry{
+				{
+					Operation: types.ContractCodeHistoryOperationTypeInit,
+					CodeID:    1,
+					Updated:   &types.AbsoluteTxPosition{BlockHeight: 10, TxIndex: 1},
+					Msg:       []byte("encrypted init"),
+				},
+				{
+					Operation: types.ContractCodeHistoryOperationTypeMigrate,
+					CodeID:    2,
+					Updated:   &types.AbsoluteTxPosition{BlockHeight: 20},
+					Msg:       []byte("encrypted migrate"),
+				},
+			},
+		},
+	}}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String()+"/history", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, fmt.Sprintf(`{
+		"entries": [
+			{"operation": "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT", "code_id": "1", "updated": {"block_height": "10", "tx_index": "1"}, "msg": %q},
+			{"operation": "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE", "code_id": "2", "updated": {"block_height": "20", "tx_index": "0"}, "msg": %q}
+		],
+		"pagination": {"next_key": null, "total": "2"}
+	}`, base64.StdEncoding.EncodeToString([]byte("encrypted init")), base64.StdEncoding.EncodeToString([]byte("encrypted migrate"))), rec.Body.String())
+
+	var req types.QueryContractHistoryRequest
+	require.NoError(t, req.Unmarshal(node.data))
+	require.Equal(t, contract.String(), req.ContractAddress)
+}
+
+func TestWasmdSmartQuery(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	node := &mockNode{responses: map[string]codec.ProtoMarshaler{
+		queryPath("QuerySecretContract"): &types.QuerySecretContractResponse{Data: []byte("encrypted result")},
+	}}
+
+	// the query is taken either base64 or URL safe base64 encoded, like the gRPC gateway does
+	query := []byte{0xfb, 0xef, 0xbe, 0x01}
+	for _, encoded := range []string{base64.StdEncoding.EncodeToString(query), base64.URLEncoding.EncodeToString(query)} {
+		rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String()+"/smart/"+encoded, nil)
+		require.Equal(t, http.StatusOK, rec.Code, encoded)
+		require.JSONEq(t, fmt.Sprintf(`{"data": %q}`, base64.StdEncoding.EncodeToString([]byte("encrypted result"))), rec.Body.String())
+
+		var req types.QuerySecretContractRequest
+		require.NoError(t, req.Unmarshal(node.data))
+		require.Equal(t, contract.String(), req.ContractAddress)
+		require.Equal(t, query, req.Query)
+	}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String()+"/smart/!!!", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestWasmdQueryError(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	node := &mockNode{errors: map[string]*sdkerrors.Error{
+		queryPath("ContractInfo"):        sdkerrors.ErrKeyNotFound,
+		queryPath("QuerySecretContract"): sdkerrors.ErrInvalidRequest,
+	}}
+
+	rec := doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String(), nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.JSONEq(t, `{"error": "key not found", "code": 5, "message": "key not found", "details": []}`, rec.Body.String())
+
+	rec = doWasmdQuery(node, "/cosmwasm/wasm/v1/contract/"+contract.String()+"/smart/e30=", nil)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), `"code":3`)
+}
@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+type recoverContractFundsProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+	Recipient   sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// RecoverContractFundsProposalHandler returns the REST handler that generates a proposal to recover the funds
+// of an orphaned contract
+func RecoverContractFundsProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "recover_contract_funds",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req recoverContractFundsProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := types.NewRecoverContractFundsProposal(req.Title, req.Description, req.Contract, req.Recipient)
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+		},
+	}
+}
+
+type flagBrokenCodeProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	CodeID      uint64         `json:"code_id" yaml:"code_id"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// FlagBrokenCodeProposalHandler returns the REST handler that generates a proposal to flag a code as broken
+func FlagBrokenCodeProposalHandler(cliCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "flag_broken_code",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req flagBrokenCodeProposalReq
+			if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := types.NewFlagBrokenCodeProposal(req.Title, req.Description, req.CodeID)
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+		},
+	}
+}
@@ -8,5 +8,6 @@ import (
 // RegisterRoutes registers staking-related REST handlers to a router
 func RegisterRoutes(cliCtx client.Context, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
+	registerWasmdCompatRoutes(cliCtx, r)
 	registerTxRoutes(cliCtx, r)
 }
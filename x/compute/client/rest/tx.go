@@ -36,9 +36,10 @@ type instantiateContractReq struct {
 }
 
 type executeContractReq struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
-	ExecMsg []byte       `json:"exec_msg" yaml:"exec_msg"`
-	Amount  sdk.Coins    `json:"coins" yaml:"coins"`
+	BaseReq         rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ExecMsg         []byte       `json:"exec_msg" yaml:"exec_msg"`
+	Amount          sdk.Coins    `json:"coins" yaml:"coins"`
+	ExpiresAtHeight int64        `json:"expires_at_height,omitempty" yaml:"expires_at_height"`
 }
 
 func storeCodeHandlerFn(cliCtx client.Context) http.HandlerFunc {
@@ -156,6 +157,7 @@ func executeContractHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			CallbackCodeHash: "",
 			Msg:              req.ExecMsg,
 			SentFunds:        req.Amount,
+			ExpiresAtHeight:  req.ExpiresAtHeight,
 		}
 
 		err = msg.ValidateBasic()
@@ -211,6 +211,22 @@ func (ctx WASMContext) OfflineEncrypt(plaintext []byte, pathToMasterIoKey string
 	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
 }
 
+// EncryptWithKeys encrypts plaintext to the enclave with the given consensus IO public key, tx sender
+// private key and nonce, so the output is fully determined by its inputs
+func EncryptWithKeys(consensusIoPubKey []byte, txSenderPrivKey []byte, nonce []byte, plaintext []byte) ([]byte, error) {
+	txSenderPubKey, err := curve25519.X25519(txSenderPrivKey, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	txEncryptionKey, err := GetTxEncryptionKeyOffline(consensusIoPubKey, txSenderPrivKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
+}
+
 // Encrypt encrypts
 func (ctx WASMContext) Encrypt(plaintext []byte) ([]byte, error) {
 	txSenderPrivKey, txSenderPubKey, err := ctx.GetTxSenderKeyPair()
@@ -0,0 +1,154 @@
+// Package computetest provides fixtures for unit tests of modules that integrate with the compute
+// module: a keeper backed by in-memory stores, funded accounts, and helpers that store,
+// instantiate, execute and query contracts with encrypted messages.
+//
+// The fixtures call into the enclave, so tests using them need the same environment as the
+// compute keeper tests: an initialized enclave and the IO master key it was bootstrapped with.
+package computetest
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+// SupportedFeatures are the contract capabilities enabled on the fixture keeper
+const SupportedFeatures = "staking,stargate,ibc3,random"
+
+// Keepers are the compute keeper and the keepers it depends on
+type Keepers = keeper.TestKeepers
+
+// Fixture is a compute keeper set up for unit tests, with the context to run it in
+type Fixture struct {
+	Ctx     sdk.Context
+	Keepers Keepers
+	// IO encrypts msgs to contracts and decrypts their outputs
+	IO utils.WASMContext
+}
+
+// NewFixture creates a compute keeper backed by in-memory stores, with the default message
+// encoders and query plugins. masterIOKeyPath is the path of the base64 encoded IO master key
+// of the enclave, usually io-master-key.txt.
+func NewFixture(t *testing.T, masterIOKeyPath string) *Fixture {
+	b64Bz, err := os.ReadFile(masterIOKeyPath)
+	require.NoError(t, err)
+	masterIOKey, err := base64.StdEncoding.DecodeString(string(b64Bz))
+	require.NoError(t, err)
+
+	encodingConfig := keeper.MakeEncodingConfig()
+	transferPortSource := keeper.MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	encoders := keeper.DefaultEncoders(transferPortSource, encodingConfig.Marshaler)
+	ctx, keepers := keeper.CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+
+	return &Fixture{
+		Ctx:     ctx,
+		Keepers: keepers,
+		IO: utils.WASMContext{
+			TestKeyPairPath: filepath.Join(t.TempDir(), "id_tx_io.json"),
+			TestMasterIOKey: reg.MasterKey{Bytes: masterIOKey},
+		},
+	}
+}
+
+// Keeper returns the compute keeper of the fixture
+func (f *Fixture) Keeper() keeper.Keeper {
+	return f.Keepers.WasmKeeper
+}
+
+// FundedAccount creates an account holding coins, and returns its address and private key
+func (f *Fixture) FundedAccount(coins sdk.Coins) (sdk.AccAddress, crypto.PrivKey) {
+	return keeper.CreateFakeFundedAccount(f.Ctx, f.Keepers.AccountKeeper, f.Keepers.BankKeeper, coins)
+}
+
+// StoreCode stores the wasm file at wasmPath and returns its code id
+func (f *Fixture) StoreCode(t *testing.T, creator sdk.AccAddress, wasmPath string) uint64 {
+	wasmCode, err := os.ReadFile(wasmPath)
+	require.NoError(t, err)
+
+	codeID, err := f.Keeper().Create(f.Ctx, creator, wasmCode, "", "")
+	require.NoError(t, err)
+	return codeID
+}
+
+// Instantiate instantiates a contract of codeID in a tx signed by creator, who also becomes its admin
+func (f *Fixture) Instantiate(t *testing.T, codeID uint64, creator sdk.AccAddress, creatorPrivKey crypto.PrivKey, initMsg []byte, label string, funds sdk.Coins) (sdk.AccAddress, error) {
+	codeInfo, err := f.Keeper().GetCodeInfo(f.Ctx, codeID)
+	require.NoError(t, err)
+
+	encMsg := f.encrypt(t, codeInfo.CodeHash, initMsg)
+	ctx := keeper.PrepareInitSignedTx(t, f.Keeper(), f.Ctx.WithEventManager(sdk.NewEventManager()), creator, creator, creatorPrivKey, encMsg, codeID, funds)
+
+	contractAddress, _, err := f.Keeper().Instantiate(ctx, codeID, creator, creator, encMsg, label, funds, nil)
+	return contractAddress, err
+}
+
+// Execute executes a contract in a tx signed by sender, and returns the decrypted data of the response.
+// Errors returned by the contract are encrypted.
+func (f *Fixture) Execute(t *testing.T, contractAddress, sender sdk.AccAddress, senderPrivKey crypto.PrivKey, msg []byte, funds sdk.Coins) ([]byte, error) {
+	encMsg := f.encrypt(t, f.contractHash(t, contractAddress), msg)
+	ctx := keeper.PrepareExecSignedTx(t, f.Keeper(), f.Ctx.WithEventManager(sdk.NewEventManager()), sender, senderPrivKey, encMsg, contractAddress, funds)
+
+	f.Keeper().LastMsgManager.SetMarker(false)
+	res, err := f.Keeper().Execute(ctx, contractAddress, sender, encMsg, funds, nil, wasmTypes.HandleTypeExecute)
+	if err != nil {
+		return nil, err
+	}
+	return f.decrypt(t, res.Data, encMsg[:32]), nil
+}
+
+// Query queries a contract and returns the decrypted result. Errors returned by the contract are encrypted.
+func (f *Fixture) Query(t *testing.T, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	encMsg := f.encrypt(t, f.contractHash(t, contractAddress), msg)
+
+	res, err := f.Keeper().QuerySmart(f.Ctx, contractAddress, encMsg, true)
+	if err != nil {
+		return nil, err
+	}
+	return f.decrypt(t, res, encMsg[:32]), nil
+}
+
+func (f *Fixture) contractHash(t *testing.T, contractAddress sdk.AccAddress) []byte {
+	hash, err := f.Keeper().GetContractHash(f.Ctx, contractAddress)
+	require.NoError(t, err)
+	return hash
+}
+
+// encrypt encrypts msg to a contract with the given code hash. The first 32 bytes of the output are the nonce.
+func (f *Fixture) encrypt(t *testing.T, codeHash []byte, msg []byte) []byte {
+	secretMsg := types.SecretMsg{
+		CodeHash: []byte(hex.EncodeToString(codeHash)),
+		Msg:      msg,
+	}
+
+	encMsg, err := f.IO.Encrypt(secretMsg.Serialize())
+	require.NoError(t, err)
+	return encMsg
+}
+
+// decrypt decrypts the output of a contract, which is base64 encoded before encryption
+func (f *Fixture) decrypt(t *testing.T, ciphertext []byte, nonce []byte) []byte {
+	if len(ciphertext) == 0 {
+		return ciphertext
+	}
+
+	plaintextBase64, err := f.IO.Decrypt(ciphertext, nonce)
+	require.NoError(t, err)
+
+	plaintext, err := base64.StdEncoding.DecodeString(string(plaintextBase64))
+	require.NoError(t, err)
+	return plaintext
+}
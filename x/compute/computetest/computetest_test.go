@@ -0,0 +1,68 @@
+package computetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
+	eng "github.com/scrtlabs/SecretNetwork/types"
+	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+var counterContractPath = filepath.Join("..", "internal", "keeper", "testdata", "v1-contract.wasm")
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(eng.Bech32PrefixAccAddr, eng.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(eng.Bech32PrefixValAddr, eng.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(eng.Bech32PrefixConsAddr, eng.Bech32PrefixConsPub)
+	config.Seal()
+
+	spid, _ := os.ReadFile("../../../ias_keys/develop/spid.txt")
+	apiKey, _ := os.ReadFile("../../../ias_keys/develop/api_key.txt")
+
+	if _, err := api.InitBootstrap(spid, apiKey); err != nil {
+		panic(fmt.Sprintf("Error initializing the enclave: %v", err))
+	}
+}
+
+type counterQueryResponse struct {
+	Get struct {
+		Count uint32 `json:"count"`
+	} `json:"get"`
+}
+
+func queryCounter(t *testing.T, f *Fixture, contractAddress sdk.AccAddress) uint32 {
+	res, err := f.Query(t, contractAddress, []byte(`{"get":{}}`))
+	require.NoError(t, err)
+
+	var resp counterQueryResponse
+	require.NoError(t, json.Unmarshal(res, &resp))
+	return resp.Get.Count
+}
+
+func TestFixture(t *testing.T) {
+	f := NewFixture(t, reg.IoExchMasterKeyPath)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	creator, creatorPrivKey := f.FundedAccount(deposit)
+
+	codeID := f.StoreCode(t, creator, counterContractPath)
+	codeInfo, err := f.Keeper().GetCodeInfo(f.Ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, creator, codeInfo.Creator)
+
+	contractAddress, err := f.Instantiate(t, codeID, creator, creatorPrivKey, []byte(`{"counter":{"counter":10, "expires":100}}`), "counter", deposit)
+	require.NoError(t, err)
+	require.Equal(t, deposit, f.Keepers.BankKeeper.GetAllBalances(f.Ctx, contractAddress))
+	require.Equal(t, uint32(10), queryCounter(t, f, contractAddress))
+
+	_, err = f.Execute(t, contractAddress, creator, creatorPrivKey, []byte(`{"increment":{"addition":5}}`), nil)
+	require.NoError(t, err)
+	require.Equal(t, uint32(15), queryCounter(t, f, contractAddress))
+}
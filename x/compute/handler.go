@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -33,6 +34,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleUpdateAdmin(ctx, k, msg)
 		case *MsgClearAdmin:
 			return handleClearAdmin(ctx, k, msg)
+		case *MsgSetContractReceiveHook:
+			return handleSetContractReceiveHook(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -63,6 +66,10 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg *MsgStoreCode) (*sdk.Result,
 	if err != nil {
 		return nil, err
 	}
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -70,6 +77,7 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg *MsgStoreCode) (*sdk.Result,
 			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
 			sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
 			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, hex.EncodeToString(codeInfo.CodeHash)),
 		),
 	})
 
@@ -227,3 +235,26 @@ func handleClearAdmin(ctx sdk.Context, k Keeper, msg *MsgClearAdmin) (*sdk.Resul
 
 	return &sdk.Result{Events: events}, nil
 }
+
+func handleSetContractReceiveHook(ctx sdk.Context, k Keeper, msg *MsgSetContractReceiveHook) (*sdk.Result, error) {
+	err := k.SetContractReceiveHook(
+		ctx,
+		sdk.MustAccAddressFromBech32(msg.Contract),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		msg.Enabled,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := filteredMessageEvents(ctx.EventManager())
+	custom := sdk.Events{sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract),
+	)}
+	events = append(events, custom.ToABCIEvents()...)
+
+	return &sdk.Result{Events: events}, nil
+}
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
@@ -52,3 +56,94 @@ func encodeHeightCounter(height int64, counter uint32) []byte {
 func decodeHeightCounter(bz []byte) (int64, uint32) {
 	return int64(sdk.BigEndianToUint64(bz[0:8])), binary.BigEndian.Uint32(bz[8:])
 }
+
+// SignerDataDecorator ante handler to pass what the signers of a tx signed to the keeper.
+type SignerDataDecorator struct {
+	ak ante.AccountKeeper
+}
+
+// NewSignerDataDecorator constructor
+func NewSignerDataDecorator(ak ante.AccountKeeper) *SignerDataDecorator {
+	return &SignerDataDecorator{ak: ak}
+}
+
+// AnteHandle records the chain id, account number and sequence of every signer, the same as the
+// signature verification uses, so the keeper can recreate the sign bytes that the enclave verifies.
+// It must run before the sequences are incremented. See `types.SignerData(ctx, signer)` to read the values.
+func (d SignerDataDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	// txs of the genesis are signed with account number 0, like in the signature verification
+	genesis := ctx.BlockHeight() == 0
+	signerData := make(map[string]authsigning.SignerData)
+	for _, signer := range sigTx.GetSigners() {
+		acc, err := ante.GetSignerAcc(ctx, d.ak, signer)
+		if err != nil {
+			return ctx, err
+		}
+		var accNum uint64
+		if !genesis {
+			accNum = acc.GetAccountNumber()
+		}
+		signerData[signer.String()] = authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accNum,
+			Sequence:      acc.GetSequence(),
+		}
+	}
+
+	return next(types.WithSignerData(ctx, signerData), tx, simulate)
+}
+
+// EncryptedMsgDecorator ante handler to reject compute msgs with a malformed encrypted payload
+// before they reach the enclave.
+type EncryptedMsgDecorator struct{}
+
+// NewEncryptedMsgDecorator constructor
+func NewEncryptedMsgDecorator() *EncryptedMsgDecorator {
+	return &EncryptedMsgDecorator{}
+}
+
+// AnteHandle checks the envelope (length and public key) of the encrypted msgs of
+// instantiate, execute and migrate msgs. Execute msgs may also be plaintext json, those are
+// left to the enclave.
+func (d EncryptedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for i, msg := range tx.GetMsgs() {
+		var err error
+		switch msg := msg.(type) {
+		case *types.MsgInstantiateContract:
+			err = types.ValidateEncryptedMsg(msg.InitMsg)
+		case *types.MsgExecuteContract:
+			if !json.Valid(msg.Msg) {
+				err = types.ValidateEncryptedMsg(msg.Msg)
+			}
+		case *types.MsgMigrateContract:
+			err = types.ValidateEncryptedMsg(msg.Msg)
+		}
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// QueryNodeDecorator ante handler to keep a node in query-node mode out of the tx flow.
+type QueryNodeDecorator struct{}
+
+// NewQueryNodeDecorator constructor
+func NewQueryNodeDecorator() *QueryNodeDecorator {
+	return &QueryNodeDecorator{}
+}
+
+// AnteHandle rejects txs in CheckTx, so the node neither accepts txs from clients nor relays the
+// txs of its peers. Simulations are allowed, as estimating gas is part of serving clients.
+// Txs of blocks are executed as usual.
+func (d QueryNodeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !simulate {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "this node is a query node and doesn't accept txs")
+	}
+	return next(ctx, tx, simulate)
+}
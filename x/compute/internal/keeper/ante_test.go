@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdksigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestSignerDataDecorator(t *testing.T) {
+	ctx, keeper, walletA, privKeyA, walletB, privKeyB := setupBasicTest(t, sdk.NewCoins())
+	walletC, _ := CreateFakeFundedAccount(ctx, keeper.accountKeeper, keeper.bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 5000)))
+
+	accA := keeper.accountKeeper.GetAccount(ctx, walletA)
+	accB := keeper.accountKeeper.GetAccount(ctx, walletB)
+	require.NoError(t, accB.SetAccountNumber(7))
+	require.NoError(t, accB.SetSequence(3))
+	keeper.accountKeeper.SetAccount(ctx, accB)
+
+	msgs := []sdk.Msg{
+		&types.MsgExecuteContract{Sender: walletA, Contract: walletC, Msg: []byte(`{}`)},
+		&types.MsgExecuteContract{Sender: walletB, Contract: walletC, Msg: []byte(`{}`)},
+	}
+	tx := newSignedTx(t, ctx, keeper, sdksigning.SignMode_SIGN_MODE_DIRECT, msgs, []crypto.PrivKey{privKeyA, privKeyB})
+
+	for _, tc := range []struct {
+		name       string
+		height     int64
+		expAccNumA uint64
+		expAccNumB uint64
+	}{
+		{name: "block", height: ctx.BlockHeight(), expAccNumA: accA.GetAccountNumber(), expAccNumB: 7},
+		// genesis txs are signed with account number 0
+		{name: "genesis", height: 0, expAccNumA: 0, expAccNumB: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var nextCtx sdk.Context
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				nextCtx = ctx
+				return ctx, nil
+			}
+			txCtx, sdkTx := decodeTestTx(t, ctx.WithBlockHeight(tc.height), tx)
+			_, err := NewSignerDataDecorator(keeper.accountKeeper).AnteHandle(txCtx, sdkTx, false, next)
+			require.NoError(t, err)
+
+			signerData, found := types.SignerData(nextCtx, walletA)
+			require.True(t, found)
+			require.Equal(t, authsigning.SignerData{ChainID: ctx.ChainID(), AccountNumber: tc.expAccNumA, Sequence: accA.GetSequence()}, signerData)
+
+			signerData, found = types.SignerData(nextCtx, walletB)
+			require.True(t, found)
+			require.Equal(t, authsigning.SignerData{ChainID: ctx.ChainID(), AccountNumber: tc.expAccNumB, Sequence: 3}, signerData)
+
+			_, found = types.SignerData(nextCtx, walletC)
+			require.False(t, found)
+		})
+	}
+
+	// outside of a tx there is no signer data
+	_, found := types.SignerData(ctx, walletA)
+	require.False(t, found)
+}
@@ -0,0 +1,19 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/scrtlabs/SecretNetwork/app"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+)
+
+// The app depends on the keeper, so its ante handler can only be imported from the external test package
+func init() {
+	keeper.NewAppAnteHandler = func(options ante.HandlerOptions, txCounterStoreKey sdk.StoreKey) (sdk.AnteHandler, error) {
+		return app.NewAnteHandler(app.HandlerOptions{
+			HandlerOptions:    options,
+			TXCounterStoreKey: txCounterStoreKey,
+		})
+	}
+}
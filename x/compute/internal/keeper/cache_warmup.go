@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm"
+)
+
+// codeUsageFlushInterval is the number of blocks between two writes of the code usage file
+const codeUsageFlushInterval = 100
+
+// codeUsage keeps track of how often each code is called, so the busiest codes can be loaded into the
+// module cache of the enclave when the node restarts. Every flush halves the previous scores, so the
+// ranking follows recent usage.
+type codeUsage struct {
+	mu     sync.Mutex
+	path   string
+	calls  map[string]uint64
+	scores map[string]uint64
+}
+
+// newCodeUsage loads the usage scores saved at path. A missing or unreadable file starts from scratch.
+func newCodeUsage(path string) *codeUsage {
+	u := &codeUsage{
+		path:   path,
+		calls:  map[string]uint64{},
+		scores: map[string]uint64{},
+	}
+	if bz, err := os.ReadFile(path); err == nil {
+		_ = json.Unmarshal(bz, &u.scores)
+	}
+	return u
+}
+
+func (u *codeUsage) record(codeHash []byte) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.calls[hex.EncodeToString(codeHash)]++
+}
+
+// flush adds the calls since the last flush to the halved scores and saves them
+func (u *codeUsage) flush() error {
+	u.mu.Lock()
+	for codeHash, score := range u.scores {
+		u.scores[codeHash] = score / 2
+	}
+	for codeHash, calls := range u.calls {
+		u.scores[codeHash] += calls
+	}
+	for codeHash, score := range u.scores {
+		if score == 0 {
+			delete(u.scores, codeHash)
+		}
+	}
+	u.calls = map[string]uint64{}
+	bz, err := json.Marshal(u.scores)
+	u.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(u.path, bz, 0o600)
+}
+
+// top returns the hashes of the n codes with the highest scores
+func (u *codeUsage) top(n int) []wasm.CodeHash {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	codeHashes := make([]string, 0, len(u.scores))
+	for codeHash := range u.scores {
+		codeHashes = append(codeHashes, codeHash)
+	}
+	sort.Slice(codeHashes, func(i, j int) bool {
+		if u.scores[codeHashes[i]] != u.scores[codeHashes[j]] {
+			return u.scores[codeHashes[i]] > u.scores[codeHashes[j]]
+		}
+		return codeHashes[i] < codeHashes[j]
+	})
+	if len(codeHashes) > n {
+		codeHashes = codeHashes[:n]
+	}
+
+	top := make([]wasm.CodeHash, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		bz, err := hex.DecodeString(codeHash)
+		if err != nil {
+			continue
+		}
+		top = append(top, bz)
+	}
+	return top
+}
+
+// warmUpCache loads the n most used codes into the module cache of the enclave.
+// A code that fails to load is loaded on its first call instead, as without a warm-up.
+func warmUpCache(wasmer *wasm.Wasmer, usage *codeUsage, n int) {
+	for _, codeHash := range usage.top(n) {
+		_ = wasmer.WarmCache(codeHash)
+	}
+}
+
+// recordCodeUsage counts a call to a code, if the cache warm-up is enabled
+func (k Keeper) recordCodeUsage(codeHash []byte) {
+	if k.codeUsage != nil {
+		k.codeUsage.record(codeHash)
+	}
+}
+
+// FlushCodeUsage saves the code usage statistics every codeUsageFlushInterval blocks, if the cache
+// warm-up is enabled. The statistics are local to the node and don't affect the state.
+func (k Keeper) FlushCodeUsage(ctx sdk.Context) {
+	if k.codeUsage == nil || ctx.BlockHeight()%codeUsageFlushInterval != 0 {
+		return
+	}
+	if err := k.codeUsage.flush(); err != nil {
+		moduleLogger(ctx).Error("failed to save the code usage statistics", "error", err)
+	}
+}
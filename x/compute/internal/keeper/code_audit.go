@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// AddCodeAudit publishes the audit attestation of an auditor for a code, replacing the previous
+// attestation of the same auditor. Only the auditors of the Auditors param may do that.
+// Attestations stay when their auditor is later removed from the param.
+func (k Keeper) AddCodeAudit(ctx sdk.Context, codeID uint64, auditor sdk.AccAddress, audit types.CodeAudit) error {
+	if _, err := k.GetCodeInfo(ctx, codeID); err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	if !k.GetParams(ctx).IsAuditor(auditor.String()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not an auditor")
+	}
+	if audit.AuditedAt.After(ctx.BlockTime()) {
+		return sdkerrors.Wrap(types.ErrInvalid, "audit date is in the future")
+	}
+
+	audit.Auditor = auditor.String()
+	audit.Height = ctx.BlockHeight()
+	k.setCodeAudit(ctx, codeID, auditor, audit)
+	return nil
+}
+
+// GetCodeAudits returns the audit attestations of a code, by auditor address
+func (k Keeper) GetCodeAudits(ctx sdk.Context, codeID uint64) []types.CodeAudit {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeAuditPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var audits []types.CodeAudit
+	for ; iter.Valid(); iter.Next() {
+		var audit types.CodeAudit
+		k.cdc.MustUnmarshal(iter.Value(), &audit)
+		audits = append(audits, audit)
+	}
+	return audits
+}
+
+func (k Keeper) setCodeAudit(ctx sdk.Context, codeID uint64, auditor sdk.AccAddress, audit types.CodeAudit) {
+	ctx.KVStore(k.storeKey).Set(types.GetCodeAuditKey(codeID, auditor), k.cdc.MustMarshal(&audit))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// SetCodeSchema attaches the JSON schema of a code's messages to the code, replacing any previous one.
+// Only the creator of the code may do that.
+func (k Keeper) SetCodeSchema(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, schema types.CodeSchema) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	if !codeInfo.Creator.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the creator of the code")
+	}
+
+	k.setCodeSchema(ctx, codeID, schema)
+	return nil
+}
+
+// GetCodeSchema returns the JSON schema attached to a code
+func (k Keeper) GetCodeSchema(ctx sdk.Context, codeID uint64) (types.CodeSchema, bool) {
+	var schema types.CodeSchema
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCodeSchemaKey(codeID))
+	if bz == nil {
+		return schema, false
+	}
+	k.cdc.MustUnmarshal(bz, &schema)
+	return schema, true
+}
+
+// setCodeSchema stores the schema, with its hash when the schema itself is stored
+func (k Keeper) setCodeSchema(ctx sdk.Context, codeID uint64, schema types.CodeSchema) {
+	if len(schema.Schema) != 0 {
+		hash := sha256.Sum256(schema.Schema)
+		schema.SchemaHash = hash[:]
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetCodeSchemaKey(codeID), k.cdc.MustMarshal(&schema))
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// AddCodeVerification attaches the verification claim of a verifier to a code hash, replacing the
+// previous claim of the same verifier. The code doesn't have to be stored yet, so builds can be
+// verified before they are uploaded.
+func (k Keeper) AddCodeVerification(ctx sdk.Context, codeHash []byte, verifier sdk.AccAddress, verification types.CodeVerification) {
+	verification.Verifier = verifier.String()
+	verification.Height = ctx.BlockHeight()
+	k.setCodeVerification(ctx, codeHash, verifier, verification)
+}
+
+// IterateCodeVerifications calls cb with every verification claim and the code hash it is attached to,
+// until cb returns true
+func (k Keeper) IterateCodeVerifications(ctx sdk.Context, cb func(codeHash []byte, verification types.CodeVerification) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CodeVerificationPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var verification types.CodeVerification
+		k.cdc.MustUnmarshal(iter.Value(), &verification)
+		// keys are the code hash followed by the verifier address
+		if cb(iter.Key()[:sha256.Size], verification) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setCodeVerification(ctx sdk.Context, codeHash []byte, verifier sdk.AccAddress, verification types.CodeVerification) {
+	ctx.KVStore(k.storeKey).Set(types.GetCodeVerificationKey(codeHash, verifier), k.cdc.MustMarshal(&verification))
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// contractAssets returns the bank balances of a contract along with its delegations and unbonding delegations,
+// for monitoring contracts that stake on behalf of their users
+func (k Keeper) contractAssets(ctx sdk.Context, contractAddress sdk.AccAddress) (*types.QueryContractAssetsResponse, error) {
+	res := &types.QueryContractAssetsResponse{
+		Balances:             k.bankKeeper.GetAllBalances(ctx, contractAddress),
+		Delegations:          []stakingtypes.DelegationResponse{},
+		UnbondingDelegations: k.stakingKeeper.GetAllUnbondingDelegations(ctx, contractAddress),
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, contractAddress) {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return nil, stakingtypes.ErrNoValidatorFound
+		}
+		balance := sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt())
+		res.Delegations = append(res.Delegations, stakingtypes.NewDelegationResp(
+			contractAddress, delegation.GetValidatorAddr(), delegation.Shares, balance,
+		))
+	}
+	return res, nil
+}
@@ -0,0 +1,244 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// RegisterCron registers a recurring execution of a contract, every interval blocks starting
+// at the end of the next block. Only the contract itself or its admin may register crons.
+// The budget is escrowed to pay the fee of each execution, at the ScheduledCallGasPrice for the
+// whole gas limit, and must pay for at least one execution. It returns the id of the cron.
+func (k Keeper) RegisterCron(ctx sdk.Context, sender, contractAddress sdk.AccAddress, msg []byte, interval int64, gasLimit uint64, budget sdk.Coin) (uint64, error) {
+	if err := k.authorizeCron(ctx, sender, contractAddress); err != nil {
+		return 0, err
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxCronsPerBlock == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "crons are disabled")
+	}
+	if gasLimit > params.MaxCronGasLimit {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "gas limit %d exceeds the max of %d", gasLimit, params.MaxCronGasLimit)
+	}
+	if k.countCrons(ctx, contractAddress) >= params.MaxCronsPerContract {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "contract already has %d crons", params.MaxCronsPerContract)
+	}
+	fee := params.MinScheduledCallFee(gasLimit, k.stakingKeeper.BondDenom(ctx))
+	if budget.Denom != fee.Denom || budget.IsLT(fee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "a gas limit of %d requires a budget of at least %s", gasLimit, fee)
+	}
+
+	if err := k.checkSpendable(ctx, sender, sdk.NewCoins(budget)); err != nil {
+		return 0, sdkerrors.Wrap(err, "budget")
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(budget)); err != nil {
+		return 0, sdkerrors.Wrap(err, "budget")
+	}
+
+	cron := types.Cron{
+		ID:         k.autoIncrementID(ctx, types.KeyLastCronID),
+		Contract:   contractAddress.String(),
+		Msg:        msg,
+		Interval:   interval,
+		GasLimit:   gasLimit,
+		NextHeight: ctx.BlockHeight() + 1,
+		Owner:      sender.String(),
+		Budget:     budget,
+	}
+	k.setCron(ctx, cron)
+
+	return cron.ID, nil
+}
+
+// CancelCron removes a cron and refunds what is left of its budget to its owner.
+// Only the contract itself or its admin may cancel its crons.
+func (k Keeper) CancelCron(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	cron, found := k.GetCron(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "cron %d", id)
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(cron.Contract)
+	if err != nil {
+		return err
+	}
+	if err := k.authorizeCron(ctx, sender, contractAddress); err != nil {
+		return err
+	}
+
+	return k.removeCron(ctx, cron)
+}
+
+// removeCron deletes a cron and refunds what is left of its budget to its owner
+func (k Keeper) removeCron(ctx sdk.Context, cron types.Cron) error {
+	k.deleteCron(ctx, cron)
+
+	if cron.Budget.IsZero() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(cron.Owner)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(cron.Budget))
+}
+
+func (k Keeper) authorizeCron(ctx sdk.Context, sender, contractAddress sdk.AccAddress) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !sender.Equals(contractAddress) && contractInfo.Admin != sender.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the contract or its admin can manage its crons")
+	}
+	return nil
+}
+
+// GetCron returns the cron with the given id
+func (k Keeper) GetCron(ctx sdk.Context, id uint64) (types.Cron, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	heightBz := store.Get(types.GetCronHeightKey(id))
+	if heightBz == nil {
+		return types.Cron{}, false
+	}
+
+	var cron types.Cron
+	k.cdc.MustUnmarshal(store.Get(types.GetCronKey(int64(sdk.BigEndianToUint64(heightBz)), id)), &cron)
+	return cron, true
+}
+
+// IterateCrons iterates over all crons, by next execution height then id, until cb returns true
+func (k Keeper) IterateCrons(ctx sdk.Context, cb func(types.Cron) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var cron types.Cron
+		k.cdc.MustUnmarshal(iter.Value(), &cron)
+		if cb(cron) {
+			break
+		}
+	}
+}
+
+func (k Keeper) countCrons(ctx sdk.Context, contractAddress sdk.AccAddress) uint32 {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCronByContractPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var count uint32
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+func (k Keeper) setCron(ctx sdk.Context, cron types.Cron) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCronKey(cron.NextHeight, cron.ID), k.cdc.MustMarshal(&cron))
+	store.Set(types.GetCronHeightKey(cron.ID), sdk.Uint64ToBigEndian(uint64(cron.NextHeight)))
+	store.Set(types.GetCronByContractKey(sdk.MustAccAddressFromBech32(cron.Contract), cron.ID), []byte{})
+}
+
+func (k Keeper) deleteCron(ctx sdk.Context, cron types.Cron) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCronKey(cron.NextHeight, cron.ID))
+	store.Delete(types.GetCronHeightKey(cron.ID))
+	store.Delete(types.GetCronByContractKey(sdk.MustAccAddressFromBech32(cron.Contract), cron.ID))
+}
+
+// ExecuteCrons runs the crons that are due at the current height, the most overdue first, and
+// reschedules them interval blocks later. At most MaxCronsPerBlock crons, reserving at most
+// MaxScheduledGasPerBlock gas in total, are executed, the rest are left for the next blocks.
+// A MaxCronsPerBlock of zero disables crons, none are executed.
+// Each execution pays its fee from the budget of the cron, and a cron whose budget can't pay
+// for the next execution is removed.
+func (k Keeper) ExecuteCrons(ctx sdk.Context) {
+	k.executeCrons(ctx, newScheduledGasBudget(k.GetParams(ctx)))
+}
+
+func (k Keeper) executeCrons(ctx sdk.Context, budget *scheduledGasBudget) {
+	params := k.GetParams(ctx)
+	maxCrons := int(params.MaxCronsPerBlock)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.CronPrefix, types.GetCronHeightPrefix(ctx.BlockHeight()+1))
+
+	var due []types.Cron
+	for ; iter.Valid() && len(due) < maxCrons; iter.Next() {
+		var cron types.Cron
+		k.cdc.MustUnmarshal(iter.Value(), &cron)
+		if !budget.reserve(cron.GasLimit) {
+			break
+		}
+		due = append(due, cron)
+	}
+	iter.Close()
+
+	for _, cron := range due {
+		fee := params.MinScheduledCallFee(cron.GasLimit, bondDenom)
+
+		// an overdue cron is not executed again to catch up, it continues from the current height
+		k.deleteCron(ctx, cron)
+		cron.NextHeight = ctx.BlockHeight() + cron.Interval
+
+		err := k.executeCron(ctx, &cron, fee)
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyCronID, strconv.FormatUint(cron.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, cron.Contract),
+		}
+		if err != nil {
+			ctx.Logger().Info("cron failed", "id", cron.ID, "contract", cron.Contract, "error", err)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+
+		if cron.Budget.Denom == fee.Denom && !cron.Budget.IsLT(fee) {
+			k.setCron(ctx, cron)
+		} else {
+			// the cron was deleted above, this only refunds the rest of its budget
+			if err := k.removeCron(ctx, cron); err != nil {
+				ctx.Logger().Error("failed to refund the budget of a cron", "id", cron.ID, "error", err)
+			}
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCronRemoved, "true"))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCronExecute, attributes...))
+	}
+}
+
+// executeCron pays the fee of a cron from its budget and executes it with at most its gas limit.
+// If the budget can't pay the fee, the cron isn't executed. If the execution fails, its state
+// changes are discarded; the cron stays registered.
+func (k Keeper) executeCron(ctx sdk.Context, cron *types.Cron, fee sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "cron-execute")
+
+	if cron.Budget.Denom != fee.Denom || cron.Budget.IsLT(fee) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "budget of %s can't pay the fee of %s", cron.Budget, fee)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+		return sdkerrors.Wrap(err, "fee")
+	}
+	cron.Budget = cron.Budget.Sub(fee)
+
+	contractAddress, err := sdk.AccAddressFromBech32(cron.Contract)
+	if err != nil {
+		return err
+	}
+
+	return k.runWithGasLimit(ctx, cron.GasLimit, func(ctx sdk.Context) error {
+		_, err := k.Execute(ctx, contractAddress, types.ZeroSender, cron.Msg, sdk.NewCoins(), nil, wasmTypes.HandleTypeScheduledExecute)
+		return err
+	})
+}
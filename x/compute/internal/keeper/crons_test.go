@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// cronFee is the fee of each execution of a cron with a gas limit of 1M at the default gas price
+var cronFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000)
+
+// setupCronTest instantiates a contract whose admin is the first returned wallet
+func setupCronTest(t *testing.T) (sdk.Context, Keeper, sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+
+	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, err)
+
+	return ctx, keeper, contractAddress, walletA, walletB
+}
+
+func cronErrors(ctx sdk.Context) map[string]string {
+	return executionErrors(ctx, types.EventTypeCronExecute, types.AttributeKeyCronID)
+}
+
+func TestRegisterCron(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, walletB := setupCronTest(t)
+	msg := []byte(`{"increment":{"addition":1}}`)
+
+	_, err := keeper.RegisterCron(ctx, walletB, contractAddress, msg, 1, 1_000_000, cronFee)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, types.DefaultMaxCronGasLimit+1, cronFee)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// the budget must pay for one execution, in the bond denom
+	_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, cronFee.SubAmount(sdk.OneInt()))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, sdk.NewInt64Coin("denom", 1_000_000))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	balanceBefore := keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom)
+	id, err := keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, cronFee)
+	require.NoError(t, err)
+
+	// the budget is escrowed
+	require.Equal(t, balanceBefore.Sub(cronFee), keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom))
+	cron, found := keeper.GetCron(ctx, id)
+	require.True(t, found)
+	require.Equal(t, walletA.String(), cron.Owner)
+	require.Equal(t, cronFee, cron.Budget)
+
+	for i := uint32(1); i < types.DefaultMaxCronsPerContract; i++ {
+		_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, cronFee)
+		require.NoError(t, err)
+	}
+	_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, cronFee)
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
+
+func TestRegisterCronDisabled(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+
+	params := keeper.GetParams(ctx)
+	params.MaxCronsPerBlock = 0
+	keeper.SetParams(ctx, params)
+
+	_, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000_000, cronFee)
+	require.ErrorIs(t, err, types.ErrInvalid)
+}
+
+func TestExecuteCronsBudget(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := keeper.bankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	balanceBefore := keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom)
+
+	// enough for two executions and a half
+	budget := sdk.NewInt64Coin(sdk.DefaultBondDenom, 250_000)
+	id, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000_000, budget)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		keeper.ExecuteCrons(ctx)
+		require.Equal(t, map[string]string{"1": ""}, cronErrors(ctx))
+		requireCounter(t, keeper, ctx, contractAddress, uint32(11+i))
+	}
+
+	// the budget can't pay for a third execution, the cron is removed and the rest is refunded
+	_, found := keeper.GetCron(ctx, id)
+	require.False(t, found)
+	require.Equal(t, feesBefore.Add(cronFee).Add(cronFee), keeper.bankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+	require.Equal(t, balanceBefore.Sub(cronFee).Sub(cronFee), keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCrons(ctx)
+	require.Empty(t, cronErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 12)
+}
+
+func TestExecuteCronsGasPriceRaised(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+	balanceBefore := keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom)
+
+	id, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000_000, cronFee.Add(cronFee))
+	require.NoError(t, err)
+
+	// the budget was enough at registration, but not at the new price
+	params := keeper.GetParams(ctx)
+	params.ScheduledCallGasPrice = sdk.NewDec(1)
+	keeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCrons(ctx)
+	require.Contains(t, cronErrors(ctx)["1"], "can't pay the fee")
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+
+	_, found := keeper.GetCron(ctx, id)
+	require.False(t, found)
+	require.Equal(t, balanceBefore, keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom))
+}
+
+func TestExecuteCronsFailure(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+
+	// a cron that runs out of gas still pays its fee and stays registered
+	id, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCrons(ctx)
+	require.Contains(t, cronErrors(ctx)["1"], "hit gas limit 1000")
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+
+	cron, found := keeper.GetCron(ctx, id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), cron.Budget)
+	require.Equal(t, ctx.BlockHeight()+1, cron.NextHeight)
+}
+
+func TestExecuteCronsPerBlock(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+
+	params := keeper.GetParams(ctx)
+	params.MaxCronsPerBlock = 2
+	keeper.SetParams(ctx, params)
+
+	for i := 0; i < 3; i++ {
+		_, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000_000, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000))
+		require.NoError(t, err)
+	}
+
+	// the cron over the limit is the most overdue in the next block, so it runs first
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCrons(ctx)
+	require.Equal(t, map[string]string{"1": "", "2": ""}, cronErrors(ctx))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteCrons(ctx)
+	require.Equal(t, map[string]string{"3": "", "1": ""}, cronErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 14)
+}
+
+func TestCancelCron(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, walletB := setupCronTest(t)
+	balanceBefore := keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom)
+
+	id, err := keeper.RegisterCron(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), 1, 1_000_000, cronFee.Add(cronFee))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.ExecuteCrons(ctx)
+
+	err = keeper.CancelCron(ctx, walletB, id)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the rest of the budget is refunded
+	require.NoError(t, keeper.CancelCron(ctx, walletA, id))
+	require.Equal(t, balanceBefore.Sub(cronFee), keeper.bankKeeper.GetBalance(ctx, walletA, sdk.DefaultBondDenom))
+
+	err = keeper.CancelCron(ctx, walletA, id)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestExecuteScheduledSharesGasBudget(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupCronTest(t)
+	msg := []byte(`{"increment":{"addition":1}}`)
+
+	params := keeper.GetParams(ctx)
+	params.MaxScheduledGasPerBlock = types.MaxScheduledCallGasLimit
+	params.ScheduledCallGasPrice = sdk.NewDecWithPrec(1, 2)
+	keeper.SetParams(ctx, params)
+
+	callGas := types.MaxScheduledCallGasLimit - 500_000
+	_, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, msg, nil, ctx.BlockHeight()+1, callGas, params.MinScheduledCallFee(callGas, sdk.DefaultBondDenom))
+	require.NoError(t, err)
+	cronExecutionFee := params.MinScheduledCallFee(1_000_000, sdk.DefaultBondDenom)
+	_, err = keeper.RegisterCron(ctx, walletA, contractAddress, msg, 1, 1_000_000, cronExecutionFee.Add(cronExecutionFee))
+	require.NoError(t, err)
+
+	// the call and the cron don't fit in one block together, the cron waits for the next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduled(ctx)
+	require.Equal(t, map[string]string{"1": ""}, scheduledCallErrors(ctx))
+	require.Empty(t, cronErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 11)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduled(ctx)
+	require.Empty(t, scheduledCallErrors(ctx))
+	require.Equal(t, map[string]string{"1": ""}, cronErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 12)
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func setDispatchLimits(ctx sdk.Context, keeper Keeper, maxDepth uint32, maxMsgs uint32) {
+	params := keeper.GetParams(ctx)
+	params.MaxDispatchDepth = maxDepth
+	params.MaxDispatchedMsgs = maxMsgs
+	keeper.SetParams(ctx, params)
+}
+
+// executeChainMsg returns a msg for the first of contracts that executes each of the others in a
+// contract -> contract chain, replying to each call if msgID isn't 0
+func executeChainMsg(t *testing.T, contracts []sdk.AccAddress, codeHashes []string, msgID uint64) string {
+	executeDetails := make([]ExecuteDetails, len(contracts)-1)
+	for i := 1; i < len(contracts); i++ {
+		executeDetails[i-1] = ExecuteDetails{
+			ContractAddress: contracts[i].String(),
+			ContractHash:    codeHashes[i],
+			ShouldError:     false,
+			MsgId:           msgID,
+			Data:            fmt.Sprintf("%d", i),
+		}
+	}
+
+	marshaledDetails, err := json.Marshal(executeDetails)
+	require.NoError(t, err)
+	return fmt.Sprintf(`{"execute_multiple_contracts":{"details": %s}}`, string(marshaledDetails))
+}
+
+func TestMaxDispatchDepth(t *testing.T) {
+	amountOfContracts := uint64(5)
+	ctx, keeper, codeIds, codeHashes, walletA, privKeyA, _, _ := setupChainTest(t, TestContractPaths[v1Contract], sdk.NewCoins(), amountOfContracts)
+	contractAddresses := make([]sdk.AccAddress, amountOfContracts)
+
+	for i := uint64(0); i < amountOfContracts; i++ {
+		_, _, contractAddresses[i], _, _ = initHelper(t, keeper, ctx, codeIds[i], walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	}
+
+	// the contract called by the tx dispatches at depth 1, so 3 calls deep is the deepest chain allowed
+	setDispatchLimits(ctx, keeper, 3, 0)
+
+	_, _, _, _, _, err := execHelper(t, keeper, ctx, contractAddresses[0], walletA, privKeyA, executeChainMsg(t, contractAddresses[:4], codeHashes[:4], 0), true, true, math.MaxUint64, 0)
+	require.Empty(t, err)
+
+	_, errResult := execHelperMultipleMsgs(t, keeper, ctx, contractAddresses[0], walletA, privKeyA, []string{executeChainMsg(t, contractAddresses, codeHashes, 0)}, false, true, math.MaxUint64, 0)
+	require.NotNil(t, errResult)
+	require.ErrorIs(t, errResult.Generic, types.ErrDispatchLimit)
+
+	// zero disables the limit
+	setDispatchLimits(ctx, keeper, 0, 0)
+	_, _, _, _, _, err = execHelper(t, keeper, ctx, contractAddresses[0], walletA, privKeyA, executeChainMsg(t, contractAddresses, codeHashes, 0), true, true, math.MaxUint64, 0)
+	require.Empty(t, err)
+}
+
+func TestDispatchLimitReplyOnError(t *testing.T) {
+	amountOfContracts := uint64(5)
+	ctx, keeper, codeIds, codeHashes, walletA, privKeyA, _, _ := setupChainTest(t, TestContractPaths[v1Contract], sdk.NewCoins(), amountOfContracts)
+	contractAddresses := make([]sdk.AccAddress, amountOfContracts)
+
+	for i := uint64(0); i < amountOfContracts; i++ {
+		_, _, contractAddresses[i], _, _ = initHelper(t, keeper, ctx, codeIds[i], walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	}
+
+	for name, limits := range map[string][2]uint32{
+		"max dispatch depth":  {2, 0},
+		"max dispatched msgs": {0, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			setDispatchLimits(ctx, keeper, limits[0], limits[1])
+
+			// the third contract exceeds the limit when dispatching, the second one gets the error in
+			// its reply and handles it, so the tx succeeds
+			_, _, data, _, _, err := execHelper(t, keeper, ctx, contractAddresses[0], walletA, privKeyA, executeChainMsg(t, contractAddresses, codeHashes, 9000), true, true, math.MaxUint64, 0)
+			require.Empty(t, err)
+			require.Equal(t, "err -> "+contractAddresses[0].String(), string(data))
+		})
+	}
+}
+
+func TestMaxDispatchedMsgsAcrossReplies(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, _ := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+
+	// the loop dispatches one msg from the execution, and one more from each of the 10 replies
+	setDispatchLimits(ctx, keeper, 0, 10)
+	_, errResult := execHelperMultipleMsgs(t, keeper, ctx, contractAddress, walletA, privKeyA, []string{`{"sub_msg_loop":{"iter": 10}}`}, false, true, math.MaxUint64, 0)
+	require.NotNil(t, errResult)
+	require.ErrorIs(t, errResult.Generic, types.ErrDispatchLimit)
+
+	setDispatchLimits(ctx, keeper, 0, 11)
+	_, _, data, _, _, err := execHelper(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"sub_msg_loop":{"iter": 10}}`, true, true, math.MaxUint64, 0)
+	require.Empty(t, err)
+	require.Equal(t, uint32(20), binary.BigEndian.Uint32(data))
+}
+
+func TestDispatchCounterPerTxMsg(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, _ := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+
+	// each msg of the tx dispatches 11 msgs, which would be over the limit if they were counted together
+	setDispatchLimits(ctx, keeper, 0, 11)
+	results, errResult := execHelperMultipleMsgs(t, keeper, ctx, contractAddress, walletA, privKeyA, []string{`{"sub_msg_loop":{"iter": 10}}`, `{"sub_msg_loop":{"iter": 10}}`}, true, true, math.MaxUint64, 0)
+	require.Nil(t, errResult)
+	require.Len(t, results, 2)
+	require.Equal(t, uint32(20), binary.BigEndian.Uint32(results[0].Data))
+	require.Equal(t, uint32(30), binary.BigEndian.Uint32(results[1].Data))
+}
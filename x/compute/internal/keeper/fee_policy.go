@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// SetContractFeePolicy sets the minimum fee a tx must pay to execute a contract.
+// An empty min fee removes the policy. Only the contract's admin or the contract itself may do that.
+func (k Keeper) SetContractFeePolicy(ctx sdk.Context, contractAddress, caller sdk.AccAddress, policy types.ContractFeePolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !caller.Equals(contractAddress) && contractInfo.Admin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin or the contract")
+	}
+
+	k.setContractFeePolicy(ctx, contractAddress, policy)
+	return nil
+}
+
+// GetContractFeePolicy returns the fee policy of a contract. A contract without a policy has an empty min fee.
+func (k Keeper) GetContractFeePolicy(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractFeePolicy {
+	var policy types.ContractFeePolicy
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractFeePolicyKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &policy)
+	}
+	return policy
+}
+
+func (k Keeper) setContractFeePolicy(ctx sdk.Context, contractAddress sdk.AccAddress, policy types.ContractFeePolicy) {
+	store := ctx.KVStore(k.storeKey)
+	if policy.MinFee.IsZero() {
+		store.Delete(types.GetContractFeePolicyKey(contractAddress))
+		return
+	}
+	store.Set(types.GetContractFeePolicyKey(contractAddress), k.cdc.MustMarshal(&policy))
+}
+
+// checkContractFeePolicy returns an error if the fee of the current tx doesn't satisfy the fee policy of the contract.
+// The whole fee of the tx is compared to the policy, regardless of the other msgs in the tx.
+func (k Keeper) checkContractFeePolicy(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	policy := k.GetContractFeePolicy(ctx, contractAddress)
+	if policy.MinFee.IsZero() {
+		return nil
+	}
+
+	fee, err := k.txFee(ctx)
+	if err != nil {
+		return err
+	}
+	if !policy.IsSatisfiedBy(fee) {
+		return sdkerrors.Wrapf(types.ErrInsufficientContractFee, "got %s, the contract requires one of %s", fee, policy.MinFee)
+	}
+	return nil
+}
+
+// txFee returns the fee of the current tx
+func (k Keeper) txFee(ctx sdk.Context) (sdk.Coins, error) {
+	var rawTx sdktx.TxRaw
+	if err := k.cdc.Unmarshal(ctx.TxBytes(), &rawTx); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	var authInfo sdktx.AuthInfo
+	if err := k.cdc.Unmarshal(rawTx.AuthInfoBytes, &authInfo); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+	if authInfo.Fee == nil {
+		return sdk.NewCoins(), nil
+	}
+	return authInfo.Fee.Amount, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// FlagBrokenCode flags a code as broken, so that the funds of its contracts without an admin may be
+// recovered once the FundRecoveryDelay param has passed. A code is flagged only once, so that the
+// delay can't be restarted.
+func (k Keeper) FlagBrokenCode(ctx sdk.Context, codeID uint64) error {
+	if _, err := k.GetCodeInfo(ctx, codeID); err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	if height, found := k.GetCodeBrokenHeight(ctx, codeID); found {
+		return sdkerrors.Wrapf(types.ErrInvalid, "code was already flagged broken at height %d", height)
+	}
+
+	k.setCodeBrokenHeight(ctx, codeID, ctx.BlockHeight())
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFlagBrokenCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
+// GetCodeBrokenHeight returns the height at which a code was flagged broken
+func (k Keeper) GetCodeBrokenHeight(ctx sdk.Context, codeID uint64) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBrokenCodeKey(codeID))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+func (k Keeper) setCodeBrokenHeight(ctx sdk.Context, codeID uint64, height int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetBrokenCodeKey(codeID), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// RecoverContractFunds transfers the whole spendable native balance of an orphaned contract to recipient.
+// A contract is orphaned if it has no admin that could migrate it to code that releases the funds, its code
+// was flagged broken at least FundRecoveryDelay blocks before, and it isn't a registered SNIP-20 wrapper,
+// whose balance backs its tokens.
+func (k Keeper) RecoverContractFunds(ctx sdk.Context, contractAddress, recipient sdk.AccAddress) (sdk.Coins, error) {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.Admin != "" {
+		return nil, sdkerrors.Wrapf(types.ErrNotOrphaned, "contract has the admin %s", contractInfo.Admin)
+	}
+	brokenHeight, found := k.GetCodeBrokenHeight(ctx, contractInfo.CodeID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotOrphaned, "code %d is not flagged broken", contractInfo.CodeID)
+	}
+	if recoverableHeight := brokenHeight + k.GetParams(ctx).FundRecoveryDelayBlocks(); ctx.BlockHeight() < recoverableHeight {
+		return nil, sdkerrors.Wrapf(types.ErrNotOrphaned, "funds are recoverable from height %d", recoverableHeight)
+	}
+	if wrapper, ok := k.GetParams(ctx).Snip20WrapperByContract(contractAddress.String()); ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotOrphaned, "contract is the SNIP-20 wrapper of %s", wrapper.Denom)
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	funds := k.bankKeeper.SpendableCoins(ctx, contractAddress)
+	if funds.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "contract has no funds")
+	}
+	if err := k.bankKeeper.SendCoins(ctx, contractAddress, recipient, funds); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRecoverContractFunds,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, funds.String()),
+	))
+	return funds, nil
+}
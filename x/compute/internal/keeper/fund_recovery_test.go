@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestFlagBrokenCode(t *testing.T) {
+	ctx, keeper, codeID, _, _, _, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	err := keeper.FlagBrokenCode(ctx, codeID+1)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	_, found := keeper.GetCodeBrokenHeight(ctx, codeID)
+	require.False(t, found)
+
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, keeper.FlagBrokenCode(ctx, codeID))
+	height, found := keeper.GetCodeBrokenHeight(ctx, codeID)
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+
+	// flagging again would restart the delay
+	err = keeper.FlagBrokenCode(ctx.WithBlockHeight(200), codeID)
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// the flag is exported and imported with the code
+	genState := ExportGenesis(ctx, keeper)
+	require.Len(t, genState.Codes, 1)
+	require.Equal(t, int64(100), genState.Codes[0].BrokenHeight)
+
+	newCtx, newKeeper, _, _, _, _ := setupBasicTest(t, sdk.NewCoins())
+	require.NoError(t, InitGenesis(newCtx, newKeeper, *genState))
+	height, found = newKeeper.GetCodeBrokenHeight(newCtx, codeID)
+	require.True(t, found)
+	require.Equal(t, int64(100), height)
+}
+
+func TestRecoverContractFunds(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 100))
+
+	params := keeper.GetParams(ctx)
+	params.FundRecoveryDelay = 10
+	keeper.SetParams(ctx, params)
+
+	_, _, withAdmin, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	_, _, orphaned, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, walletA, withAdmin, funds))
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, walletA, orphaned, funds))
+
+	// the code isn't flagged broken
+	_, err := keeper.RecoverContractFunds(ctx, orphaned, walletB)
+	require.ErrorIs(t, err, types.ErrNotOrphaned)
+
+	require.NoError(t, keeper.FlagBrokenCode(ctx, codeID))
+
+	// the delay hasn't passed
+	_, err = keeper.RecoverContractFunds(ctx.WithBlockHeight(ctx.BlockHeight()+9), orphaned, walletB)
+	require.ErrorIs(t, err, types.ErrNotOrphaned)
+	require.Equal(t, funds, keeper.bankKeeper.GetAllBalances(ctx, orphaned))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+
+	// the admin of a contract of the code can still migrate it
+	_, err = keeper.RecoverContractFunds(ctx, withAdmin, walletB)
+	require.ErrorIs(t, err, types.ErrNotOrphaned)
+
+	balanceBefore := keeper.bankKeeper.GetAllBalances(ctx, walletB)
+	recovered, err := keeper.RecoverContractFunds(ctx, orphaned, walletB)
+	require.NoError(t, err)
+	require.Equal(t, funds, recovered)
+	require.Equal(t, balanceBefore.Add(funds...), keeper.bankKeeper.GetAllBalances(ctx, walletB))
+	require.True(t, keeper.bankKeeper.GetAllBalances(ctx, orphaned).IsZero())
+
+	_, err = keeper.RecoverContractFunds(ctx, orphaned, walletB)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// the balance of a SNIP-20 wrapper backs its tokens
+	require.NoError(t, keeper.bankKeeper.SendCoins(ctx, walletA, orphaned, funds))
+	params.Snip20Wrappers = []types.Snip20Wrapper{{Denom: "denom", ContractAddress: orphaned.String()}}
+	keeper.SetParams(ctx, params)
+	_, err = keeper.RecoverContractFunds(ctx, orphaned, walletB)
+	require.ErrorIs(t, err, types.ErrNotOrphaned)
+
+	_, err = keeper.RecoverContractFunds(ctx, walletB, walletA)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
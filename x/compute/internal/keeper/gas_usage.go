@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// gasUsageStore returns the compute store without gas metering, so that keeping the gas usage
+// counters of contracts doesn't change the gas cost of their calls
+func (k Keeper) gasUsageStore(ctx sdk.Context) sdk.KVStore {
+	return ctx.MultiStore().GetKVStore(k.storeKey)
+}
+
+// recordGasUsage adds the gas used by a contract call to the gas usage of the contract in the
+// current block and day. Only the calls whose state changes are committed are counted, since the
+// counters are reverted with them.
+func (k Keeper) recordGasUsage(ctx sdk.Context, contractAddress sdk.AccAddress, gasUsed uint64) {
+	store := k.gasUsageStore(ctx)
+	addGasUsage(store, types.GetContractBlockGasKey(ctx.BlockHeight(), contractAddress), gasUsed)
+	addGasUsage(store, types.GetContractDailyGasKey(types.GasUsageDay(ctx.BlockTime()), contractAddress), gasUsed)
+}
+
+func addGasUsage(store sdk.KVStore, key []byte, gasUsed uint64) {
+	total := gasUsed
+	if bz := store.Get(key); bz != nil {
+		total += sdk.BigEndianToUint64(bz)
+	}
+	store.Set(key, sdk.Uint64ToBigEndian(total))
+}
+
+// PruneGasUsage deletes the gas usage counters of the blocks and days past their retention
+func (k Keeper) PruneGasUsage(ctx sdk.Context) {
+	store := k.gasUsageStore(ctx)
+	if firstHeight := ctx.BlockHeight() - types.GasUsageBlockRetention + 1; firstHeight > 0 {
+		deleteRange(store, types.ContractBlockGasPrefix, types.GetContractBlockGasPrefix(firstHeight))
+	}
+	if firstDay := types.GasUsageDay(ctx.BlockTime()) - types.GasUsageDayRetention + 1; firstDay > 0 {
+		deleteRange(store, types.ContractDailyGasPrefix, types.GetContractDailyGasPrefix(firstDay))
+	}
+}
+
+func deleteRange(store sdk.KVStore, start, end []byte) {
+	iter := store.Iterator(start, end)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// TopContractsByBlockGas returns the limit contracts that used the most gas in the last blocks,
+// the current one included
+func (k Keeper) TopContractsByBlockGas(ctx sdk.Context, blocks uint32, limit int) []types.ContractGasUsage {
+	firstHeight := ctx.BlockHeight() - int64(blocks) + 1
+	if firstHeight < 0 {
+		firstHeight = 0
+	}
+	start := types.GetContractBlockGasPrefix(firstHeight)
+	end := types.GetContractBlockGasPrefix(ctx.BlockHeight() + 1)
+	return topContractsByGas(k.gasUsageStore(ctx), start, end, limit)
+}
+
+// TopContractsByDailyGas returns the limit contracts that used the most gas in the last UTC days,
+// the current one included
+func (k Keeper) TopContractsByDailyGas(ctx sdk.Context, days uint32, limit int) []types.ContractGasUsage {
+	day := types.GasUsageDay(ctx.BlockTime())
+	firstDay := day - int64(days) + 1
+	if firstDay < 0 {
+		firstDay = 0
+	}
+	start := types.GetContractDailyGasPrefix(firstDay)
+	end := types.GetContractDailyGasPrefix(day + 1)
+	return topContractsByGas(k.gasUsageStore(ctx), start, end, limit)
+}
+
+// topContractsByGas sums the gas usage counters in [start, end) by contract, and returns the limit
+// contracts with the highest sums. Ties are broken by address.
+func topContractsByGas(store sdk.KVStore, start, end []byte, limit int) []types.ContractGasUsage {
+	// the keys are the prefix, the height or day, then the contract address
+	addrOffset := len(start)
+
+	totals := map[string]uint64{}
+	iter := store.Iterator(start, end)
+	for ; iter.Valid(); iter.Next() {
+		contractAddress := sdk.AccAddress(iter.Key()[addrOffset:])
+		totals[contractAddress.String()] += sdk.BigEndianToUint64(iter.Value())
+	}
+	iter.Close()
+
+	usages := make([]types.ContractGasUsage, 0, len(totals))
+	for contractAddress, gasUsed := range totals {
+		usages = append(usages, types.ContractGasUsage{ContractAddress: contractAddress, GasUsed: gasUsed})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].GasUsed != usages[j].GasUsed {
+			return usages[i].GasUsed > usages[j].GasUsed
+		}
+		return usages[i].ContractAddress < usages[j].ContractAddress
+	})
+	if len(usages) > limit {
+		usages = usages[:limit]
+	}
+	return usages
+}
@@ -18,6 +18,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		if err != nil {
 			return sdkerrors.Wrapf(err, "code %d with id: %d", i, code.CodeID)
 		}
+		if code.Schema != nil {
+			keeper.setCodeSchema(ctx, code.CodeID, *code.Schema)
+		}
+		if code.InstantiateConfig != nil {
+			keeper.setInstantiateConfig(ctx, code.CodeID, *code.InstantiateConfig)
+		}
+		for _, audit := range code.Audits {
+			auditor, err := sdk.AccAddressFromBech32(audit.Auditor)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "code %d auditor", code.CodeID)
+			}
+			keeper.setCodeAudit(ctx, code.CodeID, auditor, audit)
+		}
+		if code.BrokenHeight != 0 {
+			keeper.setCodeBrokenHeight(ctx, code.CodeID, code.BrokenHeight)
+		}
 		if code.CodeID > maxCodeID {
 			maxCodeID = code.CodeID
 		}
@@ -30,9 +46,42 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 		if err != nil {
 			return sdkerrors.Wrapf(err, "contract number %d", i)
 		}
+		if contract.FeePolicy != nil {
+			keeper.setContractFeePolicy(ctx, contract.ContractAddress, *contract.FeePolicy)
+		}
+		if contract.ReceiveHook {
+			keeper.setContractReceiveHook(ctx, contract.ContractAddress, true)
+		}
+		if len(contract.KeyHistory) != 0 {
+			keeper.importContractKeyHistory(ctx, contract.ContractAddress, contract.KeyHistory)
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	var maxScheduledCallID uint64
+	for _, call := range data.ScheduledCalls {
+		keeper.setScheduledCall(ctx, call)
+		if call.ID > maxScheduledCallID {
+			maxScheduledCallID = call.ID
+		}
+	}
+
+	var maxCronID uint64
+	for _, cron := range data.Crons {
+		keeper.setCron(ctx, cron)
+		if cron.ID > maxCronID {
+			maxCronID = cron.ID
+		}
+	}
+
+	for _, entry := range data.CodeVerifications {
+		verifier, err := sdk.AccAddressFromBech32(entry.Verification.Verifier)
+		if err != nil {
+			return sdkerrors.Wrap(err, "code verification verifier")
+		}
+		keeper.setCodeVerification(ctx, entry.CodeHash, verifier, entry.Verification)
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -47,7 +96,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 	if keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) <= uint64(maxContractID) {
 		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastInstanceID), maxContractID)
 	}
-	// keeper.setParams(ctx, data.Params)
+	if maxScheduledCallID > 0 && keeper.peekAutoIncrementID(ctx, types.KeyLastScheduledCallID) <= maxScheduledCallID {
+		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastScheduledCallID), maxScheduledCallID)
+	}
+	if maxCronID > 0 && keeper.peekAutoIncrementID(ctx, types.KeyLastCronID) <= maxCronID {
+		return sdkerrors.Wrapf(types.ErrInvalid, "seq %s must be greater %d ", string(types.KeyLastCronID), maxCronID)
+	}
+	keeper.SetParams(ctx, data.Params)
 
 	return nil
 }
@@ -56,17 +111,30 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 	var genState types.GenesisState
 
-	// genState.Params = keeper.GetParams(ctx)
+	genState.Params = keeper.GetParams(ctx)
 
 	keeper.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
 		bytecode, err := keeper.GetWasm(ctx, codeID)
 		if err != nil {
 			panic(err)
 		}
+		var schema *types.CodeSchema
+		if s, found := keeper.GetCodeSchema(ctx, codeID); found {
+			schema = &s
+		}
+		var instantiateConfig *types.AccessConfig
+		if c, found := keeper.getInstantiateConfig(ctx, codeID); found {
+			instantiateConfig = &c
+		}
+		brokenHeight, _ := keeper.GetCodeBrokenHeight(ctx, codeID)
 		genState.Codes = append(genState.Codes, types.Code{
-			CodeID:    codeID,
-			CodeInfo:  info,
-			CodeBytes: bytecode,
+			CodeID:            codeID,
+			CodeInfo:          info,
+			CodeBytes:         bytecode,
+			Schema:            schema,
+			InstantiateConfig: instantiateConfig,
+			Audits:            keeper.GetCodeAudits(ctx, codeID),
+			BrokenHeight:      brokenHeight,
 		})
 		return false
 	})
@@ -85,17 +153,49 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 		// redact contract info
 		contract.Created = nil
 
+		var feePolicy *types.ContractFeePolicy
+		if policy := keeper.GetContractFeePolicy(ctx, addr); !policy.MinFee.IsZero() {
+			feePolicy = &policy
+		}
+
+		var keyHistory []types.ContractKeyHistoryEntry
+		keeper.IterateContractKeyHistory(ctx, addr, func(height int64, key types.ContractKey) bool {
+			keyHistory = append(keyHistory, types.ContractKeyHistoryEntry{Height: height, Key: key})
+			return false
+		})
+
 		genState.Contracts = append(genState.Contracts, types.Contract{
 			ContractAddress:    addr,
 			ContractInfo:       contract,
 			ContractState:      state,
 			ContractCustomInfo: &contractCustomInfo,
+			FeePolicy:          feePolicy,
+			ReceiveHook:        keeper.HasContractReceiveHook(ctx, addr),
+			KeyHistory:         keyHistory,
 		})
 
 		return false
 	})
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
+	keeper.IterateScheduledCalls(ctx, func(call types.ScheduledCall) bool {
+		genState.ScheduledCalls = append(genState.ScheduledCalls, call)
+		return false
+	})
+
+	keeper.IterateCrons(ctx, func(cron types.Cron) bool {
+		genState.Crons = append(genState.Crons, cron)
+		return false
+	})
+
+	keeper.IterateCodeVerifications(ctx, func(codeHash []byte, verification types.CodeVerification) bool {
+		genState.CodeVerifications = append(genState.CodeVerifications, types.CodeVerificationEntry{
+			CodeHash:     codeHash,
+			Verification: verification,
+		})
+		return false
+	})
+
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastScheduledCallID, types.KeyLastCronID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.peekAutoIncrementID(ctx, k),
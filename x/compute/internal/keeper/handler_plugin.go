@@ -8,6 +8,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channelkeeper "github.com/cosmos/ibc-go/v4/modules/core/04-channel/keeper"
@@ -44,13 +45,16 @@ type SDKMessageHandler struct {
 	// truncated if the msg erred
 	legacyRouter sdk.Router
 	encoders     MessageEncoders
+	// paramSpace holds the msgs contracts may not dispatch
+	paramSpace paramtypes.Subspace
 }
 
-func NewSDKMessageHandler(router MessageRouter, legacyRouter sdk.Router, encoders MessageEncoders) SDKMessageHandler {
+func NewSDKMessageHandler(router MessageRouter, legacyRouter sdk.Router, encoders MessageEncoders, paramSpace paramtypes.Subspace) SDKMessageHandler {
 	return SDKMessageHandler{
 		router:       router,
 		legacyRouter: legacyRouter,
 		encoders:     encoders,
+		paramSpace:   paramSpace,
 	}
 }
 
@@ -88,10 +92,11 @@ func NewMessageHandler(
 	capabilityKeeper capabilitykeeper.ScopedKeeper,
 	portSource types.ICS20TransferPortSource,
 	unpacker codectypes.AnyUnpacker,
+	paramSpace paramtypes.Subspace,
 ) Messenger {
 	encoders := DefaultEncoders(portSource, unpacker).Merge(customEncoders)
 	return NewMessageHandlerChain(
-		NewSDKMessageHandler(msgRouter, legacyMsgRouter, encoders),
+		NewSDKMessageHandler(msgRouter, legacyMsgRouter, encoders, paramSpace),
 		NewIBCRawPacketHandler(channelKeeper, ics4Wrapper, capabilityKeeper),
 	)
 }
@@ -601,6 +606,13 @@ func (h SDKMessageHandler) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Ad
 		}
 	}
 
+	var params types.Params
+	h.paramSpace.GetIfExists(ctx, types.KeyDeniedMsgTypes, &params.DeniedMsgTypes)
+	h.paramSpace.GetIfExists(ctx, types.KeyDenyStakingMsgs, &params.DenyStakingMsgs)
+	if typeURL, denied := params.DeniedMsgTypeURL(msg); denied {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "contracts may not dispatch %s", typeURL)
+	}
+
 	_, isMsgInitContract := msg.(*types.MsgInstantiateContract)
 	_, isMsgExecContract := msg.(*types.MsgExecuteContract)
 
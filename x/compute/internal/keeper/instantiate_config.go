@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// UpdateInstantiateConfig changes who may instantiate a code. Only the creator of the code may do that.
+func (k Keeper) UpdateInstantiateConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, config types.AccessConfig) error {
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	if !codeInfo.Creator.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the creator of the code")
+	}
+
+	k.setInstantiateConfig(ctx, codeID, config)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInstantiateConfig,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyInstantiatePermission, config.Permission.String()),
+		sdk.NewAttribute(types.AttributeKeyInstantiateAddress, config.Address),
+	))
+	return nil
+}
+
+// GetInstantiateConfig returns who may instantiate a code. Codes that never had a config allow everybody.
+func (k Keeper) GetInstantiateConfig(ctx sdk.Context, codeID uint64) types.AccessConfig {
+	if config, found := k.getInstantiateConfig(ctx, codeID); found {
+		return config
+	}
+	return types.AccessConfig{Permission: types.AccessTypeEverybody}
+}
+
+func (k Keeper) getInstantiateConfig(ctx sdk.Context, codeID uint64) (types.AccessConfig, bool) {
+	var config types.AccessConfig
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCodeInstantiateConfigKey(codeID))
+	if bz == nil {
+		return config, false
+	}
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+func (k Keeper) setInstantiateConfig(ctx sdk.Context, codeID uint64, config types.AccessConfig) {
+	ctx.KVStore(k.storeKey).Set(types.GetCodeInstantiateConfigKey(codeID), k.cdc.MustMarshal(&config))
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// RegisterInvariants registers the compute module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-codes", ContractCodesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "labels", LabelsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "enclave-keys", EnclaveKeysInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sequences", SequencesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the compute module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ContractCodesInvariant(k),
+			LabelsInvariant(k),
+			EnclaveKeysInvariant(k),
+			SequencesInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ContractCodesInvariant checks that every contract runs a stored code
+func ContractCodesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		k.iterateRawContractInfos(ctx, func(contractAddress sdk.AccAddress, info types.ContractInfo) {
+			if !k.containsCodeInfo(ctx, info.CodeID) {
+				broken++
+				msg += fmt.Sprintf("\tcontract %s runs missing code %d\n", contractAddress, info.CodeID)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "contract-codes",
+			fmt.Sprintf("%d contracts run a missing code\n%s", broken, msg)), broken != 0
+	}
+}
+
+// LabelsInvariant checks that every entry of the label index points to an existing contract with that label
+func LabelsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+
+		prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractLabelPrefix)
+		iter := prefixStore.Iterator(nil, nil)
+		defer iter.Close()
+
+		for ; iter.Valid(); iter.Next() {
+			label := string(iter.Key())
+			contractAddress := sdk.AccAddress(iter.Value())
+
+			info := k.GetContractInfo(ctx, contractAddress)
+			switch {
+			case info == nil:
+				broken++
+				msg += fmt.Sprintf("\tlabel %q points to missing contract %s\n", label, contractAddress)
+			case info.Label != label:
+				broken++
+				msg += fmt.Sprintf("\tlabel %q points to contract %s labeled %q\n", label, contractAddress, info.Label)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "labels",
+			fmt.Sprintf("%d label index entries are broken\n%s", broken, msg)), broken != 0
+	}
+}
+
+// EnclaveKeysInvariant checks that every contract has an enclave key
+func EnclaveKeysInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := 0
+		store := ctx.KVStore(k.storeKey)
+		k.iterateRawContractInfos(ctx, func(contractAddress sdk.AccAddress, _ types.ContractInfo) {
+			if !store.Has(types.GetContractEnclaveKey(contractAddress)) {
+				broken++
+				msg += fmt.Sprintf("\tcontract %s has no enclave key\n", contractAddress)
+			}
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "enclave-keys",
+			fmt.Sprintf("%d contracts have no enclave key\n%s", broken, msg)), broken != 0
+	}
+}
+
+// SequencesInvariant checks that the id sequences are ahead of the stored ids, as InitGenesis does.
+// Contract ids are not persisted, so the instance sequence is compared to the number of contracts.
+func SequencesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var maxCodeID uint64
+		k.IterateCodeInfos(ctx, func(codeID uint64, _ types.CodeInfo) bool {
+			if codeID > maxCodeID {
+				maxCodeID = codeID
+			}
+			return false
+		})
+
+		var contracts uint64
+		k.iterateRawContractInfos(ctx, func(sdk.AccAddress, types.ContractInfo) {
+			contracts++
+		})
+
+		var maxScheduledCallID uint64
+		k.IterateScheduledCalls(ctx, func(call types.ScheduledCall) bool {
+			if call.ID > maxScheduledCallID {
+				maxScheduledCallID = call.ID
+			}
+			return false
+		})
+
+		var maxCronID uint64
+		k.IterateCrons(ctx, func(cron types.Cron) bool {
+			if cron.ID > maxCronID {
+				maxCronID = cron.ID
+			}
+			return false
+		})
+
+		var msg string
+		broken := 0
+		for _, seq := range []struct {
+			key   []byte
+			maxID uint64
+		}{
+			{types.KeyLastCodeID, maxCodeID},
+			{types.KeyLastInstanceID, contracts},
+			{types.KeyLastScheduledCallID, maxScheduledCallID},
+			{types.KeyLastCronID, maxCronID},
+		} {
+			if seq.maxID == 0 {
+				continue
+			}
+			if next := k.peekAutoIncrementID(ctx, seq.key); next <= seq.maxID {
+				broken++
+				msg += fmt.Sprintf("\tsequence %s is %d, must be greater than %d\n", seq.key[len(types.SequenceKeyPrefix):], next, seq.maxID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "sequences",
+			fmt.Sprintf("%d sequences are behind the stored ids\n%s", broken, msg)), broken != 0
+	}
+}
+
+// iterateRawContractInfos iterates the contract infos without loading their enclave keys,
+// unlike IterateContractInfo which panics on a missing key
+func (k Keeper) iterateRawContractInfos(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractKeyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.ContractInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		cb(iter.Key(), info)
+	}
+}
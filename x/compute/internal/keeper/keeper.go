@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"path/filepath"
 	"strconv"
-	"strings"
 	"time"
 
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
@@ -23,14 +22,17 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codedctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -72,19 +74,31 @@ type Keeper struct {
 	legacyAmino      codec.LegacyAmino
 	accountKeeper    authkeeper.AccountKeeper
 	bankKeeper       bankkeeper.Keeper
+	stakingKeeper    stakingkeeper.Keeper
 	portKeeper       portkeeper.Keeper
 	capabilityKeeper capabilitykeeper.ScopedKeeper
-	wasmer           wasm.Wasmer
+	wasmer           WasmEngine
 	queryPlugins     QueryPlugins
 	messenger        Messenger
 	// queryGasLimit is the max wasm gas that can be spent on executing a query with a contract
 	queryGasLimit uint64
 	HomeDir       string
 	// authZPolicy   AuthorizationPolicy
-	// paramSpace    subspace.Subspace
+	// queryDeadline limits the wall-clock time of RPC queries with a contract, nil for no limit
+	queryDeadline  *queryDeadline
+	paramSpace     paramtypes.Subspace
 	LastMsgManager *baseapp.LastMsgMarkerContainer
+	hooks          types.ComputeHooks
+	tracer         types.ExecutionTracer
+	// upgradeKeeper and runtimeUpgrades select the wasm runtime by height, see RegisterRuntimeUpgrade
+	upgradeKeeper   types.UpgradeKeeper
+	runtimeUpgrades []runtimeUpgrade
+	// codeUsage ranks the codes to warm up the enclave's module cache with, nil if the warm-up is disabled
+	codeUsage *codeUsage
 }
 
+var _ types.ComputeKeeper = (*Keeper)(nil)
+
 func moduleLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -98,6 +112,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	legacyAmino codec.LegacyAmino,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
 	accountKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	govKeeper govkeeper.Keeper,
@@ -124,13 +139,18 @@ func NewKeeper(
 		panic(err)
 	}
 
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	keeper := Keeper{
 		storeKey:         storeKey,
 		cdc:              cdc,
 		legacyAmino:      legacyAmino,
-		wasmer:           *wasmer,
+		wasmer:           wasmer,
 		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
+		stakingKeeper:    stakingKeeper,
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
 		messenger: NewMessageHandler(
@@ -142,16 +162,37 @@ func NewKeeper(
 			capabilityKeeper,
 			portSource,
 			cdc,
+			paramSpace,
 		),
 		queryGasLimit:  wasmConfig.SmartQueryGasLimit,
 		HomeDir:        homeDir,
+		paramSpace:     paramSpace,
 		LastMsgManager: lastMsgManager,
 	}
+	if wasmConfig.SmartQueryTimeout > 0 {
+		keeper.queryDeadline = newQueryDeadline(wasmConfig.SmartQueryTimeout, wasmConfig.MaxInFlightQueries)
+	}
 	keeper.queryPlugins = DefaultQueryPlugins(govKeeper, distKeeper, mintKeeper, bankKeeper, stakingKeeper, queryRouter, &keeper, channelKeeper).Merge(customPlugins)
 
+	if wasmConfig.CacheWarmupSize > 0 {
+		keeper.codeUsage = newCodeUsage(filepath.Join(homeDir, "wasm", "code-usage.json"))
+		warmUpCache(wasmer, keeper.codeUsage, int(wasmConfig.CacheWarmupSize))
+	}
+
 	return keeper
 }
 
+// SetHooks sets the contract lifecycle hooks. Must be called before the keeper is copied into
+// the module, as the keeper is passed around by value.
+func (k *Keeper) SetHooks(hooks types.ComputeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set compute hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
 func (k Keeper) GetLastMsgMarkerContainer() *baseapp.LastMsgMarkerContainer {
 	return k.LastMsgManager
 }
@@ -164,7 +205,7 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	}
 	ctx.GasMeter().ConsumeGas(types.CompileCost*uint64(len(wasmCode)), "Compiling WASM Bytecode")
 
-	codeHash, err := k.wasmer.Create(wasmCode)
+	codeHash, err := k.runtime(ctx).Create(wasmCode)
 	if err != nil {
 		return 0, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
@@ -175,6 +216,14 @@ func (k Keeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCodeStored{
+		CodeID:   codeID,
+		CodeHash: hex.EncodeToString(codeHash),
+		Creator:  creator.String(),
+	}); err != nil {
+		return 0, err
+	}
+
 	return codeID, nil
 }
 
@@ -183,7 +232,7 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
-	newCodeHash, err := k.wasmer.Create(wasmCode)
+	newCodeHash, err := k.runtime(ctx).Create(wasmCode)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
 	}
@@ -201,118 +250,193 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	return nil
 }
 
-func (k Keeper) GetTxInfo(ctx sdk.Context, sender sdk.AccAddress) ([]byte, sdktxsigning.SignMode, []byte, []byte, []byte, error) {
-	var rawTx sdktx.TxRaw
-	var parsedTx sdktx.Tx
-	err := k.cdc.Unmarshal(ctx.TxBytes(), &parsedTx)
+// decodedTx is the current tx, decoded for the recreation of the sign bytes of its signers
+type decodedTx struct {
+	raw sdktx.TxRaw
+	// tx has a nil body if its msgs can't be decoded, see decodeTx
+	tx sdktx.Tx
+}
+
+// txSigner is a signer of the current tx. index is the position of its signer info and signature.
+type txSigner struct {
+	address sdk.AccAddress
+	pubKey  cryptotypes.PubKey
+	index   int
+}
+
+// decodeTx decodes the current tx. Its msgs may be of any module, in any number and combination.
+func (k Keeper) decodeTx(ctx sdk.Context) (decodedTx, error) {
+	var decoded decodedTx
+	if err := k.cdc.Unmarshal(ctx.TxBytes(), &decoded.raw); err != nil {
+		return decodedTx{}, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode raw transaction from bytes: %s", err.Error()))
+	}
+
+	var authInfo sdktx.AuthInfo
+	if err := k.cdc.Unmarshal(decoded.raw.AuthInfoBytes, &authInfo); err != nil {
+		return decodedTx{}, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode transaction auth info from bytes: %s", err.Error()))
+	}
+	decoded.tx = sdktx.Tx{AuthInfo: &authInfo, Signatures: decoded.raw.Signatures}
+
+	// Decoding the body fails for some msgs, e.g. IBC msgs, as the IBC module doesn't support Amino encoding:
+	// "no concrete type registered for type URL /ibc.core.channel.v1.MsgChannelOpenInit against interface *types.Msg".
+	// The body is only needed for signers without a public key in the tx and for Amino JSON sign bytes,
+	// so the tx is still usable without it.
+	var body sdktx.TxBody
+	if err := k.cdc.Unmarshal(decoded.raw.BodyBytes, &body); err == nil {
+		decoded.tx.Body = &body
+	}
+	return decoded, nil
+}
+
+// txSigners returns the signers of the current tx, in the order of their signatures. A signer may omit
+// its public key from the tx once its account has one, which is then taken from the account.
+func (k Keeper) txSigners(ctx sdk.Context, decoded decodedTx) ([]txSigner, error) {
+	tx := authtx.WrapTx(&decoded.tx).GetTx()
+	pubKeys, err := tx.GetPubKeys()
 	if err != nil {
-		if strings.Contains(err.Error(), "no concrete type registered for type URL /ibc") {
-			// We're here because the tx is an IBC tx, and the IBC module doesn't support Amino encoding.
-			// It fails decoding IBC messages with the error "no concrete type registered for type URL /ibc.core.channel.v1.MsgChannelOpenInit against interface *types.Msg".
-			// "concrete type" is used to refer to the mapping between the Go struct and the Amino type string (e.g. "cosmos-sdk/MsgSend")
-			// Therefore we'll manually rebuild the tx without parsing the body, as parsing it is unnecessary here anyway.
-			//
-			// NOTE: This does not support multisigned IBC txs (if that's even a thing).
-
-			err := k.cdc.Unmarshal(ctx.TxBytes(), &rawTx)
-			if err != nil {
-				return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode raw transaction from bytes: %s", err.Error()))
-			}
+		return nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to get public keys: %s", err.Error()))
+	}
 
-			var txAuthInfo sdktx.AuthInfo
-			err = k.cdc.Unmarshal(rawTx.AuthInfoBytes, &txAuthInfo)
-			if err != nil {
-				return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode transaction auth info from bytes: %s", err.Error()))
-			}
+	// the signers of the msgs are in the same order as the signer infos
+	var addresses []sdk.AccAddress
+	if decoded.tx.Body != nil {
+		addresses = tx.GetSigners()
+	}
 
-			parsedTx = sdktx.Tx{
-				Body:       nil, // parsing rawTx.BodyBytes is the reason for the error, and it isn't used anyway
-				AuthInfo:   &txAuthInfo,
-				Signatures: rawTx.Signatures,
+	signers := make([]txSigner, 0, len(pubKeys))
+	for index, pubKey := range pubKeys {
+		if pubKey == nil {
+			if index >= len(addresses) {
+				continue
 			}
-		} else {
-			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode transaction from bytes: %s", err.Error()))
+			acc := k.accountKeeper.GetAccount(ctx, addresses[index])
+			if acc == nil || acc.GetPubKey() == nil {
+				continue
+			}
+			pubKey = acc.GetPubKey()
 		}
+		signers = append(signers, txSigner{address: sdk.AccAddress(pubKey.Address()), pubKey: pubKey, index: index})
 	}
+	return signers, nil
+}
 
-	tx := authtx.WrapTx(&parsedTx).GetTx()
-
-	pubKeys, err := tx.GetPubKeys()
+func (k Keeper) GetTxInfo(ctx sdk.Context, sender sdk.AccAddress) ([]byte, sdktxsigning.SignMode, []byte, []byte, []byte, error) {
+	decoded, err := k.decodeTx(ctx)
+	if err != nil {
+		return nil, 0, nil, nil, nil, err
+	}
+	signers, err := k.txSigners(ctx, decoded)
 	if err != nil {
-		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to get public keys for instantiate: %s", err.Error()))
+		return nil, 0, nil, nil, nil, err
+	}
+	if len(signers) == 0 {
+		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "the tx has no signer with a public key")
 	}
 
-	pkIndex := -1
+	var signer *txSigner
 	if sender == nil || sender.Equals(types.ZeroSender) {
 		// We are in a situation where the contract gets a null msg.sender,
 		// however we still need to get the sign bytes for verification against the wasm input msg inside the enclave.
-		// There can be multiple signers on the tx, for example one can be the msg.sender and the another can be the gas fee payer. Another example is if this tx also contains MsgMultiSend which supports multiple msg.senders thus requiring multiple signers.
-		// Not sure if we should support this or if this even matters here, as we're most likely here because it's an incoming IBC tx and the signer is the relayer.
-		// For now we will just take the first signer.
-		// Also, because we're not decoding the tx body anymore, we can't use tx.GetSigners() here. Therefore we'll convert the pubkey into an address.
-
-		pubkeys, err := tx.GetPubKeys()
-		if err != nil {
-			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to retrieve pubkeys from tx: %s", err.Error()))
-		}
-
-		pkIndex = 0
-		sender = sdk.AccAddress(pubkeys[pkIndex].Address())
+		// There can be multiple signers on the tx, for example one can be the msg.sender and the another can be the gas fee payer.
+		// We're most likely here because it's an incoming IBC tx and the signer is the relayer, so we just take the first signer.
+		signer = &signers[0]
 	} else {
-		var _signers [][]byte // This is just used for the error message below
-		for index, pubKey := range pubKeys {
-			thisSigner := pubKey.Address().Bytes()
-			_signers = append(_signers, thisSigner)
-			if bytes.Equal(thisSigner, sender.Bytes()) {
-				pkIndex = index
+		var _signers []sdk.AccAddress // This is just used for the error message below
+		for i := range signers {
+			_signers = append(_signers, signers[i].address)
+			if signers[i].address.Equals(sender) {
+				signer = &signers[i]
 			}
 		}
-		if pkIndex == -1 {
+		if signer == nil {
 			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Message sender: %v is not found in the tx signer set: %v, callback signature not provided", sender, _signers))
 		}
 	}
 
+	return k.signerInfo(ctx, decoded, *signer)
+}
+
+// GetTxSigners returns the verification info of every signer of the current tx that has more than one
+// signer, nil otherwise. A signer whose info can't be recreated is left out rather than failing the call,
+// the enclave only needs the info of the signer it verifies the msg against.
+func (k Keeper) GetTxSigners(ctx sdk.Context) []wasmTypes.SignerInfo {
+	decoded, err := k.decodeTx(ctx)
+	if err != nil {
+		return nil
+	}
+	signers, err := k.txSigners(ctx, decoded)
+	if err != nil || len(signers) < 2 {
+		return nil
+	}
+
+	infos := make([]wasmTypes.SignerInfo, 0, len(signers))
+	for _, signer := range signers {
+		signBytes, signMode, modeInfoBytes, pkBytes, signature, err := k.signerInfo(ctx, decoded, signer)
+		if err != nil {
+			moduleLogger(ctx).Debug("leaving out a signer of the tx", "signer", signer.address.String(), "err", err.Error())
+			continue
+		}
+		infos = append(infos, wasmTypes.SignerInfo{
+			SignBytes: signBytes,
+			SignMode:  signMode.String(),
+			ModeInfo:  modeInfoBytes,
+			PublicKey: pkBytes,
+			Signature: signature,
+		})
+	}
+	return infos
+}
+
+// signerInfo returns the sign bytes, sign mode, mode info, public key and signature of a signer of the
+// current tx, which the enclave verifies the msgs of the signer against
+func (k Keeper) signerInfo(ctx sdk.Context, decoded decodedTx, signer txSigner) ([]byte, sdktxsigning.SignMode, []byte, []byte, []byte, error) {
+	tx := authtx.WrapTx(&decoded.tx).GetTx()
 	signatures, err := tx.GetSignaturesV2()
 	if err != nil {
 		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to get signatures: %s", err.Error()))
 	}
+	if signer.index >= len(signatures) || signer.index >= len(decoded.raw.Signatures) {
+		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "missing signature")
+	}
+
 	var signMode sdktxsigning.SignMode
-	switch signData := signatures[pkIndex].Data.(type) {
+	switch signData := signatures[signer.index].Data.(type) {
 	case *sdktxsigning.SingleSignatureData:
 		signMode = signData.SignMode
 		if signMode == sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED {
-			// Some shitness with IBC txs' internals - they are not registered properly with the app,
-			// and I think that it's something in the ibc-go repo that needs to be fixed.
-			// For some txs (e.g. MsgChannelOpenInit), we can unmarsal it into parsedTx
-			// but signMode turns out to be SIGN_MODE_UNSPECIFIED which is not true
-			// and always should be SignMode_SIGN_MODE_DIRECT (as IBC txs don't support Amino encoding)
-			// which causes `modeHandler.GetSignBytes()` down the line to fail with "can't verify sign mode SIGN_MODE_UNSPECIFIED"
-			// this is a stop gap solution, however we should investigate why this is happening
-			// and fix the `k.cdc.Unmarshal(ctx.TxBytes(), &parsedTx)` above, which will maybe allow us to remove
-			// the rawTx parsing code
+			// Some IBC txs (e.g. MsgChannelOpenInit) decode with SIGN_MODE_UNSPECIFIED, which is not true:
+			// IBC txs don't support Amino encoding, so they are always signed with SIGN_MODE_DIRECT.
 			signMode = sdktxsigning.SignMode_SIGN_MODE_DIRECT
 		}
 	case *sdktxsigning.MultiSignatureData:
 		signMode = sdktxsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 	}
 
-	signerAcc, err := ante.GetSignerAcc(ctx, k.accountKeeper, sender)
-	if err != nil {
-		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to retrieve account by address: %s", err.Error()))
-	}
+	// the chain id, account number and sequence that the signer signed are recorded by the ante handler,
+	// before the sequences are incremented
+	signingData, found := types.SignerData(ctx, signer.address)
 
 	var signBytes []byte
-
-	if rawTx.BodyBytes != nil && rawTx.AuthInfoBytes != nil {
-		signBytes, err = authtx.DirectSignBytes(rawTx.BodyBytes, rawTx.AuthInfoBytes, ctx.ChainID(), signerAcc.GetAccountNumber())
+	if signMode == sdktxsigning.SignMode_SIGN_MODE_DIRECT {
+		if !found {
+			// the direct sign doc has no sequence, so the account number of the account is enough
+			signerAcc, err := ante.GetSignerAcc(ctx, k.accountKeeper, signer.address)
+			if err != nil {
+				return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to retrieve account by address: %s", err.Error()))
+			}
+			signingData = authsigning.SignerData{ChainID: ctx.ChainID(), AccountNumber: signerAcc.GetAccountNumber()}
+		}
+		// the sign doc holds the body and auth info exactly as they were signed, whatever the msgs are
+		signBytes, err = authtx.DirectSignBytes(decoded.raw.BodyBytes, decoded.raw.AuthInfoBytes, signingData.ChainID, signingData.AccountNumber)
 		if err != nil {
 			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to recreate sign bytes for the tx: %s", err.Error()))
 		}
 	} else {
-		signingData := authsigning.SignerData{
-			ChainID:       ctx.ChainID(),
-			AccountNumber: signerAcc.GetAccountNumber(),
-			Sequence:      signerAcc.GetSequence() - 1,
+		if decoded.tx.Body == nil {
+			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "Unable to recreate Amino JSON sign bytes for a tx with msgs that can't be decoded")
+		}
+		if !found {
+			return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to find the signed account number and sequence of %s", signer.address))
 		}
 		txConfig := authtx.NewTxConfig(k.cdc.(*codec.ProtoCodec), authtx.DefaultSignModes)
 		modeHandler := txConfig.SignModeHandler()
@@ -322,22 +446,20 @@ func (k Keeper) GetTxInfo(ctx sdk.Context, sender sdk.AccAddress) ([]byte, sdktx
 		}
 	}
 
-	modeInfoBytes, err := sdktxsigning.SignatureDataToProto(signatures[pkIndex].Data).Marshal()
+	modeInfoBytes, err := sdktxsigning.SignatureDataToProto(signatures[signer.index].Data).Marshal()
 	if err != nil {
 		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "couldn't marshal mode info")
 	}
 
-	var pkBytes []byte
-	pubKey := pubKeys[pkIndex]
-	anyPubKey, err := codedctypes.NewAnyWithValue(pubKey)
+	anyPubKey, err := codedctypes.NewAnyWithValue(signer.pubKey)
 	if err != nil {
 		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "couldn't turn public key into Any")
 	}
-	pkBytes, err = k.cdc.Marshal(anyPubKey)
+	pkBytes, err := k.cdc.Marshal(anyPubKey)
 	if err != nil {
 		return nil, 0, nil, nil, nil, sdkerrors.Wrap(types.ErrSigFailed, "couldn't marshal public key")
 	}
-	return signBytes, signMode, modeInfoBytes, pkBytes, parsedTx.Signatures[pkIndex], nil
+	return signBytes, signMode, modeInfoBytes, pkBytes, decoded.raw.Signatures[signer.index], nil
 }
 
 func V010MsgToV1SubMsg(contractAddress string, msg v010wasmTypes.CosmosMsg) (v1wasmTypes.SubMsg, error) {
@@ -402,6 +524,55 @@ func V010MsgsToV1SubMsgs(contractAddr string, msgs []v010wasmTypes.CosmosMsg) ([
 	return subMsgs, nil
 }
 
+// depositFunds sends the normalized funds of a call from sender to the contract, and emits a
+// contract_deposit event per denom
+func (k Keeper) depositFunds(ctx sdk.Context, sender, contractAddress sdk.AccAddress, funds sdk.Coins) error {
+	if err := k.GetParams(ctx).ValidateDeposit(funds); err != nil {
+		return err
+	}
+	if module, isModule := moduleExecutor(ctx, sender); isModule {
+		// module accounts are blocked addresses, which can still pay for their calls
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, module, contractAddress, funds); err != nil {
+			return err
+		}
+	} else {
+		if k.bankKeeper.BlockedAddr(sender) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
+		}
+		if err := k.checkSpendable(ctx, sender, funds); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, sender, contractAddress, funds); err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range funds {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractDeposit,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+		))
+	}
+	return nil
+}
+
+// checkSpendable returns an error when funds are more than the spendable balance of sender.
+// The balance of a vesting account includes its locked coins, which can't be deposited to contracts
+// until they vest.
+func (k Keeper) checkSpendable(ctx sdk.Context, sender sdk.AccAddress, funds sdk.Coins) error {
+	spendable := k.bankKeeper.SpendableCoins(ctx, sender)
+	if spendable.IsAllGTE(funds) {
+		return nil
+	}
+	if acc, ok := k.accountKeeper.GetAccount(ctx, sender).(vestexported.VestingAccount); ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s, %s is locked by vesting", spendable, funds, acc.LockedCoins(ctx.BlockTime()))
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, funds)
+}
+
 // Instantiate creates an instance of a WASM contract
 func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins, callbackSig []byte) (sdk.AccAddress, []byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "instantiate")
@@ -423,7 +594,21 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		}
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	if err != nil {
+		return nil, nil, err
+	}
+	if callbackSig == nil {
+		sigInfo.Signers = k.GetTxSigners(ctx)
+	}
+
+	params := k.GetParams(ctx)
+	if err := params.ValidateLabel(label); err != nil {
+		return nil, nil, err
+	}
+	if err := params.ValidateMsgSize(initMsg); err != nil {
+		return nil, nil, err
+	}
 
 	// create contract address
 
@@ -440,14 +625,26 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		return nil, nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
+	// get contact info, before any funds move
+	bz := store.Get(types.GetCodeKey(codeID))
+	if bz == nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
+	}
+	var codeInfo types.CodeInfo
+	k.cdc.MustUnmarshal(bz, &codeInfo)
+
+	if !k.GetInstantiateConfig(ctx, codeID).Allowed(creator) {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
+	}
+
 	// deposit initial contract funds
+	deposit, err = types.NormalizeFunds(deposit)
+	if err != nil {
+		return nil, nil, err
+	}
 	if !deposit.IsZero() {
-		if k.bankKeeper.BlockedAddr(creator) {
-			return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
-		}
-		sdkerr := k.bankKeeper.SendCoins(ctx, creator, contractAddress, deposit)
-		if sdkerr != nil {
-			return nil, nil, sdkerr
+		if err := k.depositFunds(ctx, creator, contractAddress, deposit); err != nil {
+			return nil, nil, err
 		}
 	} else {
 		// create an empty account (so we don't have issues later)
@@ -456,14 +653,6 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		k.accountKeeper.SetAccount(ctx, contractAccount)
 	}
 
-	// get contact info
-	bz := store.Get(types.GetCodeKey(codeID))
-	if bz == nil {
-		return nil, nil, sdkerrors.Wrap(types.ErrNotFound, "code")
-	}
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshal(bz, &codeInfo)
-
 	random := k.GetRandomSeed(ctx, ctx.BlockHeight())
 
 	// prepare env for contract instantiate call
@@ -478,6 +667,8 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		},
 		random,
 	)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	// create prefixed data store
 	// 0x03 | contractAddress (sdk.AccAddress)
@@ -486,13 +677,15 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
-	response, ogContractKey, adminProof, gasUsed, initError := k.wasmer.Instantiate(codeInfo.CodeHash, env, initMsg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), sigInfo, admin)
-	consumeGas(ctx, gasUsed)
+	k.recordCodeUsage(codeInfo.CodeHash)
+	response, ogContractKey, adminProof, gasUsed, initError := k.runtime(ctx).Instantiate(codeInfo.CodeHash, env, initMsg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), sigInfo, admin)
+	k.recordGasUsage(ctx, contractAddress, consumeGas(ctx, gasUsed))
 
 	if initError != nil {
 		switch res := response.(type) { //nolint:gocritic
@@ -516,7 +709,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		createdAt := types.NewAbsoluteTxPosition(ctx)
 		contractInfo := types.NewContractInfo(codeID, creator, admin.String(), adminProof, label, createdAt)
 
-		historyEntry := contractInfo.InitialHistory(initMsg)
+		historyEntry := contractInfo.InitialHistory(ctx, initMsg)
 		k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 		// k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
 		k.appendToContractHistory(ctx, contractAddress, historyEntry)
@@ -529,6 +722,13 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		})
 		store.Set(types.GetContractLabelPrefix(label), contractAddress)
 
+		if k.hooks != nil {
+			k.hooks.AfterContractInstantiated(ctx, contractAddress, codeID, creator)
+		}
+		if err := k.emitContractInstantiated(ctx, contractAddress, contractInfo); err != nil {
+			return nil, nil, err
+		}
+
 		subMessages, err := V010MsgsToV1SubMsgs(contractAddress.String(), res.Messages)
 		if err != nil {
 			return nil, nil, sdkerrors.Wrap(err, "couldn't convert v0.10 messages to v1 messages")
@@ -546,7 +746,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		contractInfo := types.NewContractInfo(codeID, creator, admin.String(), adminProof, label, createdAt)
 
 		// check for IBC flag
-		report, err := k.wasmer.AnalyzeCode(codeInfo.CodeHash)
+		report, err := k.runtime(ctx).AnalyzeCode(codeInfo.CodeHash)
 		if err != nil {
 			return contractAddress, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 		}
@@ -565,7 +765,7 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
 		))
 
-		historyEntry := contractInfo.InitialHistory(initMsg)
+		historyEntry := contractInfo.InitialHistory(ctx, initMsg)
 		k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 		// k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
 		k.appendToContractHistory(ctx, contractAddress, historyEntry)
@@ -579,6 +779,13 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		})
 		store.Set(types.GetContractLabelPrefix(label), contractAddress)
 
+		if k.hooks != nil {
+			k.hooks.AfterContractInstantiated(ctx, contractAddress, codeID, creator)
+		}
+		if err := k.emitContractInstantiated(ctx, contractAddress, contractInfo); err != nil {
+			return nil, nil, err
+		}
+
 		data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Events, res.Data, initMsg, sigInfo)
 		if err != nil {
 			return nil, nil, sdkerrors.Wrap(err, "dispatch")
@@ -590,6 +797,16 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 	}
 }
 
+func (k Keeper) emitContractInstantiated(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventContractInstantiated{
+		ContractAddress: contractAddress.String(),
+		CodeID:          contractInfo.CodeID,
+		Creator:         contractInfo.Creator.String(),
+		Admin:           contractInfo.Admin,
+		Label:           contractInfo.Label,
+	})
+}
+
 // Execute executes the contract instance
 func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, callbackSig []byte, handleType wasmTypes.HandleType) (*sdk.Result, error) {
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "execute")
@@ -603,30 +820,50 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	signerSig := []byte{}
 	var err error
 
-	// If no callback signature - we should send the actual msg sender sign bytes and signature
-	if callbackSig == nil {
-		signBytes, signMode, modeInfoBytes, pkBytes, signerSig, err = k.GetTxInfo(ctx, caller)
+	// If no callback signature - we should send the actual msg sender sign bytes and signature.
+	// Scheduled calls run outside of a tx, so there's nothing to send.
+	// Module accounts don't sign, so for them it's the tx signer whose signed msg has the module account
+	// as its sender, which we take to be the first signer like for a null msg.sender.
+	module, isModule := moduleExecutor(ctx, caller)
+	if callbackSig == nil && handleType != wasmTypes.HandleTypeScheduledExecute {
+		signer := caller
+		if isModule {
+			signer = nil
+		}
+		signBytes, signMode, modeInfoBytes, pkBytes, signerSig, err = k.GetTxInfo(ctx, signer)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	if err != nil {
+		return nil, err
+	}
+	if callbackSig == nil && handleType != wasmTypes.HandleTypeScheduledExecute {
+		sigInfo.Signers = k.GetTxSigners(ctx)
+	}
+	if callbackSig == nil && isModule {
+		sigInfo.Module = module
+	}
+
+	if err := k.GetParams(ctx).ValidateMsgSize(msg); err != nil {
+		return nil, err
+	}
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	// add more funds
-	if !coins.IsZero() {
-		if k.bankKeeper.BlockedAddr(caller) {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocked address can not be used")
-		}
-
-		sdkerr := k.bankKeeper.SendCoins(ctx, caller, contractAddress, coins)
-		if sdkerr != nil {
-			return nil, sdkerr
+	// add more funds, unless they were already sent by x/bank or released from a scheduled call's escrow
+	coins, err = types.NormalizeFunds(coins)
+	if err != nil {
+		return nil, err
+	}
+	if !coins.IsZero() && handleType != wasmTypes.HandleTypeBankReceive && handleType != wasmTypes.HandleTypeScheduledExecute {
+		if err := k.depositFunds(ctx, caller, contractAddress, coins); err != nil {
+			return nil, err
 		}
 	}
 
@@ -638,16 +875,26 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}
 
 	env := types.NewEnv(ctx, caller, coins, contractAddress, contractKey, random)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
-	}
-
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, handleType)
-	consumeGas(ctx, gasUsed)
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
+	}
+
+	var response interface{}
+	var gasUsed uint64
+	var execErr error
+	k.profile(ctx, "execute", contractAddress, contractInfo.CodeID, func() (uint64, error) {
+		k.recordCodeUsage(codeInfo.CodeHash)
+		response, gasUsed, execErr = k.runtime(ctx).Execute(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, handleType)
+		return gasUsed, execErr
+	})
+	k.recordGasUsage(ctx, contractAddress, consumeGas(ctx, gasUsed))
 
 	if execErr != nil {
 		var result sdk.Result
@@ -663,6 +910,16 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		return &result, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
 
+	if k.hooks != nil {
+		k.hooks.AfterContractExecuted(ctx, contractAddress, caller)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventContractExecuted{
+		ContractAddress: contractAddress.String(),
+		Caller:          caller.String(),
+	}); err != nil {
+		return nil, err
+	}
+
 	switch res := response.(type) {
 	case *v010wasmTypes.HandleResponse:
 		subMessages, err := V010MsgsToV1SubMsgs(contractAddress.String(), res.Messages)
@@ -702,6 +959,18 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 	return k.querySmartImpl(ctx, contractAddr, req, useDefaultGasLimit, 1)
 }
 
+// querySmartWithDeadline runs QuerySmart for RPC queries, giving up once the configured query
+// timeout has passed, see queryDeadline. Must never be used while executing a tx, as the outcome
+// depends on wall-clock time.
+func (k Keeper) querySmartWithDeadline(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, useDefaultGasLimit bool) ([]byte, error) {
+	if k.queryDeadline == nil {
+		return k.QuerySmart(ctx, contractAddr, req, useDefaultGasLimit)
+	}
+	return k.queryDeadline.run(func() ([]byte, error) {
+		return k.QuerySmart(ctx, contractAddr, req, useDefaultGasLimit)
+	})
+}
+
 // QuerySmartRecursive queries the smart contract itself. This should only be called when running inside another query recursively.
 func (k Keeper) querySmartRecursive(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, queryDepth uint32, useDefaultGasLimit bool) ([]byte, error) {
 	return k.querySmartImpl(ctx, contractAddr, req, useDefaultGasLimit, queryDepth)
@@ -716,16 +985,21 @@ func (k Keeper) querySmartImpl(ctx sdk.Context, contractAddress sdk.AccAddress,
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: query")
 
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	if err := k.GetParams(ctx).ValidateMsgSize(req); err != nil {
+		return nil, err
+	}
+
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
 	contractKey, err := k.GetContractKey(ctx, contractAddress)
@@ -741,9 +1015,22 @@ func (k Keeper) querySmartImpl(ctx sdk.Context, contractAddress sdk.AccAddress,
 		contractKey,
 		[]byte{0}, /* empty because it's unused in queries */
 	)
+	params.KeyEpoch = k.keyEpoch(ctx)
+	params.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 	params.QueryDepth = queryDepth
-
-	queryResult, gasUsed, qErr := k.wasmer.Query(codeInfo.CodeHash, params, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx))
+	params.BlockCommitment = &wasmTypes.BlockCommitment{
+		Height:  uint64(ctx.BlockHeight()),
+		AppHash: ctx.BlockHeader().AppHash,
+	}
+
+	var queryResult []byte
+	var gasUsed uint64
+	var qErr error
+	k.profile(ctx, "query", contractAddress, contractInfo.CodeID, func() (uint64, error) {
+		k.recordCodeUsage(codeInfo.CodeHash)
+		queryResult, gasUsed, qErr = k.runtime(ctx).Query(codeInfo.CodeHash, params, req, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx))
+		return gasUsed, qErr
+	})
 	consumeGas(ctx, gasUsed)
 
 	telemetry.SetGauge(float32(gasUsed), "compute", "keeper", "query", contractAddress.String(), "gasUsed")
@@ -817,6 +1104,81 @@ func (k Keeper) SetContractKey(ctx sdk.Context, contractAddress sdk.AccAddress,
 
 	contractKeyBz := k.cdc.MustMarshal(contractKey)
 	store.Set(types.GetContractEnclaveKey(contractAddress), contractKeyBz)
+	// keep the key of every epoch so state from before a rotation can still be decrypted
+	store.Set(types.GetContractKeyHistoryKey(contractAddress, ctx.BlockHeight()), contractKeyBz)
+}
+
+// GetContractKeyAtHeight returns the enclave key the contract had at the given height.
+// Keys are recorded since this history was introduced, for older heights the key can
+// only be known if the contract was never migrated before its first recorded key.
+func (k Keeper) GetContractKeyAtHeight(ctx sdk.Context, contractAddress sdk.AccAddress, height int64) (types.ContractKey, error) {
+	if height < 0 {
+		return types.ContractKey{}, sdkerrors.Wrapf(types.ErrInvalid, "height %d", height)
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractKeyHistoryPrefix(contractAddress))
+
+	// the latest key set at or before height
+	iter := prefixStore.ReverseIterator(nil, sdk.Uint64ToBigEndian(uint64(height)+1))
+	defer iter.Close()
+
+	var contractKey types.ContractKey
+	if iter.Valid() {
+		err := k.cdc.Unmarshal(iter.Value(), &contractKey)
+		return contractKey, err
+	}
+
+	// height is before the first recorded key, which is still valid there if it was never rotated
+	first := prefixStore.Iterator(nil, nil)
+	defer first.Close()
+
+	if !first.Valid() {
+		return types.ContractKey{}, sdkerrors.Wrap(types.ErrNotFound, "contract key")
+	}
+	if err := k.cdc.Unmarshal(first.Value(), &contractKey); err != nil {
+		return types.ContractKey{}, err
+	}
+	if len(contractKey.CurrentContractKey) != 0 {
+		return types.ContractKey{}, sdkerrors.Wrapf(types.ErrNotFound, "contract key at height %d", height)
+	}
+
+	return contractKey, nil
+}
+
+// IterateContractKeyHistory calls cb with every enclave key the contract had, by ascending height
+func (k Keeper) IterateContractKeyHistory(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(height int64, key types.ContractKey) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractKeyHistoryPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var contractKey types.ContractKey
+		k.cdc.MustUnmarshal(iter.Value(), &contractKey)
+		// cb returns true to stop early
+		if cb(int64(sdk.BigEndianToUint64(iter.Key())), contractKey) {
+			return
+		}
+	}
+}
+
+// importContractKeyHistory replaces the key history of the contract, which importing the contract
+// starts over at the import height, with the exported one
+func (k Keeper) importContractKeyHistory(ctx sdk.Context, contractAddress sdk.AccAddress, history []types.ContractKeyHistoryEntry) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractKeyHistoryPrefix(contractAddress))
+
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+
+	for i := range history {
+		prefixStore.Set(sdk.Uint64ToBigEndian(uint64(history[i].Height)), k.cdc.MustMarshal(&history[i].Key))
+	}
 }
 
 func (k Keeper) GetRandomSeed(ctx sdk.Context, height int64) []byte {
@@ -924,7 +1286,7 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	for _, model := range models {
+	for i, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
 		}
@@ -932,8 +1294,10 @@ func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddr
 		if prefixStore.Has(model.Key) {
 			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
 		}
+		if i > 0 && bytes.Compare(models[i-1].Key, model.Key) > 0 {
+			return sdkerrors.Wrapf(types.ErrInvalid, "key %x is not sorted", model.Key)
+		}
 		prefixStore.Set(model.Key, model.Value)
-
 	}
 	return nil
 }
@@ -975,10 +1339,18 @@ func (k Keeper) GetWasm(ctx sdk.Context, codeID uint64) ([]byte, error) {
 		return nil, nil
 	}
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	return k.wasmer.GetCode(codeInfo.CodeHash)
+	return k.runtime(ctx).GetCode(codeInfo.CodeHash)
 }
 
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
+//
+// Events of a contract call are always emitted in this order, after the module event
+// ("instantiate", "execute", ...) that the caller emits before handling the response:
+//  1. the contract's own events, see types.ContractResponseEvents
+//  2. for each submessage in order: the events of the dispatched message (recursively in the
+//     same order if it's a contract call), followed by the events of the reply, if any
+//
+// The msg handler appends the top level "message" event last.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
@@ -993,20 +1365,13 @@ func (k *Keeper) handleContractResponse(
 	// This is used mainly in replies in order to decrypt their data.
 	ogSigInfo wasmTypes.SigInfo,
 ) ([]byte, error) {
-	events := types.ContractLogsToSdkEvents(logs, contractAddr)
+	events, err := types.ContractResponseEvents(logs, evts, contractAddr)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(events)
 
-	if len(evts) > 0 {
-
-		customEvents, err := types.NewCustomEvents(evts, contractAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		ctx.EventManager().EmitEvents(customEvents)
-	}
-
 	responseHandler := NewContractResponseHandler(NewMessageDispatcher(k.messenger, k))
 	return responseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data, ogTx, ogSigInfo)
 }
@@ -1020,13 +1385,15 @@ func gasForContract(ctx sdk.Context) uint64 {
 	return remaining
 }
 
-func consumeGas(ctx sdk.Context, gas uint64) {
+// consumeGas charges the wasm gas used by a contract call to the gas meter, and returns the sdk gas charged
+func consumeGas(ctx sdk.Context, gas uint64) uint64 {
 	consumed := (gas / types.GasMultiplier) + 1
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
 		panic(sdk.ErrorOutOfGas{Descriptor: "Wasmer function execution"})
 	}
+	return consumed
 }
 
 // generates a contract address from codeID + instanceID
@@ -1101,6 +1468,14 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, cus
 		return sdkerrors.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
 
+	// the label index isn't part of the exported state, rebuild it from the contract info
+	store := ctx.KVStore(k.storeKey)
+	labelKey := types.GetContractLabelPrefix(c.Label)
+	if store.Has(labelKey) {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "label: %s", c.Label)
+	}
+	store.Set(labelKey, contractAddr)
+
 	k.setContractCustomInfo(ctx, contractAddr, customInfo)
 	k.setContractInfo(ctx, contractAddr, c)
 	return k.importContractState(ctx, contractAddr, state)
@@ -1168,12 +1543,15 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1w
 	random := k.GetRandomSeed(ctx, ctx.BlockHeight())
 
 	env := types.NewEnv(ctx, contractAddress, sdk.Coins{}, contractAddress, contractKey, random)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
 	marshaledReply, err := json.Marshal(reply)
@@ -1183,8 +1561,15 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1w
 		return nil, err
 	}
 
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, marshaledReply, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
-	consumeGas(ctx, gasUsed)
+	var response interface{}
+	var gasUsed uint64
+	var execErr error
+	k.profile(ctx, "reply", contractAddress, contractInfo.CodeID, func() (uint64, error) {
+		k.recordCodeUsage(codeInfo.CodeHash)
+		response, gasUsed, execErr = k.runtime(ctx).Execute(codeInfo.CodeHash, env, marshaledReply, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
+		return gasUsed, execErr
+	})
+	k.recordGasUsage(ctx, contractAddress, consumeGas(ctx, gasUsed))
 
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrReplyFailed, execErr.Error())
@@ -1238,7 +1623,13 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 		}
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	if err != nil {
+		return err
+	}
+	if callbackSig == nil {
+		sigInfo.Signers = k.GetTxSigners(ctx)
+	}
 
 	contractKey, err := k.GetContractKey(ctx, contractAddress)
 	if err != nil {
@@ -1246,6 +1637,8 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 	}
 
 	env := types.NewEnv(ctx, caller, sdk.Coins{}, contractAddress, contractKey, nil)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	currentAdminAddress, err := sdk.AccAddressFromBech32(contractInfo.Admin)
 	if err != nil {
@@ -1259,12 +1652,13 @@ func (k Keeper) UpdateContractAdmin(ctx sdk.Context, contractAddress, caller, ne
 	// prepare querier
 	// TODO: this is unnecessary, get rid of this
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
-	newAdminProof, updateAdminErr := k.wasmer.UpdateAdmin(codeInfo.CodeHash, env, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, currentAdminAddress, contractInfo.AdminProof, newAdmin)
+	newAdminProof, updateAdminErr := k.runtime(ctx).UpdateAdmin(codeInfo.CodeHash, env, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, currentAdminAddress, contractInfo.AdminProof, newAdmin)
 
 	if updateAdminErr != nil {
 		return updateAdminErr
@@ -1302,7 +1696,17 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		}
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, callbackSig)
+	if err != nil {
+		return nil, err
+	}
+	if callbackSig == nil {
+		sigInfo.Signers = k.GetTxSigners(ctx)
+	}
+
+	if err := k.GetParams(ctx).ValidateMsgSize(msg); err != nil {
+		return nil, err
+	}
 
 	contractInfo, _, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
@@ -1315,7 +1719,7 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}
 
 	// check for IBC flag
-	switch report, err := k.wasmer.AnalyzeCode(newCodeInfo.CodeHash); {
+	switch report, err := k.runtime(ctx).AnalyzeCode(newCodeInfo.CodeHash); {
 	case err != nil:
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	case !report.HasIBCEntryPoints && contractInfo.IBCPortID != "":
@@ -1342,6 +1746,8 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	random := k.GetRandomSeed(ctx, ctx.BlockHeight())
 
 	env := types.NewEnv(ctx, caller, sdk.Coins{}, contractAddress, contractKey, random)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	adminProof := contractInfo.AdminProof
 	admin := contractInfo.Admin
@@ -1353,13 +1759,15 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
-		Caller:  contractAddress,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		Caller:   contractAddress,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
-	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, adminAddr, adminProof)
-	consumeGas(ctx, gasUsed)
+	k.recordCodeUsage(newCodeInfo.CodeHash)
+	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.runtime(ctx).Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, adminAddr, adminProof)
+	k.recordGasUsage(ctx, contractAddress, consumeGas(ctx, gasUsed))
 
 	if migrateErr != nil {
 		var result []byte
@@ -1385,6 +1793,7 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// delete old secondary index entry
 	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractHistoryEntry(ctx, contractAddress))
 	// persist migration updates
+	oldCodeID := contractInfo.CodeID
 	historyEntry := contractInfo.AddMigration(ctx, newCodeID, msg)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
@@ -1392,6 +1801,17 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	contractInfo.CodeID = newCodeID
 	k.setContractInfo(ctx, contractAddress, &contractInfo)
 
+	if k.hooks != nil {
+		k.hooks.AfterContractMigrated(ctx, contractAddress, oldCodeID, newCodeID)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventContractMigrated{
+		ContractAddress: contractAddress.String(),
+		OldCodeID:       oldCodeID,
+		NewCodeID:       newCodeID,
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeMigrate,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
@@ -1476,6 +1896,20 @@ func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress)
 	return r
 }
 
+// IterateContractsByCode iterates over all contracts with given codeID ASC on code update time.
+func (k Keeper) IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(codeID))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if cb(key[types.AbsoluteTxPositionLen:]) {
+			return
+		}
+	}
+}
+
 // addToContractCodeSecondaryIndex adds element to the index for contracts-by-codeid queries
 func (k Keeper) addToContractCodeSecondaryIndex(ctx sdk.Context, contractAddress sdk.AccAddress, entry types.ContractCodeHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
@@ -16,6 +16,7 @@ import (
 
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
@@ -418,6 +419,29 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.Nil(t, addr)
 }
 
+func TestInstantiateNotAllowedKeepsDeposit(t *testing.T) {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	ctx, keeper, codeID, codeHash, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], deposit)
+
+	require.NoError(t, keeper.UpdateInstantiateConfig(ctx, codeID, walletA, types.AccessConfig{Permission: types.AccessTypeNobody}))
+
+	msg := types.SecretMsg{
+		CodeHash: []byte(codeHash),
+		Msg:      []byte(`{"counter":{"counter":10, "expires":100}}`),
+	}
+	initMsgBz, err := wasmCtx.Encrypt(msg.Serialize())
+	require.NoError(t, err)
+
+	balanceBefore := keeper.bankKeeper.GetAllBalances(ctx, walletA)
+	ctx = PrepareInitSignedTx(t, keeper, ctx, walletA, nil, privKeyA, initMsgBz, codeID, deposit)
+	addr, _, err := keeper.Instantiate(ctx, codeID, walletA, nil, initMsgBz, "demo contract 1", deposit, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Nil(t, addr)
+
+	// the deposit never left the creator
+	require.Equal(t, balanceBefore, keeper.bankKeeper.GetAllBalances(ctx, walletA))
+}
+
 func TestExecute(t *testing.T) {
 	encodingConfig := MakeEncodingConfig()
 	var transferPortSource types.ICS20TransferPortSource
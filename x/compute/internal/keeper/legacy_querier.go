@@ -131,7 +131,7 @@ func queryContractState(ctx sdk.Context, bech, queryMethod string, data []byte,
 	// we enforce a subjective gas limit on all queries to avoid infinite loops
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(keeper.queryGasLimit))
 	// this returns raw bytes (must be base64-encoded)
-	return keeper.QuerySmart(ctx, contractAddr, data, false)
+	return keeper.querySmartWithDeadline(ctx, contractAddr, data, false)
 }
 
 func queryContractKey(ctx sdk.Context, address sdk.AccAddress, keeper Keeper) ([]byte, error) {
@@ -106,7 +106,7 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 		// This will also prevent an inconsistent state between pre v1.11 and post v1.11 contracts.
 		contractHistory := m.keeper.GetContractHistory(ctx, contractAddress)
 		if len(contractHistory) == 0 {
-			historyEntry := contractInfo.InitialHistory(nil)
+			historyEntry := contractInfo.InitialHistory(ctx, nil)
 
 			// Persist the history entry changes.
 			m.keeper.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"golang.org/x/exp/slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ExecuteAsModule executes a contract with the account of a module as msg.sender. Module accounts have
+// no keys to sign a tx with, so the enclave is given the module name and verifies that the sender is
+// the address derived from it. Only the modules of types.ModuleSenders are accepted by the enclave.
+// The module name alone proves nothing about the call, so the enclave still verifies the msg, the funds
+// and the contract against a msg of the current tx with the module account as its sender, signed by the
+// first signer of the tx. The call fails outside of such a tx, e.g. in the EndBlocker.
+// msg is encrypted for the contract like the msg of a MsgExecuteContract. The sent funds are paid
+// by the module account.
+func (k Keeper) ExecuteAsModule(ctx sdk.Context, moduleName string, contractAddress sdk.AccAddress, msg []byte, coins sdk.Coins) (*sdk.Result, error) {
+	sender, err := k.moduleAccountAddress(ctx, moduleName)
+	if err != nil {
+		return nil, err
+	}
+	return k.Execute(types.WithModuleExecutor(ctx, moduleName), contractAddress, sender, msg, coins, nil, wasmTypes.HandleTypeExecute)
+}
+
+// moduleAccountAddress returns the address of the account of a module, creating the account if needed
+func (k Keeper) moduleAccountAddress(ctx sdk.Context, moduleName string) (sdk.AccAddress, error) {
+	if !slices.Contains(types.ModuleSenders, moduleName) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module %s may not execute contracts", moduleName)
+	}
+	acc := k.accountKeeper.GetModuleAccount(ctx, moduleName)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s doesn't exist", moduleName)
+	}
+	return acc.GetAddress(), nil
+}
+
+// moduleExecutor returns the name of the module when sender is the account of the module that
+// executes a contract through ExecuteAsModule. The contracts called by that
+// contract have other senders.
+func moduleExecutor(ctx sdk.Context, sender sdk.AccAddress) (string, bool) {
+	module, ok := types.ModuleExecutor(ctx)
+	if !ok || !sender.Equals(authtypes.NewModuleAddress(module)) {
+		return "", false
+	}
+	return module, true
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v010types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestExecuteAsModule(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+	moduleAddress := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	require.NoError(t, keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, walletA, govtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("denom", 10))))
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	execMsg, err := testEncrypt(t, keeper, ctx, contractAddress, 0, []byte(`{"get_env":{}}`))
+	require.NoError(t, err)
+	funds := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+
+	// the module name alone isn't enough, the call must be a msg of the signed tx
+	_, err = keeper.ExecuteAsModule(ctx, govtypes.ModuleName, contractAddress, execMsg, funds)
+	require.Error(t, err)
+
+	// nor may the signed msg have another msg, funds or contract
+	otherMsg, err := testEncrypt(t, keeper, ctx, contractAddress, 0, []byte(`{"get_env":{}}`))
+	require.NoError(t, err)
+	for _, signed := range []struct {
+		msg      []byte
+		contract sdk.AccAddress
+		funds    sdk.Coins
+	}{
+		{otherMsg, contractAddress, funds},
+		{execMsg, contractAddress, sdk.NewCoins(sdk.NewInt64Coin("denom", 2))},
+		{execMsg, walletA, funds},
+	} {
+		signedCtx := prepareModuleExecSignedTx(t, keeper, ctx, walletA, privKeyA, moduleAddress, signed.msg, signed.contract, signed.funds)
+		_, err = keeper.ExecuteAsModule(signedCtx, govtypes.ModuleName, contractAddress, execMsg, funds)
+		require.Error(t, err)
+	}
+
+	ctx = prepareModuleExecSignedTx(t, keeper, ctx, walletA, privKeyA, moduleAddress, execMsg, contractAddress, funds)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = keeper.ExecuteAsModule(ctx, govtypes.ModuleName, contractAddress, execMsg, funds)
+	require.NoError(t, err)
+
+	// the contract gets the module account as msg.sender
+	execEvents := tryDecryptWasmEvents(ctx, execMsg[0:32])
+	require.Len(t, execEvents, 1)
+	infoIndex := slices.IndexFunc(execEvents[0], func(c v010types.LogAttribute) bool { return c.Key == "info" })
+	require.NotEqual(t, -1, infoIndex)
+
+	var info struct {
+		Sender    cosmwasm.HumanAddress `json:"sender"`
+		SentFunds cosmwasm.Coins        `json:"funds"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(execEvents[0][infoIndex].Value), &info))
+	require.Equal(t, moduleAddress.String(), info.Sender)
+	require.Equal(t, cosmwasm.Coins{{Denom: "denom", Amount: "1"}}, info.SentFunds)
+
+	// the module account paid the deposit
+	require.Equal(t, sdk.NewInt(1), keeper.bankKeeper.GetBalance(ctx, contractAddress, "denom").Amount)
+	require.Equal(t, sdk.NewInt(9), keeper.bankKeeper.GetBalance(ctx, moduleAddress, "denom").Amount)
+
+	// only the allowed modules may execute contracts, even if their account exists
+	_, err = keeper.ExecuteAsModule(ctx, faucetAccountName, contractAddress, execMsg, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = keeper.ExecuteAsModule(ctx, "unknown", contractAddress, execMsg, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+// prepareModuleExecSignedTx sets a tx with a MsgExecuteContract whose sender is a module account, signed
+// by signer
+func prepareModuleExecSignedTx(t *testing.T, keeper Keeper, ctx sdk.Context, signer sdk.AccAddress, privKey crypto.PrivKey, module sdk.AccAddress, encMsg []byte, contract sdk.AccAddress, funds sdk.Coins) sdk.Context {
+	signerAcc, err := ante.GetSignerAcc(ctx, keeper.accountKeeper, signer)
+	require.NoError(t, err)
+
+	txBytes, err := NewTestTx(&types.MsgExecuteContract{
+		Sender:    module,
+		Contract:  contract,
+		Msg:       encMsg,
+		SentFunds: funds,
+	}, signerAcc, privKey).Marshal()
+	require.NoError(t, err)
+
+	return types.WithTXCounter(ctx.WithTxBytes(txBytes), 1)
+}
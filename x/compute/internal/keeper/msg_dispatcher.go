@@ -30,6 +30,7 @@ type Messenger interface {
 type Replyer interface {
 	reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1wasmTypes.Reply, ogTx []byte, ogSigInfo wasmTypes.SigInfo) ([]byte, error)
 	GetLastMsgMarkerContainer() *baseapp.LastMsgMarkerContainer
+	GetParams(ctx sdk.Context) types.Params
 }
 
 // MessageDispatcher coordinates message sending and submessage reply/ state commits
@@ -76,6 +77,18 @@ func sdkEventsToWasmVMEvents(events []sdk.Event) []v1wasmTypes.Event {
 	return res
 }
 
+// triggeredByContract adds the address of the contract that dispatched a msg to the events of the msg
+func triggeredByContract(events []sdk.Event, contractAddr sdk.AccAddress) []sdk.Event {
+	res := make([]sdk.Event, len(events))
+	for i, ev := range events {
+		attrs := make([]abci.EventAttribute, len(ev.Attributes), len(ev.Attributes)+1)
+		copy(attrs, ev.Attributes)
+		attrs = append(attrs, abci.EventAttribute{Key: []byte(types.AttributeKeyTriggeredBy), Value: []byte(contractAddr.String())})
+		res[i] = sdk.Event{Type: ev.Type, Attributes: attrs}
+	}
+	return res
+}
+
 // dispatchMsgWithGasLimit sends a message with gas limit applied
 func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msg v1wasmTypes.CosmosMsg, gasLimit uint64) (events []sdk.Event, data [][]byte, err error) {
 	limitedMeter := sdk.NewGasMeter(gasLimit)
@@ -185,9 +198,41 @@ func redactError(err error) (bool, error) {
 	return true, fmt.Errorf("the error was redacted (codespace: %s, code: %d). For more info use latest localsecret and reproduce the issue", codespace, code)
 }
 
+// dispatchContext returns the context to dispatch msgs from ctx in, which is one contract call deeper.
+// It returns an error if that's deeper than the max dispatch depth, or if the msgs take the current contract
+// call of the tx over the max dispatched msgs.
+func (d MessageDispatcher) dispatchContext(ctx sdk.Context, msgs []v1wasmTypes.SubMsg) (sdk.Context, error) {
+	params := d.keeper.GetParams(ctx)
+
+	depth := types.DispatchDepth(ctx) + 1
+	if params.MaxDispatchDepth != 0 && depth > params.MaxDispatchDepth {
+		return ctx, sdkerrors.Wrapf(types.ErrDispatchLimit, "msgs cannot be dispatched deeper than %d contract calls", params.MaxDispatchDepth)
+	}
+
+	counter, _ := types.DispatchCounter(ctx)
+	*counter += uint32(len(msgs))
+	if params.MaxDispatchedMsgs != 0 && *counter > params.MaxDispatchedMsgs {
+		return ctx, sdkerrors.Wrapf(types.ErrDispatchLimit, "cannot dispatch more than %d msgs from a single contract call", params.MaxDispatchedMsgs)
+	}
+
+	return types.WithDispatchDepth(ctx, depth), nil
+}
+
 // DispatchSubmessages builds a sandbox to execute these messages and returns the execution result to the contract
 // that dispatched them, both on success as well as failure
 func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []v1wasmTypes.SubMsg, ogTx []byte, ogSigInfo wasmTypes.SigInfo) ([]byte, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	// the counter is shared by the replies to the msgs, and by the msgs they dispatch
+	if _, ok := types.DispatchCounter(ctx); !ok {
+		ctx = types.WithDispatchCounter(ctx)
+	}
+	dispatchCtx, err := d.dispatchContext(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
 	var rsp []byte
 	for _, msg := range msgs {
 
@@ -210,7 +255,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		}
 
 		// first, we build a sub-context which we can use inside the submessages
-		subCtx, commit := ctx.CacheContext()
+		subCtx, commit := dispatchCtx.CacheContext()
 		em := sdk.NewEventManager()
 		subCtx = subCtx.WithEventManager(em)
 
@@ -232,11 +277,12 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		if err == nil {
 			commit()
 			filteredEvents = filterEvents(append(em.Events(), events...))
-			ctx.EventManager().EmitEvents(filteredEvents)
 
 			if msg.Msg.Wasm == nil {
+				ctx.EventManager().EmitEvents(triggeredByContract(filteredEvents, contractAddr))
 				filteredEvents = []sdk.Event{}
 			} else {
+				ctx.EventManager().EmitEvents(filteredEvents)
 				for _, e := range filteredEvents {
 					attributes := e.Attributes
 					sort.SliceStable(attributes, func(i, j int) bool {
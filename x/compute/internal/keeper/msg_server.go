@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,16 +36,67 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 	if err != nil {
 		return nil, err
 	}
+	codeInfo, err := m.keeper.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
+	codeHash := hex.EncodeToString(codeInfo.CodeHash)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
 		),
 	})
 
 	return &types.MsgStoreCodeResponse{
-		CodeID: codeID,
+		CodeID:   codeID,
+		CodeHash: codeHash,
+	}, nil
+}
+
+func (m msgServer) StoreCodeAndInstantiate(goCtx context.Context, msg *types.MsgStoreCodeAndInstantiate) (*types.MsgStoreCodeAndInstantiateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var adminAddr sdk.AccAddress
+	var err error
+	if msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	codeID, err := m.keeper.Create(ctx, msg.Sender, msg.WASMByteCode, msg.Source, msg.Builder)
+	if err != nil {
+		return nil, err
+	}
+	codeInfo, err := m.keeper.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
+	codeHash := hex.EncodeToString(codeInfo.CodeHash)
+
+	contractAddr, data, err := m.keeper.Instantiate(ctx, codeID, msg.Sender, adminAddr, msg.InitMsg, msg.Label, msg.InitFunds, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeySigner, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+
+	return &types.MsgStoreCodeAndInstantiateResponse{
+		CodeID:   codeID,
+		CodeHash: codeHash,
+		Address:  contractAddr.String(),
+		Data:     data,
 	}, nil
 }
 
@@ -79,9 +131,50 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 	}, nil
 }
 
+func (m msgServer) BatchInstantiate(goCtx context.Context, msg *types.MsgBatchInstantiate) (*types.MsgBatchInstantiateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	instances := make([]types.MsgInstantiateContractResponse, 0, len(msg.Instances))
+	for i, instance := range msg.Instances {
+		var adminAddr sdk.AccAddress
+		var err error
+		if instance.Admin != "" {
+			if adminAddr, err = sdk.AccAddressFromBech32(instance.Admin); err != nil {
+				return nil, sdkerrors.Wrapf(err, "instance %d admin", i)
+			}
+		}
+
+		contractAddr, data, err := m.keeper.Instantiate(ctx, msg.CodeID, msg.Sender, adminAddr, instance.InitMsg, instance.Label, instance.InitFunds, nil)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "instance %d", i)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		))
+
+		instances = append(instances, types.MsgInstantiateContractResponse{
+			Address: contractAddr.String(),
+			Data:    data,
+		})
+	}
+
+	return &types.MsgBatchInstantiateResponse{Instances: instances}, nil
+}
+
 func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (*types.MsgExecuteContractResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if msg.ExpiresAtHeight > 0 && ctx.BlockHeight() > msg.ExpiresAtHeight {
+		return nil, sdkerrors.Wrapf(types.ErrExpired, "expired at height %d, current height is %d", msg.ExpiresAtHeight, ctx.BlockHeight())
+	}
+	if err := m.keeper.checkContractFeePolicy(ctx, msg.Contract); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		sdk.EventTypeMessage,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -189,3 +282,323 @@ func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (
 
 	return &types.MsgClearAdminResponse{}, nil
 }
+
+func (m msgServer) SetContractReceiveHook(goCtx context.Context, msg *types.MsgSetContractReceiveHook) (*types.MsgSetContractReceiveHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetContractReceiveHook(ctx, contractAddr, senderAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractReceiveHookResponse{}, nil
+}
+
+func (m msgServer) SetContractFeePolicy(goCtx context.Context, msg *types.MsgSetContractFeePolicy) (*types.MsgSetContractFeePolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetContractFeePolicy(ctx, contractAddr, senderAddr, types.ContractFeePolicy{MinFee: msg.MinFee}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractFeePolicyResponse{}, nil
+}
+
+func (m msgServer) SetCodeSchema(goCtx context.Context, msg *types.MsgSetCodeSchema) (*types.MsgSetCodeSchemaResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.SetCodeSchema(ctx, msg.CodeID, senderAddr, msg.Schema); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeSchemaResponse{}, nil
+}
+
+func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.MsgUpdateInstantiateConfig) (*types.MsgUpdateInstantiateConfigResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateInstantiateConfig(ctx, msg.CodeID, senderAddr, msg.NewInstantiatePermission); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateInstantiateConfigResponse{}, nil
+}
+
+func (m msgServer) AddCodeVerification(goCtx context.Context, msg *types.MsgAddCodeVerification) (*types.MsgAddCodeVerificationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	m.keeper.AddCodeVerification(ctx, msg.CodeHash, senderAddr, types.CodeVerification{
+		Source:  msg.Source,
+		Commit:  msg.Commit,
+		Builder: msg.Builder,
+	})
+
+	return &types.MsgAddCodeVerificationResponse{}, nil
+}
+
+func (m msgServer) AddCodeAudit(goCtx context.Context, msg *types.MsgAddCodeAudit) (*types.MsgAddCodeAuditResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	err = m.keeper.AddCodeAudit(ctx, msg.CodeID, senderAddr, types.CodeAudit{
+		ReportHash: msg.ReportHash,
+		ReportURI:  msg.ReportURI,
+		AuditedAt:  msg.AuditedAt,
+		Scope:      msg.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddCodeAuditResponse{}, nil
+}
+
+func (m msgServer) WrapCoin(goCtx context.Context, msg *types.MsgWrapCoin) (*types.MsgWrapCoinResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	res, err := m.keeper.WrapCoin(ctx, senderAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrapCoinResponse{
+		Data: res.Data,
+	}, nil
+}
+
+func (m msgServer) UnwrapCoin(goCtx context.Context, msg *types.MsgUnwrapCoin) (*types.MsgUnwrapCoinResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	res, err := m.keeper.UnwrapCoin(ctx, senderAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnwrapCoinResponse{
+		Data: res.Data,
+	}, nil
+}
+
+func (m msgServer) ScheduleExecute(goCtx context.Context, msg *types.MsgScheduleExecute) (*types.MsgScheduleExecuteResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	id, err := m.keeper.ScheduleExecute(ctx, senderAddr, contractAddr, msg.Msg, msg.SentFunds, msg.ExecuteHeight, msg.GasLimit, msg.Fee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleExecuteResponse{ID: id}, nil
+}
+
+func (m msgServer) CancelScheduledExecute(goCtx context.Context, msg *types.MsgCancelScheduledExecute) (*types.MsgCancelScheduledExecuteResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelScheduledExecute(ctx, senderAddr, msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelScheduledExecuteResponse{}, nil
+}
+
+func (m msgServer) RegisterCron(goCtx context.Context, msg *types.MsgRegisterCron) (*types.MsgRegisterCronResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	id, err := m.keeper.RegisterCron(ctx, senderAddr, contractAddr, msg.Msg, msg.Interval, msg.GasLimit, msg.Budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCronResponse{ID: id}, nil
+}
+
+func (m msgServer) CancelCron(goCtx context.Context, msg *types.MsgCancelCron) (*types.MsgCancelCronResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.CancelCron(ctx, senderAddr, msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelCronResponse{}, nil
+}
@@ -12,6 +12,8 @@ import (
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	sdksigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -23,23 +25,15 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
+// getSignBytes returns the bytes that the keys of a multisig sign, which are the sign bytes of the multisig
+// account that signs the tx, whatever the nesting of the multisigs
 func getSignBytes(
-	t *testing.T, signModeHandler authsigning.SignModeHandler, builder client.TxBuilder, multisigAccount Account, signer Account,
+	t *testing.T, signModeHandler authsigning.SignModeHandler, builder client.TxBuilder, multisigAccount Account,
 ) []byte {
-	sig := sdksigning.SignatureV2{
-		PubKey:   signer.public,
-		Sequence: multisigAccount.acct.GetSequence() - 1,
-		Data: &sdksigning.SingleSignatureData{
-			SignMode:  sdksigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
-			Signature: nil,
-		},
-	}
-	err := builder.SetSignatures(sig)
-	require.NoError(t, err)
 	signerData := authsigning.SignerData{
 		ChainID:       TestConfig.ChainID,
-		AccountNumber: signer.acct.GetAccountNumber(),
-		Sequence:      signer.acct.GetSequence() - 1,
+		AccountNumber: multisigAccount.acct.GetAccountNumber(),
+		Sequence:      multisigAccount.acct.GetSequence(),
 	}
 	bytesToSign, err := signModeHandler.GetSignBytes(sdksigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, builder.GetTx())
 	require.NoError(t, err)
@@ -53,8 +47,8 @@ func generateSignatures(
 ) *sdksigning.MultiSignatureData {
 	multiSig := multisig.NewMultisig(len(signers))
 
+	signBytes := getSignBytes(t, signModeHandler, builder, multisigAccount)
 	for i := 0; i < len(signers); i++ {
-		signBytes := getSignBytes(t, signModeHandler, builder, multisigAccount, signers[i])
 		var signature []byte
 		if i < actualSigners {
 			signature, _ = signers[i].private.Sign(signBytes)
@@ -74,16 +68,16 @@ func generateSignatures(
 }
 
 func multisigTxCreator(
-	t *testing.T, ctx *sdk.Context, keeper Keeper, n int, threshold int, actualSigners int, sdkMsg sdk.Msg,
-) (authsigning.SignModeHandler, []Account, Account) {
-	signers, multisigAccount := generateMultisigAccount(*ctx, keeper, n, threshold)
-	signModeHandler := multisigTxCreatorForExisting(t, ctx, multisigAccount, signers, actualSigners, sdkMsg)
-	return signModeHandler, signers, multisigAccount
+	t *testing.T, ctx sdk.Context, keeper Keeper, n int, threshold int, actualSigners int, sdkMsg sdk.Msg,
+) (*sdktx.Tx, []Account, Account) {
+	signers, multisigAccount := generateMultisigAccount(ctx, keeper, n, threshold)
+	tx := multisigTxCreatorForExisting(t, multisigAccount, signers, actualSigners, sdkMsg)
+	return tx, signers, multisigAccount
 }
 
 func multisigTxCreatorForExisting(
-	t *testing.T, ctx *sdk.Context, multisigAccount Account, signers []Account, actualSigners int, sdkMsg sdk.Msg,
-) authsigning.SignModeHandler {
+	t *testing.T, multisigAccount Account, signers []Account, actualSigners int, sdkMsg sdk.Msg,
+) *sdktx.Tx {
 	switch msg := sdkMsg.(type) {
 	case *types.MsgInstantiateContract:
 		msg.Sender = multisigAccount.address
@@ -95,7 +89,7 @@ func multisigTxCreatorForExisting(
 	signmodeHandler := txConfig.SignModeHandler()
 	builder := txConfig.NewTxBuilder()
 	builder.SetFeeAmount(nil)
-	builder.SetGasLimit(0)
+	builder.SetGasLimit(testTxGasLimit)
 	builder.SetTimeoutHeight(0)
 
 	_ = builder.SetMsgs(sdkMsg)
@@ -103,20 +97,13 @@ func multisigTxCreatorForExisting(
 	multiSignature := generateSignatures(t, signmodeHandler, builder, multisigAccount, signers, actualSigners)
 	signature := sdksigning.SignatureV2{
 		PubKey:   multisigAccount.public,
-		Sequence: multisigAccount.acct.GetSequence() - 1,
+		Sequence: multisigAccount.acct.GetSequence(),
 		Data:     multiSignature,
 	}
 	err := builder.SetSignatures(signature)
 	require.NoError(t, err)
 
-	tx := builder.(protoTxProvider)
-	txBytes, err := tx.GetProtoTx().Marshal()
-	require.NoError(t, err)
-	*ctx = ctx.WithTxBytes(txBytes)
-	*ctx = types.WithTXCounter(*ctx, 1)
-	// updateLightClientHelper(t, *ctx)
-
-	return signmodeHandler
+	return builder.(protoTxProvider).GetProtoTx()
 }
 
 type Account struct {
@@ -334,7 +321,8 @@ func TestMultiSig(t *testing.T) {
 				InitFunds: nil,
 			}
 
-			_, _, multisigAddr := multisigTxCreator(t, &ctx, keeper, i+1, j+1, i+1, &sdkMsg)
+			tx, _, multisigAddr := multisigTxCreator(t, ctx, keeper, i+1, j+1, i+1, &sdkMsg)
+			ctx = runAnteHandler(t, ctx, keeper, tx)
 
 			contractAddressA, _, err := keeper.Instantiate(ctx, codeID, multisigAddr.address, nil, initMsgBz, label, sdk.NewCoins(sdk.NewInt64Coin("denom", 0)), nil)
 			if err != nil {
@@ -385,7 +373,8 @@ func TestMultiSigThreshold(t *testing.T) {
 				InitFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 0)),
 			}
 
-			_, _, multisigAddr := multisigTxCreator(t, &ctx, keeper, i+1, j+1, j+1, &sdkMsg)
+			tx, _, multisigAddr := multisigTxCreator(t, ctx, keeper, i+1, j+1, j+1, &sdkMsg)
+			ctx = runAnteHandler(t, ctx, keeper, tx)
 
 			contractAddressA, _, err := keeper.Instantiate(ctx, codeID, multisigAddr.address, nil, initMsgBz, label, sdk.NewCoins(sdk.NewInt64Coin("denom", 0)), nil)
 			if err != nil {
@@ -433,7 +422,12 @@ func TestMultiSigThresholdNotMet(t *testing.T) {
 		InitFunds: sdk.NewCoins(sdk.NewInt64Coin("denom", 0)),
 	}
 
-	_, _, multisigAddr := multisigTxCreator(t, &ctx, keeper, 3, 2, 1, &sdkMsg)
+	tx, _, multisigAddr := multisigTxCreator(t, ctx, keeper, 3, 2, 1, &sdkMsg)
+
+	// the chain rejects the tx, but the enclave must not rely on it
+	_, err = anteHandle(t, ctx, keeper, tx)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	ctx = runSignerDataDecorator(t, ctx, keeper, tx)
 
 	_, _, err = keeper.Instantiate(ctx, codeID, multisigAddr.address, nil, initMsgBz, "demo contract 1", sdk.NewCoins(sdk.NewInt64Coin("denom", 0)), nil)
 	if err != nil {
@@ -474,7 +468,8 @@ func TestMultiSigExecute(t *testing.T) {
 		CallbackSig: nil,
 	}
 
-	_ = multisigTxCreatorForExisting(t, &ctx, multisigAccount, accounts, 4, &sdkMsg)
+	tx := multisigTxCreatorForExisting(t, multisigAccount, accounts, 4, &sdkMsg)
+	ctx = runAnteHandler(t, ctx, keeper, tx)
 
 	execRes, err := keeper.Execute(ctx, contractAddress, multisigAccount.address, execMsgBz, funds, nil, wasmtypes.HandleTypeExecute)
 	if err != nil {
@@ -533,7 +528,8 @@ func TestMultiSigCallbacks(t *testing.T) {
 		CallbackSig: nil,
 	}
 
-	_, _, multisigAddr := multisigTxCreator(t, &ctx, keeper, 3, 2, 2, &sdkMsg)
+	tx, _, multisigAddr := multisigTxCreator(t, ctx, keeper, 3, 2, 2, &sdkMsg)
+	ctx = runAnteHandler(t, ctx, keeper, tx)
 
 	execRes, err := keeper.Execute(ctx, contractAddress, multisigAddr.address, execMsgBz, sdk.NewCoins(sdk.NewInt64Coin("denom", 0)), nil, wasmtypes.HandleTypeExecute)
 	if err != nil {
@@ -582,15 +578,15 @@ func TestMultiSigInMultiSig(t *testing.T) {
 	signModeHandler := txConfig.SignModeHandler()
 	builder := txConfig.NewTxBuilder()
 	builder.SetFeeAmount(nil)
-	builder.SetGasLimit(0)
+	builder.SetGasLimit(testTxGasLimit)
 	builder.SetTimeoutHeight(0)
 
 	_ = builder.SetMsgs(&sdkMsg)
-	multimultiSignBytes := getSignBytes(t, signModeHandler, builder, multimultisigAccount, multisigAccount)
+	multimultiSignBytes := getSignBytes(t, signModeHandler, builder, multimultisigAccount)
 	multimultiSig := multisig.NewMultisig(3)
 
 	// Sign by multisig
-	multiSignature := generateSignatures(t, signModeHandler, builder, multisigAccount, accounts, 3)
+	multiSignature := generateSignatures(t, signModeHandler, builder, multimultisigAccount, accounts, 3)
 	fmt.Printf("multisig sig: %v\n", multiSignature)
 
 	// Sign by wallet A
@@ -615,19 +611,13 @@ func TestMultiSigInMultiSig(t *testing.T) {
 	multimultisigAcc := keeper.accountKeeper.GetAccount(ctx, multimultisigAccount.address.Bytes())
 	signature := sdksigning.SignatureV2{
 		PubKey:   multimultisigAccount.public,
-		Sequence: multimultisigAcc.GetSequence() - 1,
+		Sequence: multimultisigAcc.GetSequence(),
 		Data:     multimultiSig,
 	}
 	err = builder.SetSignatures(signature)
 	require.NoError(t, err)
 
-	tx := builder.(protoTxProvider)
-	txBytes, err := tx.GetProtoTx().Marshal()
-	require.NoError(t, err)
-
-	ctx = ctx.WithTxBytes(txBytes)
-	ctx = types.WithTXCounter(ctx, 1)
-	// updateLightClientHelper(t, ctx)
+	ctx = runAnteHandler(t, ctx, keeper, builder.(protoTxProvider).GetProtoTx())
 
 	contractAddressA, _, err := keeper.Instantiate(
 		ctx,
@@ -688,15 +678,15 @@ func TestMultiSigInMultiSigDifferentOrder(t *testing.T) {
 	signModeHandler := txConfig.SignModeHandler()
 	builder := txConfig.NewTxBuilder()
 	builder.SetFeeAmount(nil)
-	builder.SetGasLimit(0)
+	builder.SetGasLimit(testTxGasLimit)
 	builder.SetTimeoutHeight(0)
 
 	_ = builder.SetMsgs(&sdkMsg)
-	multimultiSignBytes := getSignBytes(t, signModeHandler, builder, multimultisigAccount, multisigAccount)
+	multimultiSignBytes := getSignBytes(t, signModeHandler, builder, multimultisigAccount)
 	multimultiSig := multisig.NewMultisig(3)
 
 	// Sign by multisig
-	multiSignature := generateSignatures(t, signModeHandler, builder, multisigAccount, accounts, 3)
+	multiSignature := generateSignatures(t, signModeHandler, builder, multimultisigAccount, accounts, 3)
 	fmt.Printf("multisig sig: %v\n", multiSignature)
 
 	// Sign by wallet A
@@ -721,19 +711,13 @@ func TestMultiSigInMultiSigDifferentOrder(t *testing.T) {
 	multimultisigAcc := keeper.accountKeeper.GetAccount(ctx, multimultisigAccount.address.Bytes())
 	signature := sdksigning.SignatureV2{
 		PubKey:   multimultisigAccount.public,
-		Sequence: multimultisigAcc.GetSequence() - 1,
+		Sequence: multimultisigAcc.GetSequence(),
 		Data:     multimultiSig,
 	}
 	err = builder.SetSignatures(signature)
 	require.NoError(t, err)
 
-	tx := builder.(protoTxProvider)
-	txBytes, err := tx.GetProtoTx().Marshal()
-	require.NoError(t, err)
-
-	ctx = ctx.WithTxBytes(txBytes)
-	ctx = types.WithTXCounter(ctx, 1)
-	// updateLightClientHelper(t, ctx)
+	ctx = runAnteHandler(t, ctx, keeper, builder.(protoTxProvider).GetProtoTx())
 
 	contractAddressA, _, err := keeper.Instantiate(
 		ctx,
@@ -861,7 +845,7 @@ func TestInvalidKeyTypeInMultisig(t *testing.T) {
 	signModeHanler := txConfig.SignModeHandler()
 	builder := txConfig.NewTxBuilder()
 	builder.SetFeeAmount(nil)
-	builder.SetGasLimit(0)
+	builder.SetGasLimit(testTxGasLimit)
 	builder.SetTimeoutHeight(0)
 
 	_ = builder.SetMsgs(&sdkMsg)
@@ -871,18 +855,18 @@ func TestInvalidKeyTypeInMultisig(t *testing.T) {
 	multisigAcc := keeper.accountKeeper.GetAccount(ctx, multisigPubkey.address.Bytes())
 	signature := sdksigning.SignatureV2{
 		PubKey:   multisigPubkey.public,
-		Sequence: multisigAcc.GetSequence() - 1,
+		Sequence: multisigAcc.GetSequence(),
 		Data:     multiSignature,
 	}
 	err = builder.SetSignatures(signature)
 	require.NoError(t, err)
 
-	tx := builder.(protoTxProvider)
-	txBytes, err := tx.GetProtoTx().Marshal()
-	require.NoError(t, err)
-	ctx = ctx.WithTxBytes(txBytes)
-	ctx = types.WithTXCounter(ctx, 1)
-	// updateLightClientHelper(t, ctx)
+	tx := builder.(protoTxProvider).GetProtoTx()
+
+	// the chain rejects the tx, but the enclave must not rely on it
+	_, err = anteHandle(t, ctx, keeper, tx)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+	ctx = runSignerDataDecorator(t, ctx, keeper, tx)
 
 	_, _, err = keeper.Instantiate(
 		ctx,
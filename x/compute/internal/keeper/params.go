@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// GetParams returns the compute module params. Params that were never set (e.g. on a chain
+// that was upgraded from a version without params) fall back to their defaults.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return params
+}
+
+// SetParams sets the compute module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// keyEpoch returns the key epoch contract calls at the current height are tagged with,
+// or nil if no epoch was scheduled
+func (k Keeper) keyEpoch(ctx sdk.Context) *wasmTypes.KeyEpoch {
+	params := k.GetParams(ctx)
+	active, ok := params.ActiveKeyEpoch(ctx.BlockHeight())
+	if !ok {
+		return nil
+	}
+	return &wasmTypes.KeyEpoch{
+		Active:   active.Epoch,
+		Accepted: params.AcceptedKeyEpochs(ctx.BlockHeight()),
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"runtime/pprof"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// pprof label keys set on the goroutine while it runs a contract
+const (
+	profileLabelOperation = "compute_operation"
+	profileLabelContract  = "contract_address"
+	profileLabelCodeID    = "code_id"
+)
+
+// SetExecutionTracer sets a tracer that is called after every contract execution and query.
+// Like SetHooks, it must be called before the keeper is copied into the module.
+func (k *Keeper) SetExecutionTracer(tracer types.ExecutionTracer) *Keeper {
+	if k.tracer != nil {
+		panic("cannot set compute execution tracer twice")
+	}
+
+	k.tracer = tracer
+	return k
+}
+
+// profile runs fn with pprof labels identifying the contract, so that CPU profiles of the node
+// can be attributed to contracts, then reports the call to the execution tracer if one is set.
+func (k Keeper) profile(ctx sdk.Context, operation string, contractAddress sdk.AccAddress, codeID uint64, fn func() (uint64, error)) {
+	start := time.Now()
+
+	var gasUsed uint64
+	var err error
+	labels := pprof.Labels(
+		profileLabelOperation, operation,
+		profileLabelContract, contractAddress.String(),
+		profileLabelCodeID, strconv.FormatUint(codeID, 10),
+	)
+	pprof.Do(ctx.Context(), labels, func(context.Context) {
+		gasUsed, err = fn()
+	})
+
+	if k.tracer != nil {
+		k.tracer.TraceExecution(ctx, types.ExecutionTrace{
+			Operation:       operation,
+			ContractAddress: contractAddress,
+			CodeID:          codeID,
+			GasUsed:         gasUsed,
+			Duration:        time.Since(start),
+			Err:             err,
+		})
+	}
+}
@@ -2,13 +2,17 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"sort"
 
 	"github.com/golang/protobuf/ptypes/empty"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
 
@@ -33,6 +37,187 @@ func NewGrpcQuerier(keeper Keeper) GrpcQuerier {
 	return GrpcQuerier{keeper: keeper}
 }
 
+func (q GrpcQuerier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
+func (q GrpcQuerier) Snip20WrapperByDenom(c context.Context, req *types.QueryByDenomRequest) (*types.QuerySnip20WrapperResponse, error) {
+	wrapper, ok := q.keeper.GetParams(sdk.UnwrapSDKContext(c)).Snip20WrapperByDenom(req.Denom)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "no snip20 wrapper for denom %s", req.Denom)
+	}
+	return &types.QuerySnip20WrapperResponse{Snip20Wrapper: wrapper}, nil
+}
+
+func (q GrpcQuerier) Snip20WrapperByContract(c context.Context, req *types.QueryByContractAddressRequest) (*types.QuerySnip20WrapperResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapper, ok := q.keeper.GetParams(sdk.UnwrapSDKContext(c)).Snip20WrapperByContract(contractAddress.String())
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "contract %s is not a registered snip20 wrapper", contractAddress)
+	}
+	return &types.QuerySnip20WrapperResponse{Snip20Wrapper: wrapper}, nil
+}
+
+func (q GrpcQuerier) ScheduledCall(c context.Context, req *types.QueryScheduledCallRequest) (*types.QueryScheduledCallResponse, error) {
+	call, found := q.keeper.GetScheduledCall(sdk.UnwrapSDKContext(c), req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "scheduled call %d", req.Id)
+	}
+	return &types.QueryScheduledCallResponse{ScheduledCall: call}, nil
+}
+
+func (q GrpcQuerier) ScheduledCalls(c context.Context, req *types.QueryScheduledCallsRequest) (*types.QueryScheduledCallsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.ScheduledCallPrefix)
+
+	var calls []types.ScheduledCall
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var call types.ScheduledCall
+		if err := q.keeper.cdc.Unmarshal(value, &call); err != nil {
+			return err
+		}
+		calls = append(calls, call)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryScheduledCallsResponse{ScheduledCalls: calls, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) ContractFeePolicy(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractFeePolicyResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractFeePolicyResponse{FeePolicy: q.keeper.GetContractFeePolicy(sdk.UnwrapSDKContext(c), contractAddress)}, nil
+}
+
+func (q GrpcQuerier) CodeSchema(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryCodeSchemaResponse, error) {
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+	schema, found := q.keeper.GetCodeSchema(sdk.UnwrapSDKContext(c), req.CodeId)
+	if !found {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryCodeSchemaResponse{Schema: schema}, nil
+}
+
+func (q GrpcQuerier) CodeAudits(c context.Context, req *types.QueryByCodeIdRequest) (*types.QueryCodeAuditsResponse, error) {
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+	return &types.QueryCodeAuditsResponse{Audits: q.keeper.GetCodeAudits(sdk.UnwrapSDKContext(c), req.CodeId)}, nil
+}
+
+func (q GrpcQuerier) TopContractsByGas(c context.Context, req *types.QueryTopContractsByGasRequest) (*types.QueryTopContractsByGasResponse, error) {
+	if req.Blocks != 0 && req.Days != 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "only one of blocks and days may be set")
+	}
+	if req.Blocks > types.GasUsageBlockRetention {
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "gas usage is kept for %d blocks", types.GasUsageBlockRetention)
+	}
+	if req.Days > types.GasUsageDayRetention {
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "gas usage is kept for %d days", types.GasUsageDayRetention)
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = types.DefaultTopContractsLimit
+	}
+	if limit > types.MaxTopContractsLimit {
+		return nil, sdkerrors.Wrapf(types.ErrLimit, "limit cannot be more than %d", types.MaxTopContractsLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Blocks != 0 {
+		return &types.QueryTopContractsByGasResponse{Contracts: q.keeper.TopContractsByBlockGas(ctx, req.Blocks, limit)}, nil
+	}
+	days := req.Days
+	if days == 0 {
+		days = 1
+	}
+	return &types.QueryTopContractsByGasResponse{Contracts: q.keeper.TopContractsByDailyGas(ctx, days, limit)}, nil
+}
+
+func (q GrpcQuerier) CodeVerifications(c context.Context, req *types.QueryCodeVerificationsRequest) (*types.QueryCodeVerificationsResponse, error) {
+	codeHash, err := hex.DecodeString(req.CodeHash)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code hash")
+	}
+	if len(codeHash) != sha256.Size {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "code hash must be %d bytes", sha256.Size)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetCodeVerificationPrefix(codeHash))
+
+	var verifications []types.CodeVerification
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(_, value []byte) error {
+		var verification types.CodeVerification
+		if err := q.keeper.cdc.Unmarshal(value, &verification); err != nil {
+			return err
+		}
+		verifications = append(verifications, verification)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCodeVerificationsResponse{Verifications: verifications, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) ContractAssets(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractAssetsResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetContractInfo(ctx, contractAddress) == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	return q.keeper.contractAssets(ctx, contractAddress)
+}
+
+func (q GrpcQuerier) Cron(c context.Context, req *types.QueryCronRequest) (*types.QueryCronResponse, error) {
+	cron, found := q.keeper.GetCron(sdk.UnwrapSDKContext(c), req.Id)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "cron %d", req.Id)
+	}
+	return &types.QueryCronResponse{Cron: cron}, nil
+}
+
+func (q GrpcQuerier) CronsByContract(c context.Context, req *types.QueryCronsByContractRequest) (*types.QueryCronsByContractResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetCronByContractPrefix(contractAddress))
+
+	var crons []types.Cron
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key, _ []byte) error {
+		cron, found := q.keeper.GetCron(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNotFound, "cron %d", sdk.BigEndianToUint64(key))
+		}
+		crons = append(crons, cron)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCronsByContractResponse{Crons: crons, Pagination: pageRes}, nil
+}
+
 func (q GrpcQuerier) ContractInfo(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractInfoResponse, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -71,6 +256,40 @@ func (q GrpcQuerier) ContractsByCodeId(c context.Context, req *types.QueryByCode
 	}, nil
 }
 
+// ListContractsByCode returns the contracts running a code by page, using the contracts by code index
+func (q GrpcQuerier) ListContractsByCode(c context.Context, req *types.QueryListContractsByCodeRequest) (*types.QueryListContractsByCodeResponse, error) {
+	if req.CodeId == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "code id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(req.CodeId))
+
+	var contracts []types.ContractByCode
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key, _ []byte) error {
+		contractAddress := sdk.AccAddress(key[types.AbsoluteTxPositionLen:])
+		info := q.keeper.GetContractInfo(ctx, contractAddress)
+		if info == nil {
+			return sdkerrors.Wrapf(types.ErrNotFound, "contract %s", contractAddress)
+		}
+
+		contract := types.ContractByCode{
+			ContractAddress: contractAddress.String(),
+			Label:           info.Label,
+		}
+		if info.Created != nil {
+			contract.CreatedHeight = info.Created.BlockHeight
+		}
+		contracts = append(contracts, contract)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListContractsByCodeResponse{Contracts: contracts, Pagination: pageRes}, nil
+}
+
 func (q GrpcQuerier) QuerySecretContract(c context.Context, req *types.QuerySecretContractRequest) (*types.QuerySecretContractResponse, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -79,7 +298,7 @@ func (q GrpcQuerier) QuerySecretContract(c context.Context, req *types.QuerySecr
 
 	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.keeper.queryGasLimit))
 
-	response, err := q.keeper.QuerySmart(ctx, contractAddress, req.Query, false)
+	response, err := q.keeper.querySmartWithDeadline(ctx, contractAddress, req.Query, false)
 	switch {
 	case err != nil:
 		return nil, err
@@ -121,6 +340,63 @@ func (q GrpcQuerier) Codes(c context.Context, _ *empty.Empty) (*types.QueryCodes
 	return &types.QueryCodesResponse{CodeInfos: response}, nil
 }
 
+// ListCodes returns the code infos by page. With a creator, only the codes of the creator
+// are returned, and the page limit applies to the matching codes.
+func (q GrpcQuerier) ListCodes(c context.Context, req *types.QueryListCodesRequest) (*types.QueryListCodesResponse, error) {
+	var creator sdk.AccAddress
+	if req.Creator != "" {
+		var err error
+		if creator, err = sdk.AccAddressFromBech32(req.Creator); err != nil {
+			return nil, sdkerrors.Wrap(err, "creator")
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.keeper.storeKey), types.CodeKeyPrefix)
+
+	var infos []types.CodeInfoResponse
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var codeInfo types.CodeInfo
+		if err := q.keeper.cdc.Unmarshal(value, &codeInfo); err != nil {
+			return false, err
+		}
+		if creator != nil && !creator.Equals(codeInfo.Creator) {
+			return false, nil
+		}
+		if accumulate {
+			codeID := binary.BigEndian.Uint64(key)
+			infos = append(infos, types.CodeInfoResponse{
+				CodeId:                codeID,
+				Creator:               codeInfo.Creator.String(),
+				CodeHash:              hex.EncodeToString(codeInfo.CodeHash),
+				Source:                codeInfo.Source,
+				Builder:               codeInfo.Builder,
+				InstantiatePermission: q.keeper.GetInstantiateConfig(ctx, codeID),
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListCodesResponse{CodeInfos: infos, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) ContractKey(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractKeyResponse, error) {
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	contractKey, err := q.keeper.GetContractKey(sdk.UnwrapSDKContext(c), contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractKeyResponse{ContractKey: contractKey}, nil
+}
+
 func (q GrpcQuerier) CodeHashByContractAddress(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryCodeHashResponse, error) {
 	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
 	if err != nil {
@@ -174,8 +450,11 @@ func (q GrpcQuerier) LabelByAddress(c context.Context, req *types.QueryByContrac
 		return nil, types.ErrNotFound
 	}
 
+	labelIndexKey := types.GetContractLabelPrefix(response.Label)
 	return &types.QueryContractLabelResponse{
-		Label: response.Label,
+		Label:                  response.Label,
+		LabelIndexKey:          labelIndexKey,
+		IndexedContractAddress: sdk.AccAddress(ctx.KVStore(q.keeper.storeKey).Get(labelIndexKey)).String(),
 	}, nil
 }
 
@@ -195,6 +474,28 @@ func (q GrpcQuerier) AddressByLabel(c context.Context, req *types.QueryByLabelRe
 	}, nil
 }
 
+func (q GrpcQuerier) ContractInfoByLabel(c context.Context, req *types.QueryByLabelRequest) (*types.QueryContractInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.keeper.queryGasLimit))
+
+	contractAddress, err := queryContractAddress(ctx, req.Label, q.keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := queryContractInfo(ctx, contractAddress, q.keeper)
+	switch {
+	case err != nil:
+		return nil, err
+	case response == nil:
+		return nil, types.ErrNotFound
+	}
+
+	return &types.QueryContractInfoResponse{
+		ContractAddress: response.ContractAddress,
+		ContractInfo:    response.ContractInfo,
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, keeper Keeper) (*types.ContractInfoWithAddress, error) {
 	info := keeper.GetContractInfo(ctx, contractAddress)
 	if info == nil {
@@ -248,11 +549,12 @@ func queryCode(ctx sdk.Context, codeId uint64, keeper Keeper) (*types.QueryCodeR
 	}
 
 	info := types.CodeInfoResponse{
-		CodeId:   codeId,
-		Creator:  codeInfo.Creator.String(),
-		CodeHash: hex.EncodeToString(codeInfo.CodeHash),
-		Source:   codeInfo.Source,
-		Builder:  codeInfo.Builder,
+		CodeId:                codeId,
+		Creator:               codeInfo.Creator.String(),
+		CodeHash:              hex.EncodeToString(codeInfo.CodeHash),
+		Source:                codeInfo.Source,
+		Builder:               codeInfo.Builder,
+		InstantiatePermission: keeper.GetInstantiateConfig(ctx, codeId),
 	}
 
 	wasmBz, err := keeper.GetWasm(ctx, codeId)
@@ -270,11 +572,12 @@ func queryCodeList(ctx sdk.Context, keeper Keeper) ([]types.CodeInfoResponse, er
 	var info []types.CodeInfoResponse
 	keeper.IterateCodeInfos(ctx, func(codeId uint64, res types.CodeInfo) bool {
 		info = append(info, types.CodeInfoResponse{
-			CodeId:   codeId,
-			Creator:  res.Creator.String(),
-			CodeHash: hex.EncodeToString(res.CodeHash),
-			Source:   res.Source,
-			Builder:  res.Builder,
+			CodeId:                codeId,
+			Creator:               res.Creator.String(),
+			CodeHash:              hex.EncodeToString(res.CodeHash),
+			Source:                res.Source,
+			Builder:               res.Builder,
+			InstantiatePermission: keeper.GetInstantiateConfig(ctx, codeId),
 		})
 		return false
 	})
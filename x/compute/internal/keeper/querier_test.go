@@ -169,8 +169,8 @@ func TestQueryContractState(t *testing.T) {
 	require.NoError(t, err)
 
 	contractModel := []types.Model{
-		{Key: []byte("foo"), Value: []byte(`"bar"`)},
 		{Key: []byte{0x0, 0x1}, Value: []byte(`{"count":8}`)},
+		{Key: []byte("foo"), Value: []byte(`"bar"`)},
 	}
 	keeper.importContractState(ctx, addr, contractModel)
 
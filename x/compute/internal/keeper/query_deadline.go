@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// queryDeadline gives up on RPC queries that take longer than a timeout. The enclave call of a query
+// can't be interrupted, so on timeout it keeps running in the background and its result is discarded.
+// A query holds one of the slots until its enclave call returns, so the queries running past their
+// deadline are bounded by the number of slots, and new queries wait for a free slot within their deadline.
+type queryDeadline struct {
+	timeout time.Duration
+	slots   chan struct{}
+}
+
+func newQueryDeadline(timeout time.Duration, maxInFlight uint16) *queryDeadline {
+	if maxInFlight == 0 {
+		maxInFlight = 1
+	}
+	return &queryDeadline{
+		timeout: timeout,
+		slots:   make(chan struct{}, maxInFlight),
+	}
+}
+
+// run runs query within the deadline. Panics of query, e.g. for out of gas errors, are raised again
+// on the caller's goroutine.
+func (d *queryDeadline) run(query func() ([]byte, error)) ([]byte, error) {
+	timer := time.NewTimer(d.timeout)
+	defer timer.Stop()
+
+	select {
+	case d.slots <- struct{}{}:
+	case <-timer.C:
+		telemetry.IncrCounter(1, "compute", "keeper", "query", "no_slot")
+		return nil, sdkerrors.Wrapf(types.ErrQueryFailed, "no free query slot within the deadline of %s", d.timeout)
+	}
+
+	type queryResult struct {
+		data     []byte
+		err      error
+		panicVal interface{}
+	}
+
+	done := make(chan queryResult, 1)
+	go func() {
+		var result queryResult
+		defer func() {
+			result.panicVal = recover()
+			done <- result
+			<-d.slots
+		}()
+		result.data, result.err = query()
+	}()
+
+	select {
+	case result := <-done:
+		if result.panicVal != nil {
+			panic(result.panicVal)
+		}
+		return result.data, result.err
+	case <-timer.C:
+		telemetry.IncrCounter(1, "compute", "keeper", "query", "timeout")
+		return nil, sdkerrors.Wrapf(types.ErrQueryFailed, "query exceeded deadline of %s", d.timeout)
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestQueryDeadlineResult(t *testing.T) {
+	deadline := newQueryDeadline(time.Second, 1)
+
+	data, err := deadline.run(func() ([]byte, error) { return []byte("result"), nil })
+	require.NoError(t, err)
+	require.Equal(t, []byte("result"), data)
+
+	queryErr := errors.New("query error")
+	_, err = deadline.run(func() ([]byte, error) { return nil, queryErr })
+	require.ErrorIs(t, err, queryErr)
+}
+
+func TestQueryDeadlineTimeout(t *testing.T) {
+	deadline := newQueryDeadline(10*time.Millisecond, 1)
+	release := make(chan struct{})
+	defer close(release)
+
+	_, err := deadline.run(func() ([]byte, error) {
+		<-release
+		return []byte("late"), nil
+	})
+	require.ErrorIs(t, err, types.ErrQueryFailed)
+}
+
+func TestQueryDeadlineBoundsInFlight(t *testing.T) {
+	const maxInFlight = 3
+	deadline := newQueryDeadline(20*time.Millisecond, maxInFlight)
+
+	var inFlight, maxSeen int32
+	release := make(chan struct{})
+	blockingQuery := func() ([]byte, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			seen := atomic.LoadInt32(&maxSeen)
+			if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+				break
+			}
+		}
+		<-release
+		atomic.AddInt32(&inFlight, -1)
+		return nil, nil
+	}
+
+	// the queries time out, but keep their slots while they're running
+	var wg sync.WaitGroup
+	for i := 0; i < 2*maxInFlight; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := deadline.run(blockingQuery)
+			require.ErrorIs(t, err, types.ErrQueryFailed)
+		}()
+	}
+	wg.Wait()
+	require.LessOrEqual(t, atomic.LoadInt32(&maxSeen), int32(maxInFlight))
+
+	// a query finding no free slot never runs
+	var ran bool
+	_, err := deadline.run(func() ([]byte, error) {
+		ran = true
+		return nil, nil
+	})
+	require.ErrorIs(t, err, types.ErrQueryFailed)
+	require.False(t, ran)
+
+	// the slots are freed once the timed out queries are done
+	close(release)
+	require.Eventually(t, func() bool { return len(deadline.slots) == 0 }, time.Second, time.Millisecond)
+	data, err := deadline.run(func() ([]byte, error) { return []byte("result"), nil })
+	require.NoError(t, err)
+	require.Equal(t, []byte("result"), data)
+}
+
+func TestQueryDeadlinePanic(t *testing.T) {
+	deadline := newQueryDeadline(time.Second, 1)
+
+	require.PanicsWithValue(t, "out of gas", func() {
+		_, _ = deadline.run(func() ([]byte, error) { panic("out of gas") })
+	})
+
+	// the slot of the panicking query is freed
+	_, err := deadline.run(func() ([]byte, error) { return nil, nil })
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 
@@ -33,6 +34,8 @@ type QueryHandler struct {
 	Ctx     sdk.Context
 	Plugins QueryPlugins
 	Caller  sdk.AccAddress
+	// GasCosts is the flat gas charged per query on top of the gas used by the plugin
+	GasCosts types.QueryPluginGasCosts
 }
 
 var _ wasmTypes.Querier = QueryHandler{}
@@ -47,6 +50,10 @@ func (q QueryHandler) Query(request wasmTypes.QueryRequest, queryDepth uint32, g
 		q.Ctx.GasMeter().ConsumeGas(subctx.GasMeter().GasConsumed(), "contract sub-query")
 	}()
 
+	if surcharge, route := q.GasCosts.Of(request); surcharge > 0 {
+		subctx.GasMeter().ConsumeGas(surcharge, fmt.Sprintf("contract sub-query surcharge: %s", route))
+	}
+
 	// do the query
 	if request.Bank != nil {
 		return q.Plugins.Bank(subctx, request.Bank)
@@ -75,6 +82,9 @@ func (q QueryHandler) Query(request wasmTypes.QueryRequest, queryDepth uint32, g
 	if request.Stargate != nil {
 		return q.Plugins.Stargate(q.Ctx, request.Stargate)
 	}
+	if request.Oracle != nil {
+		return q.Plugins.Oracle(subctx, request.Oracle)
+	}
 	return nil, wasmTypes.Unknown{}
 }
 
@@ -94,6 +104,7 @@ type QueryPlugins struct {
 	Gov      func(ctx sdk.Context, request *wasmTypes.GovQuery) ([]byte, error)
 	IBC      func(ctx sdk.Context, caller sdk.AccAddress, request *wasmTypes.IBCQuery) ([]byte, error)
 	Stargate func(ctx sdk.Context, request *wasmTypes.StargateQuery) ([]byte, error)
+	Oracle   func(ctx sdk.Context, request *wasmTypes.OracleQuery) ([]byte, error)
 }
 
 func DefaultQueryPlugins(gov govkeeper.Keeper, dist distrkeeper.Keeper, mint mintkeeper.Keeper, bank bankkeeper.Keeper, staking stakingkeeper.Keeper, stargateQueryRouter GRPCQueryRouter, wasm *Keeper, channelKeeper types.ChannelKeeper) QueryPlugins {
@@ -106,7 +117,8 @@ func DefaultQueryPlugins(gov govkeeper.Keeper, dist distrkeeper.Keeper, mint min
 		Mint:     MintQuerier(mint),
 		Gov:      GovQuerier(gov),
 		Stargate: StargateQuerier(stargateQueryRouter),
-		IBC:      IBCQuerier(wasm, channelKeeper),
+		IBC:      IBCQuerier(wasm, channelKeeper, stargateQueryRouter),
+		Oracle:   NoOracleQuerier,
 	}
 }
 
@@ -142,6 +154,9 @@ func (e QueryPlugins) Merge(o *QueryPlugins) QueryPlugins {
 	if o.Stargate != nil {
 		e.Stargate = o.Stargate
 	}
+	if o.Oracle != nil {
+		e.Oracle = o.Oracle
+	}
 	return e
 }
 
@@ -203,6 +218,8 @@ var stargateQueryAllowlist = map[string]bool{
 	"/secret.compute.v1beta1.Query/CodeHashByCodeId":          true,
 	"/secret.compute.v1beta1.Query/LabelByAddress":            true,
 	"/secret.compute.v1beta1.Query/AddressByLabel":            true,
+	"/secret.compute.v1beta1.Query/Snip20WrapperByDenom":      true,
+	"/secret.compute.v1beta1.Query/Snip20WrapperByContract":   true,
 }
 
 func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *wasmTypes.StargateQuery) ([]byte, error) {
@@ -255,7 +272,7 @@ func GovQuerier(keeper govkeeper.Keeper) func(ctx sdk.Context, request *wasmType
 	}
 }
 
-func IBCQuerier(wasm *Keeper, channelKeeper types.ChannelKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmTypes.IBCQuery) ([]byte, error) {
+func IBCQuerier(wasm *Keeper, channelKeeper types.ChannelKeeper, queryRouter GRPCQueryRouter) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmTypes.IBCQuery) ([]byte, error) {
 	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmTypes.IBCQuery) ([]byte, error) {
 		if request.PortID != nil {
 			contractInfo := wasm.GetContractInfo(ctx, caller)
@@ -322,6 +339,58 @@ func IBCQuerier(wasm *Keeper, channelKeeper types.ChannelKeeper) func(ctx sdk.Co
 			}
 			return json.Marshal(res)
 		}
+		if request.ChannelState != nil {
+			channelID := request.ChannelState.ChannelID
+			portID := request.ChannelState.PortID
+			if portID == "" {
+				contractInfo := wasm.GetContractInfo(ctx, caller)
+				portID = contractInfo.IBCPortID
+			}
+			var res wasmTypes.ChannelStateResponse
+			if got, found := channelKeeper.GetChannel(ctx, portID, channelID); found {
+				res.State = got.State.String()
+				// the client may not exist yet while the channel is in its handshake
+				if clientID, _, err := channelKeeper.GetChannelClientState(ctx, portID, channelID); err == nil {
+					res.ClientID = clientID
+				}
+			}
+			return json.Marshal(res)
+		}
+		if request.ClientStatus != nil {
+			const path = "/ibc.core.client.v1.Query/ClientStatus"
+			route := queryRouter.Route(path)
+			if route == nil {
+				return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query path '%s'", path)}
+			}
+			reqBz, err := (&clienttypes.QueryClientStatusRequest{ClientId: request.ClientStatus.ClientID}).Marshal()
+			if err != nil {
+				return nil, err
+			}
+			abciRes, err := route(ctx, abci.RequestQuery{Data: reqBz, Path: path})
+			if err != nil {
+				return nil, err
+			}
+			var statusRes clienttypes.QueryClientStatusResponse
+			if err := statusRes.Unmarshal(abciRes.Value); err != nil {
+				return nil, err
+			}
+			return json.Marshal(wasmTypes.ClientStatusResponse{
+				Status: statusRes.Status,
+			})
+		}
+		if request.PacketAcknowledgement != nil {
+			channelID := request.PacketAcknowledgement.ChannelID
+			portID := request.PacketAcknowledgement.PortID
+			if portID == "" {
+				contractInfo := wasm.GetContractInfo(ctx, caller)
+				portID = contractInfo.IBCPortID
+			}
+			var res wasmTypes.PacketAcknowledgementResponse
+			if ack, found := channelKeeper.GetPacketAcknowledgement(ctx, portID, channelID, request.PacketAcknowledgement.Sequence); found {
+				res.Acknowledgement = ack
+			}
+			return json.Marshal(res)
+		}
 		return nil, wasmTypes.UnsupportedRequest{Kind: "unknown IBCQuery variant"}
 	}
 }
@@ -444,6 +513,31 @@ func NoCustomQuerier(sdk.Context, json.RawMessage) ([]byte, error) {
 	return nil, wasmTypes.UnsupportedRequest{Kind: "custom"}
 }
 
+// NoOracleQuerier is used when the chain has no price oracle
+func NoOracleQuerier(sdk.Context, *wasmTypes.OracleQuery) ([]byte, error) {
+	return nil, wasmTypes.UnsupportedRequest{Kind: "oracle"}
+}
+
+// OracleQuerier serves oracle queries from a price source, e.g. an oracle module keeper.
+// Register it with the customPlugins of NewKeeper:
+//
+//	&QueryPlugins{Oracle: OracleQuerier(oracleKeeper)}
+func OracleQuerier(prices types.PriceSource) func(ctx sdk.Context, request *wasmTypes.OracleQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmTypes.OracleQuery) ([]byte, error) {
+		if request.Price != nil {
+			rate, lastUpdated, found := prices.GetPrice(ctx, request.Price.Base, request.Price.Quote)
+			if !found {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no price for %s/%s", request.Price.Base, request.Price.Quote)
+			}
+			return json.Marshal(wasmTypes.OraclePriceResponse{
+				Rate:        rate.String(),
+				LastUpdated: uint64(lastUpdated.Unix()),
+			})
+		}
+		return nil, wasmTypes.UnsupportedRequest{Kind: "unknown OracleQuery variant"}
+	}
+}
+
 func StakingQuerier(keeper stakingkeeper.Keeper, distKeeper distrkeeper.Keeper) func(ctx sdk.Context, request *wasmTypes.StakingQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmTypes.StakingQuery) ([]byte, error) {
 		if request.BondedDenom != nil {
@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v4/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// MockChannelKeeper panics on the methods that have no Fn set
+type MockChannelKeeper struct {
+	types.ChannelKeeper
+	GetChannelFn               func(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool)
+	GetChannelClientStateFn    func(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+	GetPacketAcknowledgementFn func(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool)
+}
+
+func (m MockChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+	if m.GetChannelFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetChannelFn(ctx, srcPort, srcChan)
+}
+
+func (m MockChannelKeeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+	if m.GetChannelClientStateFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetChannelClientStateFn(ctx, portID, channelID)
+}
+
+func (m MockChannelKeeper) GetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+	if m.GetPacketAcknowledgementFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetPacketAcknowledgementFn(ctx, portID, channelID, sequence)
+}
+
+// MockQueryRouter routes every path to RouteFn
+type MockQueryRouter struct {
+	RouteFn func(path string) baseapp.GRPCQueryHandler
+}
+
+func (m MockQueryRouter) Route(path string) baseapp.GRPCQueryHandler {
+	return m.RouteFn(path)
+}
+
+func TestIBCQuerierChannelState(t *testing.T) {
+	channels := map[string]channeltypes.Channel{
+		"channel-0": {State: channeltypes.OPEN},
+		"channel-1": {State: channeltypes.CLOSED},
+		"channel-2": {State: channeltypes.INIT},
+	}
+	channelKeeper := MockChannelKeeper{
+		GetChannelFn: func(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool) {
+			channel, found := channels[srcChan]
+			return channel, found && srcPort == "wasm.port"
+		},
+		GetChannelClientStateFn: func(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+			if channelID == "channel-2" {
+				return "", nil, connectiontypes.ErrConnectionNotFound
+			}
+			return "07-tendermint-1", nil, nil
+		},
+	}
+	querier := IBCQuerier(nil, channelKeeper, nil)
+
+	specs := map[string]struct {
+		query  wasmTypes.ChannelStateQuery
+		expRes wasmTypes.ChannelStateResponse
+	}{
+		"open channel": {
+			query:  wasmTypes.ChannelStateQuery{PortID: "wasm.port", ChannelID: "channel-0"},
+			expRes: wasmTypes.ChannelStateResponse{State: "STATE_OPEN", ClientID: "07-tendermint-1"},
+		},
+		"closed channel": {
+			query:  wasmTypes.ChannelStateQuery{PortID: "wasm.port", ChannelID: "channel-1"},
+			expRes: wasmTypes.ChannelStateResponse{State: "STATE_CLOSED", ClientID: "07-tendermint-1"},
+		},
+		"channel in handshake without client": {
+			query:  wasmTypes.ChannelStateQuery{PortID: "wasm.port", ChannelID: "channel-2"},
+			expRes: wasmTypes.ChannelStateResponse{State: "STATE_INIT"},
+		},
+		"unknown channel": {
+			query: wasmTypes.ChannelStateQuery{PortID: "wasm.port", ChannelID: "channel-3"},
+		},
+		"other port": {
+			query: wasmTypes.ChannelStateQuery{PortID: "transfer", ChannelID: "channel-0"},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			query := spec.query
+			bz, err := querier(sdk.Context{}, nil, &wasmTypes.IBCQuery{ChannelState: &query})
+			require.NoError(t, err)
+
+			var res wasmTypes.ChannelStateResponse
+			require.NoError(t, json.Unmarshal(bz, &res))
+			require.Equal(t, spec.expRes, res)
+		})
+	}
+}
+
+func TestIBCQuerierClientStatus(t *testing.T) {
+	const path = "/ibc.core.client.v1.Query/ClientStatus"
+	statuses := map[string]ibcexported.Status{
+		"07-tendermint-0": ibcexported.Active,
+		"07-tendermint-1": ibcexported.Frozen,
+		"07-tendermint-2": ibcexported.Expired,
+	}
+	router := MockQueryRouter{RouteFn: func(gotPath string) baseapp.GRPCQueryHandler {
+		require.Equal(t, path, gotPath)
+		return func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			var statusReq clienttypes.QueryClientStatusRequest
+			require.NoError(t, statusReq.Unmarshal(req.Data))
+			status, found := statuses[statusReq.ClientId]
+			if !found {
+				status = ibcexported.Unknown
+			}
+			bz, err := (&clienttypes.QueryClientStatusResponse{Status: status.String()}).Marshal()
+			return abci.ResponseQuery{Value: bz}, err
+		}
+	}}
+	querier := IBCQuerier(nil, MockChannelKeeper{}, router)
+
+	for clientID, expStatus := range map[string]string{
+		"07-tendermint-0": "Active",
+		"07-tendermint-1": "Frozen",
+		"07-tendermint-2": "Expired",
+		"07-tendermint-3": "Unknown",
+	} {
+		t.Run(clientID, func(t *testing.T) {
+			bz, err := querier(sdk.Context{}, nil, &wasmTypes.IBCQuery{ClientStatus: &wasmTypes.ClientStatusQuery{ClientID: clientID}})
+			require.NoError(t, err)
+
+			var res wasmTypes.ClientStatusResponse
+			require.NoError(t, json.Unmarshal(bz, &res))
+			require.Equal(t, expStatus, res.Status)
+		})
+	}
+
+	// without an ibc client module there's no route
+	noRoute := IBCQuerier(nil, MockChannelKeeper{}, MockQueryRouter{RouteFn: func(string) baseapp.GRPCQueryHandler { return nil }})
+	_, err := noRoute(sdk.Context{}, nil, &wasmTypes.IBCQuery{ClientStatus: &wasmTypes.ClientStatusQuery{ClientID: "07-tendermint-0"}})
+	require.IsType(t, wasmTypes.UnsupportedRequest{}, err)
+}
+
+func TestIBCQuerierPacketAcknowledgement(t *testing.T) {
+	ack := []byte("ack commitment")
+	channelKeeper := MockChannelKeeper{
+		GetPacketAcknowledgementFn: func(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool) {
+			if portID == "wasm.port" && channelID == "channel-0" && sequence == 1 {
+				return ack, true
+			}
+			return nil, false
+		},
+	}
+	querier := IBCQuerier(nil, channelKeeper, nil)
+
+	specs := map[string]struct {
+		query  wasmTypes.PacketAcknowledgementQuery
+		expAck []byte
+	}{
+		"acknowledged": {
+			query:  wasmTypes.PacketAcknowledgementQuery{PortID: "wasm.port", ChannelID: "channel-0", Sequence: 1},
+			expAck: ack,
+		},
+		"not acknowledged": {
+			query: wasmTypes.PacketAcknowledgementQuery{PortID: "wasm.port", ChannelID: "channel-0", Sequence: 2},
+		},
+		"other channel": {
+			query: wasmTypes.PacketAcknowledgementQuery{PortID: "wasm.port", ChannelID: "channel-1", Sequence: 1},
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			query := spec.query
+			bz, err := querier(sdk.Context{}, nil, &wasmTypes.IBCQuery{PacketAcknowledgement: &query})
+			require.NoError(t, err)
+
+			var res wasmTypes.PacketAcknowledgementResponse
+			require.NoError(t, json.Unmarshal(bz, &res))
+			require.Equal(t, spec.expAck, res.Acknowledgement)
+		})
+	}
+}
+
+type mockPriceSource map[string]sdk.Dec
+
+func (m mockPriceSource) GetPrice(_ sdk.Context, base, quote string) (sdk.Dec, time.Time, bool) {
+	rate, found := m[base+"/"+quote]
+	return rate, time.Unix(1700000000, 0), found
+}
+
+func TestOracleQuerier(t *testing.T) {
+	querier := OracleQuerier(mockPriceSource{"uscrt/uusd": sdk.MustNewDecFromStr("1.25")})
+
+	bz, err := querier(sdk.Context{}, &wasmTypes.OracleQuery{Price: &wasmTypes.OraclePriceQuery{Base: "uscrt", Quote: "uusd"}})
+	require.NoError(t, err)
+	var res wasmTypes.OraclePriceResponse
+	require.NoError(t, json.Unmarshal(bz, &res))
+	require.Equal(t, wasmTypes.OraclePriceResponse{Rate: "1.250000000000000000", LastUpdated: 1700000000}, res)
+
+	_, err = querier(sdk.Context{}, &wasmTypes.OracleQuery{Price: &wasmTypes.OraclePriceQuery{Base: "uusd", Quote: "uscrt"}})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = querier(sdk.Context{}, &wasmTypes.OracleQuery{})
+	require.IsType(t, wasmTypes.UnsupportedRequest{}, err)
+}
+
+func TestNoOracleQuerier(t *testing.T) {
+	_, err := NoOracleQuerier(sdk.Context{}, &wasmTypes.OracleQuery{Price: &wasmTypes.OraclePriceQuery{Base: "uscrt", Quote: "uusd"}})
+	require.Equal(t, wasmTypes.UnsupportedRequest{Kind: "oracle"}, err)
+}
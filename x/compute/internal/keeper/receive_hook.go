@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// receiveHookMsg is the plaintext message a contract is executed with when it receives coins
+// via a bank MsgSend. The enclave only accepts this exact message for bank receive hooks.
+var receiveHookMsg = []byte(`{"receive_native":{}}`)
+
+// SetContractReceiveHook registers or unregisters a contract for bank receive hooks.
+// Only the contract's admin or the contract itself may do that.
+func (k Keeper) SetContractReceiveHook(ctx sdk.Context, contractAddress, caller sdk.AccAddress, enabled bool) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if !caller.Equals(contractAddress) && contractInfo.Admin != caller.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "caller is not the admin or the contract")
+	}
+
+	k.setContractReceiveHook(ctx, contractAddress, enabled)
+	return nil
+}
+
+func (k Keeper) setContractReceiveHook(ctx sdk.Context, contractAddress sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetContractReceiveHookKey(contractAddress), []byte{1})
+	} else {
+		store.Delete(types.GetContractReceiveHookKey(contractAddress))
+	}
+}
+
+// HasContractReceiveHook returns true if the contract is registered for bank receive hooks
+func (k Keeper) HasContractReceiveHook(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetContractReceiveHookKey(contractAddress))
+}
+
+// isTxBankSend returns true if msg is one of the messages in the body of the current tx.
+// The enclave verifies receive hooks against the signed tx, so sends dispatched
+// by contracts or nested in other messages cannot trigger them.
+func (k Keeper) isTxBankSend(ctx sdk.Context, msg *banktypes.MsgSend) bool {
+	if len(ctx.TxBytes()) == 0 {
+		return false
+	}
+
+	var rawTx sdktx.TxRaw
+	if err := k.cdc.Unmarshal(ctx.TxBytes(), &rawTx); err != nil {
+		return false
+	}
+
+	// Decode the body without resolving the messages, only the bank sends are decoded below
+	var body sdktx.TxBody
+	if err := body.Unmarshal(rawTx.BodyBytes); err != nil {
+		return false
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	for _, anyMsg := range body.Messages {
+		if anyMsg.TypeUrl != typeURL {
+			continue
+		}
+		var txMsg banktypes.MsgSend
+		if err := txMsg.Unmarshal(anyMsg.Value); err != nil {
+			continue
+		}
+		if isSameBankSend(&txMsg, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSameBankSend returns true if both sends have the same sender, recipient and amount
+func isSameBankSend(a, b *banktypes.MsgSend) bool {
+	if a.FromAddress != b.FromAddress || a.ToAddress != b.ToAddress || len(a.Amount) != len(b.Amount) {
+		return false
+	}
+	// the amounts are sorted, ValidateBasic rejects unsorted coins
+	for i := range a.Amount {
+		if a.Amount[i].Denom != b.Amount[i].Denom || !a.Amount[i].Amount.Equal(b.Amount[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// executeReceiveHook calls the receive hook of a contract that was sent coins by a bank MsgSend.
+// The coins were already transferred by x/bank. The hook may not spend more than ReceiveHookGasLimit.
+func (k Keeper) executeReceiveHook(ctx sdk.Context, contractAddress, sender sdk.AccAddress, coins sdk.Coins) (err error) {
+	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "receive-hook")
+
+	limitedMeter := sdk.NewGasMeter(types.ReceiveHookGasLimit)
+	subCtx := ctx.WithGasMeter(limitedMeter)
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(types.ReceiveHookGasLimit, "Receive hook OutOfGas panic")
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "receive hook hit gas limit")
+		}
+	}()
+
+	_, err = k.Execute(subCtx, contractAddress, sender, receiveHookMsg, coins, nil, wasmTypes.HandleTypeBankReceive)
+
+	// make sure we charge the parent what was spent
+	ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "From receive hook")
+
+	return err
+}
+
+var _ banktypes.MsgServer = bankMsgServer{}
+
+// bankMsgServer wraps the x/bank MsgServer to call the receive hooks of contracts
+type bankMsgServer struct {
+	banktypes.MsgServer
+	keeper Keeper
+}
+
+// BankMsgServer wraps the given bank MsgServer to call the receive hook of registered
+// contracts after they receive coins from a MsgSend of the tx.
+// A failing hook fails the send.
+func (k Keeper) BankMsgServer(inner banktypes.MsgServer) banktypes.MsgServer {
+	return bankMsgServer{MsgServer: inner, keeper: k}
+}
+
+func (s bankMsgServer) Send(goCtx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error) {
+	res, err := s.MsgServer.Send(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !s.keeper.HasContractReceiveHook(ctx, to) || !s.keeper.isTxBankSend(ctx, msg) {
+		return res, nil
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.executeReceiveHook(ctx, to, from, msg.Amount); err != nil {
+		return nil, sdkerrors.Wrap(err, "contract receive hook")
+	}
+
+	return res, nil
+}
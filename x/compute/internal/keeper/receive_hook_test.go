@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// withBankSendTx sets the tx of ctx to a tx with the given bank sends, signed by sender
+func withBankSendTx(t *testing.T, keeper Keeper, ctx sdk.Context, sender sdk.AccAddress, privKey crypto.PrivKey, sends ...*banktypes.MsgSend) sdk.Context {
+	senderAcc, err := ante.GetSignerAcc(ctx, keeper.accountKeeper, sender)
+	require.NoError(t, err)
+
+	var msgs []sdk.Msg
+	var accs []authtypes.AccountI
+	var privKeys []crypto.PrivKey
+	for _, send := range sends {
+		msgs = append(msgs, send)
+		accs = append(accs, senderAcc)
+		privKeys = append(privKeys, privKey)
+	}
+	txBytes, err := NewTestTxMultiple(msgs, accs, privKeys).Marshal()
+	require.NoError(t, err)
+
+	return types.WithTXCounter(ctx.WithTxBytes(txBytes), 1)
+}
+
+func TestSetContractReceiveHook(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	// only the admin or the contract itself may register the hook
+	err := keeper.SetContractReceiveHook(ctx, contractAddress, walletB, true)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.False(t, keeper.HasContractReceiveHook(ctx, contractAddress))
+
+	require.NoError(t, keeper.SetContractReceiveHook(ctx, contractAddress, walletA, true))
+	require.True(t, keeper.HasContractReceiveHook(ctx, contractAddress))
+
+	require.NoError(t, keeper.SetContractReceiveHook(ctx, contractAddress, contractAddress, false))
+	require.False(t, keeper.HasContractReceiveHook(ctx, contractAddress))
+
+	err = keeper.SetContractReceiveHook(ctx, walletB, walletB, true)
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestIsTxBankSend(t *testing.T) {
+	ctx, keeper, walletA, privKeyA, walletB, _ := setupBasicTest(t, sdk.NewCoins())
+
+	send := banktypes.NewMsgSend(walletA, walletB, sdk.NewCoins(sdk.NewInt64Coin("denom", 10), sdk.NewInt64Coin("stake", 5)))
+	other := banktypes.NewMsgSend(walletA, walletA, sdk.NewCoins(sdk.NewInt64Coin("denom", 1)))
+
+	// there's no tx outside of DeliverTx
+	require.False(t, keeper.isTxBankSend(ctx, send))
+
+	ctx = withBankSendTx(t, keeper, ctx, walletA, privKeyA, other, send)
+	require.True(t, keeper.isTxBankSend(ctx, send))
+	require.True(t, keeper.isTxBankSend(ctx, other))
+
+	for name, msg := range map[string]*banktypes.MsgSend{
+		"other sender":    banktypes.NewMsgSend(walletB, walletB, send.Amount),
+		"other recipient": banktypes.NewMsgSend(walletA, walletA, send.Amount),
+		"other amount":    banktypes.NewMsgSend(walletA, walletB, sdk.NewCoins(sdk.NewInt64Coin("denom", 10))),
+		"other denom":     banktypes.NewMsgSend(walletA, walletB, sdk.NewCoins(sdk.NewInt64Coin("denom", 10), sdk.NewInt64Coin("uscrt", 5))),
+	} {
+		require.False(t, keeper.isTxBankSend(ctx, msg), name)
+	}
+}
+
+func TestBankReceiveHook(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+	bankServer := keeper.BankMsgServer(bankkeeper.NewMsgServerImpl(keeper.bankKeeper))
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	send := banktypes.NewMsgSend(walletB, contractAddress, sdk.NewCoins(sdk.NewInt64Coin("denom", 10)))
+
+	// contracts without the hook just receive the coins
+	_, err := bankServer.Send(sdk.WrapSDKContext(withBankSendTx(t, keeper, ctx, walletB, privKeyB, send)), send)
+	require.NoError(t, err)
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+
+	require.NoError(t, keeper.SetContractReceiveHook(ctx, contractAddress, walletA, true))
+
+	_, err = bankServer.Send(sdk.WrapSDKContext(withBankSendTx(t, keeper, ctx, walletB, privKeyB, send)), send)
+	require.NoError(t, err)
+	requireCounter(t, keeper, ctx, contractAddress, 11)
+	require.Equal(t, sdk.NewInt(20), keeper.bankKeeper.GetBalance(ctx, contractAddress, "denom").Amount)
+
+	// a send that isn't a msg of the tx, e.g. dispatched by a contract, doesn't call the hook
+	otherTxCtx := withBankSendTx(t, keeper, ctx, walletA, privKeyA, banktypes.NewMsgSend(walletA, contractAddress, send.Amount))
+	_, err = bankServer.Send(sdk.WrapSDKContext(otherTxCtx), send)
+	require.NoError(t, err)
+	requireCounter(t, keeper, ctx, contractAddress, 11)
+}
+
+func TestBankReceiveHookFailure(t *testing.T) {
+	// the v0.10 test contract doesn't handle the receive hook msg
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v010Contract], sdk.NewCoins())
+	bankServer := keeper.BankMsgServer(bankkeeper.NewMsgServerImpl(keeper.bankKeeper))
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"nop":{}}`, true, false, defaultGasForTests)
+	require.Empty(t, initErr)
+	require.NoError(t, keeper.SetContractReceiveHook(ctx, contractAddress, walletA, true))
+
+	// a failing hook fails the send, and the tx with it
+	send := banktypes.NewMsgSend(walletB, contractAddress, sdk.NewCoins(sdk.NewInt64Coin("denom", 10)))
+	cacheCtx, _ := withBankSendTx(t, keeper, ctx, walletB, privKeyB, send).CacheContext()
+	_, err := bankServer.Send(sdk.WrapSDKContext(cacheCtx), send)
+	require.ErrorContains(t, err, "contract receive hook")
+	require.True(t, keeper.bankKeeper.GetAllBalances(ctx, contractAddress).IsZero())
+}
+
+func TestReceiveHookGenesis(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	require.NoError(t, keeper.SetContractReceiveHook(ctx, contractAddress, walletA, true))
+
+	genState := ExportGenesis(ctx, keeper)
+	require.Len(t, genState.Contracts, 1)
+	require.True(t, genState.Contracts[0].ReceiveHook)
+
+	newCtx, newKeeper, _, _, _, _ := setupBasicTest(t, sdk.NewCoins())
+	require.NoError(t, InitGenesis(newCtx, newKeeper, *genState))
+	require.True(t, newKeeper.HasContractReceiveHook(newCtx, contractAddress))
+}
@@ -27,7 +27,10 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 		return nil, err
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
@@ -48,16 +51,20 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 		contractKey,
 		random,
 	)
+	env.KeyEpoch = k.keyEpoch(ctx)
+	env.MaxMemoryPages = k.GetParams(ctx).MaxMemoryPages
 
 	// prepare querier
 	querier := QueryHandler{
-		Ctx:     ctx,
-		Plugins: k.queryPlugins,
+		Ctx:      ctx,
+		Plugins:  k.queryPlugins,
+		GasCosts: k.GetParams(ctx).QueryPluginGasCosts,
 	}
 
 	gas := gasForContract(ctx)
-	res, gasUsed, err := k.wasmer.Execute(codeInfo.CodeHash, env, msgBz, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
-	consumeGas(ctx, gasUsed)
+	k.recordCodeUsage(codeInfo.CodeHash)
+	res, gasUsed, err := k.runtime(ctx).Execute(codeInfo.CodeHash, env, msgBz, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
+	k.recordGasUsage(ctx, contractAddress, consumeGas(ctx, gasUsed))
 
 	return res, err
 }
@@ -222,7 +229,10 @@ func (k Keeper) OnRecvPacket(
 			if err != nil {
 				return nil, err
 			}
-			sigInfo := types.NewSigInfo([]byte{}, []byte{}, sdktxsigning.SignMode_SIGN_MODE_DIRECT, []byte{}, []byte{}, []byte{}, nil)
+			sigInfo, err := types.NewSigInfo([]byte{}, []byte{}, sdktxsigning.SignMode_SIGN_MODE_DIRECT, []byte{}, []byte{}, []byte{}, nil)
+			if err != nil {
+				return nil, err
+			}
 
 			ogTx := msg.Packet.Data
 
@@ -327,8 +337,11 @@ func (k Keeper) OnTimeoutPacket(
 }
 
 func (k Keeper) handleIBCBasicContractResponse(ctx sdk.Context, addr sdk.AccAddress, ibcPortID string, inputMsg []byte, res *v1types.IBCBasicResponse) error {
-	sigInfo := types.NewSigInfo([]byte{}, []byte{}, sdktxsigning.SignMode_SIGN_MODE_DIRECT, []byte{}, []byte{}, []byte{}, nil)
+	sigInfo, err := types.NewSigInfo([]byte{}, []byte{}, sdktxsigning.SignMode_SIGN_MODE_DIRECT, []byte{}, []byte{}, []byte{}, nil)
+	if err != nil {
+		return err
+	}
 
-	_, err := k.handleContractResponse(ctx, addr, ibcPortID, res.Messages, res.Attributes, res.Events, nil, inputMsg, sigInfo)
+	_, err = k.handleContractResponse(ctx, addr, ibcPortID, res.Messages, res.Attributes, res.Events, nil, inputMsg, sigInfo)
 	return err
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	wasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// WasmEngine is a wasm runtime, with its enclave, that contracts run in
+type WasmEngine interface {
+	Create(code wasm.WasmCode) (wasm.CodeHash, error)
+	GetCode(code wasm.CodeHash) (wasm.WasmCode, error)
+	Instantiate(codeID wasm.CodeHash, env wasmTypes.Env, initMsg []byte, store wasm.KVStore, goapi wasm.GoAPI, querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64, sigInfo wasmTypes.SigInfo, admin []byte) (interface{}, []byte, []byte, uint64, error)
+	Execute(code wasm.CodeHash, env wasmTypes.Env, executeMsg []byte, store wasm.KVStore, goapi wasm.GoAPI, querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64, sigInfo wasmTypes.SigInfo, handleType wasmTypes.HandleType) (interface{}, uint64, error)
+	Query(code wasm.CodeHash, env wasmTypes.Env, queryMsg []byte, store wasm.KVStore, goapi wasm.GoAPI, querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64) ([]byte, uint64, error)
+	AnalyzeCode(codeHash []byte) (*v1wasmTypes.AnalysisReport, error)
+	Migrate(newCodeID wasm.CodeHash, env wasmTypes.Env, migrateMsg []byte, store wasm.KVStore, goapi wasm.GoAPI, querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64, sigInfo wasmTypes.SigInfo, admin []byte, adminProof []byte) (interface{}, []byte, []byte, uint64, error)
+	UpdateAdmin(codeID wasm.CodeHash, env wasmTypes.Env, store wasm.KVStore, goapi wasm.GoAPI, querier wasm.Querier, gasMeter wasm.GasMeter, gasLimit uint64, sigInfo wasmTypes.SigInfo, currentAdmin []byte, currentAdminProof []byte, newAdmin []byte) ([]byte, error)
+}
+
+var _ WasmEngine = (*wasm.Wasmer)(nil)
+
+// runtimeUpgrade is a wasm runtime that replaces the previous one from the height its upgrade plan is applied at
+type runtimeUpgrade struct {
+	planName string
+	engine   WasmEngine
+}
+
+// RegisterRuntimeUpgrade registers a wasm runtime that contracts run in from the height the upgrade plan
+// planName is applied at, as recorded by the upgrade module. Until then, the previous runtime is used, so
+// validators can install a binary with both runtimes ahead of the upgrade height.
+// The app must register an upgrade handler for the plan, or the chain halts at its height.
+// Must be called before the keeper is copied into the module, as the keeper is passed around by value.
+func (k *Keeper) RegisterRuntimeUpgrade(upgradeKeeper types.UpgradeKeeper, planName string, engine WasmEngine) *Keeper {
+	for _, upgrade := range k.runtimeUpgrades {
+		if upgrade.planName == planName {
+			panic("cannot register the runtime of an upgrade plan twice")
+		}
+	}
+
+	k.upgradeKeeper = upgradeKeeper
+	k.runtimeUpgrades = append(k.runtimeUpgrades, runtimeUpgrade{planName: planName, engine: engine})
+	return k
+}
+
+// runtime returns the wasm runtime active at the height of ctx: the last registered runtime whose upgrade
+// plan was applied at or before that height, or the original runtime.
+func (k Keeper) runtime(ctx sdk.Context) WasmEngine {
+	for i := len(k.runtimeUpgrades) - 1; i >= 0; i-- {
+		upgrade := k.runtimeUpgrades[i]
+		if doneHeight := k.upgradeKeeper.GetDoneHeight(ctx, upgrade.planName); doneHeight > 0 && ctx.BlockHeight() >= doneHeight {
+			return upgrade.engine
+		}
+	}
+	return k.wasmer
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// ScheduleExecute escrows the sent funds and the fee of a contract call and schedules it
+// for the end of executeHeight. The fee must be paid in the bond denom, at least at the
+// ScheduledCallGasPrice for the whole gas limit. It returns the id of the scheduled call.
+func (k Keeper) ScheduleExecute(ctx sdk.Context, sender, contractAddress sdk.AccAddress, msg []byte, sentFunds sdk.Coins, executeHeight int64, gasLimit uint64, fee sdk.Coin) (uint64, error) {
+	if executeHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "execute height %d is not in the future", executeHeight)
+	}
+	if k.GetContractInfo(ctx, contractAddress) == nil {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	params := k.GetParams(ctx)
+	if err := params.ValidateDeposit(sentFunds); err != nil {
+		return 0, err
+	}
+	minFee := params.MinScheduledCallFee(gasLimit, k.stakingKeeper.BondDenom(ctx))
+	if fee.Denom != minFee.Denom || fee.IsLT(minFee) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "a gas limit of %d requires a fee of at least %s", gasLimit, minFee)
+	}
+
+	escrow := sentFunds.Add(fee)
+	if err := k.checkSpendable(ctx, sender, escrow); err != nil {
+		return 0, sdkerrors.Wrap(err, "escrow")
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, escrow); err != nil {
+		return 0, sdkerrors.Wrap(err, "escrow")
+	}
+
+	call := types.ScheduledCall{
+		ID:            k.autoIncrementID(ctx, types.KeyLastScheduledCallID),
+		Sender:        sender.String(),
+		Contract:      contractAddress.String(),
+		Msg:           msg,
+		SentFunds:     sentFunds,
+		ExecuteHeight: executeHeight,
+		GasLimit:      gasLimit,
+		Fee:           fee,
+	}
+	k.setScheduledCall(ctx, call)
+
+	return call.ID, nil
+}
+
+// CancelScheduledExecute removes a scheduled call and refunds its escrow.
+// Only the account that scheduled the call may cancel it.
+func (k Keeper) CancelScheduledExecute(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	call, found := k.GetScheduledCall(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "scheduled call %d", id)
+	}
+	if call.Sender != sender.String() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the sender can cancel a scheduled call")
+	}
+
+	k.deleteScheduledCall(ctx, call)
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, call.SentFunds.Add(call.Fee))
+}
+
+// GetScheduledCall returns the scheduled call with the given id
+func (k Keeper) GetScheduledCall(ctx sdk.Context, id uint64) (types.ScheduledCall, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	heightBz := store.Get(types.GetScheduledCallHeightKey(id))
+	if heightBz == nil {
+		return types.ScheduledCall{}, false
+	}
+
+	var call types.ScheduledCall
+	k.cdc.MustUnmarshal(store.Get(types.GetScheduledCallKey(int64(sdk.BigEndianToUint64(heightBz)), id)), &call)
+	return call, true
+}
+
+// IterateScheduledCalls iterates over all scheduled calls, by execution height then id,
+// until cb returns true
+func (k Keeper) IterateScheduledCalls(ctx sdk.Context, cb func(types.ScheduledCall) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledCallPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(iter.Value(), &call)
+		if cb(call) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setScheduledCall(ctx sdk.Context, call types.ScheduledCall) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetScheduledCallKey(call.ExecuteHeight, call.ID), k.cdc.MustMarshal(&call))
+	store.Set(types.GetScheduledCallHeightKey(call.ID), sdk.Uint64ToBigEndian(uint64(call.ExecuteHeight)))
+}
+
+func (k Keeper) deleteScheduledCall(ctx sdk.Context, call types.ScheduledCall) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetScheduledCallKey(call.ExecuteHeight, call.ID))
+	store.Delete(types.GetScheduledCallHeightKey(call.ID))
+}
+
+// scheduledGasBudget is the gas that the scheduled calls and the crons of a block may reserve together
+type scheduledGasBudget struct {
+	limit    uint64
+	reserved uint64
+	used     bool
+}
+
+func newScheduledGasBudget(params types.Params) *scheduledGasBudget {
+	return &scheduledGasBudget{limit: params.ScheduledGasPerBlock()}
+}
+
+// reserve reserves gas if it fits in the budget. The first reservation of a block always succeeds,
+// so that a call or a cron can't be stuck behind a lowered budget.
+func (b *scheduledGasBudget) reserve(gas uint64) bool {
+	if b.used && b.reserved+gas > b.limit {
+		return false
+	}
+	b.reserved += gas
+	b.used = true
+	return true
+}
+
+// ExecuteScheduled runs the scheduled calls and then the crons that are due at the current height,
+// which reserve at most MaxScheduledGasPerBlock gas together
+func (k Keeper) ExecuteScheduled(ctx sdk.Context) {
+	budget := newScheduledGasBudget(k.GetParams(ctx))
+	k.executeScheduledCalls(ctx, budget)
+	k.executeCrons(ctx, budget)
+}
+
+// ExecuteScheduledCalls runs the calls that are due at the current height, oldest first.
+// At most MaxScheduledCallsPerBlock calls, reserving at most MaxScheduledGasPerBlock gas in
+// total, are executed, the rest are left for the next blocks.
+func (k Keeper) ExecuteScheduledCalls(ctx sdk.Context) {
+	k.executeScheduledCalls(ctx, newScheduledGasBudget(k.GetParams(ctx)))
+}
+
+func (k Keeper) executeScheduledCalls(ctx sdk.Context, budget *scheduledGasBudget) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ScheduledCallPrefix, types.GetScheduledCallHeightPrefix(ctx.BlockHeight()+1))
+
+	var due []types.ScheduledCall
+	for ; iter.Valid() && len(due) < types.MaxScheduledCallsPerBlock; iter.Next() {
+		var call types.ScheduledCall
+		k.cdc.MustUnmarshal(iter.Value(), &call)
+		if !budget.reserve(call.GasLimit) {
+			break
+		}
+		due = append(due, call)
+	}
+	iter.Close()
+
+	for _, call := range due {
+		k.deleteScheduledCall(ctx, call)
+
+		err := k.executeScheduledCall(ctx, call)
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyScheduledCallID, strconv.FormatUint(call.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, call.Contract),
+		}
+		if err != nil {
+			ctx.Logger().Info("scheduled call failed", "id", call.ID, "contract", call.Contract, "error", err)
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeScheduledExecute, attributes...))
+	}
+}
+
+// executeScheduledCall pays the fee of a call and executes it with at most its gas limit.
+// If the execution fails, its state changes are discarded and the sent funds are refunded.
+func (k Keeper) executeScheduledCall(ctx sdk.Context, call types.ScheduledCall) (err error) {
+	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "scheduled-execute")
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(call.Fee)); err != nil {
+		return sdkerrors.Wrap(err, "fee")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(call.Sender)
+	if err != nil {
+		return err
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(call.Contract)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+		if refundErr := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, call.SentFunds); refundErr != nil {
+			err = sdkerrors.Wrap(refundErr, "refund")
+		}
+	}()
+
+	return k.runWithGasLimit(ctx, call.GasLimit, func(ctx sdk.Context) error {
+		// release the sent funds from escrow. The enclave can't verify them, so the contract
+		// gets them like a bank send and not in the env of the call.
+		if !call.SentFunds.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, call.SentFunds); err != nil {
+				return err
+			}
+		}
+
+		_, err := k.Execute(ctx, contractAddress, types.ZeroSender, call.Msg, sdk.NewCoins(), nil, wasmTypes.HandleTypeScheduledExecute)
+		return err
+	})
+}
+
+// runWithGasLimit runs fn in a cache context with at most gasLimit gas. The state changes and
+// events of fn are kept only if it succeeds.
+func (k Keeper) runWithGasLimit(ctx sdk.Context, gasLimit uint64, fn func(ctx sdk.Context) error) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	// catch out of gas panic, the caller already paid for the gas
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, fmt.Sprintf("hit gas limit %d", gasLimit))
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
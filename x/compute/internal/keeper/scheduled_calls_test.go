@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// scheduledCallFee is the least fee of a call with a gas limit of 1M at the default gas price
+var scheduledCallFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000)
+
+func setupScheduledCallTest(t *testing.T) (sdk.Context, Keeper, sdk.AccAddress, sdk.AccAddress, sdk.AccAddress) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+
+	_, _, contractAddress, _, err := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, err)
+
+	return ctx, keeper, contractAddress, walletA, walletB
+}
+
+func requireCounter(t *testing.T, keeper Keeper, ctx sdk.Context, contractAddress sdk.AccAddress, count uint32) {
+	queryRes, qErr := queryHelper(t, keeper, ctx, contractAddress, `{"get":{}}`, true, true, math.MaxUint64)
+	require.Empty(t, qErr)
+
+	var resp v1QueryResponse
+	require.NoError(t, json.Unmarshal([]byte(queryRes), &resp))
+	require.Equal(t, count, resp.Get.Count)
+}
+
+// scheduledCallErrors returns the error attribute of each scheduled call event, by call id
+func scheduledCallErrors(ctx sdk.Context) map[string]string {
+	return executionErrors(ctx, types.EventTypeScheduledExecute, types.AttributeKeyScheduledCallID)
+}
+
+// executionErrors returns the error attribute of each event of eventType, by the id in idKey
+func executionErrors(ctx sdk.Context, eventType string, idKey string) map[string]string {
+	errors := map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		var id, errMsg string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case idKey:
+				id = string(attr.Value)
+			case types.AttributeKeyError:
+				errMsg = string(attr.Value)
+			}
+		}
+		errors[id] = errMsg
+	}
+	return errors
+}
+
+func TestScheduleExecuteMinFee(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupScheduledCallTest(t)
+	msg := []byte(`{"increment":{"addition":1}}`)
+
+	_, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, msg, nil, ctx.BlockHeight()+1, 1_000_000, scheduledCallFee.SubAmount(sdk.OneInt()))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	_, err = keeper.ScheduleExecute(ctx, walletA, contractAddress, msg, nil, ctx.BlockHeight()+1, 1_000_000, sdk.NewInt64Coin("denom", 1_000_000))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// a higher gas price raises the min fee
+	params := keeper.GetParams(ctx)
+	params.ScheduledCallGasPrice = sdk.NewDec(1)
+	keeper.SetParams(ctx, params)
+	_, err = keeper.ScheduleExecute(ctx, walletA, contractAddress, msg, nil, ctx.BlockHeight()+1, 1_000_000, scheduledCallFee)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	id, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, msg, nil, ctx.BlockHeight()+1, 100_000, scheduledCallFee)
+	require.NoError(t, err)
+	_, found := keeper.GetScheduledCall(ctx, id)
+	require.True(t, found)
+}
+
+func TestExecuteScheduledCalls(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupScheduledCallTest(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := keeper.bankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	balanceBefore := keeper.bankKeeper.GetAllBalances(ctx, walletA)
+
+	sentFunds := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	id, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), sentFunds, ctx.BlockHeight()+1, 1_000_000, scheduledCallFee)
+	require.NoError(t, err)
+
+	// the sent funds and the fee are escrowed
+	require.Equal(t, balanceBefore.Sub(sentFunds.Add(scheduledCallFee)), keeper.bankKeeper.GetAllBalances(ctx, walletA))
+
+	// the call isn't due yet
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduledCalls(ctx)
+	require.Empty(t, scheduledCallErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduledCalls(ctx)
+	require.Equal(t, map[string]string{"1": ""}, scheduledCallErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 11)
+
+	// the contract got the sent funds and the fee collector the fee
+	require.Equal(t, sentFunds, keeper.bankKeeper.GetAllBalances(ctx, contractAddress))
+	require.Equal(t, feesBefore.Add(scheduledCallFee), keeper.bankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+
+	// the call ran once
+	_, found := keeper.GetScheduledCall(ctx, id)
+	require.False(t, found)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	keeper.ExecuteScheduledCalls(ctx)
+	requireCounter(t, keeper, ctx, contractAddress, 11)
+}
+
+func TestExecuteScheduledCallsRefund(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupScheduledCallTest(t)
+	balanceBefore := keeper.bankKeeper.GetAllBalances(ctx, walletA)
+	sentFunds := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+
+	// a msg the contract fails on, and a call that runs out of gas
+	_, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, []byte(`{"unknown":{}}`), sentFunds, ctx.BlockHeight()+1, 1_000_000, scheduledCallFee)
+	require.NoError(t, err)
+	_, err = keeper.ScheduleExecute(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), sentFunds, ctx.BlockHeight()+1, 1_000, scheduledCallFee)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduledCalls(ctx)
+
+	errors := scheduledCallErrors(ctx)
+	require.Len(t, errors, 2)
+	require.NotEmpty(t, errors["1"])
+	require.Contains(t, errors["2"], "hit gas limit 1000")
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+
+	// the sent funds are refunded, the fees are not
+	require.True(t, keeper.bankKeeper.GetAllBalances(ctx, contractAddress).IsZero())
+	require.Equal(t, balanceBefore.Sub(sdk.NewCoins(scheduledCallFee, scheduledCallFee)), keeper.bankKeeper.GetAllBalances(ctx, walletA))
+}
+
+func TestExecuteScheduledCallsGasPerBlock(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, _ := setupScheduledCallTest(t)
+
+	params := keeper.GetParams(ctx)
+	params.MaxScheduledGasPerBlock = types.MaxScheduledCallGasLimit
+	keeper.SetParams(ctx, params)
+
+	fee := params.MinScheduledCallFee(6_000_000, sdk.DefaultBondDenom)
+	for i := 0; i < 3; i++ {
+		_, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), nil, ctx.BlockHeight()+1, 6_000_000, fee)
+		require.NoError(t, err)
+	}
+
+	// the calls don't fit in one block together, they run one per block in order
+	for i, expectedID := range []string{"1", "2", "3"} {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		keeper.ExecuteScheduledCalls(ctx)
+		require.Equal(t, map[string]string{expectedID: ""}, scheduledCallErrors(ctx))
+		requireCounter(t, keeper, ctx, contractAddress, uint32(11+i))
+	}
+}
+
+func TestCancelScheduledExecute(t *testing.T) {
+	ctx, keeper, contractAddress, walletA, walletB := setupScheduledCallTest(t)
+	balanceBefore := keeper.bankKeeper.GetAllBalances(ctx, walletA)
+
+	id, err := keeper.ScheduleExecute(ctx, walletA, contractAddress, []byte(`{"increment":{"addition":1}}`), sdk.NewCoins(sdk.NewInt64Coin("denom", 10)), ctx.BlockHeight()+1, 1_000_000, scheduledCallFee)
+	require.NoError(t, err)
+
+	err = keeper.CancelScheduledExecute(ctx, walletB, id)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, keeper.CancelScheduledExecute(ctx, walletA, id))
+	require.Equal(t, balanceBefore, keeper.bankKeeper.GetAllBalances(ctx, walletA))
+
+	err = keeper.CancelScheduledExecute(ctx, walletA, id)
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// a cancelled call doesn't run
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	keeper.ExecuteScheduledCalls(ctx)
+	require.Empty(t, scheduledCallErrors(ctx))
+	requireCounter(t, keeper, ctx, contractAddress, 10)
+}
@@ -8264,3 +8264,46 @@ func TestContractInitsContractWithAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestContractKeyHistoryAfterMigrate(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[migrateContractV1], sdk.NewCoins())
+
+	newCodeId, _ := uploadCode(ctx, t, keeper, TestContractPaths[migrateContractV2], walletA)
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx.WithBlockHeight(10), codeID, walletA, walletA, privKeyA, `{"Nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+	ogKey, err := keeper.GetContractKey(ctx, contractAddress)
+	require.NoError(t, err)
+
+	_, migrateErr := migrateHelper(t, keeper, ctx.WithBlockHeight(20), newCodeId, contractAddress, walletA, privKeyA, `{"migrate":{}}`, true, true, math.MaxUint64)
+	require.Empty(t, migrateErr)
+	migratedKey, err := keeper.GetContractKey(ctx, contractAddress)
+	require.NoError(t, err)
+	require.NotEmpty(t, migratedKey.CurrentContractKey)
+	require.NotEqual(t, ogKey, migratedKey)
+
+	requireKeyHistory := func(ctx sdk.Context, keeper Keeper) {
+		// the og key isn't known before the first recorded key, as the contract was re-keyed since
+		_, err := keeper.GetContractKeyAtHeight(ctx, contractAddress, 9)
+		require.ErrorIs(t, err, types.ErrNotFound)
+
+		for height, expKey := range map[int64]types.ContractKey{10: ogKey, 19: ogKey, 20: migratedKey, 30: migratedKey} {
+			key, err := keeper.GetContractKeyAtHeight(ctx, contractAddress, height)
+			require.NoError(t, err)
+			require.Equal(t, expKey, key, "height %d", height)
+		}
+	}
+	requireKeyHistory(ctx, keeper)
+
+	genState := ExportGenesis(ctx, keeper)
+	require.Len(t, genState.Contracts, 1)
+	require.Equal(t, []types.ContractKeyHistoryEntry{{Height: 10, Key: ogKey}, {Height: 20, Key: migratedKey}}, genState.Contracts[0].KeyHistory)
+	require.NoError(t, genState.ValidateBasic())
+
+	// the imported history replaces the one importing the contract starts at the import height
+	newCtx, newKeeper, _, _, _, _ := setupBasicTest(t, sdk.NewCoins())
+	newCtx = newCtx.WithBlockHeight(40)
+	require.NoError(t, InitGenesis(newCtx, newKeeper, *genState))
+	requireKeyHistory(newCtx, newKeeper)
+	require.Equal(t, genState.Contracts[0].KeyHistory, ExportGenesis(newCtx, newKeeper).Contracts[0].KeyHistory)
+}
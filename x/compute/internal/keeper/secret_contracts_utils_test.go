@@ -943,7 +943,10 @@ func fakeUpdateContractAdmin(ctx sdk.Context,
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	if err != nil {
+		return err
+	}
 
 	contractKey, err := k.GetContractKey(ctx, contractAddress)
 	if err != nil {
@@ -1039,7 +1042,10 @@ func fakeMigrate(ctx sdk.Context,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
-	sigInfo := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	sigInfo, err := types.NewSigInfo(ctx.TxBytes(), signBytes, signMode, modeInfoBytes, pkBytes, signerSig, nil)
+	if err != nil {
+		return nil, err
+	}
 
 	contractInfo, _, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
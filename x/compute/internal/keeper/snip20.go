@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// snip20DepositMsg is the SNIP-20 message that wraps the sent coins
+var snip20DepositMsg = []byte(`{"deposit":{}}`)
+
+// snip20RedeemMsg is the SNIP-20 message that unwraps tokens back into native coins
+type snip20RedeemMsg struct {
+	Redeem struct {
+		Amount string `json:"amount"`
+		Denom  string `json:"denom"`
+	} `json:"redeem"`
+}
+
+// snip20Wrapper returns the address of the registered SNIP-20 wrapper of a denom
+func (k Keeper) snip20Wrapper(ctx sdk.Context, denom string) (sdk.AccAddress, error) {
+	wrapper, ok := k.GetParams(ctx).Snip20WrapperByDenom(denom)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "no snip20 wrapper for denom %s", denom)
+	}
+	return sdk.AccAddressFromBech32(wrapper.ContractAddress)
+}
+
+// WrapCoin deposits native coins into their registered SNIP-20 wrapper contract on behalf of the sender
+func (k Keeper) WrapCoin(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (*sdk.Result, error) {
+	contractAddress, err := k.snip20Wrapper(ctx, coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.Execute(ctx, contractAddress, sender, snip20DepositMsg, sdk.NewCoins(coin), nil, wasmTypes.HandleTypeSnip20Conversion)
+}
+
+// UnwrapCoin redeems native coins from their registered SNIP-20 wrapper contract on behalf of the sender
+func (k Keeper) UnwrapCoin(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (*sdk.Result, error) {
+	contractAddress, err := k.snip20Wrapper(ctx, coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	var redeemMsg snip20RedeemMsg
+	redeemMsg.Redeem.Amount = coin.Amount.String()
+	redeemMsg.Redeem.Denom = coin.Denom
+	msg, err := json.Marshal(redeemMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.Execute(ctx, contractAddress, sender, msg, sdk.NewCoins(), nil, wasmTypes.HandleTypeSnip20Conversion)
+}
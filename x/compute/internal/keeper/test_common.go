@@ -346,6 +346,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(wasmtypes.ModuleName)
 
 	// this is also used to initialize module accounts (so nil is meaningful here)
 	maccPerms := map[string][]string{
@@ -365,6 +366,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		authtypes.ProtoBaseAccount, // prototype
 		maccPerms,
 	)
+	authKeeper.SetParams(ctx, authtypes.DefaultParams())
 	blockedAddrs := make(map[string]bool)
 	for acc := range maccPerms {
 		allowReceivingFunds := acc != distrtypes.ModuleName
@@ -545,10 +547,12 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 
 	bappTxMngr := baseapp.LastMsgMarkerContainer{}
 
+	computeSubsp, _ := paramsKeeper.GetSubspace(wasmtypes.ModuleName)
 	keeper := NewKeeper(
 		encodingConfig.Marshaler,
 		*encodingConfig.Amino,
 		keys[wasmtypes.StoreKey],
+		computeSubsp,
 		authKeeper,
 		bankKeeper,
 		govKeeper,
@@ -571,7 +575,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		queriers,
 		&bappTxMngr,
 	)
-	// keeper.setParams(ctx, wasmtypes.DefaultParams())
+	keeper.SetParams(ctx, wasmtypes.DefaultParams())
 	// add wasm handler so we can loop-back (contracts calling contracts)
 	router.AddRoute(sdk.NewRoute(wasmtypes.RouterKey, TestHandler(keeper)))
 
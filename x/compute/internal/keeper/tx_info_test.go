@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	sdksigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cosmwasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// NewAppAnteHandler builds the ante handler of the app. It is set by the external test package, see app_ante_test.go.
+var NewAppAnteHandler func(options ante.HandlerOptions, txCounterStoreKey sdk.StoreKey) (sdk.AnteHandler, error)
+
+const testTxGasLimit = 100_000_000
+
+var testSignModes = []sdksigning.SignMode{
+	sdksigning.SignMode_SIGN_MODE_DIRECT,
+	sdksigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+}
+
+// newSignedTx builds a tx with msgs, signed in signMode by each of the signers of the msgs. The signers in
+// withoutPubKey leave their public key out of the tx, so it is taken from their account.
+func newSignedTx(
+	t *testing.T, ctx sdk.Context, keeper Keeper, signMode sdksigning.SignMode, msgs []sdk.Msg, privKeys []crypto.PrivKey, withoutPubKey ...sdk.AccAddress,
+) *sdktx.Tx {
+	txConfig := authtx.NewTxConfig(nil, authtx.DefaultSignModes)
+	builder := txConfig.NewTxBuilder()
+	builder.SetGasLimit(testTxGasLimit)
+	require.NoError(t, builder.SetMsgs(msgs...))
+
+	keys := make(map[string]crypto.PrivKey, len(privKeys))
+	for _, privKey := range privKeys {
+		keys[sdk.AccAddress(privKey.PubKey().Address()).String()] = privKey
+	}
+
+	// there is a signature for every signer of the msgs, in the same order
+	signers := builder.GetTx().GetSigners()
+	accs := make([]authtypes.AccountI, len(signers))
+	sigs := make([]sdksigning.SignatureV2, len(signers))
+	for i, signer := range signers {
+		accs[i] = keeper.accountKeeper.GetAccount(ctx, signer)
+		require.NotNil(t, accs[i])
+
+		pubKey := accs[i].GetPubKey()
+		for _, addr := range withoutPubKey {
+			if addr.Equals(signer) {
+				pubKey = nil
+			}
+		}
+		sigs[i] = sdksigning.SignatureV2{
+			PubKey:   pubKey,
+			Data:     &sdksigning.SingleSignatureData{SignMode: signMode},
+			Sequence: accs[i].GetSequence(),
+		}
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	for i, signer := range signers {
+		privKey, ok := keys[signer.String()]
+		require.True(t, ok, "no private key for signer %s", signer)
+
+		signerData := authsigning.SignerData{
+			ChainID:       ctx.ChainID(),
+			AccountNumber: accs[i].GetAccountNumber(),
+			Sequence:      accs[i].GetSequence(),
+		}
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+		require.NoError(t, err)
+
+		sigs[i].Data.(*sdksigning.SingleSignatureData).Signature, err = privKey.Sign(signBytes)
+		require.NoError(t, err)
+	}
+	require.NoError(t, builder.SetSignatures(sigs...))
+
+	return builder.(protoTxProvider).GetProtoTx()
+}
+
+// runAnteHandler runs tx through the ante handler of the app, like DeliverTx does, and returns the context
+// the msgs of tx are delivered with
+func runAnteHandler(t *testing.T, ctx sdk.Context, keeper Keeper, tx *sdktx.Tx) sdk.Context {
+	ctx, err := anteHandle(t, ctx, keeper, tx)
+	require.NoError(t, err)
+	return ctx
+}
+
+func anteHandle(t *testing.T, ctx sdk.Context, keeper Keeper, tx *sdktx.Tx) (sdk.Context, error) {
+	require.NotNil(t, NewAppAnteHandler)
+
+	txConfig := MakeEncodingConfig().TxConfig
+	anteHandler, err := NewAppAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   keeper.accountKeeper,
+		BankKeeper:      keeper.bankKeeper,
+		SignModeHandler: txConfig.SignModeHandler(),
+	}, keeper.storeKey)
+	require.NoError(t, err)
+
+	ctx, sdkTx := decodeTestTx(t, ctx, tx)
+	return anteHandler(ctx, sdkTx, false)
+}
+
+// runSignerDataDecorator only records the signer data of tx, for txs that the ante handler of the app rejects
+// but that the enclave must reject on its own as well
+func runSignerDataDecorator(t *testing.T, ctx sdk.Context, keeper Keeper, tx *sdktx.Tx) sdk.Context {
+	ctx, sdkTx := decodeTestTx(t, ctx, tx)
+	ctx, err := NewSignerDataDecorator(keeper.accountKeeper).AnteHandle(ctx, sdkTx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	})
+	require.NoError(t, err)
+	return ctx
+}
+
+// decodeTestTx decodes tx like the app does, and returns it with ctx holding its bytes
+func decodeTestTx(t *testing.T, ctx sdk.Context, tx *sdktx.Tx) (sdk.Context, sdk.Tx) {
+	txBytes, err := tx.Marshal()
+	require.NoError(t, err)
+	sdkTx, err := MakeEncodingConfig().TxConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	return ctx.WithTxBytes(txBytes), sdkTx
+}
+
+// requireTxInfo checks that the keeper recreates what signer signed, in signMode, with pubKey
+func requireTxInfo(t *testing.T, ctx sdk.Context, keeper Keeper, signer sdk.AccAddress, pubKey crypto.PubKey, signMode sdksigning.SignMode) {
+	signBytes, mode, _, pkBytes, signature, err := keeper.GetTxInfo(ctx, signer)
+	require.NoError(t, err)
+	require.Equal(t, signMode, mode)
+	require.True(t, pubKey.VerifySignature(signBytes, signature), "signature of %s", signer)
+
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	expPkBytes, err := keeper.cdc.Marshal(anyPubKey)
+	require.NoError(t, err)
+	require.Equal(t, expPkBytes, pkBytes)
+}
+
+// newExecuteMsg returns an execute msg of the counter contract, and its encrypted msg
+func newExecuteMsg(t *testing.T, ctx sdk.Context, keeper Keeper, sender, contractAddress sdk.AccAddress) *types.MsgExecuteContract {
+	encMsg, err := testEncrypt(t, keeper, ctx, contractAddress, 0, []byte(`{"increment":{"addition":1}}`))
+	require.NoError(t, err)
+	return &types.MsgExecuteContract{Sender: sender, Contract: contractAddress, Msg: encMsg}
+}
+
+func TestGetTxInfoMixedMsgs(t *testing.T) {
+	for _, signMode := range testSignModes {
+		t.Run(signMode.String(), func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+			_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			execute := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			msgs := []sdk.Msg{
+				banktypes.NewMsgSend(walletA, walletB, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))),
+				execute,
+				stakingtypes.NewMsgDelegate(walletA, sdk.ValAddress(walletB), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+			}
+			tx := newSignedTx(t, ctx, keeper, signMode, msgs, []crypto.PrivKey{privKeyA})
+			ctx = runAnteHandler(t, ctx, keeper, tx)
+
+			requireTxInfo(t, ctx, keeper, walletA, privKeyA.PubKey(), signMode)
+
+			// the enclave finds the execute msg among the other msgs of the signer
+			_, err := keeper.Execute(ctx, contractAddress, walletA, execute.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			requireCounter(t, keeper, ctx, contractAddress, 11)
+		})
+	}
+}
+
+func TestGetTxInfoSeveralMsgsOneSigner(t *testing.T) {
+	for _, signMode := range testSignModes {
+		t.Run(signMode.String(), func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, walletB, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+			_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			first := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			second := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			msgs := []sdk.Msg{
+				first,
+				banktypes.NewMsgSend(walletA, walletB, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))),
+				second,
+			}
+			tx := newSignedTx(t, ctx, keeper, signMode, msgs, []crypto.PrivKey{privKeyA})
+			require.Len(t, tx.Signatures, 1)
+
+			seq, err := keeper.accountKeeper.GetSequence(ctx, walletA)
+			require.NoError(t, err)
+			ctx = runAnteHandler(t, ctx, keeper, tx)
+
+			// the sequence is incremented by the ante handler, but the signer signed the one before
+			newSeq, err := keeper.accountKeeper.GetSequence(ctx, walletA)
+			require.NoError(t, err)
+			require.Equal(t, seq+1, newSeq)
+			requireTxInfo(t, ctx, keeper, walletA, privKeyA.PubKey(), signMode)
+
+			_, err = keeper.Execute(ctx, contractAddress, walletA, first.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			_, err = keeper.Execute(ctx, contractAddress, walletA, second.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			requireCounter(t, keeper, ctx, contractAddress, 12)
+		})
+	}
+}
+
+func TestGetTxInfoMultipleSigners(t *testing.T) {
+	for _, signMode := range testSignModes {
+		t.Run(signMode.String(), func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+			walletC, _ := CreateFakeFundedAccount(ctx, keeper.accountKeeper, keeper.bankKeeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 5000)))
+
+			_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			executeA := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			executeB := newExecuteMsg(t, ctx, keeper, walletB, contractAddress)
+			tx := newSignedTx(t, ctx, keeper, signMode, []sdk.Msg{executeA, executeB}, []crypto.PrivKey{privKeyA, privKeyB})
+			ctx = runAnteHandler(t, ctx, keeper, tx)
+
+			// each signer gets its own sign bytes and signature
+			requireTxInfo(t, ctx, keeper, walletA, privKeyA.PubKey(), signMode)
+			requireTxInfo(t, ctx, keeper, walletB, privKeyB.PubKey(), signMode)
+
+			_, _, _, _, _, err := keeper.GetTxInfo(ctx, walletC)
+			require.ErrorIs(t, err, types.ErrSigFailed)
+
+			_, err = keeper.Execute(ctx, contractAddress, walletA, executeA.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			_, err = keeper.Execute(ctx, contractAddress, walletB, executeB.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			requireCounter(t, keeper, ctx, contractAddress, 12)
+
+			// a msg can't be passed off as the msg of another signer
+			_, err = keeper.Execute(ctx, contractAddress, walletB, executeA.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.Error(t, err)
+			requireCounter(t, keeper, ctx, contractAddress, 12)
+		})
+	}
+}
+
+func TestGetTxInfoSignerWithoutPubKey(t *testing.T) {
+	for _, signMode := range testSignModes {
+		t.Run(signMode.String(), func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+			_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			// the account of walletB already has a public key, so the tx doesn't need to hold it
+			executeA := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			executeB := newExecuteMsg(t, ctx, keeper, walletB, contractAddress)
+			tx := newSignedTx(t, ctx, keeper, signMode, []sdk.Msg{executeA, executeB}, []crypto.PrivKey{privKeyA, privKeyB}, walletB)
+			require.Nil(t, tx.AuthInfo.SignerInfos[1].PublicKey)
+			ctx = runAnteHandler(t, ctx, keeper, tx)
+
+			requireTxInfo(t, ctx, keeper, walletA, privKeyA.PubKey(), signMode)
+			requireTxInfo(t, ctx, keeper, walletB, privKeyB.PubKey(), signMode)
+
+			_, err := keeper.Execute(ctx, contractAddress, walletB, executeB.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			requireCounter(t, keeper, ctx, contractAddress, 11)
+		})
+	}
+}
+
+func TestGetTxSigners(t *testing.T) {
+	for _, signMode := range testSignModes {
+		t.Run(signMode.String(), func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+			_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+			require.Empty(t, initErr)
+
+			// a tx with a single signer needs no signer set
+			executeA := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+			tx := newSignedTx(t, ctx, keeper, signMode, []sdk.Msg{executeA}, []crypto.PrivKey{privKeyA})
+			require.Nil(t, keeper.GetTxSigners(runAnteHandler(t, ctx, keeper, tx)))
+
+			// every signer gets its own sign bytes and signature
+			executeB := newExecuteMsg(t, ctx, keeper, walletB, contractAddress)
+			tx = newSignedTx(t, ctx, keeper, signMode, []sdk.Msg{executeA, executeB}, []crypto.PrivKey{privKeyA, privKeyB})
+			txCtx := runAnteHandler(t, ctx, keeper, tx)
+
+			signers := keeper.GetTxSigners(txCtx)
+			require.Len(t, signers, 2)
+			for i, privKey := range []crypto.PrivKey{privKeyA, privKeyB} {
+				require.Equal(t, signMode.String(), signers[i].SignMode)
+				require.True(t, privKey.PubKey().VerifySignature(signers[i].SignBytes, signers[i].Signature))
+
+				anyPubKey, err := codectypes.NewAnyWithValue(privKey.PubKey())
+				require.NoError(t, err)
+				pkBytes, err := keeper.cdc.Marshal(anyPubKey)
+				require.NoError(t, err)
+				require.Equal(t, pkBytes, signers[i].PublicKey)
+			}
+
+			// the msgs of both signers verify with the signer set passed along
+			_, err := keeper.Execute(txCtx, contractAddress, walletB, executeB.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			_, err = keeper.Execute(txCtx, contractAddress, walletA, executeA.Msg, nil, nil, cosmwasm.HandleTypeExecute)
+			require.NoError(t, err)
+			requireCounter(t, keeper, txCtx, contractAddress, 12)
+		})
+	}
+}
+
+func TestGetTxSignersLeavesOutSignersWithoutInfo(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, walletB, privKeyB := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, walletA, privKeyA, `{"counter":{"counter":10, "expires":100}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	// without the signer data recorded by the ante handler, the amino sign docs can't be recreated
+	executeA := newExecuteMsg(t, ctx, keeper, walletA, contractAddress)
+	executeB := newExecuteMsg(t, ctx, keeper, walletB, contractAddress)
+	tx := newSignedTx(t, ctx, keeper, sdksigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, []sdk.Msg{executeA, executeB}, []crypto.PrivKey{privKeyA, privKeyB})
+	txCtx, _ := decodeTestTx(t, ctx, tx)
+
+	_, _, _, _, _, err := keeper.GetTxInfo(txCtx, walletA)
+	require.ErrorIs(t, err, types.ErrSigFailed)
+	require.Empty(t, keeper.GetTxSigners(txCtx))
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// createFakeVestingAccount creates an account with a balance of vesting coins that is wrapped by newAccount
+func createFakeVestingAccount(
+	ctx sdk.Context, keeper Keeper, vesting sdk.Coins, newAccount func(*authtypes.BaseAccount, sdk.Coins) authtypes.AccountI,
+) (sdk.AccAddress, crypto.PrivKey) {
+	addr, privKey := CreateFakeFundedAccount(ctx, keeper.accountKeeper, keeper.bankKeeper, vesting)
+	baseAcct := keeper.accountKeeper.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+	keeper.accountKeeper.SetAccount(ctx, newAccount(baseAcct, vesting))
+	return addr, privKey
+}
+
+func TestVestingAccountDeposit(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		newAccount func(ctx sdk.Context) func(*authtypes.BaseAccount, sdk.Coins) authtypes.AccountI
+		// spendable is the part of the 1000denom balance that is vested
+		spendable int64
+	}{
+		{
+			name: "continuous",
+			newAccount: func(ctx sdk.Context) func(*authtypes.BaseAccount, sdk.Coins) authtypes.AccountI {
+				return func(baseAcct *authtypes.BaseAccount, vesting sdk.Coins) authtypes.AccountI {
+					// halfway through the vesting period
+					start := ctx.BlockTime().Add(-50 * time.Second).Unix()
+					end := ctx.BlockTime().Add(50 * time.Second).Unix()
+					return vestingtypes.NewContinuousVestingAccount(baseAcct, vesting, start, end)
+				}
+			},
+			spendable: 500,
+		},
+		{
+			name: "delayed",
+			newAccount: func(ctx sdk.Context) func(*authtypes.BaseAccount, sdk.Coins) authtypes.AccountI {
+				return func(baseAcct *authtypes.BaseAccount, vesting sdk.Coins) authtypes.AccountI {
+					return vestingtypes.NewDelayedVestingAccount(baseAcct, vesting, ctx.BlockTime().Add(time.Hour).Unix())
+				}
+			},
+			spendable: 0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+			vestingWallet, vestingPrivKey := createFakeVestingAccount(ctx, keeper, sdk.NewCoins(sdk.NewInt64Coin("denom", 1000)), test.newAccount(ctx))
+			// unlocked coins received after the vesting started can be deposited too
+			fundAccounts(ctx, keeper.accountKeeper, keeper.bankKeeper, vestingWallet, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)))
+			spendable := test.spendable + 100
+
+			// the deposit fails before the contract is called
+			_, _, _, _, err := initHelperImpl(t, keeper, ctx, codeID, vestingWallet, nil, vestingPrivKey, `{"nop":{}}`, false, true, defaultGasForTests, 0, sdk.NewCoins(sdk.NewInt64Coin("denom", spendable+1)))
+			require.NotNil(t, err.GenericErr)
+			require.Contains(t, err.GenericErr.Msg, "locked by vesting")
+
+			_, _, contractAddress, _, err := initHelperImpl(t, keeper, ctx, codeID, vestingWallet, nil, vestingPrivKey, `{"nop":{}}`, true, true, defaultGasForTests, -1, sdk.NewCoins(sdk.NewInt64Coin("denom", spendable/2)))
+			require.Empty(t, err)
+			spendable -= spendable / 2
+
+			_, _, _, _, _, err = execHelperMultipleCoins(t, keeper, ctx, contractAddress, vestingWallet, vestingPrivKey, `{"no_data":{}}`, false, true, defaultGasForTests, sdk.NewCoins(sdk.NewInt64Coin("denom", spendable+1)), 0)
+			require.NotNil(t, err.GenericErr)
+			require.Contains(t, err.GenericErr.Msg, "locked by vesting")
+
+			_, _, _, _, _, err = execHelperMultipleCoins(t, keeper, ctx, contractAddress, vestingWallet, vestingPrivKey, `{"no_data":{}}`, true, true, defaultGasForTests, sdk.NewCoins(sdk.NewInt64Coin("denom", spendable)), -1)
+			require.Empty(t, err)
+
+			// the locked coins stay in the vesting account
+			require.Equal(t, sdk.NewInt(1000-test.spendable), keeper.bankKeeper.GetBalance(ctx, vestingWallet, "denom").Amount)
+			require.Equal(t, sdk.NewInt(test.spendable+100), keeper.bankKeeper.GetBalance(ctx, contractAddress, "denom").Amount)
+
+			// accounts that aren't vesting get the plain error
+			_, _, _, _, _, err = execHelperMultipleCoins(t, keeper, ctx, contractAddress, walletA, privKeyA, `{"no_data":{}}`, false, true, defaultGasForTests, sdk.NewCoins(sdk.NewInt64Coin("denom", 1_000_000)), 0)
+			require.NotNil(t, err.GenericErr)
+			require.NotContains(t, err.GenericErr.Msg, "locked by vesting")
+		})
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type contextKey int
@@ -9,6 +11,10 @@ type contextKey int
 const (
 	// private type creates an interface key for Context that cannot be accessed by any other package
 	contextKeyTXCount contextKey = iota
+	contextKeyDispatchDepth
+	contextKeyDispatchCount
+	contextKeySignerData
+	contextKeyModuleExecutor
 )
 
 // WithTXCounter stores a transaction counter value in the context
@@ -22,3 +28,61 @@ func TXCounter(ctx sdk.Context) (uint32, bool) {
 	val, ok := ctx.Value(contextKeyTXCount).(uint32)
 	return val, ok
 }
+
+// WithDispatchDepth stores the depth of the contract calls in the context: the msgs dispatched by
+// a contract run one level deeper than the contract
+func WithDispatchDepth(ctx sdk.Context, depth uint32) sdk.Context {
+	return ctx.WithValue(contextKeyDispatchDepth, depth)
+}
+
+// DispatchDepth returns the dispatch depth of the context, which is 0 for the msgs of a tx
+func DispatchDepth(ctx sdk.Context) uint32 {
+	depth, _ := ctx.Value(contextKeyDispatchDepth).(uint32)
+	return depth
+}
+
+// WithDispatchCounter stores a counter of the msgs dispatched by contracts in the context. The counter
+// is shared by all the contexts derived from ctx.
+func WithDispatchCounter(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(contextKeyDispatchCount, new(uint32))
+}
+
+// DispatchCounter returns the counter of dispatched msgs and found bool from the context
+func DispatchCounter(ctx sdk.Context) (*uint32, bool) {
+	counter, ok := ctx.Value(contextKeyDispatchCount).(*uint32)
+	return counter, ok
+}
+
+// WithSignerData stores the chain id, account number and sequence that each signer of the tx signed
+// in the context, keyed by the address of the signer
+func WithSignerData(ctx sdk.Context, signerData map[string]authsigning.SignerData) sdk.Context {
+	return ctx.WithValue(contextKeySignerData, signerData)
+}
+
+// SignerData returns the data that signer signed and found bool from the context.
+// The result will be (SignerData{}, false) outside of a tx, or when signer didn't sign the tx.
+func SignerData(ctx sdk.Context, signer sdk.AccAddress) (authsigning.SignerData, bool) {
+	signerData, ok := ctx.Value(contextKeySignerData).(map[string]authsigning.SignerData)
+	if !ok {
+		return authsigning.SignerData{}, false
+	}
+	data, ok := signerData[signer.String()]
+	return data, ok
+}
+
+// WithModuleExecutor stores the name of the module whose account executes a contract in the context
+func WithModuleExecutor(ctx sdk.Context, module string) sdk.Context {
+	return ctx.WithValue(contextKeyModuleExecutor, module)
+}
+
+// ModuleSenders are the modules whose accounts may execute contracts. The enclave only accepts
+// these as the sender of a call without a signature, keep in sync with MODULE_SENDERS in
+// contract_validation.rs.
+var ModuleSenders = []string{govtypes.ModuleName}
+
+// ModuleExecutor returns the name of the module whose account executes a contract and found bool
+// from the context
+func ModuleExecutor(ctx sdk.Context) (string, bool) {
+	module, ok := ctx.Value(contextKeyModuleExecutor).(string)
+	return module, ok
+}
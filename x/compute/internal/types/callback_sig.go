@@ -0,0 +1,123 @@
+package types
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CallbackSigVersion is the first byte of a callback signature envelope
+type CallbackSigVersion byte
+
+const (
+	// CallbackSigVersionLegacy is an un-enveloped sha256 callback signature, as produced by
+	// enclaves that predate the envelope. It is identified by its length alone.
+	CallbackSigVersionLegacy CallbackSigVersion = 0
+	// CallbackSigVersion1 is an envelope of the form: version (1 byte) | algorithm (1 byte) | payload
+	CallbackSigVersion1 CallbackSigVersion = 1
+)
+
+// CallbackSigAlgorithm identifies the scheme used to produce a callback signature payload
+type CallbackSigAlgorithm byte
+
+const (
+	// CallbackSigAlgorithmSha256 is sha256(callback_secret | msg | sent_funds)
+	CallbackSigAlgorithmSha256 CallbackSigAlgorithm = 0
+	// CallbackSigAlgorithmHmacSha256 is hmac_sha256(callback_secret, msg | sent_funds)
+	CallbackSigAlgorithmHmacSha256 CallbackSigAlgorithm = 1
+	// CallbackSigAlgorithmEd25519 is an ed25519 signature by the enclave over msg | sent_funds
+	CallbackSigAlgorithmEd25519 CallbackSigAlgorithm = 2
+)
+
+const callbackSigEnvelopeHeaderSize = 2
+
+var callbackSigAlgorithmNames = map[CallbackSigAlgorithm]string{
+	CallbackSigAlgorithmSha256:     "sha256",
+	CallbackSigAlgorithmHmacSha256: "hmac-sha256",
+	CallbackSigAlgorithmEd25519:    "ed25519",
+}
+
+var callbackSigPayloadSizes = map[CallbackSigAlgorithm]int{
+	CallbackSigAlgorithmSha256:     sha256.Size,
+	CallbackSigAlgorithmHmacSha256: sha256.Size,
+	CallbackSigAlgorithmEd25519:    ed25519.SignatureSize,
+}
+
+func (a CallbackSigAlgorithm) String() string {
+	if name, ok := callbackSigAlgorithmNames[a]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CallbackSig is a decoded callback signature envelope
+type CallbackSig struct {
+	Version   CallbackSigVersion
+	Algorithm CallbackSigAlgorithm
+	Payload   []byte
+}
+
+// NewCallbackSig wraps a callback signature payload in a versioned envelope
+func NewCallbackSig(algorithm CallbackSigAlgorithm, payload []byte) CallbackSig {
+	return CallbackSig{
+		Version:   CallbackSigVersion1,
+		Algorithm: algorithm,
+		Payload:   payload,
+	}
+}
+
+// ParseCallbackSig decodes a callback signature. Signatures of exactly sha256.Size bytes are
+// treated as legacy sha256 signatures so contracts holding such signatures keep working.
+func ParseCallbackSig(bz []byte) (CallbackSig, error) {
+	if len(bz) == sha256.Size {
+		return CallbackSig{
+			Version:   CallbackSigVersionLegacy,
+			Algorithm: CallbackSigAlgorithmSha256,
+			Payload:   bz,
+		}, nil
+	}
+
+	if len(bz) < callbackSigEnvelopeHeaderSize {
+		return CallbackSig{}, sdkerrors.Wrapf(ErrInvalidCallbackSig, "too short: %d bytes", len(bz))
+	}
+
+	sig := CallbackSig{
+		Version:   CallbackSigVersion(bz[0]),
+		Algorithm: CallbackSigAlgorithm(bz[1]),
+		Payload:   bz[callbackSigEnvelopeHeaderSize:],
+	}
+	if err := sig.ValidateBasic(); err != nil {
+		return CallbackSig{}, err
+	}
+	return sig, nil
+}
+
+// ValidateBasic checks that the envelope version and algorithm are known and that the payload
+// has the size the algorithm produces
+func (s CallbackSig) ValidateBasic() error {
+	if s.Version != CallbackSigVersionLegacy && s.Version != CallbackSigVersion1 {
+		return sdkerrors.Wrapf(ErrInvalidCallbackSig, "unknown version %d", s.Version)
+	}
+	size, ok := callbackSigPayloadSizes[s.Algorithm]
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidCallbackSig, "unknown algorithm %d", s.Algorithm)
+	}
+	if s.Version == CallbackSigVersionLegacy && s.Algorithm != CallbackSigAlgorithmSha256 {
+		return sdkerrors.Wrapf(ErrInvalidCallbackSig, "legacy signatures must use %s", CallbackSigAlgorithmSha256)
+	}
+	if len(s.Payload) != size {
+		return sdkerrors.Wrapf(ErrInvalidCallbackSig, "%s payload must be %d bytes, got %d", s.Algorithm, size, len(s.Payload))
+	}
+	return nil
+}
+
+// Bytes encodes the callback signature. Legacy signatures are encoded as their bare payload.
+func (s CallbackSig) Bytes() []byte {
+	if s.Version == CallbackSigVersionLegacy {
+		return s.Payload
+	}
+	bz := make([]byte, 0, callbackSigEnvelopeHeaderSize+len(s.Payload))
+	bz = append(bz, byte(s.Version), byte(s.Algorithm))
+	return append(bz, s.Payload...)
+}
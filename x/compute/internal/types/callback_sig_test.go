@@ -0,0 +1,67 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCallbackSig(t *testing.T) {
+	sha := bytes.Repeat([]byte{1}, sha256.Size)
+	ed := bytes.Repeat([]byte{2}, ed25519.SignatureSize)
+
+	specs := map[string]struct {
+		src      []byte
+		exp      CallbackSig
+		expError bool
+	}{
+		"legacy sha256": {
+			src: sha,
+			exp: CallbackSig{Version: CallbackSigVersionLegacy, Algorithm: CallbackSigAlgorithmSha256, Payload: sha},
+		},
+		"v1 sha256": {
+			src: append([]byte{1, 0}, sha...),
+			exp: NewCallbackSig(CallbackSigAlgorithmSha256, sha),
+		},
+		"v1 hmac-sha256": {
+			src: append([]byte{1, 1}, sha...),
+			exp: NewCallbackSig(CallbackSigAlgorithmHmacSha256, sha),
+		},
+		"v1 ed25519": {
+			src: append([]byte{1, 2}, ed...),
+			exp: NewCallbackSig(CallbackSigAlgorithmEd25519, ed),
+		},
+		"empty": {
+			src:      []byte{},
+			expError: true,
+		},
+		"unknown version": {
+			src:      append([]byte{2, 0}, sha...),
+			expError: true,
+		},
+		"unknown algorithm": {
+			src:      append([]byte{1, 9}, sha...),
+			expError: true,
+		},
+		"payload size mismatch": {
+			src:      append([]byte{1, 2}, sha...),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := ParseCallbackSig(spec.src)
+			if spec.expError {
+				assert.ErrorIs(t, err, ErrInvalidCallbackSig)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+			assert.Equal(t, spec.src, got.Bytes())
+		})
+	}
+}
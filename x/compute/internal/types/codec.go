@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterCodec registers the account types and interface
@@ -16,6 +17,22 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
+	cdc.RegisterConcrete(&MsgSetContractReceiveHook{}, "wasm/MsgSetContractReceiveHook", nil)
+	cdc.RegisterConcrete(&MsgWrapCoin{}, "wasm/MsgWrapCoin", nil)
+	cdc.RegisterConcrete(&MsgUnwrapCoin{}, "wasm/MsgUnwrapCoin", nil)
+	cdc.RegisterConcrete(&MsgScheduleExecute{}, "wasm/MsgScheduleExecute", nil)
+	cdc.RegisterConcrete(&MsgCancelScheduledExecute{}, "wasm/MsgCancelScheduledExecute", nil)
+	cdc.RegisterConcrete(&MsgRegisterCron{}, "wasm/MsgRegisterCron", nil)
+	cdc.RegisterConcrete(&MsgCancelCron{}, "wasm/MsgCancelCron", nil)
+	cdc.RegisterConcrete(&MsgSetContractFeePolicy{}, "wasm/MsgSetContractFeePolicy", nil)
+	cdc.RegisterConcrete(&MsgSetCodeSchema{}, "wasm/MsgSetCodeSchema", nil)
+	cdc.RegisterConcrete(&MsgUpdateInstantiateConfig{}, "wasm/MsgUpdateInstantiateConfig", nil)
+	cdc.RegisterConcrete(&MsgAddCodeVerification{}, "wasm/MsgAddCodeVerification", nil)
+	cdc.RegisterConcrete(&MsgAddCodeAudit{}, "wasm/MsgAddCodeAudit", nil)
+	cdc.RegisterConcrete(&MsgStoreCodeAndInstantiate{}, "wasm/MsgStoreCodeAndInstantiate", nil)
+	cdc.RegisterConcrete(&MsgBatchInstantiate{}, "wasm/MsgBatchInstantiate", nil)
+	cdc.RegisterConcrete(&RecoverContractFundsProposal{}, "wasm/RecoverContractFundsProposal", nil)
+	cdc.RegisterConcrete(&FlagBrokenCodeProposal{}, "wasm/FlagBrokenCodeProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -27,6 +44,25 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMigrateContract{},
 		&MsgUpdateAdmin{},
 		&MsgClearAdmin{},
+		&MsgSetContractReceiveHook{},
+		&MsgWrapCoin{},
+		&MsgUnwrapCoin{},
+		&MsgScheduleExecute{},
+		&MsgCancelScheduledExecute{},
+		&MsgRegisterCron{},
+		&MsgCancelCron{},
+		&MsgSetContractFeePolicy{},
+		&MsgSetCodeSchema{},
+		&MsgUpdateInstantiateConfig{},
+		&MsgAddCodeVerification{},
+		&MsgAddCodeAudit{},
+		&MsgStoreCodeAndInstantiate{},
+		&MsgBatchInstantiate{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&RecoverContractFundsProposal{},
+		&FlagBrokenCodeProposal{},
 	)
 }
 
@@ -72,6 +72,28 @@ var (
 
 	// ErrMaxIBCChannels error for maximum number of ibc channels reached
 	ErrMaxIBCChannels = sdkErrors.Register(DefaultCodespace, 22, "max transfer channels")
+
+	// ErrDenomNotAllowed error for funds sent to a contract in a denom that is not whitelisted
+	ErrDenomNotAllowed = sdkErrors.Register(DefaultCodespace, 23, "deposit denom not allowed")
+
+	// ErrInvalidCallbackSig error for a callback signature with an unknown version or algorithm
+	ErrInvalidCallbackSig = sdkErrors.Register(DefaultCodespace, 24, "invalid callback signature")
+
+	// ErrExpired error for an execute msg included in a block after its expiration height
+	ErrExpired = sdkErrors.Register(DefaultCodespace, 25, "msg expired")
+
+	// ErrInvalidEncryptedMsg error for an encrypted msg with a malformed envelope
+	ErrInvalidEncryptedMsg = sdkErrors.Register(DefaultCodespace, 26, "invalid encrypted msg")
+
+	// ErrInsufficientContractFee error for a tx that doesn't pay the minimum fee of a contract it executes
+	ErrInsufficientContractFee = sdkErrors.Register(DefaultCodespace, 27, "insufficient fee for contract")
+
+	// ErrNotOrphaned error for a fund recovery of a contract that still has an admin, whose code isn't flagged
+	// broken since long enough, or that is in use by the chain
+	ErrNotOrphaned = sdkErrors.Register(DefaultCodespace, 28, "contract is not orphaned")
+
+	// ErrDispatchLimit error for contract calls that dispatch msgs too deep or too many msgs
+	ErrDispatchLimit = sdkErrors.Register(DefaultCodespace, 29, "dispatch limit exceeded")
 )
 
 func IsEncryptedErrorCode(code uint32) bool {
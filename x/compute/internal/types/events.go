@@ -6,15 +6,21 @@ const (
 	// CustomContractEventPrefix contracts can create custom events. To not mix them with other system events they got the `wasm-` prefix.
 	CustomContractEventPrefix = "wasm-"
 
-	EventTypeStoreCode           = "store_code"
-	EventTypeInstantiate         = "instantiate"
-	EventTypeExecute             = "execute"
-	EventTypeMigrate             = "migrate"
-	EventTypePinCode             = "pin_code"
-	EventTypeUnpinCode           = "unpin_code"
-	EventTypeSudo                = "sudo"
-	EventTypeReply               = "reply"
-	EventTypeUpdateContractAdmin = "update_contract_admin"
+	EventTypeStoreCode            = "store_code"
+	EventTypeInstantiate          = "instantiate"
+	EventTypeExecute              = "execute"
+	EventTypeMigrate              = "migrate"
+	EventTypePinCode              = "pin_code"
+	EventTypeUnpinCode            = "unpin_code"
+	EventTypeSudo                 = "sudo"
+	EventTypeReply                = "reply"
+	EventTypeUpdateContractAdmin  = "update_contract_admin"
+	EventTypeScheduledExecute     = "scheduled_execute"
+	EventTypeCronExecute          = "cron_execute"
+	EventTypeContractDeposit      = "contract_deposit"
+	EventTypeRecoverContractFunds = "recover_contract_funds"
+	EventTypeFlagBrokenCode       = "flag_broken_code"
+	EventTypeInstantiateConfig    = "update_instantiate_config"
 )
 
 // event attributes returned from contract execution
@@ -23,6 +29,28 @@ const (
 
 	AttributeKeyContractAddr = "contract_address"
 	AttributeKeyCodeID       = "code_id"
+	AttributeKeyCodeHash     = "code_hash"
 	AttributeKeySigner       = "signer"
 	AttributeKeyNewAdmin     = "new_admin_address"
+
+	// attributes of scheduled_execute and cron_execute events
+	AttributeKeyScheduledCallID = "scheduled_call_id"
+	AttributeKeyCronID          = "cron_id"
+	AttributeKeyError           = "error"
+	// AttributeKeyCronRemoved is set on the last execution of a cron, whose budget ran out
+	AttributeKeyCronRemoved = "cron_removed"
+
+	// attributes of contract_deposit events, emitted once per denom sent to a contract
+	AttributeKeyDenom  = "denom"
+	AttributeKeyAmount = "amount"
+
+	// attributes of recover_contract_funds events
+	AttributeKeyRecipient = "recipient"
+
+	// attributes of update_instantiate_config events
+	AttributeKeyInstantiatePermission = "instantiate_permission"
+	AttributeKeyInstantiateAddress    = "instantiate_address"
+
+	// attribute added to the events of the non-wasm msgs a contract dispatches, e.g. bank sends
+	AttributeKeyTriggeredBy = "triggered_by_contract"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -17,9 +19,22 @@ type ICS20TransferPortSource interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) ([]byte, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
 	SendPacket(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
 	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
 	GetAllChannels(ctx sdk.Context) (channels []channeltypes.IdentifiedChannel)
 	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
 	SetChannel(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
 }
+
+// PriceSource defines the expected price oracle used to serve oracle queries from contracts
+type PriceSource interface {
+	// GetPrice returns the price of base denominated in quote, and the time of its last update
+	GetPrice(ctx sdk.Context, base, quote string) (rate sdk.Dec, lastUpdated time.Time, found bool)
+}
+
+// UpgradeKeeper defines the expected upgrade keeper, which records the heights upgrade plans were applied at
+type UpgradeKeeper interface {
+	GetDoneHeight(ctx sdk.Context, name string) int64
+}
@@ -3,9 +3,32 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	v1types "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 )
 
+// ComputeKeeper is the subset of the compute keeper that other modules may depend on
+type ComputeKeeper interface {
+	// Execute executes a contract. callbackSig is nil unless the caller is another contract.
+	Execute(
+		ctx sdk.Context,
+		contractAddress sdk.AccAddress,
+		caller sdk.AccAddress,
+		msg []byte,
+		coins sdk.Coins,
+		callbackSig []byte,
+		handleType wasmTypes.HandleType,
+	) (*sdk.Result, error)
+	// QuerySmart queries a contract. req must be encrypted for the contract.
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, useDefaultGasLimit bool) ([]byte, error)
+	// GetContractInfo returns nil if the contract doesn't exist
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
+	GetCodeInfo(ctx sdk.Context, codeID uint64) (CodeInfo, error)
+	// IterateContractsByCode iterates over the contracts currently running the given code, ordered
+	// by the time they switched to it. cb returns true to stop early.
+	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
+}
+
 // IBCContractKeeper IBC lifecycle event handler
 type IBCContractKeeper interface {
 	OnOpenChannel(
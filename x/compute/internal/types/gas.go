@@ -19,3 +19,14 @@ const InstanceCost uint64 = 20_000
 
 // CompileCost is how much SDK gas we charge *per byte* for compiling WASM code.
 const CompileCost uint64 = 2
+
+// ReceiveHookGasLimit is how much SDK gas a contract's bank receive hook may spend.
+// The hook runs as part of the sender's bank MsgSend, so it must not be able to use up the whole tx.
+const ReceiveHookGasLimit uint64 = 200_000
+
+// MaxScheduledCallGasLimit is the most SDK gas a scheduled call may reserve.
+const MaxScheduledCallGasLimit uint64 = 10_000_000
+
+// MaxScheduledCallsPerBlock is how many scheduled calls the EndBlocker executes per block.
+// Due calls beyond that are executed in the next blocks, in order.
+const MaxScheduledCallsPerBlock = 100
@@ -0,0 +1,22 @@
+package types
+
+import "time"
+
+const (
+	// GasUsageBlockRetention is the number of blocks the per block gas usage of contracts is kept for
+	GasUsageBlockRetention = 600
+
+	// GasUsageDayRetention is the number of days the per day gas usage of contracts is kept for
+	GasUsageDayRetention = 30
+
+	// DefaultTopContractsLimit is the number of contracts returned by the TopContractsByGas query by default
+	DefaultTopContractsLimit = 10
+
+	// MaxTopContractsLimit is the most contracts the TopContractsByGas query can return
+	MaxTopContractsLimit = 100
+)
+
+// GasUsageDay returns the day of a block time, as the number of UTC days since the unix epoch
+func GasUsageDay(blockTime time.Time) int64 {
+	return blockTime.Unix() / (24 * 60 * 60)
+}
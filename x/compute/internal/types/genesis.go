@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -13,24 +15,123 @@ func (s Sequence) ValidateBasic() error {
 }
 
 func (s GenesisState) ValidateBasic() error {
+	if err := s.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "params")
+	}
 	for i := range s.Codes {
 		if err := s.Codes[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "code: %d", i)
 		}
 	}
+	labels := make(map[string]bool, len(s.Contracts))
 	for i := range s.Contracts {
 		if err := s.Contracts[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "contract: %d", i)
 		}
+		label := s.Contracts[i].ContractInfo.Label
+		if labels[label] {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract: %d label: %s", i, label)
+		}
+		labels[label] = true
 	}
 	for i := range s.Sequences {
 		if err := s.Sequences[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	scheduledCallIDs := make(map[uint64]bool, len(s.ScheduledCalls))
+	for i := range s.ScheduledCalls {
+		if err := s.ScheduledCalls[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "scheduled call: %d", i)
+		}
+		id := s.ScheduledCalls[i].ID
+		if scheduledCallIDs[id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "scheduled call: %d id: %d", i, id)
+		}
+		scheduledCallIDs[id] = true
+	}
+	cronIDs := make(map[uint64]bool, len(s.Crons))
+	for i := range s.Crons {
+		if err := s.Crons[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "cron: %d", i)
+		}
+		id := s.Crons[i].ID
+		if cronIDs[id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "cron: %d id: %d", i, id)
+		}
+		cronIDs[id] = true
+	}
+	verifications := make(map[string]bool, len(s.CodeVerifications))
+	for i := range s.CodeVerifications {
+		entry := s.CodeVerifications[i]
+		if err := entry.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "code verification: %d", i)
+		}
+		key := string(GetCodeVerificationKey(entry.CodeHash, sdk.MustAccAddressFromBech32(entry.Verification.Verifier)))
+		if verifications[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "code verification: %d verifier: %s", i, entry.Verification.Verifier)
+		}
+		verifications[key] = true
+	}
 	return nil
 }
 
+func (e CodeVerificationEntry) ValidateBasic() error {
+	msg := MsgAddCodeVerification{
+		Sender:   e.Verification.Verifier,
+		CodeHash: e.CodeHash,
+		Source:   e.Verification.Source,
+		Commit:   e.Verification.Commit,
+		Builder:  e.Verification.Builder,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if e.Verification.Height < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "height")
+	}
+	return nil
+}
+
+func (c ScheduledCall) ValidateBasic() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	msg := MsgScheduleExecute{
+		Sender:        c.Sender,
+		Contract:      c.Contract,
+		Msg:           c.Msg,
+		SentFunds:     c.SentFunds,
+		ExecuteHeight: c.ExecuteHeight,
+		GasLimit:      c.GasLimit,
+		Fee:           c.Fee,
+	}
+	return msg.ValidateBasic()
+}
+
+func (c Cron) ValidateBasic() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if c.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "next height")
+	}
+	// the budget is spent down to less than the fee of one execution, so it may be zero
+	if !c.Budget.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "budget")
+	}
+	msg := MsgRegisterCron{
+		// the sender of the registration is not stored, the owner paid the budget
+		Sender:   c.Owner,
+		Contract: c.Contract,
+		Msg:      c.Msg,
+		Interval: c.Interval,
+		GasLimit: c.GasLimit,
+		Budget:   sdk.NewInt64Coin(c.Budget.Denom, 1),
+	}
+	return msg.ValidateBasic()
+}
+
 func (c Code) ValidateBasic() error {
 	if c.CodeID == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "code id")
@@ -41,6 +142,29 @@ func (c Code) ValidateBasic() error {
 	if err := validateWasmCode(c.CodeBytes); err != nil {
 		return sdkerrors.Wrap(err, "code bytes")
 	}
+	if c.Schema != nil {
+		if err := c.Schema.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "schema")
+		}
+	}
+	if c.InstantiateConfig != nil {
+		if err := c.InstantiateConfig.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate config")
+		}
+	}
+	if c.BrokenHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "broken height")
+	}
+	auditors := make(map[string]bool, len(c.Audits))
+	for i := range c.Audits {
+		if err := c.Audits[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "audit %d", i)
+		}
+		if auditors[c.Audits[i].Auditor] {
+			return sdkerrors.Wrapf(ErrDuplicate, "audit %d: auditor %s", i, c.Audits[i].Auditor)
+		}
+		auditors[c.Audits[i].Auditor] = true
+	}
 	return nil
 }
 
@@ -59,6 +183,30 @@ func (c Contract) ValidateBasic() error {
 		if err := c.ContractState[i].ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "contract state %d", i)
 		}
+		// sorted keys keep the genesis of the same state byte-identical, whatever exported it
+		if i > 0 && bytes.Compare(c.ContractState[i-1].Key, c.ContractState[i].Key) >= 0 {
+			if bytes.Equal(c.ContractState[i-1].Key, c.ContractState[i].Key) {
+				return sdkerrors.Wrapf(ErrDuplicate, "contract state %d: key %x", i, c.ContractState[i].Key)
+			}
+			return sdkerrors.Wrapf(ErrInvalid, "contract state %d: keys must be sorted in ascending order", i)
+		}
+	}
+	if c.FeePolicy != nil {
+		if err := c.FeePolicy.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "fee policy")
+		}
+	}
+	for i, entry := range c.KeyHistory {
+		if entry.Height < 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "key history %d: height %d", i, entry.Height)
+		}
+		if i > 0 && c.KeyHistory[i-1].Height >= entry.Height {
+			return sdkerrors.Wrapf(ErrInvalid, "key history %d: heights must be sorted in ascending order", i)
+		}
+	}
+	// the latest key of the history is the current key of the contract
+	if n := len(c.KeyHistory); n != 0 && (c.ContractCustomInfo == nil || !c.KeyHistory[n-1].Key.Equal(c.ContractCustomInfo.EnclaveKey)) {
+		return sdkerrors.Wrap(ErrInvalid, "key history: latest key is not the contract key")
 	}
 
 	return nil
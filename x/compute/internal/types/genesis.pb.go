@@ -26,10 +26,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	//    Params params = 1 [(gogoproto.nullable) = false];
-	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Params            Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes             []Code                  `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts         []Contract              `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences         []Sequence              `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	ScheduledCalls    []ScheduledCall         `protobuf:"bytes,5,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls,omitempty"`
+	Crons             []Cron                  `protobuf:"bytes,6,rep,name=crons,proto3" json:"crons,omitempty"`
+	CodeVerifications []CodeVerificationEntry `protobuf:"bytes,7,rep,name=code_verifications,json=codeVerifications,proto3" json:"code_verifications,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -65,6 +68,13 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func (m *GenesisState) GetCodes() []Code {
 	if m != nil {
 		return m.Codes
@@ -86,11 +96,38 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledCalls() []ScheduledCall {
+	if m != nil {
+		return m.ScheduledCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCrons() []Cron {
+	if m != nil {
+		return m.Crons
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodeVerifications() []CodeVerificationEntry {
+	if m != nil {
+		return m.CodeVerifications
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
-	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
-	CodeInfo  CodeInfo `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
-	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	CodeID            uint64        `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	CodeInfo          CodeInfo      `protobuf:"bytes,2,opt,name=code_info,json=codeInfo,proto3" json:"code_info"`
+	CodeBytes         []byte        `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
+	Schema            *CodeSchema   `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	InstantiateConfig *AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config,omitempty"`
+	Audits            []CodeAudit   `protobuf:"bytes,6,rep,name=audits,proto3" json:"audits,omitempty"`
+	// broken_height is the height at which the code was flagged broken, zero if
+	// it wasn't
+	BrokenHeight int64 `protobuf:"varint,7,opt,name=broken_height,json=brokenHeight,proto3" json:"broken_height,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
@@ -147,12 +184,45 @@ func (m *Code) GetCodeBytes() []byte {
 	return nil
 }
 
+func (m *Code) GetSchema() *CodeSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *Code) GetInstantiateConfig() *AccessConfig {
+	if m != nil {
+		return m.InstantiateConfig
+	}
+	return nil
+}
+
+func (m *Code) GetAudits() []CodeAudit {
+	if m != nil {
+		return m.Audits
+	}
+	return nil
+}
+
+func (m *Code) GetBrokenHeight() int64 {
+	if m != nil {
+		return m.BrokenHeight
+	}
+	return 0
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"contract_address,omitempty"`
 	ContractInfo       ContractInfo                                  `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState      []Model                                       `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCustomInfo *ContractCustomInfo                           `protobuf:"bytes,4,opt,name=contract_custom_info,json=contractCustomInfo,proto3" json:"contract_custom_info,omitempty"`
+	FeePolicy          *ContractFeePolicy                            `protobuf:"bytes,5,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy,omitempty"`
+	// receive_hook is true if the contract is registered for bank receive hooks
+	ReceiveHook bool `protobuf:"varint,6,opt,name=receive_hook,json=receiveHook,proto3" json:"receive_hook,omitempty"`
+	// key_history is every enclave key the contract had, by the height it was set at
+	KeyHistory []ContractKeyHistoryEntry `protobuf:"bytes,7,rep,name=key_history,json=keyHistory,proto3" json:"key_history,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -216,6 +286,80 @@ func (m *Contract) GetContractCustomInfo() *ContractCustomInfo {
 	return nil
 }
 
+func (m *Contract) GetFeePolicy() *ContractFeePolicy {
+	if m != nil {
+		return m.FeePolicy
+	}
+	return nil
+}
+
+func (m *Contract) GetReceiveHook() bool {
+	if m != nil {
+		return m.ReceiveHook
+	}
+	return false
+}
+
+func (m *Contract) GetKeyHistory() []ContractKeyHistoryEntry {
+	if m != nil {
+		return m.KeyHistory
+	}
+	return nil
+}
+
+// ContractKeyHistoryEntry is an enclave key of a contract and the height it was set at
+type ContractKeyHistoryEntry struct {
+	Height int64       `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Key    ContractKey `protobuf:"bytes,2,opt,name=key,proto3" json:"key"`
+}
+
+func (m *ContractKeyHistoryEntry) Reset()         { *m = ContractKeyHistoryEntry{} }
+func (m *ContractKeyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ContractKeyHistoryEntry) ProtoMessage()    {}
+func (*ContractKeyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e737d858048ffc2a, []int{3}
+}
+func (m *ContractKeyHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractKeyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractKeyHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractKeyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractKeyHistoryEntry.Merge(m, src)
+}
+func (m *ContractKeyHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractKeyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractKeyHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractKeyHistoryEntry proto.InternalMessageInfo
+
+func (m *ContractKeyHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractKeyHistoryEntry) GetKey() ContractKey {
+	if m != nil {
+		return m.Key
+	}
+	return ContractKey{}
+}
+
 // Sequence id and value of a counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -226,7 +370,7 @@ func (m *Sequence) Reset()         { *m = Sequence{} }
 func (m *Sequence) String() string { return proto.CompactTextString(m) }
 func (*Sequence) ProtoMessage()    {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e737d858048ffc2a, []int{3}
+	return fileDescriptor_e737d858048ffc2a, []int{4}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,11 +413,66 @@ func (m *Sequence) GetValue() uint64 {
 	return 0
 }
 
+// CodeVerificationEntry is a verification claim with the code hash it is attached to
+type CodeVerificationEntry struct {
+	CodeHash     []byte           `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Verification CodeVerification `protobuf:"bytes,2,opt,name=verification,proto3" json:"verification"`
+}
+
+func (m *CodeVerificationEntry) Reset()         { *m = CodeVerificationEntry{} }
+func (m *CodeVerificationEntry) String() string { return proto.CompactTextString(m) }
+func (*CodeVerificationEntry) ProtoMessage()    {}
+func (*CodeVerificationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e737d858048ffc2a, []int{5}
+}
+func (m *CodeVerificationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeVerificationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeVerificationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeVerificationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeVerificationEntry.Merge(m, src)
+}
+func (m *CodeVerificationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeVerificationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeVerificationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeVerificationEntry proto.InternalMessageInfo
+
+func (m *CodeVerificationEntry) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *CodeVerificationEntry) GetVerification() CodeVerification {
+	if m != nil {
+		return m.Verification
+	}
+	return CodeVerification{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "secret.compute.v1beta1.GenesisState")
 	proto.RegisterType((*Code)(nil), "secret.compute.v1beta1.Code")
 	proto.RegisterType((*Contract)(nil), "secret.compute.v1beta1.Contract")
+	proto.RegisterType((*ContractKeyHistoryEntry)(nil), "secret.compute.v1beta1.ContractKeyHistoryEntry")
 	proto.RegisterType((*Sequence)(nil), "secret.compute.v1beta1.Sequence")
+	proto.RegisterType((*CodeVerificationEntry)(nil), "secret.compute.v1beta1.CodeVerificationEntry")
 }
 
 func init() {
@@ -281,41 +480,65 @@ func init() {
 }
 
 var fileDescriptor_e737d858048ffc2a = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4d, 0x6f, 0x12, 0x41,
-	0x18, 0xc7, 0x59, 0xba, 0x20, 0x1d, 0xd1, 0x9a, 0x91, 0x28, 0xa9, 0x76, 0x21, 0xd8, 0x03, 0x31,
-	0x96, 0x0d, 0x7a, 0xf3, 0xd6, 0xa5, 0x89, 0x41, 0xa2, 0x26, 0x8b, 0x27, 0x6d, 0x42, 0x96, 0x99,
-	0xa7, 0xb8, 0x01, 0x76, 0x70, 0x67, 0xa8, 0xee, 0xa7, 0xd0, 0x8b, 0xdf, 0xa9, 0xc7, 0x1e, 0x3d,
-	0x11, 0xb3, 0xdc, 0xfc, 0x08, 0x9e, 0xcc, 0xbc, 0xb0, 0xdd, 0x44, 0x69, 0x4f, 0xb0, 0xcf, 0xfc,
-	0x9f, 0xdf, 0xfc, 0x9f, 0x97, 0x41, 0x87, 0x1c, 0x48, 0x0c, 0xc2, 0x25, 0x6c, 0xbe, 0x58, 0x0a,
-	0x70, 0xcf, 0xbb, 0x63, 0x10, 0x41, 0xd7, 0x9d, 0x40, 0x04, 0x3c, 0xe4, 0x9d, 0x45, 0xcc, 0x04,
-	0xc3, 0x0f, 0xb4, 0xaa, 0x63, 0x54, 0x1d, 0xa3, 0xda, 0xaf, 0x4d, 0xd8, 0x84, 0x29, 0x89, 0x2b,
-	0xff, 0x69, 0xf5, 0x7e, 0x6b, 0x0b, 0x53, 0x24, 0x0b, 0x30, 0xc4, 0xd6, 0x8f, 0x22, 0xaa, 0xbe,
-	0xd2, 0x77, 0x0c, 0x45, 0x20, 0x00, 0x0f, 0x50, 0x89, 0x30, 0x0a, 0xbc, 0x5e, 0x6c, 0xee, 0xb4,
-	0x6f, 0x3f, 0x7f, 0xdc, 0xf9, 0xff, 0x95, 0x9d, 0x1e, 0xa3, 0xe0, 0x3d, 0xbc, 0x58, 0x35, 0x0a,
-	0xbf, 0x57, 0x8d, 0x3d, 0x95, 0xf2, 0x8c, 0xcd, 0x43, 0x01, 0xf3, 0x85, 0x48, 0x7c, 0xcd, 0xc0,
-	0x1f, 0xd1, 0x2e, 0x61, 0x91, 0x88, 0x03, 0x22, 0x78, 0x7d, 0x47, 0x01, 0x9b, 0xdb, 0x81, 0x5a,
-	0xe8, 0x3d, 0x32, 0xd0, 0xfb, 0x59, 0x6a, 0x0e, 0x7c, 0xc5, 0x93, 0x70, 0x0e, 0x9f, 0x97, 0x10,
-	0x11, 0xe0, 0x75, 0xfb, 0x7a, 0xf8, 0xd0, 0x08, 0xaf, 0xe0, 0x59, 0x6a, 0x1e, 0x9e, 0x05, 0x5b,
-	0xdf, 0x2c, 0x64, 0xcb, 0x12, 0xf1, 0x13, 0x74, 0x4b, 0xd6, 0x32, 0x0a, 0x69, 0xdd, 0x6a, 0x5a,
-	0x6d, 0xdb, 0x43, 0xe9, 0xaa, 0x51, 0x96, 0x47, 0xfd, 0x13, 0xbf, 0x2c, 0x8f, 0xfa, 0x14, 0xf7,
-	0x64, 0x9d, 0x52, 0x14, 0x9d, 0xb1, 0x7a, 0xb1, 0x69, 0x5d, 0x5f, 0x27, 0x85, 0x7e, 0x74, 0xc6,
-	0x3c, 0x5b, 0x5a, 0xf1, 0x2b, 0xc4, 0x7c, 0xe3, 0x03, 0x84, 0x14, 0x64, 0x9c, 0x08, 0x90, 0xdd,
-	0xb2, 0xda, 0x55, 0x5f, 0x61, 0x3d, 0x19, 0x68, 0xad, 0x8b, 0xa8, 0xb2, 0xe9, 0x11, 0x3e, 0x45,
-	0xf7, 0x36, 0x8d, 0x18, 0x05, 0x94, 0xc6, 0xc0, 0xb9, 0xb2, 0x57, 0xf5, 0xba, 0x7f, 0x56, 0x8d,
-	0xa3, 0x49, 0x28, 0x3e, 0x2d, 0xc7, 0xf2, 0x6a, 0x97, 0x30, 0x3e, 0x67, 0xdc, 0xfc, 0x1c, 0x71,
-	0x3a, 0x35, 0xe3, 0x3f, 0x26, 0xe4, 0x58, 0x27, 0xfa, 0x7b, 0x1b, 0x94, 0x09, 0xe0, 0x77, 0xe8,
-	0x4e, 0x46, 0xcf, 0x95, 0x74, 0x78, 0xd3, 0xe8, 0x72, 0x65, 0x55, 0x49, 0x2e, 0x86, 0x5f, 0xa3,
-	0xbb, 0x19, 0x90, 0xcb, 0x35, 0x33, 0xcb, 0x70, 0xb0, 0x8d, 0xf8, 0x86, 0x51, 0x98, 0x19, 0x54,
-	0xe6, 0x45, 0x2f, 0xe8, 0x29, 0xaa, 0x65, 0x2c, 0xb2, 0xe4, 0x82, 0xcd, 0xb5, 0x47, 0x5b, 0x79,
-	0x7c, 0x7a, 0x93, 0xc7, 0x9e, 0x4a, 0x91, 0xae, 0x7c, 0x4c, 0xfe, 0x89, 0xb5, 0x3c, 0x54, 0xd9,
-	0xec, 0x0a, 0x6e, 0xa2, 0x72, 0x48, 0x47, 0x53, 0x48, 0x4c, 0x6b, 0x77, 0xd3, 0x55, 0xa3, 0xd4,
-	0x3f, 0x19, 0x40, 0xe2, 0x97, 0x42, 0x3a, 0x80, 0x04, 0xd7, 0x50, 0xe9, 0x3c, 0x98, 0x2d, 0x41,
-	0x35, 0xc8, 0xf6, 0xf5, 0x87, 0xf7, 0xfe, 0x22, 0x75, 0xac, 0xcb, 0xd4, 0xb1, 0x7e, 0xa5, 0x8e,
-	0xf5, 0x7d, 0xed, 0x14, 0x2e, 0xd7, 0x4e, 0xe1, 0xe7, 0xda, 0x29, 0x7c, 0x78, 0x99, 0x1b, 0x0c,
-	0x27, 0xb1, 0x98, 0x05, 0x63, 0xee, 0x0e, 0x95, 0xe1, 0xb7, 0x20, 0xbe, 0xb0, 0x78, 0xea, 0x7e,
-	0xcd, 0x9e, 0x6b, 0x18, 0x09, 0x88, 0xa3, 0x60, 0xa6, 0x07, 0x36, 0x2e, 0xab, 0x07, 0xfb, 0xe2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xfb, 0xbf, 0x15, 0x2a, 0x04, 0x00, 0x00,
+	// 925 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0xb5, 0xbd, 0x4d, 0x26, 0x6e, 0xd3, 0x0e, 0x69, 0xbb, 0xb4, 0x8d, 0xed, 0x38,
+	0x41, 0x32, 0x88, 0xd8, 0x4a, 0xb9, 0x15, 0x2e, 0x59, 0x17, 0x70, 0x88, 0x80, 0x6a, 0x8d, 0x38,
+	0x40, 0x25, 0x6b, 0x3c, 0xfb, 0x6c, 0x8f, 0xbc, 0xde, 0x71, 0x77, 0xc6, 0x81, 0xbd, 0x72, 0xe2,
+	0xc8, 0x11, 0xf1, 0xdf, 0x70, 0xeb, 0xb1, 0x47, 0x4e, 0x16, 0x72, 0x6e, 0xfc, 0x09, 0x9c, 0xd0,
+	0xfc, 0xf0, 0x76, 0x69, 0x6b, 0xbb, 0xa7, 0x78, 0xdf, 0x7e, 0xbf, 0x9f, 0xf7, 0x32, 0xef, 0xcd,
+	0xd3, 0xa2, 0x63, 0x01, 0x34, 0x01, 0xd9, 0xa2, 0x7c, 0x32, 0x9d, 0x49, 0x68, 0x5d, 0x9e, 0xf6,
+	0x41, 0x92, 0xd3, 0xd6, 0x10, 0x62, 0x10, 0x4c, 0x34, 0xa7, 0x09, 0x97, 0x1c, 0xdf, 0x35, 0xaa,
+	0xa6, 0x55, 0x35, 0xad, 0xea, 0xfe, 0xfe, 0x90, 0x0f, 0xb9, 0x96, 0xb4, 0xd4, 0x2f, 0xa3, 0xbe,
+	0x5f, 0x5f, 0xc1, 0x94, 0xe9, 0x14, 0x2c, 0xb1, 0xfe, 0x7b, 0x09, 0x95, 0xbf, 0x34, 0x39, 0xba,
+	0x92, 0x48, 0xc0, 0x9f, 0x21, 0x77, 0x4a, 0x12, 0x32, 0x11, 0x9e, 0x53, 0x73, 0x1a, 0xbb, 0x8f,
+	0x2a, 0xcd, 0xb7, 0xe7, 0x6c, 0x3e, 0xd5, 0x2a, 0xbf, 0xf8, 0x62, 0x5e, 0xdd, 0x0a, 0xac, 0x07,
+	0x5f, 0xa0, 0x12, 0xe5, 0x21, 0x08, 0xef, 0x5a, 0xad, 0xd0, 0xd8, 0x7d, 0xf4, 0x70, 0x95, 0xb9,
+	0xcd, 0x43, 0xf0, 0xef, 0x29, 0xeb, 0x3f, 0xf3, 0xea, 0x9e, 0xb6, 0x7c, 0xcc, 0x27, 0x4c, 0xc2,
+	0x64, 0x2a, 0xd3, 0xc0, 0x30, 0xf0, 0x8f, 0x68, 0x87, 0xf2, 0x58, 0x26, 0x84, 0x4a, 0xe1, 0x15,
+	0x34, 0xb0, 0xb6, 0x1a, 0x68, 0x84, 0xfe, 0x03, 0x0b, 0x7d, 0x2f, 0xb3, 0xe6, 0xc0, 0xaf, 0x78,
+	0x0a, 0x2e, 0xe0, 0xf9, 0x0c, 0x62, 0x0a, 0xc2, 0x2b, 0xae, 0x87, 0x77, 0xad, 0xf0, 0x15, 0x3c,
+	0xb3, 0xe6, 0xe1, 0x59, 0x10, 0x3f, 0x47, 0x7b, 0x82, 0x8e, 0x20, 0x9c, 0x45, 0x10, 0xf6, 0x28,
+	0x89, 0x22, 0xe1, 0x95, 0x74, 0x8a, 0x0f, 0x56, 0xa6, 0x58, 0xca, 0xdb, 0x24, 0x8a, 0xfc, 0x43,
+	0x9b, 0xe7, 0xfd, 0xd7, 0x28, 0xb9, 0x6c, 0x37, 0x45, 0xde, 0x61, 0x4e, 0x3e, 0xe1, 0xb1, 0xf0,
+	0xdc, 0x0d, 0x27, 0x9f, 0xf0, 0x38, 0x77, 0xf2, 0xca, 0xf2, 0xbf, 0x93, 0x57, 0x01, 0xfc, 0x8b,
+	0x83, 0xb0, 0xea, 0x41, 0xef, 0x12, 0x12, 0x36, 0x60, 0x94, 0x48, 0xa6, 0xd0, 0xd7, 0x35, 0xfa,
+	0x64, 0x5d, 0x53, 0xbf, 0xcf, 0x19, 0x3e, 0x8f, 0x65, 0x92, 0xfa, 0xc7, 0x36, 0xd7, 0xc3, 0x37,
+	0x81, 0xb9, 0xc4, 0xb7, 0xe9, 0x6b, 0x66, 0x51, 0xff, 0xa3, 0x80, 0x8a, 0x0a, 0x89, 0x8f, 0xd0,
+	0x75, 0xed, 0x65, 0xa1, 0x9e, 0xc9, 0xa2, 0x8f, 0x16, 0xf3, 0xaa, 0xab, 0x5e, 0x9d, 0x3f, 0x09,
+	0x5c, 0xf5, 0xea, 0x3c, 0xc4, 0x6d, 0xb4, 0x63, 0x44, 0xf1, 0x80, 0x7b, 0xd7, 0x6a, 0xce, 0xba,
+	0x7e, 0x6a, 0x6b, 0x3c, 0xe0, 0x76, 0x78, 0xb7, 0xa9, 0x7d, 0xc6, 0x07, 0x08, 0x69, 0x48, 0x3f,
+	0x95, 0xa0, 0x46, 0xce, 0x69, 0x94, 0x03, 0x8d, 0xf5, 0x55, 0x00, 0x3f, 0x46, 0xae, 0x3a, 0xf5,
+	0x09, 0xf1, 0x8a, 0x3a, 0x41, 0x7d, 0x5d, 0x82, 0xae, 0x56, 0x06, 0xd6, 0x81, 0xbb, 0x08, 0xb3,
+	0x58, 0x48, 0x12, 0x4b, 0x46, 0x24, 0xf4, 0x28, 0x8f, 0x07, 0x6c, 0xe8, 0x95, 0x34, 0xe7, 0x78,
+	0x15, 0xe7, 0x8c, 0x52, 0x10, 0xa2, 0xad, 0xb5, 0xc1, 0xed, 0x9c, 0xdf, 0x84, 0x70, 0x17, 0xb9,
+	0x64, 0x16, 0x32, 0xb9, 0xec, 0xfa, 0xe1, 0xba, 0x82, 0xce, 0x94, 0xd2, 0xf7, 0x6c, 0x3b, 0x6e,
+	0x19, 0x63, 0xae, 0x05, 0x16, 0x85, 0x8f, 0xd0, 0x8d, 0x7e, 0xc2, 0xc7, 0x10, 0xf7, 0x46, 0xc0,
+	0x86, 0x23, 0xe9, 0x5d, 0xaf, 0x39, 0x8d, 0x42, 0x50, 0x36, 0xc1, 0x8e, 0x8e, 0xd5, 0xff, 0x2c,
+	0xa2, 0xed, 0xe5, 0x9d, 0xc3, 0xcf, 0xd0, 0xad, 0xe5, 0xc5, 0xea, 0x91, 0x30, 0x4c, 0x40, 0x98,
+	0xed, 0x51, 0xf6, 0x4f, 0xff, 0x9d, 0x57, 0x4f, 0x86, 0x4c, 0x8e, 0x66, 0x7d, 0x55, 0x53, 0x8b,
+	0x72, 0x31, 0xe1, 0xc2, 0xfe, 0x39, 0x11, 0xe1, 0xd8, 0x2e, 0xa3, 0x33, 0x4a, 0xcf, 0x8c, 0x31,
+	0xd8, 0x5b, 0xa2, 0x6c, 0x00, 0x7f, 0x8b, 0x6e, 0x64, 0xf4, 0x5c, 0x77, 0x8f, 0x37, 0xad, 0x82,
+	0x5c, 0x87, 0xcb, 0x34, 0x17, 0xc3, 0x5f, 0xa1, 0x9b, 0x19, 0x50, 0x48, 0x22, 0xc1, 0x2e, 0x97,
+	0x83, 0x55, 0xc4, 0xaf, 0x79, 0x08, 0x91, 0x45, 0x65, 0xb5, 0x98, 0x75, 0xf9, 0x0c, 0xed, 0x67,
+	0x2c, 0x3a, 0x13, 0x92, 0x4f, 0x4c, 0x8d, 0x66, 0x40, 0x3e, 0xda, 0x54, 0x63, 0x5b, 0x5b, 0x54,
+	0x55, 0x01, 0xa6, 0x6f, 0xc4, 0x70, 0x07, 0xa1, 0x01, 0x40, 0x6f, 0xca, 0x23, 0x46, 0x53, 0x3b,
+	0x2c, 0x1f, 0x6e, 0x62, 0x7e, 0x01, 0xf0, 0x54, 0x1b, 0x82, 0x9d, 0xc1, 0xf2, 0x27, 0x3e, 0x44,
+	0xe5, 0x04, 0x28, 0xb0, 0x4b, 0xe8, 0x8d, 0x38, 0x1f, 0x7b, 0x6e, 0xcd, 0x69, 0x6c, 0x07, 0xbb,
+	0x36, 0xd6, 0xe1, 0x7c, 0x8c, 0x39, 0xda, 0x1d, 0x43, 0xda, 0x1b, 0x31, 0x21, 0x79, 0x92, 0xda,
+	0xcb, 0xde, 0xda, 0x94, 0xed, 0x02, 0xd2, 0x8e, 0x71, 0x98, 0xeb, 0x7e, 0x60, 0xe7, 0xeb, 0x4e,
+	0x8e, 0x95, 0x1b, 0x32, 0x34, 0xce, 0xf4, 0xf5, 0x18, 0xdd, 0x5b, 0x41, 0xc1, 0x77, 0x91, 0x6b,
+	0x87, 0xcf, 0xd1, 0xc3, 0x67, 0x9f, 0xf0, 0xa7, 0xa8, 0x30, 0x86, 0xd4, 0x4e, 0xc0, 0xd1, 0x3b,
+	0xd4, 0x66, 0xbb, 0xa6, 0x5c, 0x75, 0x1f, 0x6d, 0x2f, 0x37, 0x39, 0xae, 0x21, 0x97, 0x85, 0x3d,
+	0xc5, 0x32, 0x83, 0xba, 0xb3, 0x98, 0x57, 0x4b, 0xe7, 0x4f, 0x2e, 0x20, 0x0d, 0x4a, 0x2c, 0xbc,
+	0x80, 0x14, 0xef, 0xa3, 0xd2, 0x25, 0x89, 0x66, 0xa0, 0x93, 0x15, 0x03, 0xf3, 0x50, 0xff, 0xd5,
+	0x41, 0x77, 0xde, 0xba, 0xe7, 0xf0, 0x03, 0xbb, 0x80, 0x46, 0x44, 0x8c, 0x0c, 0xd4, 0x2c, 0x96,
+	0x0e, 0x11, 0x23, 0x1c, 0xa0, 0x72, 0x7e, 0xf3, 0xd9, 0x7f, 0xa0, 0xf1, 0xae, 0x9b, 0x74, 0x39,
+	0xc6, 0x79, 0x86, 0xff, 0xdd, 0x8b, 0x45, 0xc5, 0x79, 0xb9, 0xa8, 0x38, 0x7f, 0x2f, 0x2a, 0xce,
+	0x6f, 0x57, 0x95, 0xad, 0x97, 0x57, 0x95, 0xad, 0xbf, 0xae, 0x2a, 0x5b, 0x3f, 0x3c, 0xce, 0xdd,
+	0x38, 0x41, 0x13, 0x19, 0x91, 0xbe, 0x68, 0x75, 0x75, 0xaa, 0x6f, 0x40, 0xfe, 0xc4, 0x93, 0x71,
+	0xeb, 0xe7, 0xec, 0xab, 0x80, 0xc5, 0x12, 0x92, 0x98, 0x44, 0xe6, 0x26, 0xf6, 0x5d, 0xfd, 0x5d,
+	0xf0, 0xc9, 0x7f, 0x03, 0x00, 0x2d, 0x4a, 0x97, 0xdb, 0x91, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -338,6 +561,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CodeVerifications) > 0 {
+		for iNdEx := len(m.CodeVerifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeVerifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Crons) > 0 {
+		for iNdEx := len(m.Crons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Crons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -380,6 +645,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -403,6 +678,49 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BrokenHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BrokenHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Audits) > 0 {
+		for iNdEx := len(m.Audits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Audits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.InstantiateConfig != nil {
+		{
+			size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.CodeBytes) > 0 {
 		i -= len(m.CodeBytes)
 		copy(dAtA[i:], m.CodeBytes)
@@ -448,6 +766,42 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyHistory) > 0 {
+		for iNdEx := len(m.KeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ReceiveHook {
+		i--
+		if m.ReceiveHook {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeePolicy != nil {
+		{
+			size, err := m.FeePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ContractCustomInfo != nil {
 		{
 			size, err := m.ContractCustomInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -494,6 +848,44 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractKeyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractKeyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractKeyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Key.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Sequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -529,23 +921,65 @@ func (m *Sequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CodeVerificationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
+
+func (m *CodeVerificationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeVerificationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	if len(m.Codes) > 0 {
 		for _, e := range m.Codes {
 			l = e.Size()
@@ -564,6 +998,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledCalls) > 0 {
+		for _, e := range m.ScheduledCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Crons) > 0 {
+		for _, e := range m.Crons {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodeVerifications) > 0 {
+		for _, e := range m.CodeVerifications {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -582,6 +1034,23 @@ func (m *Code) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InstantiateConfig != nil {
+		l = m.InstantiateConfig.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Audits) > 0 {
+		for _, e := range m.Audits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BrokenHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BrokenHeight))
+	}
 	return n
 }
 
@@ -607,6 +1076,33 @@ func (m *Contract) Size() (n int) {
 		l = m.ContractCustomInfo.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.FeePolicy != nil {
+		l = m.FeePolicy.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ReceiveHook {
+		n += 2
+	}
+	if len(m.KeyHistory) > 0 {
+		for _, e := range m.KeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractKeyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.Key.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -626,6 +1122,21 @@ func (m *Sequence) Size() (n int) {
 	return n
 }
 
+func (m *CodeVerificationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Verification.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -661,6 +1172,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
@@ -763,61 +1307,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Code) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Code: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCalls", wireType)
 			}
-			m.CodeID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -827,14 +1321,29 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCalls = append(m.ScheduledCalls, ScheduledCall{})
+			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crons", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -861,15 +1370,16 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Crons = append(m.Crons, Cron{})
+			if err := m.Crons[len(m.Crons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeVerifications", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -879,24 +1389,24 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeBytes == nil {
-				m.CodeBytes = []byte{}
+			m.CodeVerifications = append(m.CodeVerifications, CodeVerificationEntry{})
+			if err := m.CodeVerifications[len(m.CodeVerifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -920,7 +1430,7 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *Code) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,17 +1453,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: Code: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Code: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
 			}
-			var byteLen int
+			m.CodeID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -963,29 +1473,14 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CodeID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = append(m.ContractAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContractAddress == nil {
-				m.ContractAddress = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1012,15 +1507,15 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CodeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1030,29 +1525,341 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractState = append(m.ContractState, Model{})
-			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &CodeSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiateConfig == nil {
+				m.InstantiateConfig = &AccessConfig{}
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audits = append(m.Audits, CodeAudit{})
+			if err := m.Audits[len(m.Audits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenHeight", wireType)
+			}
+			m.BrokenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BrokenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = append(m.ContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractAddress == nil {
+				m.ContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractState = append(m.ContractState, Model{})
+			if err := m.ContractState[len(m.ContractState)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractCustomInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCustomInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractCustomInfo == nil {
+				m.ContractCustomInfo = &ContractCustomInfo{}
+			}
+			if err := m.ContractCustomInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1079,10 +1886,166 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractCustomInfo == nil {
-				m.ContractCustomInfo = &ContractCustomInfo{}
+			if m.FeePolicy == nil {
+				m.FeePolicy = &ContractFeePolicy{}
 			}
-			if err := m.ContractCustomInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveHook", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReceiveHook = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHistory = append(m.KeyHistory, ContractKeyHistoryEntry{})
+			if err := m.KeyHistory[len(m.KeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractKeyHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractKeyHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractKeyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1210,6 +2173,123 @@ func (m *Sequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CodeVerificationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeVerificationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeVerificationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,14 +15,12 @@ func TestValidateGenesisState(t *testing.T) {
 		"all good": {
 			srcMutator: func(s *GenesisState) {},
 		},
-		/*
-			"params invalid": {
-				srcMutator: func(s *GenesisState) {
-					s.Params = Params{}
-				},
-				expError: true,
-			},
-		*/
+		"params invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Params = Params{AllowedDepositDenoms: []string{"uscrt", "uscrt"}}
+			},
+			expError: true,
+		},
 		"codeinfo invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Codes[0].CodeInfo.CodeHash = nil
@@ -35,6 +33,12 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"duplicate contract label": {
+			srcMutator: func(s *GenesisState) {
+				s.Contracts[1].ContractInfo.Label = s.Contracts[0].ContractInfo.Label
+			},
+			expError: true,
+		},
 		"sequence invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Sequences[0].IDKey = nil
@@ -106,6 +110,9 @@ func TestCodeValidateBasic(t *testing.T) {
 }
 
 func TestContractValidateBasic(t *testing.T) {
+	ogKey := ContractKey{OgContractKey: []byte("og key")}
+	migratedKey := ContractKey{OgContractKey: []byte("og key"), CurrentContractKey: []byte("migrated key"), CurrentContractKeyProof: []byte("proof")}
+
 	specs := map[string]struct {
 		srcMutator func(*Contract)
 		expError   bool
@@ -135,6 +142,56 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contract state sorted": {
+			srcMutator: func(c *Contract) {
+				c.ContractState = append(c.ContractState, Model{Key: []byte("anyKey2"), Value: []byte("anyValue")})
+			},
+		},
+		"contract state not sorted": {
+			srcMutator: func(c *Contract) {
+				c.ContractState = append([]Model{{Key: []byte("otherKey"), Value: []byte("anyValue")}}, c.ContractState...)
+			},
+			expError: true,
+		},
+		"contract state duplicate key": {
+			srcMutator: func(c *Contract) {
+				c.ContractState = append(c.ContractState, c.ContractState[0])
+			},
+			expError: true,
+		},
+		"key history": {
+			srcMutator: func(c *Contract) {
+				c.ContractCustomInfo = &ContractCustomInfo{EnclaveKey: &migratedKey}
+				c.KeyHistory = []ContractKeyHistoryEntry{{Height: 5, Key: ogKey}, {Height: 10, Key: migratedKey}}
+			},
+		},
+		"key history negative height": {
+			srcMutator: func(c *Contract) {
+				c.ContractCustomInfo = &ContractCustomInfo{EnclaveKey: &ogKey}
+				c.KeyHistory = []ContractKeyHistoryEntry{{Height: -1, Key: ogKey}}
+			},
+			expError: true,
+		},
+		"key history not sorted": {
+			srcMutator: func(c *Contract) {
+				c.ContractCustomInfo = &ContractCustomInfo{EnclaveKey: &migratedKey}
+				c.KeyHistory = []ContractKeyHistoryEntry{{Height: 10, Key: ogKey}, {Height: 10, Key: migratedKey}}
+			},
+			expError: true,
+		},
+		"key history without the contract key": {
+			srcMutator: func(c *Contract) {
+				c.ContractCustomInfo = &ContractCustomInfo{EnclaveKey: &migratedKey}
+				c.KeyHistory = []ContractKeyHistoryEntry{{Height: 5, Key: ogKey}}
+			},
+			expError: true,
+		},
+		"key history without custom info": {
+			srcMutator: func(c *Contract) {
+				c.KeyHistory = []ContractKeyHistoryEntry{{Height: 5, Key: ogKey}}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ComputeHooks lets other modules react to contract lifecycle events. Hooks run in the same
+// context as the operation that triggered them, so any state they write is reverted along with it.
+type ComputeHooks interface {
+	// AfterContractInstantiated is called once a new contract instance has been persisted
+	AfterContractInstantiated(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, creator sdk.AccAddress)
+	// AfterContractExecuted is called after a contract executed successfully, before its messages are dispatched
+	AfterContractExecuted(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress)
+	// AfterContractMigrated is called once a contract has been switched to newCodeID
+	AfterContractMigrated(ctx sdk.Context, contractAddress sdk.AccAddress, oldCodeID uint64, newCodeID uint64)
+}
+
+var _ ComputeHooks = MultiComputeHooks{}
+
+// MultiComputeHooks combines multiple compute hooks, all hook functions are run in array sequence
+type MultiComputeHooks []ComputeHooks
+
+func NewMultiComputeHooks(hooks ...ComputeHooks) MultiComputeHooks {
+	return hooks
+}
+
+func (h MultiComputeHooks) AfterContractInstantiated(ctx sdk.Context, contractAddress sdk.AccAddress, codeID uint64, creator sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterContractInstantiated(ctx, contractAddress, codeID, creator)
+	}
+}
+
+func (h MultiComputeHooks) AfterContractExecuted(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterContractExecuted(ctx, contractAddress, caller)
+	}
+}
+
+func (h MultiComputeHooks) AfterContractMigrated(ctx sdk.Context, contractAddress sdk.AccAddress, oldCodeID uint64, newCodeID uint64) {
+	for i := range h {
+		h[i].AfterContractMigrated(ctx, contractAddress, oldCodeID, newCodeID)
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingHooks struct {
+	calls *[]string
+	name  string
+}
+
+func (h recordingHooks) AfterContractInstantiated(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ sdk.AccAddress) {
+	*h.calls = append(*h.calls, h.name+":instantiated")
+}
+
+func (h recordingHooks) AfterContractExecuted(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress) {
+	*h.calls = append(*h.calls, h.name+":executed")
+}
+
+func (h recordingHooks) AfterContractMigrated(_ sdk.Context, _ sdk.AccAddress, _ uint64, _ uint64) {
+	*h.calls = append(*h.calls, h.name+":migrated")
+}
+
+func TestMultiComputeHooks(t *testing.T) {
+	var calls []string
+	hooks := NewMultiComputeHooks(recordingHooks{&calls, "a"}, recordingHooks{&calls, "b"})
+
+	hooks.AfterContractInstantiated(sdk.Context{}, nil, 1, nil)
+	hooks.AfterContractExecuted(sdk.Context{}, nil, nil)
+	hooks.AfterContractMigrated(sdk.Context{}, nil, 1, 2)
+
+	assert.Equal(t, []string{
+		"a:instantiated", "b:instantiated",
+		"a:executed", "b:executed",
+		"a:migrated", "b:migrated",
+	}, calls)
+}
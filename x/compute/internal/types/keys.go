@@ -33,10 +33,27 @@ var (
 	TXCounterPrefix                                = []byte{0x08}
 	ContractCodeHistoryElementPrefix               = []byte{0x09}
 	ContractByCodeIDAndCreatedSecondaryIndexPrefix = []byte{0x0A}
+	ContractKeyHistoryPrefix                       = []byte{0x0B}
+	ContractReceiveHookPrefix                      = []byte{0x0C}
+	ScheduledCallPrefix                            = []byte{0x0D}
+	ScheduledCallHeightPrefix                      = []byte{0x0E}
+	CronPrefix                                     = []byte{0x0F}
+	CronHeightPrefix                               = []byte{0x10}
+	CronByContractPrefix                           = []byte{0x11}
+	ContractFeePolicyPrefix                        = []byte{0x12}
+	CodeSchemaPrefix                               = []byte{0x13}
+	CodeInstantiateConfigPrefix                    = []byte{0x14}
+	CodeVerificationPrefix                         = []byte{0x15}
+	CodeAuditPrefix                                = []byte{0x16}
+	ContractBlockGasPrefix                         = []byte{0x17}
+	ContractDailyGasPrefix                         = []byte{0x18}
+	BrokenCodePrefix                               = []byte{0x19}
 	RandomPrefix                                   = []byte{0xFF}
 
-	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
-	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastCodeID          = append(SequenceKeyPrefix, []byte("lastCodeId")...)
+	KeyLastInstanceID      = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastScheduledCallID = append(SequenceKeyPrefix, []byte("lastScheduledCallId")...)
+	KeyLastCronID          = append(SequenceKeyPrefix, []byte("lastCronId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -118,3 +135,128 @@ func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) [
 	copy(r[prefixLen:], sdk.Uint64ToBigEndian(pos))
 	return r
 }
+
+// GetContractKeyHistoryPrefix returns the key prefix for the enclave key history of a contract: `<prefix><contractAddr>`
+func GetContractKeyHistoryPrefix(contractAddr sdk.AccAddress) []byte {
+	prefixLen := len(ContractKeyHistoryPrefix)
+	contractAddrLen := len(contractAddr)
+	r := make([]byte, prefixLen+contractAddrLen)
+	copy(r[0:], ContractKeyHistoryPrefix)
+	copy(r[prefixLen:], contractAddr)
+	return r
+}
+
+// GetContractReceiveHookKey returns the key marking a contract as registered for bank receive hooks
+func GetContractReceiveHookKey(addr sdk.AccAddress) []byte {
+	return append(ContractReceiveHookPrefix, addr...)
+}
+
+// GetContractFeePolicyKey returns the key of the fee policy of a contract
+func GetContractFeePolicyKey(addr sdk.AccAddress) []byte {
+	return append(ContractFeePolicyPrefix, addr...)
+}
+
+// GetCodeSchemaKey returns the key of the JSON schema of a code
+func GetCodeSchemaKey(codeID uint64) []byte {
+	return append(CodeSchemaPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeInstantiateConfigKey returns the key of the instantiate permission of a code
+func GetCodeInstantiateConfigKey(codeID uint64) []byte {
+	return append(CodeInstantiateConfigPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetBrokenCodeKey returns the key of the height at which a code was flagged broken
+func GetBrokenCodeKey(codeID uint64) []byte {
+	return append(BrokenCodePrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeAuditPrefix returns the prefix of the audit attestations of a code
+func GetCodeAuditPrefix(codeID uint64) []byte {
+	return append(CodeAuditPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodeAuditKey returns the key of the audit attestation of an auditor: `<prefix><codeID><auditor>`
+func GetCodeAuditKey(codeID uint64, auditor sdk.AccAddress) []byte {
+	return append(GetCodeAuditPrefix(codeID), auditor...)
+}
+
+// GetContractBlockGasPrefix returns the prefix of the gas used by contracts in a block
+func GetContractBlockGasPrefix(height int64) []byte {
+	return append(ContractBlockGasPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetContractBlockGasKey returns the key of the gas used by a contract in a block: `<prefix><height><contractAddr>`
+func GetContractBlockGasKey(height int64, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractBlockGasPrefix(height), contractAddr...)
+}
+
+// GetContractDailyGasPrefix returns the prefix of the gas used by contracts in a day, see GasUsageDay
+func GetContractDailyGasPrefix(day int64) []byte {
+	return append(ContractDailyGasPrefix, sdk.Uint64ToBigEndian(uint64(day))...)
+}
+
+// GetContractDailyGasKey returns the key of the gas used by a contract in a day: `<prefix><day><contractAddr>`
+func GetContractDailyGasKey(day int64, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractDailyGasPrefix(day), contractAddr...)
+}
+
+// GetCodeVerificationPrefix returns the prefix of the verification claims attached to a code hash
+func GetCodeVerificationPrefix(codeHash []byte) []byte {
+	return append(CodeVerificationPrefix, codeHash...)
+}
+
+// GetCodeVerificationKey returns the key of the verification claim of a verifier: `<prefix><codeHash><verifier>`
+func GetCodeVerificationKey(codeHash []byte, verifier sdk.AccAddress) []byte {
+	return append(GetCodeVerificationPrefix(codeHash), verifier...)
+}
+
+// GetContractKeyHistoryKey returns the key for the enclave key a contract had since the given height: `<prefix><contractAddr><height>`
+func GetContractKeyHistoryKey(contractAddr sdk.AccAddress, height int64) []byte {
+	prefix := GetContractKeyHistoryPrefix(contractAddr)
+	prefixLen := len(prefix)
+	r := make([]byte, prefixLen+8)
+	copy(r[0:], prefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(uint64(height)))
+	return r
+}
+
+// GetScheduledCallKey returns the key of a scheduled call. Calls are ordered by execution height, then id.
+func GetScheduledCallKey(height int64, id uint64) []byte {
+	return append(GetScheduledCallHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetScheduledCallHeightPrefix returns the prefix of the calls scheduled at the given height
+func GetScheduledCallHeightPrefix(height int64) []byte {
+	return append(ScheduledCallPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetScheduledCallHeightKey returns the key of the execution height of a scheduled call
+func GetScheduledCallHeightKey(id uint64) []byte {
+	return append(ScheduledCallHeightPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCronKey returns the key of a cron in the execution queue. Crons are ordered by next execution height, then id.
+func GetCronKey(height int64, id uint64) []byte {
+	return append(GetCronHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCronHeightPrefix returns the prefix of the crons due at the given height
+func GetCronHeightPrefix(height int64) []byte {
+	return append(CronPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCronHeightKey returns the key of the next execution height of a cron
+func GetCronHeightKey(id uint64) []byte {
+	return append(CronHeightPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetCronByContractPrefix returns the prefix of the crons registered for a contract
+func GetCronByContractPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(CronByContractPrefix, contractAddr...)
+}
+
+// GetCronByContractKey returns the key of a cron in the index of the crons registered for a contract
+func GetCronByContractKey(contractAddr sdk.AccAddress, id uint64) []byte {
+	return append(GetCronByContractPrefix(contractAddr), sdk.Uint64ToBigEndian(id)...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -64,6 +66,10 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateMsg(msg.InitMsg); err != nil {
+		return sdkerrors.Wrap(err, "init msg")
+	}
+
 	if !msg.InitFunds.IsValid() {
 		return sdkerrors.ErrInvalidCoins
 	}
@@ -79,6 +85,122 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgStoreCodeAndInstantiate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStoreCodeAndInstantiate) Type() string {
+	return "store-code-and-instantiate"
+}
+
+func (msg MsgStoreCodeAndInstantiate) ValidateBasic() error {
+	store := MsgStoreCode{
+		Sender:       msg.Sender,
+		WASMByteCode: msg.WASMByteCode,
+		Source:       msg.Source,
+		Builder:      msg.Builder,
+	}
+	if err := store.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := validateLabel(msg.Label); err != nil {
+		return err
+	}
+
+	if err := validateMsg(msg.InitMsg); err != nil {
+		return sdkerrors.Wrap(err, "init msg")
+	}
+
+	if !msg.InitFunds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgStoreCodeAndInstantiate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStoreCodeAndInstantiate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBatchInstantiate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgBatchInstantiate) Type() string {
+	return "batch-instantiate"
+}
+
+func (msg MsgBatchInstantiate) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "code_id is required")
+	}
+
+	if len(msg.Instances) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "instances")
+	}
+	if len(msg.Instances) > MaxBatchInstances {
+		return sdkerrors.Wrapf(ErrLimit, "cannot instantiate more than %d instances", MaxBatchInstances)
+	}
+
+	labels := make(map[string]bool, len(msg.Instances))
+	for i, instance := range msg.Instances {
+		if err := instance.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "instance %d", i)
+		}
+		if labels[instance.Label] {
+			return sdkerrors.Wrapf(ErrDuplicate, "label %s", instance.Label)
+		}
+		labels[instance.Label] = true
+	}
+
+	return nil
+}
+
+func (msg MsgBatchInstantiate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgBatchInstantiate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (i BatchInstance) ValidateBasic() error {
+	if err := validateLabel(i.Label); err != nil {
+		return err
+	}
+
+	if err := validateMsg(i.InitMsg); err != nil {
+		return sdkerrors.Wrap(err, "init msg")
+	}
+
+	if !i.InitFunds.IsValid() {
+		return sdkerrors.ErrInvalidCoins
+	}
+
+	if i.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(i.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+
+	return nil
+}
+
 func (msg MsgExecuteContract) Route() string {
 	return RouterKey
 }
@@ -94,10 +216,16 @@ func (msg MsgExecuteContract) ValidateBasic() error {
 	if err := sdk.VerifyAddressFormat(msg.Contract); err != nil {
 		return err
 	}
+	if err := validateMsg(msg.Msg); err != nil {
+		return sdkerrors.Wrap(err, "msg")
+	}
 
 	if !msg.SentFunds.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "sentFunds")
 	}
+	if msg.ExpiresAtHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "expires at height")
+	}
 
 	return nil
 }
@@ -128,6 +256,9 @@ func (msg MsgMigrateContract) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
 		return sdkerrors.Wrap(err, "contract")
 	}
+	if err := validateMsg(msg.Msg); err != nil {
+		return sdkerrors.Wrap(err, "msg")
+	}
 
 	return nil
 }
@@ -214,3 +345,412 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgSetContractReceiveHook) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractReceiveHook) Type() string {
+	return "set-contract-receive-hook"
+}
+
+func (msg MsgSetContractReceiveHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgSetContractReceiveHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetContractReceiveHook) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetContractFeePolicy) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractFeePolicy) Type() string {
+	return "set-contract-fee-policy"
+}
+
+func (msg MsgSetContractFeePolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	policy := ContractFeePolicy{MinFee: msg.MinFee}
+	return policy.ValidateBasic()
+}
+
+func (msg MsgSetContractFeePolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetContractFeePolicy) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetCodeSchema) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeSchema) Type() string {
+	return "set-code-schema"
+}
+
+func (msg MsgSetCodeSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	return msg.Schema.ValidateBasic()
+}
+
+func (msg MsgSetCodeSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCodeSchema) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAddCodeVerification) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAddCodeVerification) Type() string {
+	return "add-code-verification"
+}
+
+func (msg MsgAddCodeVerification) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.CodeHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "code hash must be %d bytes", sha256.Size)
+	}
+	if msg.Source == "" {
+		return sdkerrors.Wrap(ErrEmpty, "source")
+	}
+	if err := validateSourceURL(msg.Source); err != nil {
+		return sdkerrors.Wrap(err, "source")
+	}
+	if err := validateCommit(msg.Commit); err != nil {
+		return sdkerrors.Wrap(err, "commit")
+	}
+	if msg.Builder == "" {
+		return sdkerrors.Wrap(ErrEmpty, "builder")
+	}
+	if err := validateBuilder(msg.Builder); err != nil {
+		return sdkerrors.Wrap(err, "builder")
+	}
+	return nil
+}
+
+func (msg MsgAddCodeVerification) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddCodeVerification) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAddCodeAudit) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAddCodeAudit) Type() string {
+	return "add-code-audit"
+}
+
+func (msg MsgAddCodeAudit) ValidateBasic() error {
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	audit := CodeAudit{
+		Auditor:    msg.Sender,
+		ReportHash: msg.ReportHash,
+		ReportURI:  msg.ReportURI,
+		AuditedAt:  msg.AuditedAt,
+		Scope:      msg.Scope,
+	}
+	return audit.ValidateBasic()
+}
+
+func (msg MsgAddCodeAudit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddCodeAudit) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateInstantiateConfig) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateInstantiateConfig) Type() string {
+	return "update-instantiate-config"
+}
+
+func (msg MsgUpdateInstantiateConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	return sdkerrors.Wrap(msg.NewInstantiatePermission.ValidateBasic(), "instantiate permission")
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateInstantiateConfig) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgWrapCoin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgWrapCoin) Type() string {
+	return "wrap-coin"
+}
+
+func (msg MsgWrapCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg MsgWrapCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWrapCoin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUnwrapCoin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnwrapCoin) Type() string {
+	return "unwrap-coin"
+}
+
+func (msg MsgUnwrapCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg MsgUnwrapCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnwrapCoin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgScheduleExecute) Route() string {
+	return RouterKey
+}
+
+func (msg MsgScheduleExecute) Type() string {
+	return "schedule-execute"
+}
+
+func (msg MsgScheduleExecute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(msg.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg must be json")
+	}
+	if !msg.SentFunds.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "sentFunds")
+	}
+	if msg.ExecuteHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "execute height")
+	}
+	if msg.GasLimit == 0 || msg.GasLimit > MaxScheduledCallGasLimit {
+		return sdkerrors.Wrapf(ErrInvalid, "gas limit must be between 1 and %d", MaxScheduledCallGasLimit)
+	}
+	if !msg.Fee.IsValid() || msg.Fee.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	return nil
+}
+
+func (msg MsgScheduleExecute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleExecute) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelScheduledExecute) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelScheduledExecute) Type() string {
+	return "cancel-scheduled-execute"
+}
+
+func (msg MsgCancelScheduledExecute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	return nil
+}
+
+func (msg MsgCancelScheduledExecute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelScheduledExecute) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgRegisterCron) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRegisterCron) Type() string {
+	return "register-cron"
+}
+
+func (msg MsgRegisterCron) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !json.Valid(msg.Msg) {
+		return sdkerrors.Wrap(ErrInvalid, "msg must be json")
+	}
+	if msg.Interval <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "interval")
+	}
+	// the upper bound of the gas limit is a param, it is checked by the keeper
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	// the min budget depends on a param, it is checked by the keeper
+	if !msg.Budget.IsValid() || msg.Budget.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "budget")
+	}
+	return nil
+}
+
+func (msg MsgRegisterCron) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterCron) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelCron) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelCron) Type() string {
+	return "cancel-cron"
+}
+
+func (msg MsgCancelCron) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	return nil
+}
+
+func (msg MsgCancelCron) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelCron) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -173,6 +175,24 @@ func TestInstantiateContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"binary label": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo\x00\xff",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"label with control character": {
+			msg: MsgInstantiateContract{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Label:   "foo\nbar",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
 		"bad sender minimal": {
 			msg: MsgInstantiateContract{
 				Sender:  badAddress,
@@ -236,6 +256,249 @@ func TestInstantiateContractValidation(t *testing.T) {
 	}
 }
 
+func TestStoreCodeAndInstantiateValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20))
+
+	cases := map[string]struct {
+		msg   MsgStoreCodeAndInstantiate
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgStoreCodeAndInstantiate{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte("{}"),
+			},
+			valid: true,
+		},
+		"correct maximal": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Builder:      "confio/cosmwasm-opt:0.6.2",
+				Source:       "https://crates.io/api/v1/crates/cw-erc20/0.1.0/download",
+				Label:        "foo",
+				InitMsg:      []byte(`{"some": "data"}`),
+				InitFunds:    sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+				Admin:        goodAddress.String(),
+			},
+			valid: true,
+		},
+		"missing code": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:  goodAddress,
+				Label:   "foo",
+				InitMsg: []byte("{}"),
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				InitMsg:      []byte("{}"),
+			},
+			valid: false,
+		},
+		"negative funds": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte("{}"),
+				InitFunds:    sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(-200)}},
+			},
+			valid: false,
+		},
+		"bad admin": {
+			msg: MsgStoreCodeAndInstantiate{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Label:        "foo",
+				InitMsg:      []byte("{}"),
+				Admin:        "foo",
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBatchInstantiateValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20))
+
+	tooMany := make([]BatchInstance, MaxBatchInstances+1)
+	for i := range tooMany {
+		tooMany[i] = BatchInstance{Label: fmt.Sprintf("foo-%d", i), InitMsg: []byte("{}")}
+	}
+
+	cases := map[string]struct {
+		msg   MsgBatchInstantiate
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgBatchInstantiate{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgBatchInstantiate{
+				Sender: goodAddress,
+				CodeID: 1,
+				Instances: []BatchInstance{
+					{Label: "foo", InitMsg: []byte("{}")},
+					{
+						Label:     "bar",
+						InitMsg:   []byte(`{"some": "data"}`),
+						InitFunds: sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}},
+						Admin:     goodAddress.String(),
+					},
+				},
+			},
+			valid: true,
+		},
+		"missing code id": {
+			msg: MsgBatchInstantiate{
+				Sender:    goodAddress,
+				Instances: []BatchInstance{{Label: "foo", InitMsg: []byte("{}")}},
+			},
+			valid: false,
+		},
+		"no instances": {
+			msg: MsgBatchInstantiate{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+			valid: false,
+		},
+		"too many instances": {
+			msg: MsgBatchInstantiate{
+				Sender:    goodAddress,
+				CodeID:    1,
+				Instances: tooMany,
+			},
+			valid: false,
+		},
+		"duplicate label": {
+			msg: MsgBatchInstantiate{
+				Sender: goodAddress,
+				CodeID: 1,
+				Instances: []BatchInstance{
+					{Label: "foo", InitMsg: []byte("{}")},
+					{Label: "foo", InitMsg: []byte("{}")},
+				},
+			},
+			valid: false,
+		},
+		"missing label": {
+			msg: MsgBatchInstantiate{
+				Sender:    goodAddress,
+				CodeID:    1,
+				Instances: []BatchInstance{{InitMsg: []byte("{}")}},
+			},
+			valid: false,
+		},
+		"bad admin": {
+			msg: MsgBatchInstantiate{
+				Sender:    goodAddress,
+				CodeID:    1,
+				Instances: []BatchInstance{{Label: "foo", InitMsg: []byte("{}"), Admin: "foo"}},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAddCodeVerificationValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	codeHash := bytes.Repeat([]byte{1}, 32)
+	source := "https://github.com/scrtlabs/secret-template"
+	commit := "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	builder := "enigmampc/secret-contract-optimizer:1.0.10"
+
+	cases := map[string]struct {
+		msg   MsgAddCodeVerification
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgAddCodeVerification{},
+			valid: false,
+		},
+		"correct": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Commit: commit, Builder: builder},
+			valid: true,
+		},
+		"short code hash": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash[:20], Source: source, Commit: commit, Builder: builder},
+			valid: false,
+		},
+		"missing source": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Commit: commit, Builder: builder},
+			valid: false,
+		},
+		"source not https": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: "http://github.com/foo", Commit: commit, Builder: builder},
+			valid: false,
+		},
+		"missing commit": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Builder: builder},
+			valid: false,
+		},
+		"commit with spaces": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Commit: "v1 final", Builder: builder},
+			valid: false,
+		},
+		"commit too long": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Commit: strings.Repeat("a", MaxCommitSize+1), Builder: builder},
+			valid: false,
+		},
+		"missing builder": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Commit: commit},
+			valid: false,
+		},
+		"builder without tag": {
+			msg:   MsgAddCodeVerification{Sender: goodAddress, CodeHash: codeHash, Source: source, Commit: commit, Builder: "enigmampc/secret-contract-optimizer"},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestExecuteContractValidation(t *testing.T) {
 	badAddress := sdk.AccAddress(make([]byte, 2000))
 	// require.NoError(t, err)
@@ -290,6 +553,14 @@ func TestExecuteContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"msg too large": {
+			msg: MsgExecuteContract{
+				Sender:   goodAddress,
+				Contract: goodAddress,
+				Msg:      make([]byte, MaxMsgSize+1),
+			},
+			valid: false,
+		},
 		"empty contract": {
 			msg: MsgExecuteContract{
 				Sender: goodAddress,
@@ -315,6 +586,24 @@ func TestExecuteContractValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with expiration height": {
+			msg: MsgExecuteContract{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				Msg:             []byte(`{"some": "data"}`),
+				ExpiresAtHeight: 100,
+			},
+			valid: true,
+		},
+		"negative expiration height": {
+			msg: MsgExecuteContract{
+				Sender:          goodAddress,
+				Contract:        goodAddress,
+				Msg:             []byte(`{"some": "data"}`),
+				ExpiresAtHeight: -1,
+			},
+			valid: false,
+		},
 		/*
 			"non json msg": {
 				msg: MsgExecuteContract{
@@ -345,3 +634,138 @@ func TestExecuteContractValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestWrapCoinValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgWrapCoin
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgWrapCoin{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgWrapCoin{
+				Sender: goodAddress,
+				Amount: sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: true,
+		},
+		"zero amount": {
+			msg: MsgWrapCoin{
+				Sender: goodAddress,
+				Amount: sdk.NewInt64Coin("uscrt", 0),
+			},
+			valid: false,
+		},
+		"bad sender": {
+			msg: MsgWrapCoin{
+				Sender: "foo",
+				Amount: sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			// MsgUnwrapCoin has the same fields and validation
+			err = MsgUnwrapCoin(tc.msg).ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduleExecuteValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgScheduleExecute
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgScheduleExecute{},
+			valid: false,
+		},
+		"correct": {
+			msg: MsgScheduleExecute{
+				Sender:        goodAddress,
+				Contract:      contract,
+				Msg:           []byte(`{"some": "data"}`),
+				SentFunds:     sdk.NewCoins(sdk.NewInt64Coin("uscrt", 5)),
+				ExecuteHeight: 10,
+				GasLimit:      100_000,
+				Fee:           sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: true,
+		},
+		"non json msg": {
+			msg: MsgScheduleExecute{
+				Sender:        goodAddress,
+				Contract:      contract,
+				Msg:           []byte("not json"),
+				ExecuteHeight: 10,
+				GasLimit:      100_000,
+				Fee:           sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: false,
+		},
+		"zero height": {
+			msg: MsgScheduleExecute{
+				Sender:   goodAddress,
+				Contract: contract,
+				Msg:      []byte(`{}`),
+				GasLimit: 100_000,
+				Fee:      sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: false,
+		},
+		"gas limit too high": {
+			msg: MsgScheduleExecute{
+				Sender:        goodAddress,
+				Contract:      contract,
+				Msg:           []byte(`{}`),
+				ExecuteHeight: 10,
+				GasLimit:      MaxScheduledCallGasLimit + 1,
+				Fee:           sdk.NewInt64Coin("uscrt", 1),
+			},
+			valid: false,
+		},
+		"zero fee": {
+			msg: MsgScheduleExecute{
+				Sender:        goodAddress,
+				Contract:      contract,
+				Msg:           []byte(`{}`),
+				ExecuteHeight: 10,
+				GasLimit:      100_000,
+				Fee:           sdk.NewInt64Coin("uscrt", 0),
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
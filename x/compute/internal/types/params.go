@@ -0,0 +1,620 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+)
+
+var (
+	KeyAllowedDepositDenoms = []byte("AllowedDepositDenoms")
+	KeySnip20Wrappers       = []byte("Snip20Wrappers")
+	KeyKeyEpochs            = []byte("KeyEpochs")
+	KeyMaxCronGasLimit      = []byte("MaxCronGasLimit")
+	KeyMaxCronsPerBlock     = []byte("MaxCronsPerBlock")
+	KeyMaxCronsPerContract  = []byte("MaxCronsPerContract")
+	KeyQueryPluginGasCosts  = []byte("QueryPluginGasCosts")
+	KeyMaxLabelLength       = []byte("MaxLabelLength")
+	KeyLabelCharset         = []byte("LabelCharset")
+	KeyMaxMemoryPages       = []byte("MaxMemoryPages")
+	KeyMaxDispatchDepth     = []byte("MaxDispatchDepth")
+	KeyMaxDispatchedMsgs    = []byte("MaxDispatchedMsgs")
+	KeyDeniedMsgTypes       = []byte("DeniedMsgTypes")
+	KeyDenyStakingMsgs      = []byte("DenyStakingMsgs")
+	KeyAuditors             = []byte("Auditors")
+	KeyMaxMsgSize           = []byte("MaxMsgSize")
+
+	KeyScheduledCallGasPrice   = []byte("ScheduledCallGasPrice")
+	KeyMaxScheduledGasPerBlock = []byte("MaxScheduledGasPerBlock")
+
+	KeyFundRecoveryDelay = []byte("FundRecoveryDelay")
+)
+
+// Default limits of the crons
+const (
+	DefaultMaxCronGasLimit     uint64 = 1_000_000
+	DefaultMaxCronsPerBlock    uint32 = 20
+	DefaultMaxCronsPerContract uint32 = 5
+)
+
+// Default price and limit of the calls scheduled with MsgScheduleExecute
+var DefaultScheduledCallGasPrice = sdk.NewDecWithPrec(1, 1)
+
+const DefaultMaxScheduledGasPerBlock uint64 = 50_000_000
+
+// DefaultFundRecoveryDelay is about two weeks of 6 second blocks
+const DefaultFundRecoveryDelay int64 = 200_000
+
+// type URLs of the staking msgs denied by DenyStakingMsgs
+const (
+	stakingMsgDelegate        = "/cosmos.staking.v1beta1.MsgDelegate"
+	stakingMsgBeginRedelegate = "/cosmos.staking.v1beta1.MsgBeginRedelegate"
+	stakingMsgUndelegate      = "/cosmos.staking.v1beta1.MsgUndelegate"
+)
+
+// msgsWrapper is implemented by msgs executing other msgs, e.g. authz MsgExec
+type msgsWrapper interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// txMsgWrapper is implemented by msgs executing another msg, e.g. mauth MsgSubmitTx
+type txMsgWrapper interface {
+	GetTxMsg() sdk.Msg
+}
+
+// Default limits of the msgs dispatched by contracts
+const (
+	DefaultMaxDispatchDepth  uint32 = 16
+	DefaultMaxDispatchedMsgs uint32 = 256
+)
+
+// MaxWasmMemoryPages is the memory limit of the wasm engine of the enclave, in 64KiB pages (12MiB)
+const MaxWasmMemoryPages uint32 = 192
+
+// MaxKeyEpoch is the last key epoch the enclave has keys for: epoch 0 runs on the io keys of
+// the genesis consensus seed, epoch 1 on those of the current one
+const MaxKeyEpoch uint32 = 1
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table for the compute module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default compute module params, which allow all deposit denoms
+// and have no registered SNIP-20 wrappers nor key epochs
+func DefaultParams() Params {
+	return Params{
+		AllowedDepositDenoms: []string{},
+		Snip20Wrappers:       []Snip20Wrapper{},
+		KeyEpochs:            []KeyEpoch{},
+		DeniedMsgTypes:       []string{},
+		Auditors:             []string{},
+		MaxCronGasLimit:      DefaultMaxCronGasLimit,
+		MaxCronsPerBlock:     DefaultMaxCronsPerBlock,
+		MaxCronsPerContract:  DefaultMaxCronsPerContract,
+		MaxDispatchDepth:     DefaultMaxDispatchDepth,
+		MaxDispatchedMsgs:    DefaultMaxDispatchedMsgs,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedDepositDenoms, &p.AllowedDepositDenoms, validateAllowedDepositDenoms),
+		paramtypes.NewParamSetPair(KeySnip20Wrappers, &p.Snip20Wrappers, validateSnip20Wrappers),
+		paramtypes.NewParamSetPair(KeyKeyEpochs, &p.KeyEpochs, validateKeyEpochs),
+		paramtypes.NewParamSetPair(KeyMaxCronGasLimit, &p.MaxCronGasLimit, validateMaxCronGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxCronsPerBlock, &p.MaxCronsPerBlock, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxCronsPerContract, &p.MaxCronsPerContract, validateUint32),
+		paramtypes.NewParamSetPair(KeyQueryPluginGasCosts, &p.QueryPluginGasCosts, validateQueryPluginGasCosts),
+		paramtypes.NewParamSetPair(KeyMaxLabelLength, &p.MaxLabelLength, validateMaxLabelLength),
+		paramtypes.NewParamSetPair(KeyLabelCharset, &p.LabelCharset, validateLabelCharset),
+		paramtypes.NewParamSetPair(KeyMaxMemoryPages, &p.MaxMemoryPages, validateMaxMemoryPages),
+		paramtypes.NewParamSetPair(KeyMaxDispatchDepth, &p.MaxDispatchDepth, validateUint32),
+		paramtypes.NewParamSetPair(KeyMaxDispatchedMsgs, &p.MaxDispatchedMsgs, validateUint32),
+		paramtypes.NewParamSetPair(KeyDeniedMsgTypes, &p.DeniedMsgTypes, validateDeniedMsgTypes),
+		paramtypes.NewParamSetPair(KeyDenyStakingMsgs, &p.DenyStakingMsgs, validateBool),
+		paramtypes.NewParamSetPair(KeyAuditors, &p.Auditors, validateAuditors),
+		paramtypes.NewParamSetPair(KeyMaxMsgSize, &p.MaxMsgSize, validateMaxMsgSize),
+		paramtypes.NewParamSetPair(KeyScheduledCallGasPrice, &p.ScheduledCallGasPrice, validateScheduledCallGasPrice),
+		paramtypes.NewParamSetPair(KeyMaxScheduledGasPerBlock, &p.MaxScheduledGasPerBlock, validateMaxScheduledGasPerBlock),
+		paramtypes.NewParamSetPair(KeyFundRecoveryDelay, &p.FundRecoveryDelay, validateFundRecoveryDelay),
+	}
+}
+
+// ValidateBasic performs basic validation on compute module params
+func (p Params) ValidateBasic() error {
+	if err := validateAllowedDepositDenoms(p.AllowedDepositDenoms); err != nil {
+		return sdkerrors.Wrap(err, "allowed deposit denoms")
+	}
+	if err := validateSnip20Wrappers(p.Snip20Wrappers); err != nil {
+		return sdkerrors.Wrap(err, "snip20 wrappers")
+	}
+	if err := validateKeyEpochs(p.KeyEpochs); err != nil {
+		return sdkerrors.Wrap(err, "key epochs")
+	}
+	if err := validateMaxCronGasLimit(p.MaxCronGasLimit); err != nil {
+		return sdkerrors.Wrap(err, "max cron gas limit")
+	}
+	if err := validateMaxLabelLength(p.MaxLabelLength); err != nil {
+		return sdkerrors.Wrap(err, "max label length")
+	}
+	if err := validateLabelCharset(p.LabelCharset); err != nil {
+		return sdkerrors.Wrap(err, "label charset")
+	}
+	if err := validateMaxMemoryPages(p.MaxMemoryPages); err != nil {
+		return sdkerrors.Wrap(err, "max memory pages")
+	}
+	if err := validateDeniedMsgTypes(p.DeniedMsgTypes); err != nil {
+		return sdkerrors.Wrap(err, "denied msg types")
+	}
+	if err := validateAuditors(p.Auditors); err != nil {
+		return sdkerrors.Wrap(err, "auditors")
+	}
+	if err := validateMaxMsgSize(p.MaxMsgSize); err != nil {
+		return sdkerrors.Wrap(err, "max msg size")
+	}
+	if err := validateScheduledCallGasPrice(p.ScheduledCallGasPrice); err != nil {
+		return sdkerrors.Wrap(err, "scheduled call gas price")
+	}
+	if err := validateMaxScheduledGasPerBlock(p.MaxScheduledGasPerBlock); err != nil {
+		return sdkerrors.Wrap(err, "max scheduled gas per block")
+	}
+	if err := validateFundRecoveryDelay(p.FundRecoveryDelay); err != nil {
+		return sdkerrors.Wrap(err, "fund recovery delay")
+	}
+	return nil
+}
+
+// IsDepositDenomAllowed returns true if coins of the given denom may be sent to a contract.
+// An empty whitelist allows all denoms.
+func (p Params) IsDepositDenomAllowed(denom string) bool {
+	if len(p.AllowedDepositDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedDepositDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateDeposit returns an error if any of the given coins is not whitelisted
+func (p Params) ValidateDeposit(coins sdk.Coins) error {
+	for _, coin := range coins {
+		if !p.IsDepositDenomAllowed(coin.Denom) {
+			return sdkerrors.Wrapf(ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// ValidateLabel returns an error if label is longer than the max label length, or has a character
+// outside of the label charset
+func (p Params) ValidateLabel(label string) error {
+	maxLength := int(p.MaxLabelLength)
+	if maxLength == 0 {
+		maxLength = MaxLabelSize
+	}
+	if len(label) > maxLength {
+		return sdkerrors.Wrapf(ErrLimit, "label cannot be longer than %d bytes", maxLength)
+	}
+	if p.LabelCharset != "" && !labelCharsetRegexp(p.LabelCharset).MatchString(label) {
+		return sdkerrors.Wrapf(ErrInvalid, "label must only contain the characters [%s]", p.LabelCharset)
+	}
+	return nil
+}
+
+// ValidateMsgSize returns an error if the encrypted msg to a contract is larger than the max msg size
+func (p Params) ValidateMsgSize(msg []byte) error {
+	maxSize := int(p.MaxMsgSize)
+	if maxSize == 0 {
+		maxSize = MaxMsgSize
+	}
+	if len(msg) > maxSize {
+		return sdkerrors.Wrapf(ErrLimit, "msg cannot be longer than %d bytes", maxSize)
+	}
+	return nil
+}
+
+// MinScheduledCallFee returns the least fee a call scheduled with the given gas limit must pay,
+// in the bond denom
+func (p Params) MinScheduledCallFee(gasLimit uint64, bondDenom string) sdk.Coin {
+	price := p.ScheduledCallGasPrice
+	if price.IsNil() || price.IsZero() {
+		price = DefaultScheduledCallGasPrice
+	}
+	amount := price.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().TruncateInt()
+	return sdk.NewCoin(bondDenom, amount)
+}
+
+// ScheduledGasPerBlock returns the most gas the scheduled calls and the crons of a block may reserve in total
+func (p Params) ScheduledGasPerBlock() uint64 {
+	if p.MaxScheduledGasPerBlock == 0 {
+		return DefaultMaxScheduledGasPerBlock
+	}
+	return p.MaxScheduledGasPerBlock
+}
+
+// FundRecoveryDelayBlocks returns the least number of blocks between the flagging of a code as broken
+// and the recovery of the funds of its contracts
+func (p Params) FundRecoveryDelayBlocks() int64 {
+	if p.FundRecoveryDelay == 0 {
+		return DefaultFundRecoveryDelay
+	}
+	return p.FundRecoveryDelay
+}
+
+// IsAuditor returns true if the given address may publish audit attestations of codes
+func (p Params) IsAuditor(address string) bool {
+	for _, auditor := range p.Auditors {
+		if auditor == address {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMsgTypeDenied returns true if contracts may not dispatch sdk msgs of the given type URL
+func (p Params) IsMsgTypeDenied(typeURL string) bool {
+	if p.DenyStakingMsgs && (typeURL == stakingMsgDelegate || typeURL == stakingMsgBeginRedelegate || typeURL == stakingMsgUndelegate) {
+		return true
+	}
+	for _, denied := range p.DeniedMsgTypes {
+		if denied == typeURL || (strings.HasSuffix(denied, ".") && strings.HasPrefix(typeURL, denied)) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeniedMsgTypeURL returns the type URL of msg, or of a msg it wraps at any depth, if contracts may
+// not dispatch it. A wrapper whose msgs can't be unpacked is denied as a whole.
+func (p Params) DeniedMsgTypeURL(msg sdk.Msg) (string, bool) {
+	typeURL := sdk.MsgTypeURL(msg)
+	if p.IsMsgTypeDenied(typeURL) {
+		return typeURL, true
+	}
+
+	var wrapped []sdk.Msg
+	switch wrapper := msg.(type) {
+	case msgsWrapper:
+		msgs, err := wrapper.GetMessages()
+		if err != nil {
+			return typeURL, true
+		}
+		wrapped = msgs
+	case txMsgWrapper:
+		txMsg := wrapper.GetTxMsg()
+		if txMsg == nil {
+			return typeURL, true
+		}
+		wrapped = []sdk.Msg{txMsg}
+	}
+
+	for _, m := range wrapped {
+		if deniedTypeURL, denied := p.DeniedMsgTypeURL(m); denied {
+			return deniedTypeURL, true
+		}
+	}
+	return "", false
+}
+
+// Snip20WrapperByDenom returns the canonical SNIP-20 wrapper of a native denom
+func (p Params) Snip20WrapperByDenom(denom string) (Snip20Wrapper, bool) {
+	for _, wrapper := range p.Snip20Wrappers {
+		if wrapper.Denom == denom {
+			return wrapper, true
+		}
+	}
+	return Snip20Wrapper{}, false
+}
+
+// Snip20WrapperByContract returns the registry entry of a canonical SNIP-20 wrapper contract
+func (p Params) Snip20WrapperByContract(contractAddress string) (Snip20Wrapper, bool) {
+	for _, wrapper := range p.Snip20Wrappers {
+		if wrapper.ContractAddress == contractAddress {
+			return wrapper, true
+		}
+	}
+	return Snip20Wrapper{}, false
+}
+
+// ActiveKeyEpoch returns the key epoch active at the given height, if any epoch was scheduled
+func (p Params) ActiveKeyEpoch(height int64) (KeyEpoch, bool) {
+	for i := len(p.KeyEpochs) - 1; i >= 0; i-- {
+		if p.KeyEpochs[i].StartHeight <= height {
+			return p.KeyEpochs[i], true
+		}
+	}
+	return KeyEpoch{}, false
+}
+
+// AcceptedKeyEpochs returns the epochs whose keys are valid at the given height: the active
+// epoch, preceded by the previous one while the transition window of the active epoch is open.
+func (p Params) AcceptedKeyEpochs(height int64) []uint32 {
+	for i := len(p.KeyEpochs) - 1; i >= 0; i-- {
+		epoch := p.KeyEpochs[i]
+		if epoch.StartHeight > height {
+			continue
+		}
+		if i > 0 && height < epoch.StartHeight+epoch.TransitionBlocks {
+			return []uint32{p.KeyEpochs[i-1].Epoch, epoch.Epoch}
+		}
+		return []uint32{epoch.Epoch}
+	}
+	return nil
+}
+
+// Of returns the gas surcharge of the plugin that handles the request, and the name of the plugin
+func (c QueryPluginGasCosts) Of(request wasmTypes.QueryRequest) (uint64, string) {
+	switch {
+	case request.Bank != nil:
+		return c.Bank, "bank"
+	case request.Custom != nil:
+		return c.Custom, "custom"
+	case request.Staking != nil:
+		return c.Staking, "staking"
+	case request.Wasm != nil:
+		return c.Wasm, "wasm"
+	case request.Dist != nil:
+		return c.Dist, "dist"
+	case request.Mint != nil:
+		return c.Mint, "mint"
+	case request.Gov != nil:
+		return c.Gov, "gov"
+	case request.IBC != nil:
+		return c.IBC, "ibc"
+	case request.Stargate != nil:
+		return c.Stargate, "stargate"
+	case request.Oracle != nil:
+		return c.Oracle, "oracle"
+	}
+	return 0, "unknown"
+}
+
+func validateAllowedDepositDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(ErrDuplicate, "denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateDeniedMsgTypes(i interface{}) error {
+	typeURLs, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if len(typeURL) < 2 || !strings.HasPrefix(typeURL, "/") {
+			return sdkerrors.Wrapf(ErrInvalid, "type URL %q must start with /", typeURL)
+		}
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrDuplicate, "type URL %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
+
+func validateAuditors(i interface{}) error {
+	auditors, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(auditors))
+	for _, auditor := range auditors {
+		if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+			return sdkerrors.Wrapf(err, "auditor %s", auditor)
+		}
+		if seen[auditor] {
+			return sdkerrors.Wrapf(ErrDuplicate, "auditor %s", auditor)
+		}
+		seen[auditor] = true
+	}
+	return nil
+}
+
+func validateSnip20Wrappers(i interface{}) error {
+	wrappers, ok := i.([]Snip20Wrapper)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool, len(wrappers))
+	seenContracts := make(map[string]bool, len(wrappers))
+	for _, wrapper := range wrappers {
+		if err := sdk.ValidateDenom(wrapper.Denom); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(wrapper.ContractAddress); err != nil {
+			return sdkerrors.Wrapf(err, "contract of denom %s", wrapper.Denom)
+		}
+		if seenDenoms[wrapper.Denom] {
+			return sdkerrors.Wrapf(ErrDuplicate, "denom %s", wrapper.Denom)
+		}
+		if seenContracts[wrapper.ContractAddress] {
+			return sdkerrors.Wrapf(ErrDuplicate, "contract %s", wrapper.ContractAddress)
+		}
+		seenDenoms[wrapper.Denom] = true
+		seenContracts[wrapper.ContractAddress] = true
+	}
+	return nil
+}
+
+func validateKeyEpochs(i interface{}) error {
+	epochs, ok := i.([]KeyEpoch)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, epoch := range epochs {
+		if epoch.Epoch > MaxKeyEpoch {
+			return sdkerrors.Wrapf(ErrInvalid, "the enclave has no keys for epoch %d", epoch.Epoch)
+		}
+		if epoch.StartHeight < 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "start height of epoch %d", epoch.Epoch)
+		}
+		if epoch.TransitionBlocks < 0 {
+			return sdkerrors.Wrapf(ErrInvalid, "transition blocks of epoch %d", epoch.Epoch)
+		}
+		if j == 0 {
+			continue
+		}
+		prev := epochs[j-1]
+		if epoch.Epoch <= prev.Epoch {
+			return sdkerrors.Wrapf(ErrInvalid, "epoch %d follows epoch %d", epoch.Epoch, prev.Epoch)
+		}
+		if epoch.StartHeight <= prev.StartHeight {
+			return sdkerrors.Wrapf(ErrInvalid, "epoch %d starts before epoch %d", epoch.Epoch, prev.Epoch)
+		}
+	}
+	return nil
+}
+
+func validateMaxCronGasLimit(i interface{}) error {
+	gasLimit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if gasLimit > MaxScheduledCallGasLimit {
+		return sdkerrors.Wrapf(ErrInvalid, "must not exceed %d", MaxScheduledCallGasLimit)
+	}
+	return nil
+}
+
+func validateScheduledCallGasPrice(i interface{}) error {
+	price, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !price.IsNil() && price.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "must not be negative")
+	}
+	return nil
+}
+
+func validateMaxScheduledGasPerBlock(i interface{}) error {
+	gas, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if gas != 0 && gas < MaxScheduledCallGasLimit {
+		return sdkerrors.Wrapf(ErrInvalid, "must be at least %d, the max gas limit of a scheduled call", MaxScheduledCallGasLimit)
+	}
+	return nil
+}
+
+func validateFundRecoveryDelay(i interface{}) error {
+	delay, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if delay < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "must not be negative")
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateUint32(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxLabelLength(i interface{}) error {
+	length, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if length > MaxLabelSize {
+		return sdkerrors.Wrapf(ErrInvalid, "must not exceed %d", MaxLabelSize)
+	}
+	return nil
+}
+
+func validateMaxMsgSize(i interface{}) error {
+	size, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if size > MaxMsgSize {
+		return sdkerrors.Wrapf(ErrInvalid, "must not exceed %d", MaxMsgSize)
+	}
+	return nil
+}
+
+func validateMaxMemoryPages(i interface{}) error {
+	pages, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if pages > MaxWasmMemoryPages {
+		return sdkerrors.Wrapf(ErrInvalid, "must not exceed %d", MaxWasmMemoryPages)
+	}
+	return nil
+}
+
+func validateLabelCharset(i interface{}) error {
+	charset, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if charset == "" {
+		return nil
+	}
+	// the charset must be the body of a single character class, so it can't escape it in labelCharsetPattern
+	class, err := syntax.Parse("["+charset+"]", syntax.Perl)
+	if err != nil || class.Op != syntax.OpCharClass {
+		return sdkerrors.Wrapf(ErrInvalid, "%q is not the body of a character class", charset)
+	}
+	return nil
+}
+
+func labelCharsetPattern(charset string) string {
+	return "^[" + charset + "]*$"
+}
+
+func labelCharsetRegexp(charset string) *regexp.Regexp {
+	return regexp.MustCompile(labelCharsetPattern(charset))
+}
+
+func validateQueryPluginGasCosts(i interface{}) error {
+	if _, ok := i.(QueryPluginGasCosts); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
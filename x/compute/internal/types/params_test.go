@@ -0,0 +1,432 @@
+package types
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src      Params
+		expError bool
+	}{
+		"default": {src: DefaultParams()},
+		"single denom": {
+			src: Params{AllowedDepositDenoms: []string{"uscrt"}},
+		},
+		"ibc denom": {
+			src: Params{AllowedDepositDenoms: []string{"uscrt", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}},
+		},
+		"invalid denom": {
+			src:      Params{AllowedDepositDenoms: []string{"!"}},
+			expError: true,
+		},
+		"duplicate denom": {
+			src:      Params{AllowedDepositDenoms: []string{"uscrt", "uscrt"}},
+			expError: true,
+		},
+		"snip20 wrappers": {
+			src: Params{Snip20Wrappers: []Snip20Wrapper{
+				{Denom: "uscrt", ContractAddress: sdk.AccAddress(make([]byte, 20)).String()},
+				{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()},
+			}},
+		},
+		"snip20 wrapper with invalid contract": {
+			src:      Params{Snip20Wrappers: []Snip20Wrapper{{Denom: "uscrt", ContractAddress: "foo"}}},
+			expError: true,
+		},
+		"snip20 wrapper with invalid denom": {
+			src:      Params{Snip20Wrappers: []Snip20Wrapper{{Denom: "!", ContractAddress: sdk.AccAddress(make([]byte, 20)).String()}}},
+			expError: true,
+		},
+		"duplicate snip20 wrapper denom": {
+			src: Params{Snip20Wrappers: []Snip20Wrapper{
+				{Denom: "uscrt", ContractAddress: sdk.AccAddress(make([]byte, 20)).String()},
+				{Denom: "uscrt", ContractAddress: sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()},
+			}},
+			expError: true,
+		},
+		"duplicate snip20 wrapper contract": {
+			src: Params{Snip20Wrappers: []Snip20Wrapper{
+				{Denom: "uscrt", ContractAddress: sdk.AccAddress(make([]byte, 20)).String()},
+				{Denom: "uatom", ContractAddress: sdk.AccAddress(make([]byte, 20)).String()},
+			}},
+			expError: true,
+		},
+		"key epochs": {
+			src: Params{KeyEpochs: []KeyEpoch{{Epoch: 0}, {Epoch: 1, StartHeight: 100, TransitionBlocks: 10}}},
+		},
+		"key epochs out of order": {
+			src:      Params{KeyEpochs: []KeyEpoch{{Epoch: 1, StartHeight: 100}, {Epoch: 0, StartHeight: 200}}},
+			expError: true,
+		},
+		"key epochs starting at the same height": {
+			src:      Params{KeyEpochs: []KeyEpoch{{Epoch: 0, StartHeight: 100}, {Epoch: 1, StartHeight: 100}}},
+			expError: true,
+		},
+		"key epoch unknown to the enclave": {
+			src:      Params{KeyEpochs: []KeyEpoch{{Epoch: 0}, {Epoch: MaxKeyEpoch + 1, StartHeight: 100}}},
+			expError: true,
+		},
+		"negative transition": {
+			src:      Params{KeyEpochs: []KeyEpoch{{Epoch: 0, TransitionBlocks: -1}}},
+			expError: true,
+		},
+		"crons disabled": {
+			src: Params{MaxCronGasLimit: 0, MaxCronsPerBlock: 0},
+		},
+		"cron gas limit above the scheduled call limit": {
+			src:      Params{MaxCronGasLimit: MaxScheduledCallGasLimit + 1},
+			expError: true,
+		},
+		"label limits": {
+			src: Params{MaxLabelLength: 64, LabelCharset: "a-zA-Z0-9 _.-"},
+		},
+		"max label length above the label size": {
+			src:      Params{MaxLabelLength: MaxLabelSize + 1},
+			expError: true,
+		},
+		"label charset escaping its class": {
+			src:      Params{LabelCharset: "a-z]|.*[a"},
+			expError: true,
+		},
+		"label charset not a regexp": {
+			src:      Params{LabelCharset: "z-a"},
+			expError: true,
+		},
+		"max memory pages": {
+			src: Params{MaxMemoryPages: 64},
+		},
+		"max memory pages above the engine limit": {
+			src:      Params{MaxMemoryPages: MaxWasmMemoryPages + 1},
+			expError: true,
+		},
+		"max msg size": {
+			src: Params{MaxMsgSize: 64 * 1024},
+		},
+		"max msg size above the hard limit": {
+			src:      Params{MaxMsgSize: MaxMsgSize + 1},
+			expError: true,
+		},
+		"scheduled call limits": {
+			src: Params{ScheduledCallGasPrice: sdk.NewDecWithPrec(25, 2), MaxScheduledGasPerBlock: 2 * MaxScheduledCallGasLimit},
+		},
+		"negative scheduled call gas price": {
+			src:      Params{ScheduledCallGasPrice: sdk.NewDec(-1)},
+			expError: true,
+		},
+		"max scheduled gas per block below the gas limit of a call": {
+			src:      Params{MaxScheduledGasPerBlock: MaxScheduledCallGasLimit - 1},
+			expError: true,
+		},
+		"fund recovery delay": {
+			src: Params{FundRecoveryDelay: 100},
+		},
+		"negative fund recovery delay": {
+			src:      Params{FundRecoveryDelay: -1},
+			expError: true,
+		},
+		"denied msg types": {
+			src: Params{DeniedMsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote", "/cosmos.staking."}},
+		},
+		"denied msg type without slash": {
+			src:      Params{DeniedMsgTypes: []string{"cosmos.gov.v1beta1.MsgVote"}},
+			expError: true,
+		},
+		"duplicate denied msg type": {
+			src:      Params{DeniedMsgTypes: []string{"/cosmos.staking.", "/cosmos.staking."}},
+			expError: true,
+		},
+		"auditors": {
+			src: Params{Auditors: []string{sdk.AccAddress(make([]byte, 20)).String()}},
+		},
+		"invalid auditor": {
+			src:      Params{Auditors: []string{"foo"}},
+			expError: true,
+		},
+		"duplicate auditor": {
+			src:      Params{Auditors: []string{sdk.AccAddress(make([]byte, 20)).String(), sdk.AccAddress(make([]byte, 20)).String()}},
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsIsMsgTypeDenied(t *testing.T) {
+	params := Params{DeniedMsgTypes: []string{"/cosmos.gov.v1beta1.MsgVote", "/cosmos.staking."}}
+
+	assert.True(t, params.IsMsgTypeDenied("/cosmos.gov.v1beta1.MsgVote"))
+	assert.True(t, params.IsMsgTypeDenied("/cosmos.staking.v1beta1.MsgDelegate"))
+	assert.False(t, params.IsMsgTypeDenied("/cosmos.gov.v1beta1.MsgVoteWeighted"))
+	assert.False(t, params.IsMsgTypeDenied("/cosmos.bank.v1beta1.MsgSend"))
+	assert.False(t, DefaultParams().IsMsgTypeDenied("/cosmos.gov.v1beta1.MsgVote"))
+
+	params = Params{DenyStakingMsgs: true}
+	assert.True(t, params.IsMsgTypeDenied("/cosmos.staking.v1beta1.MsgDelegate"))
+	assert.True(t, params.IsMsgTypeDenied("/cosmos.staking.v1beta1.MsgBeginRedelegate"))
+	assert.True(t, params.IsMsgTypeDenied("/cosmos.staking.v1beta1.MsgUndelegate"))
+	assert.False(t, params.IsMsgTypeDenied("/cosmos.staking.v1beta1.MsgCreateValidator"))
+}
+
+func TestParamsDeniedMsgTypeURL(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	delegate := stakingtypes.NewMsgDelegate(contract, sdk.ValAddress("validator___________"), sdk.NewInt64Coin("uscrt", 1))
+	send := banktypes.NewMsgSend(contract, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1)))
+	params := Params{DenyStakingMsgs: true}
+
+	typeURL, denied := params.DeniedMsgTypeURL(delegate)
+	assert.True(t, denied)
+	assert.Equal(t, "/cosmos.staking.v1beta1.MsgDelegate", typeURL)
+
+	_, denied = params.DeniedMsgTypeURL(send)
+	assert.False(t, denied)
+
+	// msgs wrapped in an authz exec are checked too, at any depth
+	exec := authz.NewMsgExec(contract, []sdk.Msg{send, delegate})
+	typeURL, denied = params.DeniedMsgTypeURL(&exec)
+	assert.True(t, denied)
+	assert.Equal(t, "/cosmos.staking.v1beta1.MsgDelegate", typeURL)
+
+	nested := authz.NewMsgExec(contract, []sdk.Msg{&exec})
+	typeURL, denied = params.DeniedMsgTypeURL(&nested)
+	assert.True(t, denied)
+	assert.Equal(t, "/cosmos.staking.v1beta1.MsgDelegate", typeURL)
+
+	allowed := authz.NewMsgExec(contract, []sdk.Msg{send})
+	_, denied = params.DeniedMsgTypeURL(&allowed)
+	assert.False(t, denied)
+
+	// the wrapper itself may be denied
+	params = Params{DeniedMsgTypes: []string{"/cosmos.authz.v1beta1.MsgExec"}}
+	typeURL, denied = params.DeniedMsgTypeURL(&allowed)
+	assert.True(t, denied)
+	assert.Equal(t, "/cosmos.authz.v1beta1.MsgExec", typeURL)
+}
+
+func TestParamsValidateDeposit(t *testing.T) {
+	specs := map[string]struct {
+		params   Params
+		deposit  sdk.Coins
+		expError bool
+	}{
+		"empty whitelist allows all": {
+			params:  DefaultParams(),
+			deposit: sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1), sdk.NewInt64Coin("spam", 1)),
+		},
+		"whitelisted denom": {
+			params:  Params{AllowedDepositDenoms: []string{"uscrt"}},
+			deposit: sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1)),
+		},
+		"empty deposit": {
+			params: Params{AllowedDepositDenoms: []string{"uscrt"}},
+		},
+		"denom not whitelisted": {
+			params:   Params{AllowedDepositDenoms: []string{"uscrt"}},
+			deposit:  sdk.NewCoins(sdk.NewInt64Coin("uscrt", 1), sdk.NewInt64Coin("spam", 1)),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.params.ValidateDeposit(spec.deposit)
+			if spec.expError {
+				assert.ErrorIs(t, err, ErrDenomNotAllowed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateLabel(t *testing.T) {
+	specs := map[string]struct {
+		params   Params
+		label    string
+		expError bool
+	}{
+		"defaults allow any printable label": {
+			params: DefaultParams(),
+			label:  "my contract #1 ✓",
+		},
+		"default max length": {
+			params:   DefaultParams(),
+			label:    strings.Repeat("a", MaxLabelSize+1),
+			expError: true,
+		},
+		"within max length": {
+			params: Params{MaxLabelLength: 4},
+			label:  "abcd",
+		},
+		"above max length": {
+			params:   Params{MaxLabelLength: 4},
+			label:    "abcde",
+			expError: true,
+		},
+		"within charset": {
+			params: Params{LabelCharset: "a-z0-9-"},
+			label:  "my-contract-1",
+		},
+		"outside of charset": {
+			params:   Params{LabelCharset: "a-z0-9-"},
+			label:    "my contract",
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.params.ValidateLabel(spec.label)
+			if spec.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateMsgSize(t *testing.T) {
+	specs := map[string]struct {
+		params   Params
+		msg      []byte
+		expError bool
+	}{
+		"default max size": {
+			params: DefaultParams(),
+			msg:    make([]byte, MaxMsgSize),
+		},
+		"above default max size": {
+			params:   DefaultParams(),
+			msg:      make([]byte, MaxMsgSize+1),
+			expError: true,
+		},
+		"within max size": {
+			params: Params{MaxMsgSize: 4},
+			msg:    []byte("abcd"),
+		},
+		"above max size": {
+			params:   Params{MaxMsgSize: 4},
+			msg:      []byte("abcde"),
+			expError: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.params.ValidateMsgSize(spec.msg)
+			if spec.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsMinScheduledCallFee(t *testing.T) {
+	// the default price is 0.1 per unit of gas
+	assert.Equal(t, sdk.NewInt64Coin("uscrt", 100_000), DefaultParams().MinScheduledCallFee(1_000_000, "uscrt"))
+
+	// the fee is rounded up
+	params := Params{ScheduledCallGasPrice: sdk.NewDecWithPrec(25, 2)}
+	assert.Equal(t, sdk.NewInt64Coin("uscrt", 1), params.MinScheduledCallFee(1, "uscrt"))
+	assert.Equal(t, sdk.NewInt64Coin("uscrt", 250), params.MinScheduledCallFee(1_000, "uscrt"))
+}
+
+func TestParamsScheduledGasPerBlock(t *testing.T) {
+	assert.Equal(t, DefaultMaxScheduledGasPerBlock, DefaultParams().ScheduledGasPerBlock())
+	assert.Equal(t, 2*MaxScheduledCallGasLimit, Params{MaxScheduledGasPerBlock: 2 * MaxScheduledCallGasLimit}.ScheduledGasPerBlock())
+}
+
+func TestParamsFundRecoveryDelayBlocks(t *testing.T) {
+	assert.Equal(t, DefaultFundRecoveryDelay, DefaultParams().FundRecoveryDelayBlocks())
+	assert.Equal(t, int64(100), Params{FundRecoveryDelay: 100}.FundRecoveryDelayBlocks())
+}
+
+func TestParamsSnip20WrapperLookup(t *testing.T) {
+	contract := sdk.AccAddress(make([]byte, 20)).String()
+	params := Params{Snip20Wrappers: []Snip20Wrapper{{Denom: "uscrt", ContractAddress: contract}}}
+
+	wrapper, ok := params.Snip20WrapperByDenom("uscrt")
+	require.True(t, ok)
+	assert.Equal(t, contract, wrapper.ContractAddress)
+
+	wrapper, ok = params.Snip20WrapperByContract(contract)
+	require.True(t, ok)
+	assert.Equal(t, "uscrt", wrapper.Denom)
+
+	_, ok = params.Snip20WrapperByDenom("uatom")
+	assert.False(t, ok)
+	_, ok = params.Snip20WrapperByContract(sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String())
+	assert.False(t, ok)
+}
+
+func TestParamsKeyEpochs(t *testing.T) {
+	params := Params{KeyEpochs: []KeyEpoch{
+		{Epoch: 0, StartHeight: 0},
+		{Epoch: 1, StartHeight: 100, TransitionBlocks: 10},
+	}}
+
+	specs := map[string]struct {
+		height      int64
+		expActive   uint32
+		expAccepted []uint32
+	}{
+		"first epoch":             {height: 50, expActive: 0, expAccepted: []uint32{0}},
+		"transition start":        {height: 100, expActive: 1, expAccepted: []uint32{0, 1}},
+		"transition end":          {height: 109, expActive: 1, expAccepted: []uint32{0, 1}},
+		"after transition window": {height: 110, expActive: 1, expAccepted: []uint32{1}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			active, ok := params.ActiveKeyEpoch(spec.height)
+			require.True(t, ok)
+			assert.Equal(t, spec.expActive, active.Epoch)
+			assert.Equal(t, spec.expAccepted, params.AcceptedKeyEpochs(spec.height))
+		})
+	}
+
+	_, ok := DefaultParams().ActiveKeyEpoch(100)
+	assert.False(t, ok)
+	assert.Nil(t, DefaultParams().AcceptedKeyEpochs(100))
+}
+
+func TestQueryPluginGasCostsOf(t *testing.T) {
+	costs := QueryPluginGasCosts{Bank: 1, Staking: 3, Wasm: 4, Stargate: 9}
+
+	specs := map[string]struct {
+		request  wasmTypes.QueryRequest
+		expGas   uint64
+		expRoute string
+	}{
+		"bank":     {request: wasmTypes.QueryRequest{Bank: &wasmTypes.BankQuery{}}, expGas: 1, expRoute: "bank"},
+		"staking":  {request: wasmTypes.QueryRequest{Staking: &wasmTypes.StakingQuery{}}, expGas: 3, expRoute: "staking"},
+		"wasm":     {request: wasmTypes.QueryRequest{Wasm: &wasmTypes.WasmQuery{}}, expGas: 4, expRoute: "wasm"},
+		"stargate": {request: wasmTypes.QueryRequest{Stargate: &wasmTypes.StargateQuery{}}, expGas: 9, expRoute: "stargate"},
+		"unset":    {request: wasmTypes.QueryRequest{Gov: &wasmTypes.GovQuery{}}, expGas: 0, expRoute: "gov"},
+		"unknown":  {request: wasmTypes.QueryRequest{}, expGas: 0, expRoute: "unknown"},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			gas, route := costs.Of(spec.request)
+			assert.Equal(t, spec.expGas, gas)
+			assert.Equal(t, spec.expRoute, route)
+		})
+	}
+}
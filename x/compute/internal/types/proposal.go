@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeRecoverContractFunds is the type of RecoverContractFundsProposal
+	ProposalTypeRecoverContractFunds = "RecoverContractFunds"
+	// ProposalTypeFlagBrokenCode is the type of FlagBrokenCodeProposal
+	ProposalTypeFlagBrokenCode = "FlagBrokenCode"
+)
+
+var (
+	_ govtypes.Content = &RecoverContractFundsProposal{}
+	_ govtypes.Content = &FlagBrokenCodeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRecoverContractFunds)
+	govtypes.RegisterProposalTypeCodec(&RecoverContractFundsProposal{}, "wasm/RecoverContractFundsProposal")
+	govtypes.RegisterProposalType(ProposalTypeFlagBrokenCode)
+	govtypes.RegisterProposalTypeCodec(&FlagBrokenCodeProposal{}, "wasm/FlagBrokenCodeProposal")
+}
+
+// NewRecoverContractFundsProposal creates a proposal to transfer the funds of an orphaned contract to recipient
+func NewRecoverContractFundsProposal(title, description string, contract, recipient sdk.AccAddress) *RecoverContractFundsProposal {
+	return &RecoverContractFundsProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract.String(),
+		Recipient:   recipient.String(),
+	}
+}
+
+func (p *RecoverContractFundsProposal) GetTitle() string { return p.Title }
+
+func (p *RecoverContractFundsProposal) GetDescription() string { return p.Description }
+
+func (p *RecoverContractFundsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RecoverContractFundsProposal) ProposalType() string {
+	return ProposalTypeRecoverContractFunds
+}
+
+func (p *RecoverContractFundsProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	contract, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	if contract.Equals(recipient) {
+		return sdkerrors.Wrap(ErrInvalid, "recipient is the contract")
+	}
+	return nil
+}
+
+func (p RecoverContractFundsProposal) String() string {
+	return fmt.Sprintf(`Recover Contract Funds Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Recipient:   %s
+`, p.Title, p.Description, p.Contract, p.Recipient)
+}
+
+// NewFlagBrokenCodeProposal creates a proposal to flag a code as broken, so that the funds of its orphaned
+// contracts may be recovered
+func NewFlagBrokenCodeProposal(title, description string, codeID uint64) *FlagBrokenCodeProposal {
+	return &FlagBrokenCodeProposal{
+		Title:       title,
+		Description: description,
+		CodeID:      codeID,
+	}
+}
+
+func (p *FlagBrokenCodeProposal) GetTitle() string { return p.Title }
+
+func (p *FlagBrokenCodeProposal) GetDescription() string { return p.Description }
+
+func (p *FlagBrokenCodeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *FlagBrokenCodeProposal) ProposalType() string {
+	return ProposalTypeFlagBrokenCode
+}
+
+func (p *FlagBrokenCodeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "code id is required")
+	}
+	return nil
+}
+
+func (p FlagBrokenCodeProposal) String() string {
+	return fmt.Sprintf(`Flag Broken Code Proposal:
+  Title:       %s
+  Description: %s
+  Code ID:     %d
+`, p.Title, p.Description, p.CodeID)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverContractFundsProposalValidateBasic(t *testing.T) {
+	contract := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	recipient := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+
+	specs := map[string]struct {
+		src      *RecoverContractFundsProposal
+		expError bool
+	}{
+		"valid": {
+			src: NewRecoverContractFundsProposal("title", "description", contract, recipient),
+		},
+		"empty title": {
+			src:      NewRecoverContractFundsProposal("", "description", contract, recipient),
+			expError: true,
+		},
+		"empty description": {
+			src:      NewRecoverContractFundsProposal("title", "", contract, recipient),
+			expError: true,
+		},
+		"invalid contract": {
+			src:      &RecoverContractFundsProposal{Title: "title", Description: "description", Contract: "invalid", Recipient: recipient.String()},
+			expError: true,
+		},
+		"invalid recipient": {
+			src:      &RecoverContractFundsProposal{Title: "title", Description: "description", Contract: contract.String(), Recipient: "invalid"},
+			expError: true,
+		},
+		"recipient is the contract": {
+			src:      NewRecoverContractFundsProposal("title", "description", contract, contract),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFlagBrokenCodeProposalValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src      *FlagBrokenCodeProposal
+		expError bool
+	}{
+		"valid": {
+			src: NewFlagBrokenCodeProposal("title", "description", 1),
+		},
+		"empty title": {
+			src:      NewFlagBrokenCodeProposal("", "description", 1),
+			expError: true,
+		},
+		"empty description": {
+			src:      NewFlagBrokenCodeProposal("title", "", 1),
+			expError: true,
+		},
+		"no code id": {
+			src:      NewFlagBrokenCodeProposal("title", "description", 0),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
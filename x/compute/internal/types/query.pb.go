@@ -7,8 +7,10 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -184,6 +186,43 @@ func (m *QueryByCodeIdRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryByCodeIdRequest proto.InternalMessageInfo
 
+type QueryByDenomRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryByDenomRequest) Reset()         { *m = QueryByDenomRequest{} }
+func (m *QueryByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryByDenomRequest) ProtoMessage()    {}
+func (*QueryByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{4}
+}
+func (m *QueryByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryByDenomRequest.Merge(m, src)
+}
+func (m *QueryByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryByDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryByDenomRequest proto.InternalMessageInfo
+
 type QuerySecretContractResponse struct {
 	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
@@ -192,7 +231,7 @@ func (m *QuerySecretContractResponse) Reset()         { *m = QuerySecretContract
 func (m *QuerySecretContractResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySecretContractResponse) ProtoMessage()    {}
 func (*QuerySecretContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{4}
+	return fileDescriptor_7735281c5fa969d4, []int{5}
 }
 func (m *QuerySecretContractResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +271,7 @@ func (m *QueryContractInfoResponse) Reset()         { *m = QueryContractInfoResp
 func (m *QueryContractInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractInfoResponse) ProtoMessage()    {}
 func (*QueryContractInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{5}
+	return fileDescriptor_7735281c5fa969d4, []int{6}
 }
 func (m *QueryContractInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +311,7 @@ func (m *ContractInfoWithAddress) Reset()         { *m = ContractInfoWithAddress
 func (m *ContractInfoWithAddress) String() string { return proto.CompactTextString(m) }
 func (*ContractInfoWithAddress) ProtoMessage()    {}
 func (*ContractInfoWithAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{6}
+	return fileDescriptor_7735281c5fa969d4, []int{7}
 }
 func (m *ContractInfoWithAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,7 +348,7 @@ func (m *QueryContractsByCodeIdResponse) Reset()         { *m = QueryContractsBy
 func (m *QueryContractsByCodeIdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractsByCodeIdResponse) ProtoMessage()    {}
 func (*QueryContractsByCodeIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{7}
+	return fileDescriptor_7735281c5fa969d4, []int{8}
 }
 func (m *QueryContractsByCodeIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -338,6 +377,124 @@ func (m *QueryContractsByCodeIdResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryContractsByCodeIdResponse proto.InternalMessageInfo
 
+type QueryListContractsByCodeRequest struct {
+	CodeId     uint64             `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListContractsByCodeRequest) Reset()         { *m = QueryListContractsByCodeRequest{} }
+func (m *QueryListContractsByCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListContractsByCodeRequest) ProtoMessage()    {}
+func (*QueryListContractsByCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{9}
+}
+func (m *QueryListContractsByCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListContractsByCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListContractsByCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListContractsByCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListContractsByCodeRequest.Merge(m, src)
+}
+func (m *QueryListContractsByCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListContractsByCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListContractsByCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListContractsByCodeRequest proto.InternalMessageInfo
+
+// ContractByCode is a contract running a code
+type ContractByCode struct {
+	// contract_address is the bech32 human readable address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Label           string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// created_height is the block the contract was instantiated at
+	CreatedHeight int64 `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *ContractByCode) Reset()         { *m = ContractByCode{} }
+func (m *ContractByCode) String() string { return proto.CompactTextString(m) }
+func (*ContractByCode) ProtoMessage()    {}
+func (*ContractByCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{10}
+}
+func (m *ContractByCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractByCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractByCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractByCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractByCode.Merge(m, src)
+}
+func (m *ContractByCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractByCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractByCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractByCode proto.InternalMessageInfo
+
+type QueryListContractsByCodeResponse struct {
+	Contracts  []ContractByCode    `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListContractsByCodeResponse) Reset()         { *m = QueryListContractsByCodeResponse{} }
+func (m *QueryListContractsByCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListContractsByCodeResponse) ProtoMessage()    {}
+func (*QueryListContractsByCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{11}
+}
+func (m *QueryListContractsByCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListContractsByCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListContractsByCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListContractsByCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListContractsByCodeResponse.Merge(m, src)
+}
+func (m *QueryListContractsByCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListContractsByCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListContractsByCodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListContractsByCodeResponse proto.InternalMessageInfo
+
 type CodeInfoResponse struct {
 	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 	// creator is the bech32 human readable address of the contract
@@ -345,13 +502,15 @@ type CodeInfoResponse struct {
 	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 	Source   string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
 	Builder  string `protobuf:"bytes,5,opt,name=builder,proto3" json:"builder,omitempty"`
+	// instantiate_permission is who may instantiate the code
+	InstantiatePermission AccessConfig `protobuf:"bytes,6,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission"`
 }
 
 func (m *CodeInfoResponse) Reset()         { *m = CodeInfoResponse{} }
 func (m *CodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*CodeInfoResponse) ProtoMessage()    {}
 func (*CodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{8}
+	return fileDescriptor_7735281c5fa969d4, []int{12}
 }
 func (m *CodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +548,7 @@ func (m *QueryCodeResponse) Reset()         { *m = QueryCodeResponse{} }
 func (m *QueryCodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeResponse) ProtoMessage()    {}
 func (*QueryCodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{9}
+	return fileDescriptor_7735281c5fa969d4, []int{13}
 }
 func (m *QueryCodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +585,7 @@ func (m *QueryCodesResponse) Reset()         { *m = QueryCodesResponse{} }
 func (m *QueryCodesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodesResponse) ProtoMessage()    {}
 func (*QueryCodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{10}
+	return fileDescriptor_7735281c5fa969d4, []int{14}
 }
 func (m *QueryCodesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -455,6 +614,83 @@ func (m *QueryCodesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodesResponse proto.InternalMessageInfo
 
+type QueryListCodesRequest struct {
+	// creator is the bech32 address of the creator of the codes, optional
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCodesRequest) Reset()         { *m = QueryListCodesRequest{} }
+func (m *QueryListCodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCodesRequest) ProtoMessage()    {}
+func (*QueryListCodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{15}
+}
+func (m *QueryListCodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListCodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCodesRequest.Merge(m, src)
+}
+func (m *QueryListCodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCodesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListCodesRequest proto.InternalMessageInfo
+
+type QueryListCodesResponse struct {
+	CodeInfos  []CodeInfoResponse  `protobuf:"bytes,1,rep,name=code_infos,json=codeInfos,proto3" json:"code_infos"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCodesResponse) Reset()         { *m = QueryListCodesResponse{} }
+func (m *QueryListCodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCodesResponse) ProtoMessage()    {}
+func (*QueryListCodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{16}
+}
+func (m *QueryListCodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListCodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListCodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListCodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCodesResponse.Merge(m, src)
+}
+func (m *QueryListCodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListCodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListCodesResponse proto.InternalMessageInfo
+
 type QueryContractAddressResponse struct {
 	// address is the bech32 human readable address of the contract
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
@@ -464,7 +700,7 @@ func (m *QueryContractAddressResponse) Reset()         { *m = QueryContractAddre
 func (m *QueryContractAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractAddressResponse) ProtoMessage()    {}
 func (*QueryContractAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{11}
+	return fileDescriptor_7735281c5fa969d4, []int{17}
 }
 func (m *QueryContractAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,13 +731,18 @@ var xxx_messageInfo_QueryContractAddressResponse proto.InternalMessageInfo
 
 type QueryContractLabelResponse struct {
 	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// label_index_key is the key of the label in the label index
+	LabelIndexKey []byte `protobuf:"bytes,2,opt,name=label_index_key,json=labelIndexKey,proto3" json:"label_index_key,omitempty"`
+	// indexed_contract_address is the bech32 address the label index entry
+	// points to. It is the requested contract unless the index is inconsistent.
+	IndexedContractAddress string `protobuf:"bytes,3,opt,name=indexed_contract_address,json=indexedContractAddress,proto3" json:"indexed_contract_address,omitempty"`
 }
 
 func (m *QueryContractLabelResponse) Reset()         { *m = QueryContractLabelResponse{} }
 func (m *QueryContractLabelResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractLabelResponse) ProtoMessage()    {}
 func (*QueryContractLabelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{12}
+	return fileDescriptor_7735281c5fa969d4, []int{18}
 }
 func (m *QueryContractLabelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,7 +779,7 @@ func (m *QueryCodeHashResponse) Reset()         { *m = QueryCodeHashResponse{} }
 func (m *QueryCodeHashResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCodeHashResponse) ProtoMessage()    {}
 func (*QueryCodeHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{13}
+	return fileDescriptor_7735281c5fa969d4, []int{19}
 }
 func (m *QueryCodeHashResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -567,6 +808,45 @@ func (m *QueryCodeHashResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryCodeHashResponse proto.InternalMessageInfo
 
+type QueryContractKeyResponse struct {
+	// contract_key is the enclave key of the contract: the key it got at
+	// instantiation, and the key it got at its last migration with its proof
+	ContractKey ContractKey `protobuf:"bytes,1,opt,name=contract_key,json=contractKey,proto3" json:"contract_key"`
+}
+
+func (m *QueryContractKeyResponse) Reset()         { *m = QueryContractKeyResponse{} }
+func (m *QueryContractKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractKeyResponse) ProtoMessage()    {}
+func (*QueryContractKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{20}
+}
+func (m *QueryContractKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractKeyResponse.Merge(m, src)
+}
+func (m *QueryContractKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractKeyResponse proto.InternalMessageInfo
+
 // DecryptedAnswer is a struct that represents a decrypted tx-query
 type DecryptedAnswer struct {
 	Type               string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -579,7 +859,7 @@ func (m *DecryptedAnswer) Reset()         { *m = DecryptedAnswer{} }
 func (m *DecryptedAnswer) String() string { return proto.CompactTextString(m) }
 func (*DecryptedAnswer) ProtoMessage()    {}
 func (*DecryptedAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{14}
+	return fileDescriptor_7735281c5fa969d4, []int{21}
 }
 func (m *DecryptedAnswer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -619,7 +899,7 @@ func (m *DecryptedAnswers) Reset()         { *m = DecryptedAnswers{} }
 func (m *DecryptedAnswers) String() string { return proto.CompactTextString(m) }
 func (*DecryptedAnswers) ProtoMessage()    {}
 func (*DecryptedAnswers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{15}
+	return fileDescriptor_7735281c5fa969d4, []int{22}
 }
 func (m *DecryptedAnswers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +939,7 @@ func (m *QueryContractHistoryRequest) Reset()         { *m = QueryContractHistor
 func (m *QueryContractHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryContractHistoryRequest) ProtoMessage()    {}
 func (*QueryContractHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{16}
+	return fileDescriptor_7735281c5fa969d4, []int{23}
 }
 func (m *QueryContractHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -698,7 +978,7 @@ func (m *QueryContractHistoryResponse) Reset()         { *m = QueryContractHisto
 func (m *QueryContractHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryContractHistoryResponse) ProtoMessage()    {}
 func (*QueryContractHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7735281c5fa969d4, []int{17}
+	return fileDescriptor_7735281c5fa969d4, []int{24}
 }
 func (m *QueryContractHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,1908 +1007,7649 @@ func (m *QueryContractHistoryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryContractHistoryResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QuerySecretContractRequest)(nil), "secret.compute.v1beta1.QuerySecretContractRequest")
-	proto.RegisterType((*QueryByLabelRequest)(nil), "secret.compute.v1beta1.QueryByLabelRequest")
-	proto.RegisterType((*QueryByContractAddressRequest)(nil), "secret.compute.v1beta1.QueryByContractAddressRequest")
-	proto.RegisterType((*QueryByCodeIdRequest)(nil), "secret.compute.v1beta1.QueryByCodeIdRequest")
-	proto.RegisterType((*QuerySecretContractResponse)(nil), "secret.compute.v1beta1.QuerySecretContractResponse")
-	proto.RegisterType((*QueryContractInfoResponse)(nil), "secret.compute.v1beta1.QueryContractInfoResponse")
-	proto.RegisterType((*ContractInfoWithAddress)(nil), "secret.compute.v1beta1.ContractInfoWithAddress")
-	proto.RegisterType((*QueryContractsByCodeIdResponse)(nil), "secret.compute.v1beta1.QueryContractsByCodeIdResponse")
-	proto.RegisterType((*CodeInfoResponse)(nil), "secret.compute.v1beta1.CodeInfoResponse")
-	proto.RegisterType((*QueryCodeResponse)(nil), "secret.compute.v1beta1.QueryCodeResponse")
-	proto.RegisterType((*QueryCodesResponse)(nil), "secret.compute.v1beta1.QueryCodesResponse")
-	proto.RegisterType((*QueryContractAddressResponse)(nil), "secret.compute.v1beta1.QueryContractAddressResponse")
-	proto.RegisterType((*QueryContractLabelResponse)(nil), "secret.compute.v1beta1.QueryContractLabelResponse")
-	proto.RegisterType((*QueryCodeHashResponse)(nil), "secret.compute.v1beta1.QueryCodeHashResponse")
-	proto.RegisterType((*DecryptedAnswer)(nil), "secret.compute.v1beta1.DecryptedAnswer")
-	proto.RegisterType((*DecryptedAnswers)(nil), "secret.compute.v1beta1.DecryptedAnswers")
-	proto.RegisterType((*QueryContractHistoryRequest)(nil), "secret.compute.v1beta1.QueryContractHistoryRequest")
-	proto.RegisterType((*QueryContractHistoryResponse)(nil), "secret.compute.v1beta1.QueryContractHistoryResponse")
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("secret/compute/v1beta1/query.proto", fileDescriptor_7735281c5fa969d4)
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{25}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_7735281c5fa969d4 = []byte{
-	// 1245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xa4, 0x4e, 0xd2, 0x4c, 0x7e, 0x76, 0xbe, 0x69, 0xea, 0x3a, 0xfd, 0x3a, 0xed, 0x52,
-	0xc8, 0xaf, 0xe2, 0xad, 0x9d, 0x50, 0xa4, 0x8a, 0x4b, 0x92, 0x46, 0x6a, 0x50, 0x28, 0xe0, 0x1c,
-	0x90, 0x50, 0x91, 0x35, 0x5e, 0x4f, 0xec, 0x55, 0x9d, 0x9d, 0xed, 0xce, 0x38, 0x89, 0x85, 0xc2,
-	0x81, 0x13, 0x47, 0x24, 0xe0, 0x80, 0x7a, 0xe1, 0x04, 0x15, 0x07, 0x24, 0xae, 0xfc, 0x05, 0x39,
-	0x70, 0x88, 0xc4, 0x85, 0x53, 0x05, 0x09, 0x07, 0xc4, 0x9d, 0x3b, 0xda, 0x37, 0xb3, 0x9b, 0xb5,
-	0xbd, 0x8e, 0xed, 0x72, 0xe0, 0xb6, 0x33, 0xf3, 0xe6, 0x7d, 0x3e, 0xf3, 0x79, 0x6f, 0xde, 0x9b,
-	0xc5, 0x86, 0x60, 0x96, 0xc7, 0xa4, 0x69, 0xf1, 0x3d, 0xb7, 0x2e, 0x99, 0xb9, 0x9f, 0x2b, 0x31,
-	0x49, 0x73, 0xe6, 0xd3, 0x3a, 0xf3, 0x1a, 0x59, 0xd7, 0xe3, 0x92, 0x93, 0x19, 0x65, 0x93, 0xd5,
-	0x36, 0x59, 0x6d, 0x93, 0x9e, 0xae, 0xf0, 0x0a, 0x07, 0x13, 0xd3, 0xff, 0x52, 0xd6, 0xe9, 0x4e,
-	0x1e, 0x65, 0xc3, 0x65, 0x42, 0xdb, 0xcc, 0x56, 0x38, 0xaf, 0xd4, 0x98, 0x09, 0xa3, 0x52, 0x7d,
-	0xd7, 0x64, 0x7b, 0xae, 0xd4, 0x70, 0xe9, 0x1b, 0x7a, 0x91, 0xba, 0xb6, 0x49, 0x1d, 0x87, 0x4b,
-	0x2a, 0x6d, 0xee, 0x04, 0x5b, 0x5f, 0xb1, 0xb8, 0xd8, 0xe3, 0xc2, 0x2c, 0x51, 0xc1, 0x4c, 0x5a,
-	0xb2, 0xec, 0x10, 0xc0, 0x1f, 0x68, 0xa3, 0xa5, 0xa8, 0x11, 0x1c, 0x25, 0xb4, 0x72, 0x69, 0xc5,
-	0x76, 0xc0, 0xa3, 0xb2, 0x35, 0x3e, 0xc2, 0xe9, 0xf7, 0x7d, 0x8b, 0x1d, 0xa0, 0xbd, 0xc1, 0x1d,
-	0xe9, 0x51, 0x4b, 0x16, 0xd8, 0xd3, 0x3a, 0x13, 0x92, 0x2c, 0xe2, 0x29, 0x4b, 0x4f, 0x15, 0x69,
-	0xb9, 0xec, 0x31, 0x21, 0x52, 0xe8, 0x26, 0x5a, 0x18, 0x29, 0x4c, 0x06, 0xf3, 0x6b, 0x6a, 0x9a,
-	0x4c, 0xe3, 0x41, 0x80, 0x4a, 0x0d, 0xdc, 0x44, 0x0b, 0x63, 0x05, 0x35, 0x30, 0x96, 0xf1, 0xff,
-	0xc0, 0xfd, 0x7a, 0x63, 0x9b, 0x96, 0x58, 0x2d, 0xf0, 0x3b, 0x8d, 0x07, 0x6b, 0xfe, 0x58, 0x3b,
-	0x53, 0x03, 0xe3, 0x6d, 0xfc, 0x7f, 0x6d, 0xbc, 0xd1, 0xec, 0xbc, 0x7f, 0x3a, 0x86, 0x89, 0xa7,
-	0x43, 0x5f, 0x65, 0xb6, 0x55, 0x0e, 0x5c, 0x5c, 0xc3, 0xc3, 0x16, 0x2f, 0xb3, 0xa2, 0x5d, 0x86,
-	0x9d, 0xc9, 0xc2, 0x90, 0x05, 0xeb, 0x46, 0x0e, 0xcf, 0xc6, 0x0a, 0x21, 0x5c, 0xee, 0x08, 0x46,
-	0x08, 0x4e, 0x96, 0xa9, 0xa4, 0xb0, 0x69, 0xac, 0x00, 0xdf, 0xc6, 0x33, 0x84, 0xaf, 0xc3, 0x9e,
-	0xc0, 0x7a, 0xcb, 0xd9, 0xe5, 0xe1, 0x8e, 0x3e, 0xb4, 0xdb, 0xc1, 0xe3, 0xa1, 0xa9, 0xed, 0xec,
-	0x72, 0xd0, 0x70, 0x34, 0x7f, 0x3b, 0x1b, 0x9f, 0x7a, 0xd9, 0x28, 0xde, 0xfa, 0xe5, 0x93, 0x17,
-	0x73, 0xe8, 0xaf, 0x17, 0x73, 0x89, 0xc2, 0x98, 0x15, 0x99, 0x37, 0xbe, 0x46, 0xf8, 0x5a, 0xd4,
-	0xf0, 0x03, 0x5b, 0x56, 0x03, 0xc0, 0xff, 0x9a, 0xdb, 0x27, 0x38, 0xd3, 0x24, 0x9c, 0x38, 0x0f,
-	0x93, 0x56, 0xef, 0x31, 0x9e, 0x68, 0x82, 0xf5, 0xf9, 0x5d, 0x5a, 0x18, 0xcd, 0x9b, 0xbd, 0xe0,
-	0x46, 0x8e, 0xba, 0x9e, 0x3c, 0xf6, 0xe1, 0xc7, 0xa3, 0xf0, 0xc2, 0xf8, 0x12, 0xe1, 0x29, 0x00,
-	0x8c, 0x06, 0xac, 0x53, 0x6a, 0x90, 0x14, 0x1e, 0xb6, 0x3c, 0x46, 0x25, 0xf7, 0xe0, 0xf0, 0x23,
-	0x85, 0x60, 0x48, 0x66, 0xf1, 0x08, 0x6c, 0xa9, 0x52, 0x51, 0x4d, 0x5d, 0x82, 0xb5, 0xcb, 0xfe,
-	0xc4, 0x43, 0x2a, 0xaa, 0x64, 0x06, 0x0f, 0x09, 0x5e, 0xf7, 0x2c, 0x96, 0x4a, 0xc2, 0x8a, 0x1e,
-	0xf9, 0xee, 0x4a, 0x75, 0xbb, 0x56, 0x66, 0x5e, 0x6a, 0x50, 0xb9, 0xd3, 0x43, 0xe3, 0x10, 0x5f,
-	0xd1, 0xb2, 0x94, 0x59, 0x48, 0xeb, 0x5d, 0x8d, 0x01, 0xe2, 0x23, 0x10, 0x7f, 0xa1, 0xb3, 0x08,
-	0xcd, 0x67, 0x8a, 0x04, 0x00, 0x78, 0xf9, 0x6b, 0x7e, 0x2a, 0x1f, 0x50, 0xb1, 0xa7, 0x2f, 0x2a,
-	0x7c, 0x1b, 0x16, 0x26, 0x21, 0xb2, 0x08, 0xa1, 0xdf, 0xc1, 0x38, 0x84, 0x0e, 0x02, 0xd0, 0x3b,
-	0xb6, 0x52, 0x7e, 0x24, 0xc0, 0x15, 0xc6, 0x16, 0xbe, 0xd1, 0x14, 0xf5, 0xf0, 0x76, 0xf7, 0x7d,
-	0x63, 0x8c, 0xbc, 0x2e, 0x5b, 0x81, 0x2b, 0x5d, 0x5d, 0xb4, 0xa3, 0xf8, 0xf2, 0xb2, 0x8a, 0xaf,
-	0x86, 0x67, 0xf4, 0x03, 0x14, 0x9a, 0x37, 0x45, 0x11, 0x35, 0x47, 0xd1, 0xf8, 0x0a, 0xe1, 0xc9,
-	0x07, 0xcc, 0xf2, 0x1a, 0xae, 0x64, 0xe5, 0x35, 0x47, 0x1c, 0x30, 0xcf, 0x57, 0xd0, 0xaf, 0xe7,
-	0xda, 0x16, 0xbe, 0x7d, 0x4c, 0xdb, 0x71, 0xeb, 0x52, 0xa7, 0x88, 0x1a, 0x90, 0x39, 0x3c, 0xca,
-	0xeb, 0xd2, 0xad, 0xcb, 0x22, 0x54, 0x0f, 0x95, 0x22, 0x58, 0x4d, 0x3d, 0xa0, 0x92, 0x92, 0x1c,
-	0xbe, 0x1a, 0x31, 0x28, 0x52, 0x51, 0x14, 0xd2, 0xb3, 0x9d, 0x8a, 0xce, 0x19, 0x72, 0x6e, 0xba,
-	0x26, 0x76, 0x60, 0xe5, 0x7e, 0xf2, 0xcf, 0x6f, 0xe6, 0x12, 0xc6, 0xdf, 0x08, 0x4f, 0xb5, 0xf0,
-	0x12, 0x64, 0x0d, 0x0f, 0x53, 0xf5, 0xa9, 0xa3, 0x35, 0xdf, 0x29, 0x5a, 0x2d, 0x5b, 0x0b, 0xc1,
-	0x3e, 0xb2, 0x1d, 0x32, 0xae, 0xf1, 0x8a, 0x48, 0x0d, 0x80, 0x9b, 0x57, 0xb3, 0xaa, 0xa5, 0x64,
-	0xfd, 0x96, 0x92, 0x85, 0x56, 0x13, 0x38, 0x52, 0xa4, 0x36, 0xf7, 0x99, 0x23, 0x75, 0xc4, 0xf5,
-	0xf1, 0xb6, 0x79, 0x45, 0x90, 0x5b, 0x78, 0x4c, 0x7b, 0x63, 0x9e, 0xc7, 0x3d, 0x2d, 0x80, 0x46,
-	0xd8, 0xf4, 0xa7, 0xc8, 0x3c, 0x9e, 0x74, 0x6b, 0xd4, 0x76, 0x24, 0x3b, 0x0c, 0xac, 0xd4, 0xd9,
-	0x27, 0xc2, 0x69, 0x30, 0xd4, 0xe7, 0x7e, 0xa4, 0xeb, 0x74, 0x10, 0xf9, 0x87, 0xb6, 0x90, 0xdc,
-	0x6b, 0xf4, 0xdf, 0x22, 0xb4, 0xbf, 0xfd, 0x96, 0xa4, 0x0c, 0xfd, 0xe9, 0xe4, 0x78, 0x0f, 0x0f,
-	0x33, 0x47, 0x7a, 0x36, 0x0b, 0x24, 0xbd, 0xdb, 0xad, 0x02, 0x41, 0x7e, 0x29, 0x2f, 0x9b, 0x8e,
-	0xf4, 0x1a, 0x5a, 0x96, 0xc0, 0x8d, 0xc2, 0xcd, 0x3f, 0x1b, 0xc7, 0x83, 0x00, 0x4c, 0xbe, 0x47,
-	0x78, 0x2c, 0x5a, 0xbd, 0xc8, 0x1b, 0x9d, 0x10, 0x2e, 0xec, 0x8e, 0xe9, 0xdc, 0x85, 0xdb, 0xe2,
-	0x7a, 0x94, 0x71, 0xf7, 0xd3, 0x5f, 0xfe, 0xf8, 0x62, 0x60, 0x89, 0x2c, 0xb4, 0xbd, 0x57, 0xfc,
-	0x2b, 0x6f, 0x7e, 0xdc, 0x2a, 0xe5, 0x11, 0xf9, 0x0e, 0xe1, 0x2b, 0x6d, 0x55, 0x9b, 0xdc, 0xe9,
-	0xca, 0x38, 0xd2, 0x83, 0xd3, 0xf7, 0x7a, 0x22, 0xda, 0xd6, 0x13, 0x8c, 0x3b, 0xc0, 0xf6, 0x35,
-	0x72, 0xbb, 0x8d, 0x6d, 0xc0, 0x53, 0xf8, 0x94, 0xa1, 0x84, 0x1f, 0x91, 0x1f, 0x91, 0x7e, 0x7b,
-	0x34, 0x77, 0x74, 0x92, 0xbf, 0x10, 0x3d, 0xf6, 0x1d, 0x94, 0x5e, 0xe9, 0x6b, 0x8f, 0xa6, 0x9b,
-	0x03, 0xba, 0xcb, 0x64, 0x31, 0xfe, 0x79, 0x19, 0xa7, 0xee, 0x67, 0x08, 0x27, 0xfd, 0x43, 0xf7,
-	0x29, 0xe8, 0x62, 0x17, 0x41, 0xcf, 0xbb, 0x89, 0x31, 0x0f, 0xa4, 0x6e, 0x91, 0xb9, 0x18, 0x0d,
-	0xcb, 0x2c, 0x22, 0xdf, 0x13, 0x3c, 0x08, 0xcd, 0x80, 0xcc, 0x64, 0xd5, 0x8b, 0x34, 0x1b, 0x3c,
-	0x57, 0xb3, 0x9b, 0xfe, 0x73, 0x35, 0xbd, 0xd4, 0x15, 0x34, 0xac, 0xec, 0x46, 0x06, 0x50, 0x53,
-	0x64, 0x26, 0x16, 0x55, 0x90, 0x9f, 0x11, 0xbe, 0x1e, 0x94, 0xe5, 0xb6, 0xfc, 0x7e, 0xd9, 0xfb,
-	0xf0, 0x7a, 0x57, 0x82, 0xd1, 0x2e, 0x60, 0x6c, 0x01, 0xc7, 0x0d, 0xb2, 0x16, 0xcb, 0x11, 0x9a,
-	0x83, 0x59, 0x6a, 0x14, 0x5b, 0x83, 0x16, 0x17, 0xc6, 0xe7, 0xfa, 0x79, 0x11, 0x1c, 0xe7, 0x25,
-	0xee, 0x48, 0x9f, 0xe4, 0xdf, 0x04, 0xf2, 0x39, 0x62, 0x76, 0x23, 0x0f, 0xd1, 0x8d, 0x84, 0xf9,
-	0x07, 0x84, 0x27, 0xa0, 0x79, 0xae, 0x37, 0xfe, 0xa5, 0xdc, 0xf9, 0x9e, 0x6e, 0x75, 0x53, 0xa3,
-	0xbe, 0xe0, 0x8a, 0x40, 0xcb, 0x8e, 0xd3, 0xf6, 0x5b, 0x84, 0x27, 0x82, 0xb7, 0x9d, 0xfa, 0xa9,
-	0x20, 0xcb, 0x5d, 0x08, 0x47, 0x7f, 0x3d, 0xd2, 0xab, 0x3d, 0xd1, 0x6c, 0x79, 0x9a, 0x5c, 0x40,
-	0xb4, 0x3d, 0x1f, 0x80, 0xfa, 0x11, 0xf9, 0x09, 0xe1, 0xc9, 0x96, 0xa6, 0x42, 0x56, 0x7a, 0x02,
-	0x6f, 0x6e, 0x69, 0x3d, 0x32, 0x6e, 0xe9, 0x5b, 0xc6, 0x5b, 0xc0, 0xf8, 0x1e, 0x59, 0xed, 0xcc,
-	0xb8, 0xaa, 0xb6, 0xc4, 0xa8, 0xbc, 0xfe, 0xf8, 0xf8, 0xf7, 0x4c, 0xe2, 0xf9, 0x69, 0x06, 0x1d,
-	0x9f, 0x66, 0xd0, 0xc9, 0x69, 0x06, 0xfd, 0x76, 0x9a, 0x41, 0x9f, 0x9f, 0x65, 0x12, 0x27, 0x67,
-	0x99, 0xc4, 0xaf, 0x67, 0x99, 0xc4, 0x87, 0xf7, 0x2b, 0xb6, 0xac, 0xd6, 0x4b, 0x3e, 0x29, 0x53,
-	0x58, 0x9e, 0xac, 0xd1, 0x92, 0x30, 0x55, 0x25, 0x7c, 0xc4, 0xe4, 0x01, 0xf7, 0x9e, 0x98, 0x87,
-	0x21, 0xb4, 0xdf, 0xc6, 0x3d, 0x87, 0xd6, 0xd4, 0x6f, 0x70, 0x69, 0x08, 0x4a, 0xc9, 0xca, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x66, 0x4a, 0xf1, 0xe3, 0x7f, 0x0f, 0x00, 0x00,
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (this *QuerySecretContractRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{26}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QuerySecretContractRequest)
-	if !ok {
-		that2, ok := that.(QuerySecretContractRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+// QuerySnip20WrapperResponse is the response type for the Query/Snip20WrapperByDenom
+// and Query/Snip20WrapperByContract RPC methods
+type QuerySnip20WrapperResponse struct {
+	Snip20Wrapper Snip20Wrapper `protobuf:"bytes,1,opt,name=snip20_wrapper,json=snip20Wrapper,proto3" json:"snip20_wrapper"`
+}
+
+func (m *QuerySnip20WrapperResponse) Reset()         { *m = QuerySnip20WrapperResponse{} }
+func (m *QuerySnip20WrapperResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySnip20WrapperResponse) ProtoMessage()    {}
+func (*QuerySnip20WrapperResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{27}
+}
+func (m *QuerySnip20WrapperResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnip20WrapperResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnip20WrapperResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	if !bytes.Equal(this.Query, that1.Query) {
-		return false
-	}
-	return true
 }
-func (this *QueryByLabelRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QuerySnip20WrapperResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnip20WrapperResponse.Merge(m, src)
+}
+func (m *QuerySnip20WrapperResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnip20WrapperResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnip20WrapperResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryByLabelRequest)
-	if !ok {
-		that2, ok := that.(QueryByLabelRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	return true
+var xxx_messageInfo_QuerySnip20WrapperResponse proto.InternalMessageInfo
+
+type QueryScheduledCallRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (this *QueryByContractAddressRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryByContractAddressRequest)
-	if !ok {
-		that2, ok := that.(QueryByContractAddressRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryScheduledCallRequest) Reset()         { *m = QueryScheduledCallRequest{} }
+func (m *QueryScheduledCallRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallRequest) ProtoMessage()    {}
+func (*QueryScheduledCallRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{28}
+}
+func (m *QueryScheduledCallRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	return true
 }
-func (this *QueryByCodeIdRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryScheduledCallRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryByCodeIdRequest)
-	if !ok {
-		that2, ok := that.(QueryByCodeIdRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeId != that1.CodeId {
-		return false
-	}
-	return true
+var xxx_messageInfo_QueryScheduledCallRequest proto.InternalMessageInfo
+
+type QueryScheduledCallResponse struct {
+	ScheduledCall ScheduledCall `protobuf:"bytes,1,opt,name=scheduled_call,json=scheduledCall,proto3" json:"scheduled_call"`
 }
-func (this *QuerySecretContractResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QuerySecretContractResponse)
-	if !ok {
-		that2, ok := that.(QuerySecretContractResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryScheduledCallResponse) Reset()         { *m = QueryScheduledCallResponse{} }
+func (m *QueryScheduledCallResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallResponse) ProtoMessage()    {}
+func (*QueryScheduledCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{29}
+}
+func (m *QueryScheduledCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.Data, that1.Data) {
-		return false
-	}
-	return true
 }
-func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryScheduledCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryContractInfoResponse)
-	if !ok {
-		that2, ok := that.(QueryContractInfoResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	if !this.ContractInfo.Equal(that1.ContractInfo) {
-		return false
-	}
-	return true
+var xxx_messageInfo_QueryScheduledCallResponse proto.InternalMessageInfo
+
+type QueryScheduledCallsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (this *ContractInfoWithAddress) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ContractInfoWithAddress)
-	if !ok {
-		that2, ok := that.(ContractInfoWithAddress)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryScheduledCallsRequest) Reset()         { *m = QueryScheduledCallsRequest{} }
+func (m *QueryScheduledCallsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsRequest) ProtoMessage()    {}
+func (*QueryScheduledCallsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{30}
+}
+func (m *QueryScheduledCallsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	if !this.ContractInfo.Equal(that1.ContractInfo) {
-		return false
-	}
-	return true
 }
-func (this *QueryContractsByCodeIdResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryScheduledCallsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsRequest.Merge(m, src)
+}
+func (m *QueryScheduledCallsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryContractsByCodeIdResponse)
-	if !ok {
-		that2, ok := that.(QueryContractsByCodeIdResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_QueryScheduledCallsRequest proto.InternalMessageInfo
+
+type QueryScheduledCallsResponse struct {
+	ScheduledCalls []ScheduledCall     `protobuf:"bytes,1,rep,name=scheduled_calls,json=scheduledCalls,proto3" json:"scheduled_calls"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduledCallsResponse) Reset()         { *m = QueryScheduledCallsResponse{} }
+func (m *QueryScheduledCallsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduledCallsResponse) ProtoMessage()    {}
+func (*QueryScheduledCallsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{31}
+}
+func (m *QueryScheduledCallsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduledCallsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduledCallsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.ContractInfos) != len(that1.ContractInfos) {
-		return false
-	}
-	for i := range this.ContractInfos {
-		if !this.ContractInfos[i].Equal(&that1.ContractInfos[i]) {
-			return false
+}
+func (m *QueryScheduledCallsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduledCallsResponse.Merge(m, src)
+}
+func (m *QueryScheduledCallsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduledCallsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduledCallsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduledCallsResponse proto.InternalMessageInfo
+
+type QueryCronRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryCronRequest) Reset()         { *m = QueryCronRequest{} }
+func (m *QueryCronRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCronRequest) ProtoMessage()    {}
+func (*QueryCronRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{32}
+}
+func (m *QueryCronRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *CodeInfoResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryCronRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronRequest.Merge(m, src)
+}
+func (m *QueryCronRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*CodeInfoResponse)
-	if !ok {
-		that2, ok := that.(CodeInfoResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeId != that1.CodeId {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if this.CodeHash != that1.CodeHash {
-		return false
-	}
-	if this.Source != that1.Source {
-		return false
-	}
-	if this.Builder != that1.Builder {
-		return false
-	}
-	return true
+var xxx_messageInfo_QueryCronRequest proto.InternalMessageInfo
+
+type QueryCronResponse struct {
+	Cron Cron `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron"`
 }
-func (this *QueryCodeResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryCodeResponse)
-	if !ok {
-		that2, ok := that.(QueryCodeResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryCronResponse) Reset()         { *m = QueryCronResponse{} }
+func (m *QueryCronResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCronResponse) ProtoMessage()    {}
+func (*QueryCronResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{33}
+}
+func (m *QueryCronResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CodeInfoResponse.Equal(that1.CodeInfoResponse) {
-		return false
-	}
-	if !bytes.Equal(this.Wasm, that1.Wasm) {
-		return false
-	}
-	return true
 }
-func (this *QueryCodesResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryCronResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronResponse.Merge(m, src)
+}
+func (m *QueryCronResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryCodesResponse)
-	if !ok {
-		that2, ok := that.(QueryCodesResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.CodeInfos) != len(that1.CodeInfos) {
-		return false
-	}
-	for i := range this.CodeInfos {
-		if !this.CodeInfos[i].Equal(&that1.CodeInfos[i]) {
-			return false
-		}
-	}
-	return true
+var xxx_messageInfo_QueryCronResponse proto.InternalMessageInfo
+
+type QueryCronsByContractRequest struct {
+	ContractAddress string             `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (this *QueryContractAddressResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryContractAddressResponse)
-	if !ok {
-		that2, ok := that.(QueryContractAddressResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryCronsByContractRequest) Reset()         { *m = QueryCronsByContractRequest{} }
+func (m *QueryCronsByContractRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCronsByContractRequest) ProtoMessage()    {}
+func (*QueryCronsByContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{34}
+}
+func (m *QueryCronsByContractRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronsByContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronsByContractRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ContractAddress != that1.ContractAddress {
-		return false
-	}
-	return true
 }
-func (this *QueryContractLabelResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *QueryCronsByContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronsByContractRequest.Merge(m, src)
+}
+func (m *QueryCronsByContractRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronsByContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronsByContractRequest.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryContractLabelResponse)
-	if !ok {
-		that2, ok := that.(QueryContractLabelResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Label != that1.Label {
-		return false
-	}
-	return true
+var xxx_messageInfo_QueryCronsByContractRequest proto.InternalMessageInfo
+
+type QueryCronsByContractResponse struct {
+	Crons      []Cron              `protobuf:"bytes,1,rep,name=crons,proto3" json:"crons"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (this *QueryCodeHashResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*QueryCodeHashResponse)
-	if !ok {
-		that2, ok := that.(QueryCodeHashResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *QueryCronsByContractResponse) Reset()         { *m = QueryCronsByContractResponse{} }
+func (m *QueryCronsByContractResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCronsByContractResponse) ProtoMessage()    {}
+func (*QueryCronsByContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{35}
+}
+func (m *QueryCronsByContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCronsByContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCronsByContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeHash != that1.CodeHash {
-		return false
-	}
-	return true
+}
+func (m *QueryCronsByContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCronsByContractResponse.Merge(m, src)
+}
+func (m *QueryCronsByContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCronsByContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCronsByContractResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCronsByContractResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Query contract info by address
-	ContractInfo(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error)
-	// Query code info by id
-	ContractsByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIdResponse, error)
-	// Query secret contract
-	QuerySecretContract(ctx context.Context, in *QuerySecretContractRequest, opts ...grpc.CallOption) (*QuerySecretContractResponse, error)
-	// Query a specific contract code by id
-	Code(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
-	// Query all contract codes on-chain
-	Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryCodesResponse, error)
-	// Query code hash by contract address
-	CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
-	// Query code hash by code id
-	CodeHashByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
-	// Query contract label by address
-	LabelByAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractLabelResponse, error)
-	// Query contract address by label
-	AddressByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
-	// ContractHistory gets the contract code history
-	ContractHistory(ctx context.Context, in *QueryContractHistoryRequest, opts ...grpc.CallOption) (*QueryContractHistoryResponse, error)
+type QueryContractFeePolicyResponse struct {
+	FeePolicy ContractFeePolicy `protobuf:"bytes,1,opt,name=fee_policy,json=feePolicy,proto3" json:"fee_policy"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryContractFeePolicyResponse) Reset()         { *m = QueryContractFeePolicyResponse{} }
+func (m *QueryContractFeePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractFeePolicyResponse) ProtoMessage()    {}
+func (*QueryContractFeePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{36}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryContractFeePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) ContractInfo(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error) {
-	out := new(QueryContractInfoResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryContractFeePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractFeePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ContractsByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIdResponse, error) {
-	out := new(QueryContractsByCodeIdResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractsByCodeId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryContractFeePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractFeePolicyResponse.Merge(m, src)
 }
-
-func (c *queryClient) QuerySecretContract(ctx context.Context, in *QuerySecretContractRequest, opts ...grpc.CallOption) (*QuerySecretContractResponse, error) {
-	out := new(QuerySecretContractResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/QuerySecretContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryContractFeePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractFeePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractFeePolicyResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Code(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
-	out := new(QueryCodeResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Code", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryContractFeePolicyResponse proto.InternalMessageInfo
 
-func (c *queryClient) Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryCodesResponse, error) {
-	out := new(QueryCodesResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Codes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryCodeSchemaResponse struct {
+	Schema CodeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
 }
 
-func (c *queryClient) CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
-	out := new(QueryCodeHashResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeHashByContractAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCodeSchemaResponse) Reset()         { *m = QueryCodeSchemaResponse{} }
+func (m *QueryCodeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeSchemaResponse) ProtoMessage()    {}
+func (*QueryCodeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{37}
+}
+func (m *QueryCodeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCodeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeSchemaResponse.Merge(m, src)
+}
+func (m *QueryCodeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeSchemaResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) CodeHashByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
-	out := new(QueryCodeHashResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeHashByCodeId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryCodeSchemaResponse proto.InternalMessageInfo
+
+type QueryCodeAuditsResponse struct {
+	Audits []CodeAudit `protobuf:"bytes,1,rep,name=audits,proto3" json:"audits"`
 }
 
-func (c *queryClient) LabelByAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractLabelResponse, error) {
-	out := new(QueryContractLabelResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/LabelByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCodeAuditsResponse) Reset()         { *m = QueryCodeAuditsResponse{} }
+func (m *QueryCodeAuditsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeAuditsResponse) ProtoMessage()    {}
+func (*QueryCodeAuditsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{38}
+}
+func (m *QueryCodeAuditsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeAuditsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeAuditsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCodeAuditsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeAuditsResponse.Merge(m, src)
+}
+func (m *QueryCodeAuditsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeAuditsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeAuditsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AddressByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
-	out := new(QueryContractAddressResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/AddressByLabel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryCodeAuditsResponse proto.InternalMessageInfo
+
+type QueryCodeVerificationsRequest struct {
+	// code_hash is the hex encoded sha256 hash of the code
+	CodeHash   string             `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ContractHistory(ctx context.Context, in *QueryContractHistoryRequest, opts ...grpc.CallOption) (*QueryContractHistoryResponse, error) {
-	out := new(QueryContractHistoryResponse)
-	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCodeVerificationsRequest) Reset()         { *m = QueryCodeVerificationsRequest{} }
+func (m *QueryCodeVerificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeVerificationsRequest) ProtoMessage()    {}
+func (*QueryCodeVerificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{39}
+}
+func (m *QueryCodeVerificationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeVerificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeVerificationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Query contract info by address
-	ContractInfo(context.Context, *QueryByContractAddressRequest) (*QueryContractInfoResponse, error)
-	// Query code info by id
-	ContractsByCodeId(context.Context, *QueryByCodeIdRequest) (*QueryContractsByCodeIdResponse, error)
-	// Query secret contract
-	QuerySecretContract(context.Context, *QuerySecretContractRequest) (*QuerySecretContractResponse, error)
-	// Query a specific contract code by id
-	Code(context.Context, *QueryByCodeIdRequest) (*QueryCodeResponse, error)
-	// Query all contract codes on-chain
-	Codes(context.Context, *emptypb.Empty) (*QueryCodesResponse, error)
-	// Query code hash by contract address
-	CodeHashByContractAddress(context.Context, *QueryByContractAddressRequest) (*QueryCodeHashResponse, error)
-	// Query code hash by code id
-	CodeHashByCodeId(context.Context, *QueryByCodeIdRequest) (*QueryCodeHashResponse, error)
-	// Query contract label by address
-	LabelByAddress(context.Context, *QueryByContractAddressRequest) (*QueryContractLabelResponse, error)
-	// Query contract address by label
-	AddressByLabel(context.Context, *QueryByLabelRequest) (*QueryContractAddressResponse, error)
-	// ContractHistory gets the contract code history
-	ContractHistory(context.Context, *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error)
+func (m *QueryCodeVerificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeVerificationsRequest.Merge(m, src)
+}
+func (m *QueryCodeVerificationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeVerificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeVerificationsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryCodeVerificationsRequest proto.InternalMessageInfo
+
+type QueryCodeVerificationsResponse struct {
+	Verifications []CodeVerification  `protobuf:"bytes,1,rep,name=verifications,proto3" json:"verifications"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) ContractInfo(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractInfo not implemented")
+func (m *QueryCodeVerificationsResponse) Reset()         { *m = QueryCodeVerificationsResponse{} }
+func (m *QueryCodeVerificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeVerificationsResponse) ProtoMessage()    {}
+func (*QueryCodeVerificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{40}
 }
-func (*UnimplementedQueryServer) ContractsByCodeId(ctx context.Context, req *QueryByCodeIdRequest) (*QueryContractsByCodeIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractsByCodeId not implemented")
+func (m *QueryCodeVerificationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QuerySecretContract(ctx context.Context, req *QuerySecretContractRequest) (*QuerySecretContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySecretContract not implemented")
+func (m *QueryCodeVerificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeVerificationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+func (m *QueryCodeVerificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeVerificationsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Codes(ctx context.Context, req *emptypb.Empty) (*QueryCodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Codes not implemented")
+func (m *QueryCodeVerificationsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CodeHashByContractAddress(ctx context.Context, req *QueryByContractAddressRequest) (*QueryCodeHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CodeHashByContractAddress not implemented")
+func (m *QueryCodeVerificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeVerificationsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) CodeHashByCodeId(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CodeHashByCodeId not implemented")
+
+var xxx_messageInfo_QueryCodeVerificationsResponse proto.InternalMessageInfo
+
+type QueryContractAssetsResponse struct {
+	Balances             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	Delegations          []types1.DelegationResponse              `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations"`
+	UnbondingDelegations []types1.UnbondingDelegation             `protobuf:"bytes,3,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations"`
 }
-func (*UnimplementedQueryServer) LabelByAddress(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractLabelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LabelByAddress not implemented")
+
+func (m *QueryContractAssetsResponse) Reset()         { *m = QueryContractAssetsResponse{} }
+func (m *QueryContractAssetsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractAssetsResponse) ProtoMessage()    {}
+func (*QueryContractAssetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{41}
 }
-func (*UnimplementedQueryServer) AddressByLabel(ctx context.Context, req *QueryByLabelRequest) (*QueryContractAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressByLabel not implemented")
+func (m *QueryContractAssetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContractHistory(ctx context.Context, req *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractHistory not implemented")
+func (m *QueryContractAssetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractAssetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractAssetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractAssetsResponse.Merge(m, src)
+}
+func (m *QueryContractAssetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractAssetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractAssetsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryContractAssetsResponse proto.InternalMessageInfo
+
+type QueryTopContractsByGasRequest struct {
+	// blocks sums the gas used in the last blocks, the current one included, up
+	// to GasUsageBlockRetention blocks
+	Blocks uint32 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// days sums the gas used in the last UTC days, the current one included, up
+	// to GasUsageDayRetention days. If both blocks and days are zero, the gas of
+	// the current day is summed.
+	Days uint32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// limit is the number of contracts returned, DefaultTopContractsLimit if zero
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func _Query_ContractInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByContractAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/ContractInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractInfo(ctx, req.(*QueryByContractAddressRequest))
+func (m *QueryTopContractsByGasRequest) Reset()         { *m = QueryTopContractsByGasRequest{} }
+func (m *QueryTopContractsByGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopContractsByGasRequest) ProtoMessage()    {}
+func (*QueryTopContractsByGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{42}
+}
+func (m *QueryTopContractsByGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopContractsByGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopContractsByGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTopContractsByGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopContractsByGasRequest.Merge(m, src)
+}
+func (m *QueryTopContractsByGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopContractsByGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopContractsByGasRequest.DiscardUnknown(m)
 }
 
-func _Query_ContractsByCodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByCodeIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractsByCodeId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/ContractsByCodeId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractsByCodeId(ctx, req.(*QueryByCodeIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryTopContractsByGasRequest proto.InternalMessageInfo
+
+type QueryTopContractsByGasResponse struct {
+	// contracts are sorted by decreasing gas used
+	Contracts []ContractGasUsage `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
 }
 
-func _Query_QuerySecretContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySecretContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySecretContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/QuerySecretContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySecretContract(ctx, req.(*QuerySecretContractRequest))
+func (m *QueryTopContractsByGasResponse) Reset()         { *m = QueryTopContractsByGasResponse{} }
+func (m *QueryTopContractsByGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopContractsByGasResponse) ProtoMessage()    {}
+func (*QueryTopContractsByGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{43}
+}
+func (m *QueryTopContractsByGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopContractsByGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopContractsByGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTopContractsByGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopContractsByGasResponse.Merge(m, src)
+}
+func (m *QueryTopContractsByGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopContractsByGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopContractsByGasResponse.DiscardUnknown(m)
 }
 
-func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByCodeIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Code(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/Code",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Code(ctx, req.(*QueryByCodeIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryTopContractsByGasResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QuerySecretContractRequest)(nil), "secret.compute.v1beta1.QuerySecretContractRequest")
+	proto.RegisterType((*QueryByLabelRequest)(nil), "secret.compute.v1beta1.QueryByLabelRequest")
+	proto.RegisterType((*QueryByContractAddressRequest)(nil), "secret.compute.v1beta1.QueryByContractAddressRequest")
+	proto.RegisterType((*QueryByCodeIdRequest)(nil), "secret.compute.v1beta1.QueryByCodeIdRequest")
+	proto.RegisterType((*QueryByDenomRequest)(nil), "secret.compute.v1beta1.QueryByDenomRequest")
+	proto.RegisterType((*QuerySecretContractResponse)(nil), "secret.compute.v1beta1.QuerySecretContractResponse")
+	proto.RegisterType((*QueryContractInfoResponse)(nil), "secret.compute.v1beta1.QueryContractInfoResponse")
+	proto.RegisterType((*ContractInfoWithAddress)(nil), "secret.compute.v1beta1.ContractInfoWithAddress")
+	proto.RegisterType((*QueryContractsByCodeIdResponse)(nil), "secret.compute.v1beta1.QueryContractsByCodeIdResponse")
+	proto.RegisterType((*QueryListContractsByCodeRequest)(nil), "secret.compute.v1beta1.QueryListContractsByCodeRequest")
+	proto.RegisterType((*ContractByCode)(nil), "secret.compute.v1beta1.ContractByCode")
+	proto.RegisterType((*QueryListContractsByCodeResponse)(nil), "secret.compute.v1beta1.QueryListContractsByCodeResponse")
+	proto.RegisterType((*CodeInfoResponse)(nil), "secret.compute.v1beta1.CodeInfoResponse")
+	proto.RegisterType((*QueryCodeResponse)(nil), "secret.compute.v1beta1.QueryCodeResponse")
+	proto.RegisterType((*QueryCodesResponse)(nil), "secret.compute.v1beta1.QueryCodesResponse")
+	proto.RegisterType((*QueryListCodesRequest)(nil), "secret.compute.v1beta1.QueryListCodesRequest")
+	proto.RegisterType((*QueryListCodesResponse)(nil), "secret.compute.v1beta1.QueryListCodesResponse")
+	proto.RegisterType((*QueryContractAddressResponse)(nil), "secret.compute.v1beta1.QueryContractAddressResponse")
+	proto.RegisterType((*QueryContractLabelResponse)(nil), "secret.compute.v1beta1.QueryContractLabelResponse")
+	proto.RegisterType((*QueryCodeHashResponse)(nil), "secret.compute.v1beta1.QueryCodeHashResponse")
+	proto.RegisterType((*QueryContractKeyResponse)(nil), "secret.compute.v1beta1.QueryContractKeyResponse")
+	proto.RegisterType((*DecryptedAnswer)(nil), "secret.compute.v1beta1.DecryptedAnswer")
+	proto.RegisterType((*DecryptedAnswers)(nil), "secret.compute.v1beta1.DecryptedAnswers")
+	proto.RegisterType((*QueryContractHistoryRequest)(nil), "secret.compute.v1beta1.QueryContractHistoryRequest")
+	proto.RegisterType((*QueryContractHistoryResponse)(nil), "secret.compute.v1beta1.QueryContractHistoryResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "secret.compute.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "secret.compute.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QuerySnip20WrapperResponse)(nil), "secret.compute.v1beta1.QuerySnip20WrapperResponse")
+	proto.RegisterType((*QueryScheduledCallRequest)(nil), "secret.compute.v1beta1.QueryScheduledCallRequest")
+	proto.RegisterType((*QueryScheduledCallResponse)(nil), "secret.compute.v1beta1.QueryScheduledCallResponse")
+	proto.RegisterType((*QueryScheduledCallsRequest)(nil), "secret.compute.v1beta1.QueryScheduledCallsRequest")
+	proto.RegisterType((*QueryScheduledCallsResponse)(nil), "secret.compute.v1beta1.QueryScheduledCallsResponse")
+	proto.RegisterType((*QueryCronRequest)(nil), "secret.compute.v1beta1.QueryCronRequest")
+	proto.RegisterType((*QueryCronResponse)(nil), "secret.compute.v1beta1.QueryCronResponse")
+	proto.RegisterType((*QueryCronsByContractRequest)(nil), "secret.compute.v1beta1.QueryCronsByContractRequest")
+	proto.RegisterType((*QueryCronsByContractResponse)(nil), "secret.compute.v1beta1.QueryCronsByContractResponse")
+	proto.RegisterType((*QueryContractFeePolicyResponse)(nil), "secret.compute.v1beta1.QueryContractFeePolicyResponse")
+	proto.RegisterType((*QueryCodeSchemaResponse)(nil), "secret.compute.v1beta1.QueryCodeSchemaResponse")
+	proto.RegisterType((*QueryCodeAuditsResponse)(nil), "secret.compute.v1beta1.QueryCodeAuditsResponse")
+	proto.RegisterType((*QueryCodeVerificationsRequest)(nil), "secret.compute.v1beta1.QueryCodeVerificationsRequest")
+	proto.RegisterType((*QueryCodeVerificationsResponse)(nil), "secret.compute.v1beta1.QueryCodeVerificationsResponse")
+	proto.RegisterType((*QueryContractAssetsResponse)(nil), "secret.compute.v1beta1.QueryContractAssetsResponse")
+	proto.RegisterType((*QueryTopContractsByGasRequest)(nil), "secret.compute.v1beta1.QueryTopContractsByGasRequest")
+	proto.RegisterType((*QueryTopContractsByGasResponse)(nil), "secret.compute.v1beta1.QueryTopContractsByGasResponse")
 }
 
-func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func init() {
+	proto.RegisterFile("secret/compute/v1beta1/query.proto", fileDescriptor_7735281c5fa969d4)
+}
+
+var fileDescriptor_7735281c5fa969d4 = []byte{
+	// 2565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xd7, 0xc8, 0x92, 0x6c, 0x3f, 0x59, 0x94, 0x3d, 0x96, 0x65, 0x99, 0x56, 0x28, 0x67, 0x1d,
+	0xdb, 0xb4, 0x65, 0x73, 0x25, 0xd9, 0x7f, 0xc5, 0xff, 0x20, 0x68, 0x23, 0xc9, 0x9f, 0xb1, 0xeb,
+	0xba, 0x54, 0xd2, 0x00, 0x69, 0x0a, 0x62, 0xb8, 0x3b, 0xa2, 0xb6, 0xa2, 0x76, 0x37, 0x3b, 0x4b,
+	0xdb, 0x84, 0xe1, 0x06, 0xc8, 0x29, 0xe8, 0xa5, 0x05, 0xfa, 0x01, 0x14, 0xb9, 0x14, 0xe8, 0x47,
+	0xd2, 0x1c, 0xda, 0xe6, 0xd2, 0x43, 0x8a, 0x5e, 0x5a, 0x14, 0xf0, 0xa1, 0x07, 0x03, 0xbd, 0xf4,
+	0x94, 0xb6, 0x76, 0x0f, 0x45, 0xd1, 0x6b, 0xd1, 0x6b, 0xb1, 0xf3, 0xb1, 0x9c, 0x25, 0x97, 0x5c,
+	0x52, 0x51, 0xd0, 0x13, 0xb9, 0xb3, 0xef, 0xe3, 0x37, 0x6f, 0xde, 0xbc, 0x79, 0xf3, 0x5b, 0x30,
+	0x18, 0xb5, 0x02, 0x1a, 0x9a, 0x96, 0xb7, 0xed, 0x37, 0x42, 0x6a, 0xde, 0x5b, 0xac, 0xd2, 0x90,
+	0x2c, 0x9a, 0x6f, 0x37, 0x68, 0xd0, 0x2c, 0xf9, 0x81, 0x17, 0x7a, 0x78, 0x5a, 0xc8, 0x94, 0xa4,
+	0x4c, 0x49, 0xca, 0xe4, 0xa7, 0x6a, 0x5e, 0xcd, 0xe3, 0x22, 0x66, 0xf4, 0x4f, 0x48, 0xe7, 0xbb,
+	0x59, 0x0c, 0x9b, 0x3e, 0x65, 0x52, 0xe6, 0x78, 0xcd, 0xf3, 0x6a, 0x75, 0x6a, 0xf2, 0xa7, 0x6a,
+	0x63, 0xc3, 0xa4, 0xdb, 0x7e, 0x28, 0xdd, 0xe5, 0x67, 0xe5, 0x4b, 0xe2, 0x3b, 0x26, 0x71, 0x5d,
+	0x2f, 0x24, 0xa1, 0xe3, 0xb9, 0x4a, 0xf5, 0xa4, 0xe5, 0xb1, 0x6d, 0x8f, 0x99, 0x55, 0xc2, 0xa8,
+	0x49, 0xaa, 0x96, 0x13, 0x3b, 0x88, 0x1e, 0xa4, 0xd0, 0x39, 0x5d, 0x88, 0x4f, 0x25, 0x96, 0xf2,
+	0x49, 0xcd, 0x71, 0xb9, 0x45, 0x29, 0x5b, 0xd0, 0x65, 0x95, 0x94, 0xe5, 0x39, 0xea, 0xfd, 0x0b,
+	0xf2, 0x3d, 0x0b, 0xc9, 0x96, 0xe3, 0xd6, 0x62, 0x11, 0xf9, 0x2c, 0xa4, 0x8c, 0xaf, 0x43, 0xfe,
+	0x2b, 0x91, 0x9f, 0x75, 0x3e, 0xf9, 0x35, 0xcf, 0x0d, 0x03, 0x62, 0x85, 0x65, 0xfa, 0x76, 0x83,
+	0xb2, 0x10, 0x9f, 0x85, 0x83, 0x96, 0x1c, 0xaa, 0x10, 0xdb, 0x0e, 0x28, 0x63, 0x33, 0xe8, 0x04,
+	0x2a, 0xee, 0x2f, 0x4f, 0xaa, 0xf1, 0x15, 0x31, 0x8c, 0xa7, 0x60, 0x94, 0x03, 0x9e, 0x19, 0x3e,
+	0x81, 0x8a, 0x07, 0xca, 0xe2, 0xc1, 0x98, 0x87, 0xc3, 0xdc, 0xfc, 0x6a, 0xf3, 0x36, 0xa9, 0xd2,
+	0xba, 0xb2, 0x3b, 0x05, 0xa3, 0xf5, 0xe8, 0x59, 0x1a, 0x13, 0x0f, 0xc6, 0xab, 0xf0, 0x9c, 0x14,
+	0x5e, 0x4b, 0x1a, 0x1f, 0x1c, 0x8e, 0x61, 0xc2, 0x54, 0x6c, 0xcb, 0xa6, 0x37, 0x6d, 0x65, 0xe2,
+	0x28, 0xec, 0xb5, 0x3c, 0x9b, 0x56, 0x1c, 0x9b, 0x6b, 0x8e, 0x94, 0xc7, 0x2c, 0xfe, 0x5e, 0x43,
+	0x7a, 0x85, 0xba, 0xde, 0xb6, 0x86, 0xd4, 0x8e, 0x9e, 0x15, 0x52, 0xfe, 0x60, 0x2c, 0xc2, 0xf1,
+	0xd4, 0xa8, 0x31, 0xdf, 0x73, 0x19, 0xc5, 0x18, 0x46, 0x6c, 0x12, 0x12, 0xae, 0x73, 0xa0, 0xcc,
+	0xff, 0x1b, 0xef, 0x23, 0x38, 0xc6, 0x75, 0x94, 0xf4, 0x4d, 0x77, 0xc3, 0x8b, 0x35, 0x06, 0x08,
+	0xf4, 0x3a, 0x4c, 0xc4, 0xa2, 0x8e, 0xbb, 0xe1, 0xf1, 0x80, 0x8f, 0x2f, 0xbd, 0x50, 0x4a, 0xcf,
+	0xf6, 0x92, 0xee, 0x6f, 0x75, 0xdf, 0x93, 0x4f, 0xe7, 0xd0, 0x3f, 0x3f, 0x9d, 0x1b, 0x2a, 0x1f,
+	0xb0, 0xb4, 0x71, 0xe3, 0x87, 0x08, 0x8e, 0xea, 0x82, 0x6f, 0x38, 0xe1, 0xa6, 0x72, 0xf8, 0xbf,
+	0xc6, 0xf6, 0x4d, 0x28, 0x24, 0x02, 0xc7, 0x5a, 0x6b, 0x2a, 0xa3, 0xf7, 0x16, 0xe4, 0x12, 0x6e,
+	0x23, 0x7c, 0x7b, 0x8a, 0xe3, 0x4b, 0x66, 0x3f, 0x7e, 0xb5, 0xa9, 0xae, 0x8e, 0x3c, 0x8e, 0xdc,
+	0x4f, 0xe8, 0xee, 0x99, 0xf1, 0x1e, 0x82, 0x39, 0x0e, 0xe0, 0xb6, 0xc3, 0xc2, 0x36, 0x10, 0x59,
+	0x69, 0x85, 0xaf, 0x01, 0xb4, 0x76, 0xae, 0x0c, 0xc7, 0xe9, 0x92, 0xd8, 0x9a, 0xa5, 0x68, 0xeb,
+	0x96, 0x44, 0xc5, 0x52, 0xc8, 0xee, 0x92, 0x9a, 0x32, 0x5a, 0xd6, 0x34, 0x5f, 0x1a, 0xf9, 0xc7,
+	0x8f, 0xe6, 0x86, 0x8c, 0x07, 0x90, 0x53, 0x00, 0x84, 0xff, 0x01, 0x77, 0xa8, 0xd8, 0x74, 0xc3,
+	0xda, 0xa6, 0xc3, 0xa7, 0x20, 0x67, 0x05, 0x94, 0x84, 0xd4, 0xae, 0x6c, 0x52, 0xa7, 0xb6, 0x19,
+	0xce, 0xec, 0x39, 0x81, 0x8a, 0x7b, 0xca, 0x13, 0x72, 0xf4, 0x06, 0x1f, 0x34, 0x7e, 0x8b, 0xe0,
+	0x44, 0xf7, 0x20, 0xc8, 0x75, 0x78, 0x15, 0xf6, 0x2b, 0xa7, 0x6a, 0x09, 0x4e, 0x67, 0x2d, 0x81,
+	0x30, 0x21, 0x23, 0xdf, 0x52, 0xc7, 0xd7, 0x53, 0x02, 0x77, 0x26, 0x33, 0x70, 0x02, 0x48, 0x4a,
+	0xe4, 0xfe, 0x83, 0xe0, 0x20, 0xcf, 0x1a, 0x7d, 0xd7, 0x75, 0x5d, 0xb5, 0x19, 0xd8, 0xcb, 0xa7,
+	0xef, 0x05, 0x32, 0x58, 0xea, 0x11, 0x1f, 0x8f, 0xa6, 0x68, 0xd3, 0xca, 0x26, 0x61, 0x9b, 0x3c,
+	0x52, 0xfb, 0xcb, 0xfb, 0xa2, 0x81, 0x1b, 0x84, 0x6d, 0xe2, 0x69, 0x18, 0x63, 0x5e, 0x23, 0xb0,
+	0xe8, 0xcc, 0x08, 0x7f, 0x23, 0x9f, 0x22, 0x73, 0xd5, 0x86, 0x53, 0xb7, 0x69, 0x30, 0x33, 0x2a,
+	0xcc, 0xc9, 0x47, 0x4c, 0x60, 0xda, 0x71, 0x59, 0x48, 0xdc, 0xd0, 0x21, 0x21, 0xad, 0xf8, 0x34,
+	0xd8, 0x76, 0x18, 0x8b, 0x66, 0x3c, 0xd6, 0x7b, 0xe7, 0xac, 0x58, 0x16, 0x65, 0x6c, 0xcd, 0x73,
+	0x37, 0x9c, 0x9a, 0x0c, 0xde, 0x11, 0xcd, 0xd2, 0xdd, 0xd8, 0x90, 0xf1, 0x00, 0x0e, 0xc9, 0xed,
+	0xa3, 0xad, 0xd4, 0x97, 0xe5, 0x34, 0xf8, 0x26, 0x45, 0xdc, 0x55, 0xb1, 0xfb, 0x4a, 0x25, 0xc3,
+	0xa6, 0x6d, 0xd4, 0x7d, 0x96, 0x7c, 0x17, 0x95, 0xbc, 0xfb, 0x84, 0x6d, 0xcb, 0xea, 0xcf, 0xff,
+	0x1b, 0x16, 0xe0, 0xd8, 0x33, 0x8b, 0x5d, 0x7f, 0x09, 0x20, 0x76, 0xad, 0xb2, 0xa4, 0x7f, 0xdf,
+	0x71, 0x9e, 0x88, 0x71, 0x66, 0xbc, 0x03, 0x47, 0xb4, 0xbc, 0xe4, 0x8e, 0xc4, 0x96, 0xd4, 0xd6,
+	0x10, 0x25, 0xd7, 0x70, 0x77, 0xf7, 0xe4, 0xaf, 0x11, 0x4c, 0xb7, 0x23, 0xf8, 0x5c, 0xa6, 0xba,
+	0xdb, 0x5b, 0xe2, 0x26, 0xcc, 0x26, 0xea, 0x6a, 0x7c, 0xd8, 0x0e, 0x7c, 0x26, 0x19, 0xdf, 0x43,
+	0x90, 0x4f, 0xd8, 0x92, 0xa7, 0xbd, 0xb4, 0x94, 0x7a, 0xdc, 0xe3, 0xd3, 0x30, 0xc9, 0xff, 0x54,
+	0x1c, 0xd7, 0xa6, 0x0f, 0x2a, 0x5b, 0x54, 0xf5, 0x0e, 0x13, 0x7c, 0xf8, 0x66, 0x34, 0x7a, 0x8b,
+	0x36, 0xf1, 0x65, 0x98, 0xe1, 0x12, 0xd4, 0xae, 0x74, 0xe0, 0x11, 0x3b, 0x70, 0x5a, 0xbe, 0x6f,
+	0x9b, 0x89, 0x71, 0x49, 0xe6, 0xc6, 0x9a, 0xdc, 0xa0, 0x31, 0xa0, 0xc4, 0x2e, 0x46, 0xc9, 0x5d,
+	0x6c, 0x6c, 0xc2, 0x4c, 0x62, 0x2e, 0xb7, 0x68, 0x33, 0x56, 0xbc, 0x0d, 0xf1, 0xd9, 0xc4, 0x01,
+	0x8b, 0xad, 0x73, 0x32, 0xab, 0xc8, 0xdd, 0xa2, 0x4d, 0xb9, 0x9c, 0xe3, 0x56, 0x6b, 0xc8, 0xf8,
+	0x3e, 0x82, 0xc9, 0x2b, 0xd4, 0x0a, 0x9a, 0x7e, 0x48, 0xed, 0x15, 0x97, 0xdd, 0xa7, 0x41, 0xb4,
+	0x91, 0xa2, 0x8e, 0x53, 0xa2, 0xe2, 0xff, 0xa3, 0xf8, 0x39, 0xae, 0xdf, 0x08, 0x55, 0xe5, 0xe6,
+	0x0f, 0x78, 0x0e, 0xc6, 0xbd, 0x46, 0xe8, 0x37, 0xc2, 0x0a, 0x6f, 0x36, 0x44, 0x28, 0x40, 0x0c,
+	0x5d, 0x21, 0x21, 0xc1, 0x8b, 0x70, 0x44, 0x13, 0xa8, 0x10, 0x56, 0x61, 0x61, 0xe0, 0xb8, 0x35,
+	0x59, 0x9d, 0x70, 0x4b, 0x74, 0x85, 0xad, 0xf3, 0x37, 0x32, 0x33, 0xfe, 0x8d, 0xe0, 0x60, 0x1b,
+	0x2e, 0x86, 0x57, 0x60, 0x2f, 0x11, 0x7f, 0x65, 0x26, 0x9f, 0xe9, 0x36, 0xeb, 0x36, 0xd5, 0xb2,
+	0xd2, 0xc3, 0xb7, 0x63, 0xc4, 0x75, 0xaf, 0xc6, 0x66, 0x86, 0xb9, 0x99, 0x53, 0x89, 0x0c, 0xe6,
+	0xcd, 0xb0, 0x32, 0x24, 0x40, 0x5d, 0xbd, 0x47, 0xdd, 0x50, 0x86, 0x4f, 0x4e, 0xef, 0xb6, 0x57,
+	0x63, 0xf8, 0x79, 0x38, 0x20, 0xad, 0xd1, 0x20, 0xf0, 0x02, 0x19, 0x00, 0xe9, 0xe1, 0x6a, 0x34,
+	0x84, 0xcf, 0xc0, 0xa4, 0x5f, 0x27, 0x8e, 0x1b, 0xd2, 0x07, 0x4a, 0x4a, 0xcc, 0x3d, 0x17, 0x0f,
+	0x73, 0x41, 0x39, 0xef, 0x3b, 0xb2, 0xad, 0x53, 0xcb, 0x76, 0xc3, 0x61, 0xa1, 0x17, 0x34, 0x07,
+	0x6f, 0x3f, 0xa5, 0xbd, 0x7b, 0x30, 0x9b, 0x6e, 0x4f, 0x66, 0xd3, 0x5d, 0xd8, 0x4b, 0xdd, 0x30,
+	0x70, 0xa8, 0x0a, 0xe9, 0x42, 0x56, 0x22, 0xf1, 0x4c, 0x16, 0x56, 0xae, 0xba, 0x61, 0xa0, 0xb2,
+	0x4a, 0x99, 0x91, 0x7e, 0xa7, 0x64, 0xe1, 0xbd, 0x4b, 0x02, 0xb2, 0xad, 0x0a, 0xa2, 0xb1, 0x0e,
+	0x87, 0x13, 0xa3, 0x12, 0xc4, 0xcb, 0x30, 0xe6, 0xf3, 0x11, 0x99, 0xcc, 0x85, 0x6e, 0x18, 0x84,
+	0x9e, 0xf4, 0x28, 0x75, 0x0c, 0x5f, 0xdd, 0x1f, 0x5c, 0xc7, 0x5f, 0x5a, 0x78, 0x23, 0x20, 0xbe,
+	0x4f, 0x83, 0xd8, 0x76, 0x19, 0x72, 0x8c, 0xbf, 0xa8, 0xdc, 0x17, 0x6f, 0xa4, 0x8f, 0x53, 0xdd,
+	0x7c, 0x24, 0xcc, 0xa8, 0x76, 0x8c, 0xe9, 0x83, 0xc6, 0xbc, 0xec, 0xa3, 0xd7, 0xad, 0x4d, 0x6a,
+	0x37, 0xea, 0xd4, 0x5e, 0x23, 0xf5, 0xf8, 0x62, 0x91, 0x83, 0xe1, 0xf8, 0x30, 0x1f, 0x76, 0xec,
+	0x16, 0xbc, 0xa4, 0xb0, 0x06, 0x4f, 0xbd, 0xa8, 0x58, 0xa4, 0x5e, 0xcf, 0x84, 0xa7, 0x9b, 0x89,
+	0xe1, 0xe9, 0x83, 0xc6, 0x37, 0xd2, 0x3c, 0xc6, 0x87, 0x52, 0xf2, 0xe8, 0x41, 0x9f, 0xf1, 0xe8,
+	0xf9, 0x1d, 0x82, 0xe3, 0xa9, 0xce, 0xe4, 0xfc, 0x5e, 0x83, 0xc9, 0xe4, 0xfc, 0x54, 0x9e, 0x0d,
+	0x34, 0xc1, 0x5c, 0x62, 0x82, 0xbb, 0x7e, 0x0c, 0x19, 0x70, 0x50, 0x6c, 0x92, 0xc0, 0x73, 0xbb,
+	0x2d, 0xe3, 0x2d, 0x38, 0xa4, 0xc9, 0xc8, 0xd9, 0x2d, 0xc3, 0x88, 0x15, 0xc4, 0x51, 0x9c, 0xed,
+	0xba, 0x75, 0x02, 0xcf, 0x95, 0x33, 0xe1, 0xf2, 0xc6, 0x0f, 0x54, 0xd4, 0xa2, 0x37, 0xac, 0x75,
+	0xd9, 0xdc, 0xc1, 0xa5, 0x77, 0x77, 0x3b, 0x89, 0x0f, 0x10, 0xcc, 0xa6, 0x03, 0x93, 0x33, 0xbe,
+	0x0c, 0xa3, 0xd1, 0x0c, 0xd4, 0x2a, 0xf6, 0x33, 0x65, 0xa1, 0xb0, 0xdb, 0x6b, 0xe6, 0xb7, 0x5d,
+	0xc9, 0xae, 0x51, 0x7a, 0xd7, 0xab, 0x3b, 0x56, 0xab, 0xb4, 0xdd, 0x01, 0xd8, 0xa0, 0xb4, 0xe2,
+	0xf3, 0x51, 0xb9, 0x44, 0x67, 0xb3, 0xaa, 0x5b, 0x6c, 0x46, 0xf5, 0x3e, 0x1b, 0x6a, 0xc0, 0xf8,
+	0x1a, 0x1c, 0x8d, 0x8f, 0xf2, 0x28, 0x49, 0xb7, 0x49, 0xec, 0xea, 0x15, 0x18, 0x63, 0x7c, 0x44,
+	0xba, 0x31, 0x7a, 0x75, 0x58, 0x42, 0x57, 0x15, 0x31, 0xa1, 0x67, 0xbc, 0xa9, 0x19, 0x5f, 0x69,
+	0xd8, 0x4e, 0xd8, 0xda, 0x42, 0x5f, 0x84, 0x31, 0xc2, 0x47, 0x64, 0xcc, 0x9f, 0xef, 0x65, 0x9c,
+	0xeb, 0x2a, 0xdb, 0x42, 0xcd, 0xf8, 0x16, 0x92, 0xac, 0x46, 0x24, 0xf0, 0x55, 0x1a, 0x38, 0x1b,
+	0x8e, 0xc5, 0x43, 0x19, 0xd7, 0x84, 0x5e, 0xcd, 0xc8, 0x2e, 0x67, 0xd8, 0x1f, 0x10, 0x14, 0xba,
+	0x81, 0x89, 0x6b, 0xc6, 0xc4, 0x3d, 0xfd, 0x45, 0x3f, 0x6d, 0xab, 0x6e, 0x49, 0x55, 0xc5, 0x84,
+	0x91, 0xdd, 0xce, 0xbf, 0xc7, 0xc3, 0x6d, 0x27, 0xf5, 0x0a, 0x63, 0x54, 0x5b, 0xb5, 0x1a, 0xec,
+	0xab, 0x92, 0x3a, 0x71, 0xad, 0xf8, 0x64, 0x3d, 0x96, 0x70, 0xd6, 0x02, 0xef, 0xb8, 0xab, 0x0b,
+	0x11, 0xe0, 0x8f, 0xfe, 0x32, 0x57, 0xac, 0x39, 0xe1, 0x66, 0xa3, 0x1a, 0xcd, 0xd0, 0x14, 0xc2,
+	0xf2, 0xe7, 0x02, 0xb3, 0xb7, 0x24, 0x0d, 0x18, 0x29, 0xb0, 0x72, 0x6c, 0x1c, 0x97, 0x61, 0xdc,
+	0xa6, 0x75, 0x5a, 0x93, 0xb1, 0x12, 0x1d, 0xcd, 0x39, 0xe5, 0x4b, 0x51, 0x6d, 0xad, 0xc6, 0x48,
+	0x89, 0xb6, 0x35, 0xf9, 0xba, 0x11, 0xbc, 0x01, 0x47, 0x1a, 0x6e, 0xd5, 0x73, 0x6d, 0xc7, 0xad,
+	0x55, 0x74, 0xeb, 0x7b, 0xb8, 0xf5, 0xf9, 0x6e, 0xd6, 0x5f, 0x57, 0x4a, 0x2d, 0x37, 0xd2, 0xfc,
+	0x54, 0xa3, 0xf3, 0x95, 0xea, 0x15, 0x88, 0x4c, 0xcf, 0xd7, 0x3c, 0x5f, 0xbb, 0xd6, 0x5f, 0x27,
+	0x71, 0x7a, 0x4e, 0xc3, 0x58, 0xb5, 0xee, 0x59, 0x5b, 0xa2, 0x08, 0x4e, 0x94, 0xe5, 0x93, 0x20,
+	0xb9, 0x9a, 0x8c, 0x2f, 0xe6, 0x44, 0x99, 0xff, 0xe7, 0x8d, 0xbe, 0xb3, 0xed, 0x08, 0x0e, 0x61,
+	0xa2, 0x2c, 0x1e, 0x0c, 0x17, 0x0a, 0xdd, 0x5c, 0xc4, 0x6d, 0x75, 0x07, 0x71, 0x50, 0xcc, 0x2a,
+	0x16, 0xd7, 0x09, 0x7b, 0x9d, 0x91, 0x5a, 0x27, 0x75, 0xb0, 0xf4, 0xaf, 0x93, 0x30, 0xca, 0x1d,
+	0xe2, 0x8f, 0x10, 0x1c, 0xd0, 0xb9, 0x1e, 0xfc, 0x7f, 0xdd, 0xac, 0xf6, 0x24, 0x1e, 0xf3, 0x8b,
+	0x3d, 0xd5, 0xd2, 0x18, 0x3d, 0x63, 0xe1, 0xdd, 0x3f, 0xfd, 0xfd, 0xbb, 0xc3, 0xe7, 0x70, 0xb1,
+	0x83, 0x50, 0x8e, 0x6e, 0x83, 0xe6, 0xc3, 0xf6, 0x23, 0xe6, 0x11, 0xfe, 0x00, 0xc1, 0xa1, 0x0e,
+	0x8e, 0x0b, 0x9f, 0xcf, 0x44, 0xac, 0xd1, 0x9b, 0xf9, 0xe5, 0xbe, 0x80, 0x76, 0x30, 0x68, 0xc6,
+	0x79, 0x8e, 0xf6, 0x34, 0x7e, 0xa1, 0x03, 0x6d, 0x1c, 0x56, 0xf3, 0xa1, 0xb8, 0xcb, 0xda, 0x8f,
+	0xf0, 0xef, 0x11, 0x1c, 0x4e, 0xe1, 0x81, 0xf0, 0x8b, 0x3d, 0xbd, 0x77, 0xa7, 0xcf, 0xf2, 0x97,
+	0x07, 0x57, 0x94, 0xc0, 0xff, 0x9f, 0x03, 0xbf, 0x88, 0x17, 0x3b, 0x80, 0xd7, 0x1d, 0x16, 0xc6,
+	0x17, 0x46, 0x56, 0xa9, 0x36, 0x2b, 0x11, 0x7e, 0x6d, 0x16, 0x1f, 0x23, 0x38, 0x9c, 0xc2, 0xe2,
+	0xe2, 0xa5, 0x9e, 0x60, 0x52, 0x89, 0xf2, 0xfc, 0xc5, 0x81, 0x74, 0x24, 0xf6, 0x45, 0x8e, 0x7d,
+	0x1e, 0x9f, 0x4d, 0xff, 0x8a, 0x91, 0x96, 0x23, 0xef, 0x21, 0x18, 0xe1, 0xa1, 0x1e, 0x2c, 0x2d,
+	0xce, 0x66, 0xa4, 0x85, 0x16, 0xd0, 0x33, 0x1c, 0xd4, 0xf3, 0x78, 0x2e, 0x25, 0x13, 0x12, 0xe1,
+	0xdb, 0x82, 0xd1, 0x48, 0x91, 0xe1, 0xe9, 0x92, 0xf8, 0xf0, 0x51, 0x52, 0x5f, 0x45, 0x4a, 0x57,
+	0xa3, 0xaf, 0x22, 0xf9, 0x73, 0x99, 0x4e, 0xe3, 0x02, 0x60, 0x14, 0xb8, 0xd7, 0x19, 0x3c, 0x9d,
+	0xea, 0x95, 0xe1, 0x6f, 0x23, 0xd8, 0x1f, 0xf3, 0x2b, 0xf8, 0x42, 0x1f, 0xe9, 0xd2, 0x62, 0x82,
+	0xf2, 0xa5, 0x7e, 0xc5, 0x25, 0x98, 0x93, 0x1c, 0xcc, 0x73, 0xf8, 0x78, 0xb7, 0x9c, 0x8a, 0x30,
+	0xfc, 0x11, 0xc1, 0x31, 0xc5, 0x2b, 0x74, 0xd4, 0x8d, 0x9d, 0xd6, 0x99, 0x0b, 0x99, 0x21, 0xd3,
+	0x69, 0x0c, 0xe3, 0x26, 0x07, 0xba, 0x86, 0x57, 0x52, 0xa3, 0xc6, 0x1b, 0x0a, 0x93, 0xe7, 0x7d,
+	0x32, 0x8d, 0xd2, 0x12, 0xeb, 0x43, 0xc9, 0x8f, 0xaa, 0xe9, 0xec, 0xa0, 0xf6, 0x0c, 0x08, 0xfe,
+	0x45, 0x0e, 0x7e, 0x11, 0x9b, 0x59, 0xe0, 0x79, 0xbe, 0x69, 0x89, 0xf7, 0x2b, 0x04, 0xe3, 0x1a,
+	0xb1, 0xb2, 0xd3, 0x58, 0x2f, 0xf4, 0x55, 0x2a, 0x35, 0xf2, 0xc7, 0xb8, 0xcc, 0x11, 0x2f, 0xe1,
+	0x85, 0xae, 0x45, 0x32, 0xe2, 0x84, 0xd2, 0xa2, 0xfb, 0x0b, 0x04, 0x39, 0x4e, 0x89, 0xad, 0x36,
+	0x3f, 0x63, 0x86, 0x2c, 0xf5, 0x85, 0x3a, 0x41, 0xbf, 0xf5, 0xa8, 0x33, 0x9c, 0x68, 0x4b, 0x03,
+	0xfc, 0x33, 0x04, 0x39, 0xf5, 0x51, 0x44, 0x7c, 0xba, 0xc3, 0xf3, 0x19, 0x80, 0xf5, 0x0f, 0x7c,
+	0xf9, 0x4b, 0x7d, 0xc1, 0x6c, 0x63, 0x1c, 0x7b, 0x00, 0xed, 0x4c, 0x61, 0x0e, 0xfd, 0x11, 0xfe,
+	0x29, 0x82, 0xc3, 0x89, 0xaf, 0x48, 0x3b, 0x41, 0xbb, 0x83, 0xe3, 0xbd, 0xc4, 0xa1, 0x16, 0xf1,
+	0xe9, 0xd4, 0xe3, 0x3d, 0x3a, 0x6d, 0x64, 0x6c, 0x25, 0xce, 0x4f, 0x10, 0x4c, 0xb6, 0xd1, 0x40,
+	0xf8, 0x62, 0x5f, 0x6e, 0x93, 0x24, 0x54, 0xfe, 0xd2, 0x60, 0x4a, 0x12, 0xee, 0xcb, 0x1c, 0xee,
+	0x32, 0xbe, 0xd4, 0x3d, 0xb2, 0x9b, 0x42, 0x25, 0x2d, 0x1b, 0xde, 0x45, 0x30, 0x26, 0xd8, 0x1f,
+	0xdc, 0xbb, 0xa8, 0x27, 0x08, 0xa7, 0xfc, 0x7c, 0x5f, 0xb2, 0x12, 0xe1, 0x1c, 0x47, 0x78, 0x0c,
+	0x1f, 0xed, 0x40, 0x28, 0x98, 0x26, 0xfc, 0x73, 0x04, 0x53, 0x49, 0x7a, 0x48, 0x7c, 0xa9, 0xcd,
+	0x5c, 0x6a, 0xfd, 0x7b, 0x6e, 0xc6, 0xfe, 0x49, 0x65, 0xb1, 0x7a, 0xb4, 0x72, 0x49, 0x72, 0x2b,
+	0x2a, 0x57, 0xfc, 0xfb, 0x70, 0x74, 0x38, 0x1c, 0x6d, 0xc3, 0x1a, 0xb7, 0x17, 0x9f, 0xcb, 0xc6,
+	0x4f, 0x07, 0x7e, 0x8d, 0x03, 0x7f, 0x05, 0x7f, 0xa1, 0x0f, 0xe0, 0x6a, 0xd5, 0xd3, 0xd6, 0xff,
+	0x27, 0x08, 0x26, 0x12, 0xcc, 0x10, 0xee, 0xbd, 0x63, 0xd2, 0xa8, 0xb9, 0xfc, 0xd2, 0x20, 0x2a,
+	0x99, 0x6d, 0x69, 0x92, 0xd7, 0x32, 0x1f, 0x46, 0x07, 0xc3, 0x8f, 0x11, 0xe4, 0xd6, 0x93, 0x5c,
+	0xd5, 0x00, 0x4e, 0x59, 0x9f, 0xbd, 0x5c, 0x2a, 0xd5, 0x66, 0x14, 0x39, 0x52, 0x03, 0x9f, 0xc8,
+	0x40, 0xca, 0xf0, 0x3b, 0x30, 0x12, 0xf1, 0x33, 0xb8, 0xd8, 0x7b, 0x23, 0xb7, 0xd8, 0xb0, 0xfc,
+	0xd9, 0x3e, 0x24, 0x25, 0x0c, 0x83, 0xc3, 0x98, 0xc5, 0xf9, 0xce, 0x7d, 0x1e, 0x78, 0xae, 0x08,
+	0xd3, 0x2f, 0xa3, 0x52, 0x94, 0x64, 0x98, 0xb2, 0x4a, 0x51, 0x2a, 0x51, 0x96, 0xbf, 0x34, 0x98,
+	0x52, 0x76, 0x91, 0x8f, 0x34, 0xd2, 0xf2, 0xef, 0x13, 0xed, 0x66, 0x14, 0x73, 0x44, 0x3b, 0xdd,
+	0x48, 0xfd, 0x5d, 0x91, 0x3a, 0x18, 0x2d, 0x63, 0x99, 0xe3, 0x5e, 0xc0, 0xa5, 0x0e, 0xdc, 0x2d,
+	0xa2, 0x2b, 0x0d, 0xfc, 0xfb, 0x08, 0xa0, 0xc5, 0x3c, 0x0d, 0xd8, 0x53, 0x99, 0x99, 0x3d, 0x55,
+	0x92, 0x0c, 0xeb, 0x71, 0x2e, 0xf1, 0xfe, 0x49, 0x10, 0x5e, 0x5a, 0x33, 0xf5, 0x1b, 0x1e, 0xda,
+	0x36, 0x32, 0x28, 0x23, 0xb4, 0xdd, 0x98, 0xac, 0xfc, 0xf2, 0xa0, 0x6a, 0xfd, 0xb5, 0x82, 0x09,
+	0x2a, 0xc9, 0x7c, 0x18, 0xb7, 0x87, 0xad, 0xd8, 0x0a, 0xd2, 0x6e, 0xd7, 0x63, 0x9b, 0xe4, 0x02,
+	0xb3, 0x62, 0x2b, 0x08, 0xbf, 0x64, 0xa3, 0x9a, 0x4b, 0x12, 0x54, 0x3b, 0xcd, 0xd9, 0xfe, 0x3a,
+	0x85, 0x24, 0x09, 0x66, 0x2c, 0x71, 0xb8, 0xe7, 0xf1, 0xb9, 0x0e, 0xb8, 0x84, 0x0b, 0xa6, 0x25,
+	0xeb, 0xc7, 0x08, 0x0e, 0x75, 0xd0, 0x34, 0x19, 0xa8, 0xbb, 0x31, 0x47, 0xf9, 0xe5, 0x41, 0xd5,
+	0x24, 0xf0, 0x0b, 0x1c, 0xf8, 0x19, 0x7c, 0xaa, 0x03, 0x78, 0xe8, 0xf9, 0xc9, 0x2b, 0x7d, 0x8d,
+	0xb0, 0xd5, 0xb7, 0x1e, 0xff, 0xad, 0x30, 0xf4, 0xe1, 0xd3, 0x02, 0x7a, 0xfc, 0xb4, 0x80, 0x9e,
+	0x3c, 0x2d, 0xa0, 0xbf, 0x3e, 0x2d, 0xa0, 0xef, 0x3c, 0x2b, 0x0c, 0x3d, 0x79, 0x56, 0x18, 0xfa,
+	0xf3, 0xb3, 0xc2, 0xd0, 0x9b, 0x2f, 0x69, 0xcc, 0x1e, 0xb3, 0x82, 0xb0, 0x4e, 0xaa, 0xcc, 0x14,
+	0x97, 0xf2, 0x3b, 0x34, 0xbc, 0xef, 0x05, 0x5b, 0xe6, 0x83, 0xd8, 0x97, 0xe3, 0x86, 0x34, 0x70,
+	0x49, 0x5d, 0x30, 0x7e, 0xd5, 0x31, 0x7e, 0xab, 0xbd, 0xf8, 0xdf, 0x01, 0x00, 0x65, 0xf0, 0x4d,
+	0x34, 0x71, 0x28, 0x00, 0x00,
+}
+
+func (this *QuerySecretContractRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Codes(ctx, in)
+
+	that1, ok := that.(*QuerySecretContractRequest)
+	if !ok {
+		that2, ok := that.(QuerySecretContractRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/Codes",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Codes(ctx, req.(*emptypb.Empty))
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !bytes.Equal(this.Query, that1.Query) {
+		return false
+	}
+	return true
 }
-
-func _Query_CodeHashByContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByContractAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryByLabelRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CodeHashByContractAddress(ctx, in)
+
+	that1, ok := that.(*QueryByLabelRequest)
+	if !ok {
+		that2, ok := that.(QueryByLabelRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/CodeHashByContractAddress",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CodeHashByContractAddress(ctx, req.(*QueryByContractAddressRequest))
+	if this.Label != that1.Label {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_CodeHashByCodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByCodeIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryByContractAddressRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CodeHashByCodeId(ctx, in)
+
+	that1, ok := that.(*QueryByContractAddressRequest)
+	if !ok {
+		that2, ok := that.(QueryByContractAddressRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/CodeHashByCodeId",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CodeHashByCodeId(ctx, req.(*QueryByCodeIdRequest))
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Query_LabelByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByContractAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryByCodeIdRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LabelByAddress(ctx, in)
+
+	that1, ok := that.(*QueryByCodeIdRequest)
+	if !ok {
+		that2, ok := that.(QueryByCodeIdRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/LabelByAddress",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LabelByAddress(ctx, req.(*QueryByContractAddressRequest))
+	if this.CodeId != that1.CodeId {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *QueryByDenomRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_AddressByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryByLabelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryByDenomRequest)
+	if !ok {
+		that2, ok := that.(QueryByDenomRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressByLabel(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/AddressByLabel",
+	if this.Denom != that1.Denom {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressByLabel(ctx, req.(*QueryByLabelRequest))
+	return true
+}
+func (this *QuerySecretContractResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return interceptor(ctx, in, info, handler)
+
+	that1, ok := that.(*QuerySecretContractResponse)
+	if !ok {
+		that2, ok := that.(QuerySecretContractResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
 }
+func (this *QueryContractInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_ContractHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryContractInfoResponse)
+	if !ok {
+		that2, ok := that.(QueryContractInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractHistory(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.compute.v1beta1.Query/ContractHistory",
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractHistory(ctx, req.(*QueryContractHistoryRequest))
+	if !this.ContractInfo.Equal(that1.ContractInfo) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ContractInfoWithAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "secret.compute.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ContractInfo",
-			Handler:    _Query_ContractInfo_Handler,
-		},
-		{
-			MethodName: "ContractsByCodeId",
-			Handler:    _Query_ContractsByCodeId_Handler,
-		},
-		{
-			MethodName: "QuerySecretContract",
-			Handler:    _Query_QuerySecretContract_Handler,
-		},
-		{
-			MethodName: "Code",
-			Handler:    _Query_Code_Handler,
-		},
-		{
-			MethodName: "Codes",
-			Handler:    _Query_Codes_Handler,
-		},
-		{
-			MethodName: "CodeHashByContractAddress",
-			Handler:    _Query_CodeHashByContractAddress_Handler,
-		},
-		{
-			MethodName: "CodeHashByCodeId",
-			Handler:    _Query_CodeHashByCodeId_Handler,
-		},
-		{
-			MethodName: "LabelByAddress",
-			Handler:    _Query_LabelByAddress_Handler,
-		},
-		{
-			MethodName: "AddressByLabel",
-			Handler:    _Query_AddressByLabel_Handler,
-		},
-		{
-			MethodName: "ContractHistory",
-			Handler:    _Query_ContractHistory_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "secret/compute/v1beta1/query.proto",
-}
-
-func (m *QuerySecretContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ContractInfoWithAddress)
+	if !ok {
+		that2, ok := that.(ContractInfoWithAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySecretContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySecretContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.ContractAddress != that1.ContractAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryByLabelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.ContractInfo.Equal(that1.ContractInfo) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryByLabelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *QueryByLabelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0xa
+func (this *QueryContractsByCodeIdResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryByContractAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryContractsByCodeIdResponse)
+	if !ok {
+		that2, ok := that.(QueryContractsByCodeIdResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryByContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryByContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryByCodeIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.ContractInfos) != len(that1.ContractInfos) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryByCodeIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryByCodeIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CodeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.ContractInfos {
+		if !this.ContractInfos[i].Equal(&that1.ContractInfos[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *QuerySecretContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ContractByCode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySecretContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *QuerySecretContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ContractByCode)
+	if !ok {
+		that2, ok := that.(ContractByCode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryContractInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.Label != that1.Label {
+		return false
+	}
+	if this.CreatedHeight != that1.CreatedHeight {
+		return false
+	}
+	return true
 }
+func (this *CodeInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryContractInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractInfo != nil {
-		{
-			size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*CodeInfoResponse)
+	if !ok {
+		that2, ok := that.(CodeInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractInfoWithAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.CodeId != that1.CodeId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractInfoWithAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.CodeHash != that1.CodeHash {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	if !this.InstantiatePermission.Equal(&that1.InstantiatePermission) {
+		return false
+	}
+	return true
 }
+func (this *QueryCodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractInfoWithAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractInfo != nil {
-		{
-			size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*QueryCodeResponse)
+	if !ok {
+		that2, ok := that.(QueryCodeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryContractsByCodeIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.CodeInfoResponse.Equal(that1.CodeInfoResponse) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryContractsByCodeIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.Wasm, that1.Wasm) {
+		return false
+	}
+	return true
 }
+func (this *QueryCodesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractsByCodeIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractInfos) > 0 {
-		for iNdEx := len(m.ContractInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContractInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*QueryCodesResponse)
+	if !ok {
+		that2, ok := that.(QueryCodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if len(this.CodeInfos) != len(that1.CodeInfos) {
+		return false
+	}
+	for i := range this.CodeInfos {
+		if !this.CodeInfos[i].Equal(&that1.CodeInfos[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *QueryContractAddressResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryContractAddressResponse)
+	if !ok {
+		that2, ok := that.(QueryContractAddressResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	return true
 }
+func (this *QueryContractLabelResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Builder) > 0 {
-		i -= len(m.Builder)
-		copy(dAtA[i:], m.Builder)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Builder)))
-		i--
-		dAtA[i] = 0x2a
+	that1, ok := that.(*QueryContractLabelResponse)
+	if !ok {
+		that2, ok := that.(QueryContractLabelResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Label != that1.Label {
+		return false
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.LabelIndexKey, that1.LabelIndexKey) {
+		return false
 	}
-	if m.CodeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
-		i--
-		dAtA[i] = 0x8
+	if this.IndexedContractAddress != that1.IndexedContractAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *QueryCodeHashResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Wasm) > 0 {
-		i -= len(m.Wasm)
-		copy(dAtA[i:], m.Wasm)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Wasm)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CodeInfoResponse != nil {
-		{
-			size, err := m.CodeInfoResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	that1, ok := that.(*QueryCodeHashResponse)
+	if !ok {
+		that2, ok := that.(QueryCodeHashResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeHash != that1.CodeHash {
+		return false
+	}
+	return true
 }
+func (this *QueryContractKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryContractKeyResponse)
+	if !ok {
+		that2, ok := that.(QueryContractKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ContractKey.Equal(&that1.ContractKey) {
+		return false
+	}
+	return true
 }
+func (this *QueryParamsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryParamsRequest)
+	if !ok {
+		that2, ok := that.(QueryParamsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *QueryParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeInfos) > 0 {
-		for iNdEx := len(m.CodeInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CodeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*QueryParamsResponse)
+	if !ok {
+		that2, ok := that.(QueryParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
 }
+func (this *QuerySnip20WrapperResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QuerySnip20WrapperResponse)
+	if !ok {
+		that2, ok := that.(QuerySnip20WrapperResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Snip20Wrapper.Equal(&that1.Snip20Wrapper) {
+		return false
+	}
+	return true
 }
+func (this *QueryScheduledCallRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryScheduledCallRequest)
+	if !ok {
+		that2, ok := that.(QueryScheduledCallRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
 }
+func (this *QueryScheduledCallResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*QueryScheduledCallResponse)
+	if !ok {
+		that2, ok := that.(QueryScheduledCallResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ScheduledCall.Equal(&that1.ScheduledCall) {
+		return false
+	}
+	return true
 }
+func (this *QueryCronRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractLabelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCronRequest)
+	if !ok {
+		that2, ok := that.(QueryCronRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	return true
 }
+func (this *QueryCronResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractLabelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryCronResponse)
+	if !ok {
+		that2, ok := that.(QueryCronResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Cron.Equal(&that1.Cron) {
+		return false
+	}
+	return true
 }
+func (this *QueryContractFeePolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryContractLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*QueryContractFeePolicyResponse)
+	if !ok {
+		that2, ok := that.(QueryContractFeePolicyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FeePolicy.Equal(&that1.FeePolicy) {
+		return false
+	}
+	return true
 }
+func (this *QueryCodeSchemaResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodeHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryCodeSchemaResponse)
+	if !ok {
+		that2, ok := that.(QueryCodeSchemaResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Schema.Equal(&that1.Schema) {
+		return false
+	}
+	return true
 }
+func (this *QueryCodeAuditsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodeHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryCodeAuditsResponse)
+	if !ok {
+		that2, ok := that.(QueryCodeAuditsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Audits) != len(that1.Audits) {
+		return false
+	}
+	for i := range this.Audits {
+		if !this.Audits[i].Equal(&that1.Audits[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *QueryTopContractsByGasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*QueryTopContractsByGasRequest)
+	if !ok {
+		that2, ok := that.(QueryTopContractsByGasRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Blocks != that1.Blocks {
+		return false
+	}
+	if this.Days != that1.Days {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
 }
+func (this *QueryTopContractsByGasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DecryptedAnswer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*QueryTopContractsByGasResponse)
+	if !ok {
+		that2, ok := that.(QueryTopContractsByGasResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Contracts) != len(that1.Contracts) {
+		return false
+	}
+	for i := range this.Contracts {
+		if !this.Contracts[i].Equal(&that1.Contracts[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Query contract info by address
+	ContractInfo(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error)
+	// Query code info by id
+	ContractsByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIdResponse, error)
+	// Query the contracts running a code by page, ordered by the time they
+	// started running it
+	ListContractsByCode(ctx context.Context, in *QueryListContractsByCodeRequest, opts ...grpc.CallOption) (*QueryListContractsByCodeResponse, error)
+	// Query secret contract
+	QuerySecretContract(ctx context.Context, in *QuerySecretContractRequest, opts ...grpc.CallOption) (*QuerySecretContractResponse, error)
+	// Query a specific contract code by id
+	Code(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error)
+	// Query all contract codes on-chain
+	Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryCodesResponse, error)
+	// Query contract codes on-chain by page, optionally only those of a creator
+	ListCodes(ctx context.Context, in *QueryListCodesRequest, opts ...grpc.CallOption) (*QueryListCodesResponse, error)
+	// Query code hash by contract address
+	CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
+	// Query code hash by code id
+	CodeHashByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
+	// Query the enclave key of a contract
+	ContractKey(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractKeyResponse, error)
+	// Query contract label by address
+	LabelByAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractLabelResponse, error)
+	// Query contract address by label
+	AddressByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error)
+	// Query contract info by label
+	ContractInfoByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error)
+	// ContractHistory gets the contract code history
+	ContractHistory(ctx context.Context, in *QueryContractHistoryRequest, opts ...grpc.CallOption) (*QueryContractHistoryResponse, error)
+	// Params gets the module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Snip20WrapperByDenom gets the canonical SNIP-20 wrapper of a native denom
+	Snip20WrapperByDenom(ctx context.Context, in *QueryByDenomRequest, opts ...grpc.CallOption) (*QuerySnip20WrapperResponse, error)
+	// Snip20WrapperByContract gets the native denom wrapped by a canonical SNIP-20 contract
+	Snip20WrapperByContract(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QuerySnip20WrapperResponse, error)
+	// Query a call escrowed by MsgScheduleExecute
+	ScheduledCall(ctx context.Context, in *QueryScheduledCallRequest, opts ...grpc.CallOption) (*QueryScheduledCallResponse, error)
+	// Query all pending scheduled calls, by execution height
+	ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error)
+	// Query a recurring execution registered by MsgRegisterCron
+	Cron(ctx context.Context, in *QueryCronRequest, opts ...grpc.CallOption) (*QueryCronResponse, error)
+	// Query the recurring executions registered for a contract
+	CronsByContract(ctx context.Context, in *QueryCronsByContractRequest, opts ...grpc.CallOption) (*QueryCronsByContractResponse, error)
+	// Query the minimum fee for executing a contract
+	ContractFeePolicy(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractFeePolicyResponse, error)
+	// Query the JSON schema of a code's messages
+	CodeSchema(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeSchemaResponse, error)
+	// Query the verification claims attached to a code hash
+	CodeVerifications(ctx context.Context, in *QueryCodeVerificationsRequest, opts ...grpc.CallOption) (*QueryCodeVerificationsResponse, error)
+	// Query the audit attestations of a code
+	CodeAudits(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeAuditsResponse, error)
+	// Query the bank balances, delegations and unbonding delegations of a
+	// contract
+	ContractAssets(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAssetsResponse, error)
+	// Query the contracts that used the most gas over the last blocks or days
+	TopContractsByGas(ctx context.Context, in *QueryTopContractsByGasRequest, opts ...grpc.CallOption) (*QueryTopContractsByGasResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ContractInfo(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error) {
+	out := new(QueryContractInfoResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DecryptedAnswer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractsByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryContractsByCodeIdResponse, error) {
+	out := new(QueryContractsByCodeIdResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractsByCodeId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DecryptedAnswer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OutputDataAsString) > 0 {
-		i -= len(m.OutputDataAsString)
-		copy(dAtA[i:], m.OutputDataAsString)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDataAsString)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OutputData) > 0 {
-		i -= len(m.OutputData)
-		copy(dAtA[i:], m.OutputData)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputData)))
-		i--
-		dAtA[i] = 0x1a
+func (c *queryClient) ListContractsByCode(ctx context.Context, in *QueryListContractsByCodeRequest, opts ...grpc.CallOption) (*QueryListContractsByCodeResponse, error) {
+	out := new(QueryListContractsByCodeResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ListContractsByCode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Input) > 0 {
-		i -= len(m.Input)
-		copy(dAtA[i:], m.Input)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) QuerySecretContract(ctx context.Context, in *QuerySecretContractRequest, opts ...grpc.CallOption) (*QuerySecretContractResponse, error) {
+	out := new(QuerySecretContractResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/QuerySecretContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) Code(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeResponse, error) {
+	out := new(QueryCodeResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Code", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DecryptedAnswers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Codes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryCodesResponse, error) {
+	out := new(QueryCodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Codes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DecryptedAnswers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListCodes(ctx context.Context, in *QueryListCodesRequest, opts ...grpc.CallOption) (*QueryListCodesResponse, error) {
+	out := new(QueryListCodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ListCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DecryptedAnswers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlaintextError) > 0 {
-		i -= len(m.PlaintextError)
-		copy(dAtA[i:], m.PlaintextError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlaintextError)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OutputError) > 0 {
-		i -= len(m.OutputError)
-		copy(dAtA[i:], m.OutputError)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputError)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OutputLogs) > 0 {
-		for iNdEx := len(m.OutputLogs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutputLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Answers) > 0 {
-		for iNdEx := len(m.Answers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Answers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) CodeHashByContractAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
+	out := new(QueryCodeHashResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeHashByContractAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CodeHashByCodeId(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error) {
+	out := new(QueryCodeHashResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeHashByCodeId", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractKey(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractKeyResponse, error) {
+	out := new(QueryContractKeyResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LabelByAddress(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractLabelResponse, error) {
+	out := new(QueryContractLabelResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/LabelByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryContractHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AddressByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractAddressResponse, error) {
+	out := new(QueryContractAddressResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/AddressByLabel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryContractHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContractInfoByLabel(ctx context.Context, in *QueryByLabelRequest, opts ...grpc.CallOption) (*QueryContractInfoResponse, error) {
+	out := new(QueryContractInfoResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractInfoByLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryContractHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) ContractHistory(ctx context.Context, in *QueryContractHistoryRequest, opts ...grpc.CallOption) (*QueryContractHistoryResponse, error) {
+	out := new(QueryContractHistoryResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QuerySecretContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) Snip20WrapperByDenom(ctx context.Context, in *QueryByDenomRequest, opts ...grpc.CallOption) (*QuerySnip20WrapperResponse, error) {
+	out := new(QuerySnip20WrapperResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Snip20WrapperByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryByLabelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Snip20WrapperByContract(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QuerySnip20WrapperResponse, error) {
+	out := new(QuerySnip20WrapperResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Snip20WrapperByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryByContractAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ScheduledCall(ctx context.Context, in *QueryScheduledCallRequest, opts ...grpc.CallOption) (*QueryScheduledCallResponse, error) {
+	out := new(QueryScheduledCallResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ScheduledCall", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryByCodeIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeId != 0 {
-		n += 1 + sovQuery(uint64(m.CodeId))
+func (c *queryClient) ScheduledCalls(ctx context.Context, in *QueryScheduledCallsRequest, opts ...grpc.CallOption) (*QueryScheduledCallsResponse, error) {
+	out := new(QueryScheduledCallsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ScheduledCalls", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QuerySecretContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Cron(ctx context.Context, in *QueryCronRequest, opts ...grpc.CallOption) (*QueryCronResponse, error) {
+	out := new(QueryCronResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/Cron", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryContractInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) CronsByContract(ctx context.Context, in *QueryCronsByContractRequest, opts ...grpc.CallOption) (*QueryCronsByContractResponse, error) {
+	out := new(QueryCronsByContractResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CronsByContract", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ContractInfo != nil {
-		l = m.ContractInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ContractFeePolicy(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractFeePolicyResponse, error) {
+	out := new(QueryContractFeePolicyResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractFeePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ContractInfoWithAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CodeSchema(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeSchemaResponse, error) {
+	out := new(QueryCodeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CodeVerifications(ctx context.Context, in *QueryCodeVerificationsRequest, opts ...grpc.CallOption) (*QueryCodeVerificationsResponse, error) {
+	out := new(QueryCodeVerificationsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeVerifications", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ContractInfo != nil {
-		l = m.ContractInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) CodeAudits(ctx context.Context, in *QueryByCodeIdRequest, opts ...grpc.CallOption) (*QueryCodeAuditsResponse, error) {
+	out := new(QueryCodeAuditsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/CodeAudits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryContractsByCodeIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractInfos) > 0 {
-		for _, e := range m.ContractInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) ContractAssets(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractAssetsResponse, error) {
+	out := new(QueryContractAssetsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *CodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeId != 0 {
-		n += 1 + sovQuery(uint64(m.CodeId))
+func (c *queryClient) TopContractsByGas(ctx context.Context, in *QueryTopContractsByGasRequest, opts ...grpc.CallOption) (*QueryTopContractsByGasResponse, error) {
+	out := new(QueryTopContractsByGasResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/TopContractsByGas", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Query contract info by address
+	ContractInfo(context.Context, *QueryByContractAddressRequest) (*QueryContractInfoResponse, error)
+	// Query code info by id
+	ContractsByCodeId(context.Context, *QueryByCodeIdRequest) (*QueryContractsByCodeIdResponse, error)
+	// Query the contracts running a code by page, ordered by the time they
+	// started running it
+	ListContractsByCode(context.Context, *QueryListContractsByCodeRequest) (*QueryListContractsByCodeResponse, error)
+	// Query secret contract
+	QuerySecretContract(context.Context, *QuerySecretContractRequest) (*QuerySecretContractResponse, error)
+	// Query a specific contract code by id
+	Code(context.Context, *QueryByCodeIdRequest) (*QueryCodeResponse, error)
+	// Query all contract codes on-chain
+	Codes(context.Context, *emptypb.Empty) (*QueryCodesResponse, error)
+	// Query contract codes on-chain by page, optionally only those of a creator
+	ListCodes(context.Context, *QueryListCodesRequest) (*QueryListCodesResponse, error)
+	// Query code hash by contract address
+	CodeHashByContractAddress(context.Context, *QueryByContractAddressRequest) (*QueryCodeHashResponse, error)
+	// Query code hash by code id
+	CodeHashByCodeId(context.Context, *QueryByCodeIdRequest) (*QueryCodeHashResponse, error)
+	// Query the enclave key of a contract
+	ContractKey(context.Context, *QueryByContractAddressRequest) (*QueryContractKeyResponse, error)
+	// Query contract label by address
+	LabelByAddress(context.Context, *QueryByContractAddressRequest) (*QueryContractLabelResponse, error)
+	// Query contract address by label
+	AddressByLabel(context.Context, *QueryByLabelRequest) (*QueryContractAddressResponse, error)
+	// Query contract info by label
+	ContractInfoByLabel(context.Context, *QueryByLabelRequest) (*QueryContractInfoResponse, error)
+	// ContractHistory gets the contract code history
+	ContractHistory(context.Context, *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error)
+	// Params gets the module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Snip20WrapperByDenom gets the canonical SNIP-20 wrapper of a native denom
+	Snip20WrapperByDenom(context.Context, *QueryByDenomRequest) (*QuerySnip20WrapperResponse, error)
+	// Snip20WrapperByContract gets the native denom wrapped by a canonical SNIP-20 contract
+	Snip20WrapperByContract(context.Context, *QueryByContractAddressRequest) (*QuerySnip20WrapperResponse, error)
+	// Query a call escrowed by MsgScheduleExecute
+	ScheduledCall(context.Context, *QueryScheduledCallRequest) (*QueryScheduledCallResponse, error)
+	// Query all pending scheduled calls, by execution height
+	ScheduledCalls(context.Context, *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error)
+	// Query a recurring execution registered by MsgRegisterCron
+	Cron(context.Context, *QueryCronRequest) (*QueryCronResponse, error)
+	// Query the recurring executions registered for a contract
+	CronsByContract(context.Context, *QueryCronsByContractRequest) (*QueryCronsByContractResponse, error)
+	// Query the minimum fee for executing a contract
+	ContractFeePolicy(context.Context, *QueryByContractAddressRequest) (*QueryContractFeePolicyResponse, error)
+	// Query the JSON schema of a code's messages
+	CodeSchema(context.Context, *QueryByCodeIdRequest) (*QueryCodeSchemaResponse, error)
+	// Query the verification claims attached to a code hash
+	CodeVerifications(context.Context, *QueryCodeVerificationsRequest) (*QueryCodeVerificationsResponse, error)
+	// Query the audit attestations of a code
+	CodeAudits(context.Context, *QueryByCodeIdRequest) (*QueryCodeAuditsResponse, error)
+	// Query the bank balances, delegations and unbonding delegations of a
+	// contract
+	ContractAssets(context.Context, *QueryByContractAddressRequest) (*QueryContractAssetsResponse, error)
+	// Query the contracts that used the most gas over the last blocks or days
+	TopContractsByGas(context.Context, *QueryTopContractsByGasRequest) (*QueryTopContractsByGasResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ContractInfo(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractInfo not implemented")
+}
+func (*UnimplementedQueryServer) ContractsByCodeId(ctx context.Context, req *QueryByCodeIdRequest) (*QueryContractsByCodeIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractsByCodeId not implemented")
+}
+func (*UnimplementedQueryServer) ListContractsByCode(ctx context.Context, req *QueryListContractsByCodeRequest) (*QueryListContractsByCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContractsByCode not implemented")
+}
+func (*UnimplementedQueryServer) QuerySecretContract(ctx context.Context, req *QuerySecretContractRequest) (*QuerySecretContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySecretContract not implemented")
+}
+func (*UnimplementedQueryServer) Code(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Code not implemented")
+}
+func (*UnimplementedQueryServer) Codes(ctx context.Context, req *emptypb.Empty) (*QueryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Codes not implemented")
+}
+func (*UnimplementedQueryServer) ListCodes(ctx context.Context, req *QueryListCodesRequest) (*QueryListCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCodes not implemented")
+}
+func (*UnimplementedQueryServer) CodeHashByContractAddress(ctx context.Context, req *QueryByContractAddressRequest) (*QueryCodeHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeHashByContractAddress not implemented")
+}
+func (*UnimplementedQueryServer) CodeHashByCodeId(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeHashByCodeId not implemented")
+}
+func (*UnimplementedQueryServer) ContractKey(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractKey not implemented")
+}
+func (*UnimplementedQueryServer) LabelByAddress(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LabelByAddress not implemented")
+}
+func (*UnimplementedQueryServer) AddressByLabel(ctx context.Context, req *QueryByLabelRequest) (*QueryContractAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressByLabel not implemented")
+}
+func (*UnimplementedQueryServer) ContractInfoByLabel(ctx context.Context, req *QueryByLabelRequest) (*QueryContractInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractInfoByLabel not implemented")
+}
+func (*UnimplementedQueryServer) ContractHistory(ctx context.Context, req *QueryContractHistoryRequest) (*QueryContractHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractHistory not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Snip20WrapperByDenom(ctx context.Context, req *QueryByDenomRequest) (*QuerySnip20WrapperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snip20WrapperByDenom not implemented")
+}
+func (*UnimplementedQueryServer) Snip20WrapperByContract(ctx context.Context, req *QueryByContractAddressRequest) (*QuerySnip20WrapperResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snip20WrapperByContract not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCall(ctx context.Context, req *QueryScheduledCallRequest) (*QueryScheduledCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCall not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledCalls(ctx context.Context, req *QueryScheduledCallsRequest) (*QueryScheduledCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCalls not implemented")
+}
+func (*UnimplementedQueryServer) Cron(ctx context.Context, req *QueryCronRequest) (*QueryCronResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cron not implemented")
+}
+func (*UnimplementedQueryServer) CronsByContract(ctx context.Context, req *QueryCronsByContractRequest) (*QueryCronsByContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CronsByContract not implemented")
+}
+func (*UnimplementedQueryServer) ContractFeePolicy(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractFeePolicy not implemented")
+}
+func (*UnimplementedQueryServer) CodeSchema(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeSchema not implemented")
+}
+func (*UnimplementedQueryServer) CodeVerifications(ctx context.Context, req *QueryCodeVerificationsRequest) (*QueryCodeVerificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeVerifications not implemented")
+}
+func (*UnimplementedQueryServer) CodeAudits(ctx context.Context, req *QueryByCodeIdRequest) (*QueryCodeAuditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeAudits not implemented")
+}
+func (*UnimplementedQueryServer) ContractAssets(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractAssets not implemented")
+}
+func (*UnimplementedQueryServer) TopContractsByGas(ctx context.Context, req *QueryTopContractsByGasRequest) (*QueryTopContractsByGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopContractsByGas not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ContractInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractInfo(ctx, in)
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractInfo",
 	}
-	l = len(m.Builder)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractInfo(ctx, req.(*QueryByContractAddressRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContractsByCodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.CodeInfoResponse != nil {
-		l = m.CodeInfoResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ContractsByCodeId(ctx, in)
 	}
-	l = len(m.Wasm)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractsByCodeId",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractsByCodeId(ctx, req.(*QueryByCodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCodesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ListContractsByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListContractsByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CodeInfos) > 0 {
-		for _, e := range m.CodeInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListContractsByCode(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryContractAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ListContractsByCode",
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListContractsByCode(ctx, req.(*QueryListContractsByCodeRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryContractLabelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QuerySecretContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecretContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySecretContract(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryCodeHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/QuerySecretContract",
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySecretContract(ctx, req.(*QuerySecretContractRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DecryptedAnswer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_Code_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Input)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Code(ctx, in)
 	}
-	l = len(m.OutputData)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Code",
 	}
-	l = len(m.OutputDataAsString)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Code(ctx, req.(*QueryByCodeIdRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DecryptedAnswers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func _Query_Codes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Codes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Codes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Codes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ListCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCodes(ctx, req.(*QueryListCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeHashByContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeHashByContractAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeHashByContractAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeHashByContractAddress(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeHashByCodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeHashByCodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeHashByCodeId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeHashByCodeId(ctx, req.(*QueryByCodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractKey(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LabelByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LabelByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/LabelByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LabelByAddress(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/AddressByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressByLabel(ctx, req.(*QueryByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractInfoByLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractInfoByLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractInfoByLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractInfoByLabel(ctx, req.(*QueryByLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractHistory(ctx, req.(*QueryContractHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Snip20WrapperByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Snip20WrapperByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Snip20WrapperByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Snip20WrapperByDenom(ctx, req.(*QueryByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Snip20WrapperByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Snip20WrapperByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Snip20WrapperByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Snip20WrapperByContract(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ScheduledCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCall(ctx, req.(*QueryScheduledCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCallsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ScheduledCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCalls(ctx, req.(*QueryScheduledCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Cron_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Cron(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/Cron",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cron(ctx, req.(*QueryCronRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CronsByContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronsByContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CronsByContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CronsByContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CronsByContract(ctx, req.(*QueryCronsByContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractFeePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractFeePolicy(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeSchema(ctx, req.(*QueryByCodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeVerifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeVerifications(ctx, req.(*QueryCodeVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByCodeIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeAudits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/CodeAudits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeAudits(ctx, req.(*QueryByCodeIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractAssets(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopContractsByGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopContractsByGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopContractsByGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/TopContractsByGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopContractsByGas(ctx, req.(*QueryTopContractsByGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.compute.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractInfo",
+			Handler:    _Query_ContractInfo_Handler,
+		},
+		{
+			MethodName: "ContractsByCodeId",
+			Handler:    _Query_ContractsByCodeId_Handler,
+		},
+		{
+			MethodName: "ListContractsByCode",
+			Handler:    _Query_ListContractsByCode_Handler,
+		},
+		{
+			MethodName: "QuerySecretContract",
+			Handler:    _Query_QuerySecretContract_Handler,
+		},
+		{
+			MethodName: "Code",
+			Handler:    _Query_Code_Handler,
+		},
+		{
+			MethodName: "Codes",
+			Handler:    _Query_Codes_Handler,
+		},
+		{
+			MethodName: "ListCodes",
+			Handler:    _Query_ListCodes_Handler,
+		},
+		{
+			MethodName: "CodeHashByContractAddress",
+			Handler:    _Query_CodeHashByContractAddress_Handler,
+		},
+		{
+			MethodName: "CodeHashByCodeId",
+			Handler:    _Query_CodeHashByCodeId_Handler,
+		},
+		{
+			MethodName: "ContractKey",
+			Handler:    _Query_ContractKey_Handler,
+		},
+		{
+			MethodName: "LabelByAddress",
+			Handler:    _Query_LabelByAddress_Handler,
+		},
+		{
+			MethodName: "AddressByLabel",
+			Handler:    _Query_AddressByLabel_Handler,
+		},
+		{
+			MethodName: "ContractInfoByLabel",
+			Handler:    _Query_ContractInfoByLabel_Handler,
+		},
+		{
+			MethodName: "ContractHistory",
+			Handler:    _Query_ContractHistory_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Snip20WrapperByDenom",
+			Handler:    _Query_Snip20WrapperByDenom_Handler,
+		},
+		{
+			MethodName: "Snip20WrapperByContract",
+			Handler:    _Query_Snip20WrapperByContract_Handler,
+		},
+		{
+			MethodName: "ScheduledCall",
+			Handler:    _Query_ScheduledCall_Handler,
+		},
+		{
+			MethodName: "ScheduledCalls",
+			Handler:    _Query_ScheduledCalls_Handler,
+		},
+		{
+			MethodName: "Cron",
+			Handler:    _Query_Cron_Handler,
+		},
+		{
+			MethodName: "CronsByContract",
+			Handler:    _Query_CronsByContract_Handler,
+		},
+		{
+			MethodName: "ContractFeePolicy",
+			Handler:    _Query_ContractFeePolicy_Handler,
+		},
+		{
+			MethodName: "CodeSchema",
+			Handler:    _Query_CodeSchema_Handler,
+		},
+		{
+			MethodName: "CodeVerifications",
+			Handler:    _Query_CodeVerifications_Handler,
+		},
+		{
+			MethodName: "CodeAudits",
+			Handler:    _Query_CodeAudits_Handler,
+		},
+		{
+			MethodName: "ContractAssets",
+			Handler:    _Query_ContractAssets_Handler,
+		},
+		{
+			MethodName: "TopContractsByGas",
+			Handler:    _Query_TopContractsByGas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/compute/v1beta1/query.proto",
+}
+
+func (m *QuerySecretContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecretContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecretContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByLabelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByLabelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByLabelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByContractAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByContractAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByContractAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByCodeIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByCodeIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByCodeIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecretContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecretContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecretContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractInfo != nil {
+		{
+			size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfoWithAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfoWithAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfoWithAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContractInfo != nil {
+		{
+			size, err := m.ContractInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractsByCodeIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractsByCodeIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractsByCodeIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractInfos) > 0 {
+		for iNdEx := len(m.ContractInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListContractsByCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListContractsByCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListContractsByCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractByCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractByCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractByCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListContractsByCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListContractsByCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListContractsByCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Wasm) > 0 {
+		i -= len(m.Wasm)
+		copy(dAtA[i:], m.Wasm)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Wasm)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeInfoResponse != nil {
+		{
+			size, err := m.CodeInfoResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeInfos) > 0 {
+		for iNdEx := len(m.CodeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeInfos) > 0 {
+		for iNdEx := len(m.CodeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractLabelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractLabelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractLabelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexedContractAddress) > 0 {
+		i -= len(m.IndexedContractAddress)
+		copy(dAtA[i:], m.IndexedContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IndexedContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LabelIndexKey) > 0 {
+		i -= len(m.LabelIndexKey)
+		copy(dAtA[i:], m.LabelIndexKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LabelIndexKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DecryptedAnswer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptedAnswer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecryptedAnswer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutputDataAsString) > 0 {
+		i -= len(m.OutputDataAsString)
+		copy(dAtA[i:], m.OutputDataAsString)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputDataAsString)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OutputData) > 0 {
+		i -= len(m.OutputData)
+		copy(dAtA[i:], m.OutputData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Input) > 0 {
+		i -= len(m.Input)
+		copy(dAtA[i:], m.Input)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Input)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecryptedAnswers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptedAnswers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecryptedAnswers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlaintextError) > 0 {
+		i -= len(m.PlaintextError)
+		copy(dAtA[i:], m.PlaintextError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlaintextError)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OutputError) > 0 {
+		i -= len(m.OutputError)
+		copy(dAtA[i:], m.OutputError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutputError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OutputLogs) > 0 {
+		for iNdEx := len(m.OutputLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Answers) > 0 {
+		for iNdEx := len(m.Answers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Answers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnip20WrapperResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnip20WrapperResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnip20WrapperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snip20Wrapper.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ScheduledCall.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduledCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduledCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduledCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduledCalls) > 0 {
+		for iNdEx := len(m.ScheduledCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Cron.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronsByContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronsByContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronsByContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCronsByContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCronsByContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCronsByContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Crons) > 0 {
+		for iNdEx := len(m.Crons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Crons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractFeePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractFeePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractFeePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeAuditsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeAuditsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeAuditsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for iNdEx := len(m.Audits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Audits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeVerificationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeVerificationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeVerificationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeVerificationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeVerificationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeVerificationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifications) > 0 {
+		for iNdEx := len(m.Verifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractAssetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopContractsByGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopContractsByGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopContractsByGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Days != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopContractsByGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopContractsByGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopContractsByGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySecretContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByLabelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByContractAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryByCodeIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *QueryByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySecretContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractInfo != nil {
+		l = m.ContractInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfoWithAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractInfo != nil {
+		l = m.ContractInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractsByCodeIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractInfos) > 0 {
+		for _, e := range m.ContractInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListContractsByCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractByCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *QueryListContractsByCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.InstantiatePermission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeInfoResponse != nil {
+		l = m.CodeInfoResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Wasm)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeInfos) > 0 {
+		for _, e := range m.CodeInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListCodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CodeInfos) > 0 {
+		for _, e := range m.CodeInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractLabelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LabelIndexKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IndexedContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *DecryptedAnswer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Input)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OutputDataAsString)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DecryptedAnswers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Answers) > 0 {
 		for _, e := range m.Answers {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.OutputLogs) > 0 {
-		for _, e := range m.OutputLogs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.OutputLogs) > 0 {
+		for _, e := range m.OutputLogs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.OutputError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PlaintextError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySnip20WrapperResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snip20Wrapper.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ScheduledCall.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduledCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduledCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledCalls) > 0 {
+		for _, e := range m.ScheduledCalls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCronRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryCronResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cron.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCronsByContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCronsByContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Crons) > 0 {
+		for _, e := range m.Crons {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractFeePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCodeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCodeAuditsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Audits) > 0 {
+		for _, e := range m.Audits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCodeVerificationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeVerificationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Verifications) > 0 {
+		for _, e := range m.Verifications {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopContractsByGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	if m.Days != 0 {
+		n += 1 + sovQuery(uint64(m.Days))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryTopContractsByGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecretContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecretContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
+			if m.Query == nil {
+				m.Query = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByLabelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByLabelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByContractAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByContractAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByCodeIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByCodeIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByCodeIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecretContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecretContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecretContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractInfo == nil {
+				m.ContractInfo = &ContractInfo{}
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfoWithAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfoWithAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractInfo == nil {
+				m.ContractInfo = &ContractInfo{}
+			}
+			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractsByCodeIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractsByCodeIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractsByCodeIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
+			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListContractsByCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListContractsByCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListContractsByCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractByCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractByCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractByCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListContractsByCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListContractsByCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListContractsByCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, ContractByCode{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfoResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CodeInfoResponse == nil {
+				m.CodeInfoResponse = &CodeInfoResponse{}
+			}
+			if err := m.CodeInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
+			if m.Wasm == nil {
+				m.Wasm = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
+			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListCodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListCodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListCodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListCodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListCodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
+			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractLabelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractLabelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIndexKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelIndexKey = append(m.LabelIndexKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.LabelIndexKey == nil {
+				m.LabelIndexKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexedContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptedAnswer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptedAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Input = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputDataAsString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputDataAsString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.OutputError)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PlaintextError)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryContractHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryContractHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
+func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2651,15 +8672,83 @@ func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySecretContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptedAnswers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySecretContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptedAnswers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Answers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Answers = append(m.Answers, &DecryptedAnswer{})
+			if err := m.Answers[len(m.Answers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputLogs = append(m.OutputLogs, types.StringEvent{})
+			if err := m.OutputLogs[len(m.OutputLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2687,13 +8776,13 @@ func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.OutputError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaintextError", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2703,25 +8792,23 @@ func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = append(m.Query[:0], dAtA[iNdEx:postIndex]...)
-			if m.Query == nil {
-				m.Query = []byte{}
-			}
+			m.PlaintextError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2744,7 +8831,7 @@ func (m *QuerySecretContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByLabelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2767,15 +8854,15 @@ func (m *QueryByLabelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByLabelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByLabelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2803,7 +8890,7 @@ func (m *QueryByLabelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2826,7 +8913,7 @@ func (m *QueryByLabelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByContractAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2849,17 +8936,17 @@ func (m *QueryByContractAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByContractAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByContractAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2869,23 +8956,25 @@ func (m *QueryByContractAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, ContractCodeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2908,7 +8997,7 @@ func (m *QueryByContractAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryByCodeIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2931,17 +9020,67 @@ func (m *QueryByCodeIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryByCodeIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryByCodeIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.CodeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2951,11 +9090,25 @@ func (m *QueryByCodeIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2977,7 +9130,7 @@ func (m *QueryByCodeIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySecretContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySnip20WrapperResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3000,17 +9153,17 @@ func (m *QuerySecretContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySecretContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnip20WrapperResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySecretContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnip20WrapperResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snip20Wrapper", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3020,24 +9173,23 @@ func (m *QuerySecretContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Snip20Wrapper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3061,7 +9213,7 @@ func (m *QuerySecretContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3084,17 +9236,86 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCall", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3104,27 +9325,78 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ScheduledCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduledCallsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduledCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3151,10 +9423,10 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractInfo == nil {
-				m.ContractInfo = &ContractInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3179,7 +9451,7 @@ func (m *QueryContractInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduledCallsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,17 +9474,17 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfoWithAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfoWithAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduledCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCalls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3222,27 +9494,29 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ScheduledCalls = append(m.ScheduledCalls, ScheduledCall{})
+			if err := m.ScheduledCalls[len(m.ScheduledCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3269,10 +9543,10 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContractInfo == nil {
-				m.ContractInfo = &ContractInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ContractInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3297,7 +9571,7 @@ func (m *ContractInfoWithAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractsByCodeIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCronRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3320,17 +9594,17 @@ func (m *QueryContractsByCodeIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractsByCodeIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCronRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractsByCodeIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCronRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractInfos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3340,26 +9614,11 @@ func (m *QueryContractsByCodeIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractInfos = append(m.ContractInfos, ContractInfoWithAddress{})
-			if err := m.ContractInfos[len(m.ContractInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3381,7 +9640,7 @@ func (m *QueryContractsByCodeIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCronResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3404,100 +9663,17 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCronResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCronResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
-			}
-			m.CodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3507,55 +9683,24 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3578,7 +9723,7 @@ func (m *CodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCronsByContractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3601,17 +9746,17 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCronsByContractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCronsByContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfoResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3621,33 +9766,29 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CodeInfoResponse == nil {
-				m.CodeInfoResponse = &CodeInfoResponse{}
-			}
-			if err := m.CodeInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3657,24 +9798,26 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Wasm = append(m.Wasm[:0], dAtA[iNdEx:postIndex]...)
-			if m.Wasm == nil {
-				m.Wasm = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3698,7 +9841,7 @@ func (m *QueryCodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCronsByContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3721,15 +9864,49 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCronsByContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCronsByContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Crons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Crons = append(m.Crons, Cron{})
+			if err := m.Crons[len(m.Crons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3756,8 +9933,10 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeInfos = append(m.CodeInfos, CodeInfoResponse{})
-			if err := m.CodeInfos[len(m.CodeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3782,7 +9961,7 @@ func (m *QueryCodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractFeePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3805,17 +9984,17 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractFeePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractFeePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3825,23 +10004,24 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.FeePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3864,7 +10044,7 @@ func (m *QueryContractAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractLabelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3887,17 +10067,17 @@ func (m *QueryContractLabelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractLabelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractLabelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3907,23 +10087,24 @@ func (m *QueryContractLabelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3946,7 +10127,7 @@ func (m *QueryContractLabelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeAuditsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3969,17 +10150,17 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCodeHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeAuditsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCodeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeAuditsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Audits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3989,23 +10170,25 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			m.Audits = append(m.Audits, CodeAudit{})
+			if err := m.Audits[len(m.Audits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4028,7 +10211,7 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeVerificationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4051,15 +10234,15 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptedAnswer: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeVerificationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptedAnswer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeVerificationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4087,13 +10270,13 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4103,29 +10286,83 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Input = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCodeVerificationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCodeVerificationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCodeVerificationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4135,29 +10372,31 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputData = string(dAtA[iNdEx:postIndex])
+			m.Verifications = append(m.Verifications, CodeVerification{})
+			if err := m.Verifications[len(m.Verifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputDataAsString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4167,23 +10406,27 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputDataAsString = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4206,7 +10449,7 @@ func (m *DecryptedAnswer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
+func (m *QueryContractAssetsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4229,15 +10472,15 @@ func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptedAnswers: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractAssetsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptedAnswers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4264,14 +10507,14 @@ func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Answers = append(m.Answers, &DecryptedAnswer{})
-			if err := m.Answers[len(m.Answers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4298,16 +10541,16 @@ func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputLogs = append(m.OutputLogs, types.StringEvent{})
-			if err := m.OutputLogs[len(m.OutputLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, types1.DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4317,55 +10560,25 @@ func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlaintextError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.UnbondingDelegations = append(m.UnbondingDelegations, types1.UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PlaintextError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4388,7 +10601,7 @@ func (m *DecryptedAnswers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopContractsByGasRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4411,17 +10624,17 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopContractsByGasRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopContractsByGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var stringLen uint64
+			m.Blocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4431,24 +10644,49 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Blocks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4470,7 +10708,7 @@ func (m *QueryContractHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopContractsByGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4493,15 +10731,15 @@ func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopContractsByGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopContractsByGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4528,8 +10766,8 @@ func (m *QueryContractHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, ContractCodeHistoryEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Contracts = append(m.Contracts, ContractGasUsage{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
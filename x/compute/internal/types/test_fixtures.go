@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 
 	"github.com/tendermint/tendermint/libs/rand"
 )
@@ -15,7 +16,7 @@ func GenesisFixture(mutators ...func(*GenesisState)) GenesisState {
 	)
 
 	fixture := GenesisState{
-		// Params:    DefaultParams(),
+		Params:    DefaultParams(),
 		Codes:     make([]Code, numCodes),
 		Contracts: make([]Contract, numContracts),
 		Sequences: make([]Sequence, numSequences),
@@ -24,7 +25,9 @@ func GenesisFixture(mutators ...func(*GenesisState)) GenesisState {
 		fixture.Codes[i] = CodeFixture()
 	}
 	for i := 0; i < numContracts; i++ {
-		fixture.Contracts[i] = ContractFixture()
+		fixture.Contracts[i] = ContractFixture(func(c *Contract) {
+			c.ContractInfo.Label = fmt.Sprintf("any-%d", i)
+		})
 	}
 	for i := 0; i < numSequences; i++ {
 		fixture.Sequences[i] = Sequence{
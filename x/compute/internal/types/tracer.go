@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExecutionTrace describes a single call into a contract
+type ExecutionTrace struct {
+	// Operation is the kind of call, e.g. "execute" or "query"
+	Operation       string
+	ContractAddress sdk.AccAddress
+	CodeID          uint64
+	GasUsed         uint64
+	Duration        time.Duration
+	Err             error
+}
+
+// ExecutionTracer receives a trace of every call into a contract. It's meant for node operators
+// profiling contracts, so it must not write state nor affect the outcome of the call.
+type ExecutionTracer interface {
+	TraceExecution(ctx sdk.Context, trace ExecutionTrace)
+}
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	fmt "fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +24,9 @@ const (
 	defaultLRUCacheSize        = uint64(0)
 	defaultEnclaveLRUCacheSize = uint16(100)
 	defaultQueryGasLimit       = uint64(10_000_000)
+	defaultQueryNodeGasLimit   = uint64(100_000_000)
+	defaultQueryRateBurst      = uint64(20)
+	defaultMaxInFlightQueries  = uint16(32)
 )
 
 func (m Model) ValidateBasic() error {
@@ -82,6 +89,124 @@ func (c *ContractInfo) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic checks that the min fee is a valid set of coins. An empty min fee is valid and means no policy.
+func (p ContractFeePolicy) ValidateBasic() error {
+	if err := p.MinFee.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// IsSatisfiedBy returns true if fee covers at least one of the min fee coins
+func (p ContractFeePolicy) IsSatisfiedBy(fee sdk.Coins) bool {
+	return p.MinFee.IsZero() || fee.IsAnyGTE(p.MinFee)
+}
+
+// ValidateBasic checks that the schema is either stored or referenced by its hash and URI.
+// When the schema is stored, its hash must match it.
+func (s CodeSchema) ValidateBasic() error {
+	if len(s.Schema) == 0 {
+		if len(s.SchemaHash) != sha256.Size {
+			return sdkerrors.Wrapf(ErrInvalid, "schema hash must be %d bytes", sha256.Size)
+		}
+		if s.SchemaURI == "" {
+			return sdkerrors.Wrap(ErrEmpty, "schema or schema uri")
+		}
+		return validateSourceURL(s.SchemaURI)
+	}
+
+	if len(s.Schema) > MaxCodeSchemaSize {
+		return sdkerrors.Wrapf(ErrLimit, "schema cannot be longer than %d bytes", MaxCodeSchemaSize)
+	}
+	if !json.Valid(s.Schema) {
+		return sdkerrors.Wrap(ErrInvalid, "schema is not valid json")
+	}
+	if len(s.SchemaHash) != 0 {
+		hash := sha256.Sum256(s.Schema)
+		if !bytes.Equal(hash[:], s.SchemaHash) {
+			return sdkerrors.Wrap(ErrInvalid, "schema hash doesn't match the schema")
+		}
+	}
+	return validateSourceURL(s.SchemaURI)
+}
+
+// ValidateBasic checks that the audit attestation references its report by hash, has a date
+// and a scope
+func (a CodeAudit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Auditor); err != nil {
+		return sdkerrors.Wrap(err, "auditor")
+	}
+	if len(a.ReportHash) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalid, "report hash must be %d bytes", sha256.Size)
+	}
+	if err := validateSourceURL(a.ReportURI); err != nil {
+		return sdkerrors.Wrap(err, "report uri")
+	}
+	if a.AuditedAt.IsZero() {
+		return sdkerrors.Wrap(ErrEmpty, "audit date")
+	}
+	if a.Scope == "" {
+		return sdkerrors.Wrap(ErrEmpty, "scope")
+	}
+	if len(a.Scope) > MaxAuditScopeSize {
+		return sdkerrors.Wrapf(ErrLimit, "scope cannot be longer than %d bytes", MaxAuditScopeSize)
+	}
+	if !utf8.ValidString(a.Scope) {
+		return sdkerrors.Wrap(ErrInvalid, "scope must be valid UTF-8")
+	}
+	if a.Height < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "height")
+	}
+	return nil
+}
+
+func (a AccessType) String() string {
+	if name, ok := AccessType_name[int32(a)]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", int32(a))
+}
+
+// ParseAccessType parses the name of an access type, e.g. "only_address" or "ONLY_ADDRESS"
+func ParseAccessType(s string) (AccessType, error) {
+	v, ok := AccessType_value[strings.ToUpper(s)]
+	if !ok || AccessType(v) == AccessTypeUndefined {
+		return AccessTypeUndefined, sdkerrors.Wrapf(ErrInvalid, "access type %q", s)
+	}
+	return AccessType(v), nil
+}
+
+// ValidateBasic checks that the permission is defined, and that an address is set only with ONLY_ADDRESS
+func (c AccessConfig) ValidateBasic() error {
+	switch c.Permission {
+	case AccessTypeNobody, AccessTypeEverybody:
+		if c.Address != "" {
+			return sdkerrors.Wrapf(ErrInvalid, "address is not allowed with %s", c.Permission)
+		}
+		return nil
+	case AccessTypeOnlyAddress:
+		if _, err := sdk.AccAddressFromBech32(c.Address); err != nil {
+			return sdkerrors.Wrap(err, "address")
+		}
+		return nil
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "permission %s", c.Permission)
+	}
+}
+
+// Allowed returns true if addr may instantiate a code with this config.
+// An undefined permission is the config of codes that never had one, and allows everybody.
+func (c AccessConfig) Allowed(addr sdk.AccAddress) bool {
+	switch c.Permission {
+	case AccessTypeUndefined, AccessTypeEverybody:
+		return true
+	case AccessTypeOnlyAddress:
+		return c.Address == addr.String()
+	default:
+		return false
+	}
+}
+
 // LessThan can be used to sort
 func (a *AbsoluteTxPosition) LessThan(b *AbsoluteTxPosition) bool {
 	if a == nil {
@@ -198,6 +323,23 @@ func ContractLogsToSdkEvents(logs []wasmTypesV010.LogAttribute, contractAddr sdk
 
 const eventTypeMinLength = 2
 
+// ContractResponseEvents returns the events of a contract response in the order they are emitted:
+// the "wasm" event holding the response attributes first, then the custom "wasm-*" events in
+// the order the contract returned them. Attributes keep the contract's order.
+func ContractResponseEvents(logs []wasmTypesV010.LogAttribute, evts wasmTypesV1.Events, contractAddr sdk.AccAddress) (sdk.Events, error) {
+	events := ContractLogsToSdkEvents(logs, contractAddr)
+	if len(evts) == 0 {
+		return events, nil
+	}
+
+	customEvents, err := NewCustomEvents(evts, contractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(events, customEvents...), nil
+}
+
 // NewCustomEvents converts wasm events from a contract response to sdk type events
 func NewCustomEvents(evts wasmTypesV1.Events, contractAddr sdk.AccAddress) (sdk.Events, error) {
 	events := make(sdk.Events, 0, len(evts))
@@ -242,8 +384,28 @@ func contractSDKEventAttributes(customAttributes []wasmTypesV010.LogAttribute, c
 // WasmConfig is the extra config required for wasm
 type WasmConfig struct {
 	SmartQueryGasLimit uint64
-	CacheSize          uint64
-	EnclaveCacheSize   uint16
+	// SmartQueryTimeout is the wall-clock deadline for RPC smart queries, 0 disables it
+	SmartQueryTimeout time.Duration
+	// MaxInFlightQueries is the number of RPC smart queries that may run in the enclave at once when
+	// SmartQueryTimeout is set, including the queries that ran past their deadline
+	MaxInFlightQueries uint16
+	// QueryRateLimit is the number of contract queries per second a single client may make
+	// through the REST API and gRPC, 0 disables rate limiting
+	QueryRateLimit float64
+	// QueryRateBurst is the number of queries a client may make at once before being limited
+	QueryRateBurst uint64
+	// QueryAPIKeys are the API keys that identify a client for rate limiting, other clients are
+	// identified by their IP address
+	QueryAPIKeys     []string
+	CacheSize        uint64
+	EnclaveCacheSize uint16
+	// CacheWarmupSize is the number of most used codes loaded into the enclave cache on startup, 0 disables it
+	CacheWarmupSize uint16
+	// QueryNodeMode dedicates the node to serving queries: it rejects txs in CheckTx and
+	// raises the default query gas limit
+	QueryNodeMode bool
+	// ArchiveMode keeps every store version and block, so contracts can be queried at any height
+	ArchiveMode bool
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -252,6 +414,8 @@ func DefaultWasmConfig() *WasmConfig {
 		SmartQueryGasLimit: defaultQueryGasLimit,
 		CacheSize:          defaultLRUCacheSize,
 		EnclaveCacheSize:   defaultEnclaveLRUCacheSize,
+		QueryRateBurst:     defaultQueryRateBurst,
+		MaxInFlightQueries: defaultMaxInFlightQueries,
 	}
 }
 
@@ -271,6 +435,30 @@ func (m SecretMsg) Serialize() []byte {
 	return append(m.CodeHash, m.Msg...)
 }
 
+// Layout of an encrypted msg: nonce(32) || tx sender x25519 pubkey(32) || AES-SIV ciphertext
+const (
+	EncryptedMsgNonceSize  = 32
+	EncryptedMsgPubKeySize = 32
+	// EncryptedMsgMinSize is the smallest encrypted msg the enclave accepts
+	EncryptedMsgMinSize = 82
+)
+
+// ValidateEncryptedMsg checks the envelope of an encrypted msg without decrypting it,
+// so that malformed msgs can be rejected before they reach the enclave
+func ValidateEncryptedMsg(msg []byte) error {
+	if len(msg) < EncryptedMsgMinSize {
+		return sdkerrors.Wrapf(ErrInvalidEncryptedMsg, "length %d is shorter than %d", len(msg), EncryptedMsgMinSize)
+	}
+	pubKey := msg[EncryptedMsgNonceSize : EncryptedMsgNonceSize+EncryptedMsgPubKeySize]
+	if bytes.Equal(pubKey, make([]byte, EncryptedMsgPubKeySize)) {
+		return sdkerrors.Wrap(ErrInvalidEncryptedMsg, "empty public key")
+	}
+	return nil
+}
+
+// NewSigInfo builds the verification info passed to the enclave. A non-nil callbackSig is
+// decoded from its versioned envelope (see ParseCallbackSig) and only its payload and
+// algorithm are handed to the enclave.
 func NewSigInfo(
 	txBytes []byte,
 	signBytes []byte,
@@ -279,16 +467,30 @@ func NewSigInfo(
 	publicKey []byte,
 	signature []byte,
 	callbackSig []byte,
-) wasmTypes.SigInfo {
-	return wasmTypes.SigInfo{
-		TxBytes:           txBytes,
-		SignBytes:         signBytes,
-		SignMode:          signMode.String(),
-		ModeInfo:          modeInfo,
-		Signature:         signature,
-		PublicKey:         publicKey,
-		CallbackSignature: callbackSig,
+) (wasmTypes.SigInfo, error) {
+	sigInfo := wasmTypes.SigInfo{
+		TxBytes:   txBytes,
+		SignBytes: signBytes,
+		SignMode:  signMode.String(),
+		ModeInfo:  modeInfo,
+		Signature: signature,
+		PublicKey: publicKey,
+	}
+
+	if callbackSig == nil {
+		return sigInfo, nil
+	}
+
+	sig, err := ParseCallbackSig(callbackSig)
+	if err != nil {
+		return wasmTypes.SigInfo{}, err
 	}
+
+	sigInfo.CallbackSignature = sig.Payload
+	if sig.Version != CallbackSigVersionLegacy {
+		sigInfo.CallbackSigAlgorithm = sig.Algorithm.String()
+	}
+	return sigInfo, nil
 }
 
 // GetConfig load config values from the app options
@@ -300,11 +502,43 @@ func GetConfig(appOpts servertypes.AppOptions) *WasmConfig {
 		config.SmartQueryGasLimit = updatedGasLimit
 	}
 
+	config.ArchiveMode = cast.ToBool(appOpts.Get("wasm.archive-mode"))
+
+	config.QueryNodeMode = cast.ToBool(appOpts.Get("wasm.query-node-mode"))
+	// app.toml always has the default limit, so only a different one counts as set by the operator
+	if config.QueryNodeMode && config.SmartQueryGasLimit == defaultQueryGasLimit {
+		config.SmartQueryGasLimit = defaultQueryNodeGasLimit
+	}
+
+	queryTimeout := cast.ToDuration(appOpts.Get("wasm.contract-query-timeout"))
+	if queryTimeout > 0 {
+		config.SmartQueryTimeout = queryTimeout
+	}
+
+	maxInFlightQueries := cast.ToUint16(appOpts.Get("wasm.contract-query-max-in-flight"))
+	if maxInFlightQueries > 0 {
+		config.MaxInFlightQueries = maxInFlightQueries
+	}
+
+	queryRateLimit := cast.ToFloat64(appOpts.Get("wasm.contract-query-rate-limit"))
+	if queryRateLimit > 0 {
+		config.QueryRateLimit = queryRateLimit
+	}
+
+	queryRateBurst := cast.ToUint64(appOpts.Get("wasm.contract-query-rate-burst"))
+	if queryRateBurst > 0 {
+		config.QueryRateBurst = queryRateBurst
+	}
+
+	config.QueryAPIKeys = cast.ToStringSlice(appOpts.Get("wasm.contract-query-api-keys"))
+
 	enclaveCacheSize := cast.ToUint16(appOpts.Get("wasm.contract-memory-enclave-cache-size"))
 	if enclaveCacheSize > 0 {
 		config.EnclaveCacheSize = enclaveCacheSize
 	}
 
+	config.CacheWarmupSize = cast.ToUint16(appOpts.Get("wasm.contract-cache-warmup-size"))
+
 	return config
 }
 
@@ -316,11 +550,47 @@ const DefaultConfigTemplate = `
 # so we need to restrict the max usage to prevent DoS attack
 contract-query-gas-limit = "{{ .WASMConfig.SmartQueryGasLimit }}"
 
+# The maximum wall-clock time a contract query may take (e.g. "5s"), independent of gas.
+# Gas doesn't protect public nodes from contracts that hit slow enclave paths. 0 disables the limit.
+# Queries run by contracts during tx execution are never subject to this limit.
+contract-query-timeout = "{{ .WASMConfig.SmartQueryTimeout }}"
+
+# The number of contract queries that may run at once when contract-query-timeout is set.
+# A query that passes the deadline can't be interrupted, so it keeps its slot until it's done,
+# and the queries that find no free slot before their deadline fail.
+contract-query-max-in-flight = "{{ .WASMConfig.MaxInFlightQueries }}"
+
+# The number of contract queries per second a single client may make through the REST API and gRPC.
+# Clients are identified by their IP address, or by the X-API-Key header (x-api-key gRPC metadata)
+# if it's one of contract-query-api-keys. 0 disables the limit.
+contract-query-rate-limit = "{{ .WASMConfig.QueryRateLimit }}"
+
+# The number of contract queries a single client may make at once before being rate limited
+contract-query-rate-burst = "{{ .WASMConfig.QueryRateBurst }}"
+
+# The API keys that identify a client for the rate limit of contract queries, e.g. ["key1", "key2"].
+# Unknown keys are ignored, so that a client can't get around the limit by changing its key.
+contract-query-api-keys = [{{ range $i, $key := .WASMConfig.QueryAPIKeys }}{{ if $i }}, {{ end }}"{{ $key }}"{{ end }}]
+
 # The WASM VM memory cache size in MiB not bytes
 contract-memory-cache-size = "{{ .WASMConfig.CacheSize }}"
 
 # The WASM VM memory cache size in number of cached modules. Can safely go up to 15, but not recommended for validators
 contract-memory-enclave-cache-size = "{{ .WASMConfig.EnclaveCacheSize }}"
+
+# The number of most used codes to load into the enclave cache on startup, before the node
+# accepts blocks. The usage is saved every 100 blocks to wasm/code-usage.json. 0 disables it.
+contract-cache-warmup-size = "{{ .WASMConfig.CacheWarmupSize }}"
+
+# Dedicates the node to serving queries, for API fleets behind a load balancer.
+# The node rejects txs from clients and peers, but still executes blocks and simulates txs.
+# If contract-query-gas-limit is left at 10000000, it is raised to 100000000.
+query-node-mode = {{ .WASMConfig.QueryNodeMode }}
+
+# Keeps every store version and block, overriding pruning and min-retain-blocks, so contracts can
+# be queried and txs decrypted at any height. Historical queries run under the key epochs that
+# were active at their height, which the enclave of an archive node must still hold.
+archive-mode = {{ .WASMConfig.ArchiveMode }}
 `
 
 // ZeroSender is a valid 20 byte canonical address that's used to bypass the x/compute checks
@@ -333,7 +603,16 @@ var ZeroSender = sdk.AccAddress{
 	0, 0, 0, 0, 0,
 }
 
-func (c ContractInfo) InitialHistory(initMsg []byte) ContractCodeHistoryEntry {
+// TxHash returns the hash of the tx being executed, or nil outside of a tx
+func TxHash(ctx sdk.Context) []byte {
+	if len(ctx.TxBytes()) == 0 {
+		return nil
+	}
+	txhash := sha256.Sum256(ctx.TxBytes())
+	return txhash[:]
+}
+
+func (c ContractInfo) InitialHistory(ctx sdk.Context, initMsg []byte) ContractCodeHistoryEntry {
 	if c.Created == nil {
 		c.Created = &AbsoluteTxPosition{
 			BlockHeight: 0,
@@ -346,6 +625,7 @@ func (c ContractInfo) InitialHistory(initMsg []byte) ContractCodeHistoryEntry {
 		CodeID:    c.CodeID,
 		Updated:   c.Created,
 		Msg:       initMsg,
+		TxHash:    TxHash(ctx),
 	}
 }
 
@@ -355,6 +635,7 @@ func (c *ContractInfo) AddMigration(ctx sdk.Context, codeID uint64, msg []byte)
 		CodeID:    codeID,
 		Updated:   NewAbsoluteTxPosition(ctx),
 		Msg:       msg,
+		TxHash:    TxHash(ctx),
 	}
 	c.CodeID = codeID
 	return h
@@ -7,18 +7,23 @@ import (
 	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -89,6 +94,523 @@ func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_8ba7f40a6d1951b3, []int{1}
 }
 
+// Params defines the set of compute module parameters.
+type Params struct {
+	// AllowedDepositDenoms lists the denoms that may be sent as funds with
+	// MsgInstantiateContract and MsgExecuteContract. An empty list allows all denoms.
+	AllowedDepositDenoms []string `protobuf:"bytes,1,rep,name=allowed_deposit_denoms,json=allowedDepositDenoms,proto3" json:"allowed_deposit_denoms,omitempty" yaml:"allowed_deposit_denoms"`
+	// Snip20Wrappers maps native denoms to their canonical SNIP-20 wrapper contract.
+	// Each denom and each contract may appear only once.
+	Snip20Wrappers []Snip20Wrapper `protobuf:"bytes,2,rep,name=snip20_wrappers,json=snip20Wrappers,proto3" json:"snip20_wrappers" yaml:"snip20_wrappers"`
+	// KeyEpochs schedules the enclave key epochs of the chain, in increasing order.
+	// An empty list runs every call on the keys of the current consensus seed.
+	KeyEpochs []KeyEpoch `protobuf:"bytes,3,rep,name=key_epochs,json=keyEpochs,proto3" json:"key_epochs" yaml:"key_epochs"`
+	// MaxCronGasLimit is the most gas a single cron execution may be registered with.
+	// Zero disables the registration of new crons.
+	MaxCronGasLimit uint64 `protobuf:"varint,4,opt,name=max_cron_gas_limit,json=maxCronGasLimit,proto3" json:"max_cron_gas_limit,omitempty" yaml:"max_cron_gas_limit"`
+	// MaxCronsPerBlock is the most cron executions run at the end of a block.
+	// Crons that are due but over the limit run in the following blocks.
+	// Zero disables crons, new ones can't be registered and registered ones don't run.
+	MaxCronsPerBlock uint32 `protobuf:"varint,5,opt,name=max_crons_per_block,json=maxCronsPerBlock,proto3" json:"max_crons_per_block,omitempty" yaml:"max_crons_per_block"`
+	// MaxCronsPerContract is the most crons a single contract may have registered
+	MaxCronsPerContract uint32 `protobuf:"varint,6,opt,name=max_crons_per_contract,json=maxCronsPerContract,proto3" json:"max_crons_per_contract,omitempty" yaml:"max_crons_per_contract"`
+	// QueryPluginGasCosts is the flat gas charged for each query a contract makes
+	// to the chain, per query plugin, on top of the gas used by the query itself.
+	QueryPluginGasCosts QueryPluginGasCosts `protobuf:"bytes,7,opt,name=query_plugin_gas_costs,json=queryPluginGasCosts,proto3" json:"query_plugin_gas_costs" yaml:"query_plugin_gas_costs"`
+	// MaxLabelLength is the longest label, in bytes, a new contract may have.
+	// Zero means MaxLabelSize.
+	MaxLabelLength uint32 `protobuf:"varint,8,opt,name=max_label_length,json=maxLabelLength,proto3" json:"max_label_length,omitempty" yaml:"max_label_length"`
+	// LabelCharset is the body of a regexp character class, e.g. "a-zA-Z0-9 _.-",
+	// that every character of the label of a new contract must match.
+	// An empty charset allows all printable characters.
+	LabelCharset string `protobuf:"bytes,9,opt,name=label_charset,json=labelCharset,proto3" json:"label_charset,omitempty" yaml:"label_charset"`
+	// MaxMemoryPages is the most 64KiB pages of memory a contract instance may
+	// use. Zero means MaxWasmMemoryPages, the limit of the wasm engine.
+	MaxMemoryPages uint32 `protobuf:"varint,10,opt,name=max_memory_pages,json=maxMemoryPages,proto3" json:"max_memory_pages,omitempty" yaml:"max_memory_pages"`
+	// MaxDispatchDepth is the longest chain of contract calls, e.g. contract ->
+	// contract -> contract, a contract call may dispatch msgs down to. The msgs
+	// of a contract called by a tx are at depth 1. Zero means no limit.
+	MaxDispatchDepth uint32 `protobuf:"varint,11,opt,name=max_dispatch_depth,json=maxDispatchDepth,proto3" json:"max_dispatch_depth,omitempty" yaml:"max_dispatch_depth"`
+	// MaxDispatchedMsgs is the most msgs that may be dispatched, at all depths,
+	// as a result of a single contract call of a tx. Zero means no limit.
+	MaxDispatchedMsgs uint32 `protobuf:"varint,12,opt,name=max_dispatched_msgs,json=maxDispatchedMsgs,proto3" json:"max_dispatched_msgs,omitempty" yaml:"max_dispatched_msgs"`
+	// DeniedMsgTypes lists the sdk msgs contracts may not dispatch, by type URL,
+	// e.g. "/cosmos.gov.v1beta1.MsgVote". An entry ending with "." denies all the
+	// msgs of a package, e.g. "/cosmos.staking.".
+	DeniedMsgTypes []string `protobuf:"bytes,13,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types,omitempty" yaml:"denied_msg_types"`
+	// DenyStakingMsgs stops contracts from delegating, redelegating and
+	// undelegating.
+	DenyStakingMsgs bool `protobuf:"varint,14,opt,name=deny_staking_msgs,json=denyStakingMsgs,proto3" json:"deny_staking_msgs,omitempty" yaml:"deny_staking_msgs"`
+	// Auditors lists the addresses that may publish audit attestations of codes
+	// with MsgAddCodeAudit
+	Auditors []string `protobuf:"bytes,15,rep,name=auditors,proto3" json:"auditors,omitempty" yaml:"auditors"`
+	// MaxMsgSize is the largest encrypted init, execute, migrate or query msg, in
+	// bytes, that is sent to a contract. Zero means MaxMsgSize, the limit checked
+	// by ValidateBasic.
+	MaxMsgSize uint32 `protobuf:"varint,16,opt,name=max_msg_size,json=maxMsgSize,proto3" json:"max_msg_size,omitempty" yaml:"max_msg_size"`
+	// ScheduledCallGasPrice is the least fee, in the bond denom per unit of gas,
+	// that a call scheduled with MsgScheduleExecute must pay for its gas limit.
+	// Zero means DefaultScheduledCallGasPrice.
+	ScheduledCallGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=scheduled_call_gas_price,json=scheduledCallGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"scheduled_call_gas_price" yaml:"scheduled_call_gas_price"`
+	// MaxScheduledGasPerBlock is the most gas the scheduled calls executed at the
+	// end of a block may reserve in total. Due calls over the limit run in the
+	// following blocks, in order. Zero means DefaultMaxScheduledGasPerBlock.
+	MaxScheduledGasPerBlock uint64 `protobuf:"varint,18,opt,name=max_scheduled_gas_per_block,json=maxScheduledGasPerBlock,proto3" json:"max_scheduled_gas_per_block,omitempty" yaml:"max_scheduled_gas_per_block"`
+	// FundRecoveryDelay is the least number of blocks between the flagging of a
+	// code as broken and the recovery of the funds of its contracts, so that
+	// users and developers have time to react. Zero means
+	// DefaultFundRecoveryDelay.
+	FundRecoveryDelay int64 `protobuf:"varint,19,opt,name=fund_recovery_delay,json=fundRecoveryDelay,proto3" json:"fund_recovery_delay,omitempty" yaml:"fund_recovery_delay"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// QueryPluginGasCosts holds the flat SDK gas surcharge of each query plugin
+type QueryPluginGasCosts struct {
+	Bank     uint64 `protobuf:"varint,1,opt,name=bank,proto3" json:"bank,omitempty"`
+	Custom   uint64 `protobuf:"varint,2,opt,name=custom,proto3" json:"custom,omitempty"`
+	Staking  uint64 `protobuf:"varint,3,opt,name=staking,proto3" json:"staking,omitempty"`
+	Wasm     uint64 `protobuf:"varint,4,opt,name=wasm,proto3" json:"wasm,omitempty"`
+	Dist     uint64 `protobuf:"varint,5,opt,name=dist,proto3" json:"dist,omitempty"`
+	Mint     uint64 `protobuf:"varint,6,opt,name=mint,proto3" json:"mint,omitempty"`
+	Gov      uint64 `protobuf:"varint,7,opt,name=gov,proto3" json:"gov,omitempty"`
+	IBC      uint64 `protobuf:"varint,8,opt,name=ibc,proto3" json:"ibc,omitempty"`
+	Stargate uint64 `protobuf:"varint,9,opt,name=stargate,proto3" json:"stargate,omitempty"`
+	Oracle   uint64 `protobuf:"varint,10,opt,name=oracle,proto3" json:"oracle,omitempty"`
+}
+
+func (m *QueryPluginGasCosts) Reset()         { *m = QueryPluginGasCosts{} }
+func (m *QueryPluginGasCosts) String() string { return proto.CompactTextString(m) }
+func (*QueryPluginGasCosts) ProtoMessage()    {}
+func (*QueryPluginGasCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{1}
+}
+func (m *QueryPluginGasCosts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPluginGasCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPluginGasCosts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPluginGasCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPluginGasCosts.Merge(m, src)
+}
+func (m *QueryPluginGasCosts) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPluginGasCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPluginGasCosts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPluginGasCosts proto.InternalMessageInfo
+
+// Snip20Wrapper is the canonical SNIP-20 contract wrapping a native denom
+type Snip20Wrapper struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// contract_address is the bech32 address of the SNIP-20 contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *Snip20Wrapper) Reset()         { *m = Snip20Wrapper{} }
+func (m *Snip20Wrapper) String() string { return proto.CompactTextString(m) }
+func (*Snip20Wrapper) ProtoMessage()    {}
+func (*Snip20Wrapper) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{2}
+}
+func (m *Snip20Wrapper) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Snip20Wrapper) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Snip20Wrapper.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Snip20Wrapper) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snip20Wrapper.Merge(m, src)
+}
+func (m *Snip20Wrapper) XXX_Size() int {
+	return m.Size()
+}
+func (m *Snip20Wrapper) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snip20Wrapper.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snip20Wrapper proto.InternalMessageInfo
+
+// KeyEpoch is a generation of sealed enclave keys. When an enclave upgrade
+// introduces new keys, a new epoch starts and the keys of the previous epoch
+// remain valid for transition_blocks blocks, so that nodes can upgrade at
+// their own pace.
+type KeyEpoch struct {
+	// epoch 0 runs on the io keys of the genesis consensus seed, epoch 1 on those
+	// of the current consensus seed
+	Epoch uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// start_height is the first height at which this epoch is active
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// transition_blocks is the number of blocks after start_height during
+	// which the keys of the previous epoch are still accepted
+	TransitionBlocks int64 `protobuf:"varint,3,opt,name=transition_blocks,json=transitionBlocks,proto3" json:"transition_blocks,omitempty"`
+}
+
+func (m *KeyEpoch) Reset()         { *m = KeyEpoch{} }
+func (m *KeyEpoch) String() string { return proto.CompactTextString(m) }
+func (*KeyEpoch) ProtoMessage()    {}
+func (*KeyEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{3}
+}
+func (m *KeyEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyEpoch.Merge(m, src)
+}
+func (m *KeyEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyEpoch proto.InternalMessageInfo
+
+// ScheduledCall is a contract execution escrowed by MsgScheduleExecute
+type ScheduledCall struct {
+	ID            uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sender        string                                   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Contract      string                                   `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Msg           []byte                                   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	SentFunds     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=sent_funds,json=sentFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent_funds"`
+	ExecuteHeight int64                                    `protobuf:"varint,6,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	GasLimit      uint64                                   `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Fee           types.Coin                               `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *ScheduledCall) Reset()         { *m = ScheduledCall{} }
+func (m *ScheduledCall) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCall) ProtoMessage()    {}
+func (*ScheduledCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{4}
+}
+func (m *ScheduledCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCall.Merge(m, src)
+}
+func (m *ScheduledCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCall proto.InternalMessageInfo
+
+// ContractFeePolicy is the minimum fee a tx must pay to execute a contract.
+// The tx fee must cover at least one of the min_fee coins, so a single coin
+// also acts as a required fee denom.
+type ContractFeePolicy struct {
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *ContractFeePolicy) Reset()         { *m = ContractFeePolicy{} }
+func (m *ContractFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*ContractFeePolicy) ProtoMessage()    {}
+func (*ContractFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{5}
+}
+func (m *ContractFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFeePolicy.Merge(m, src)
+}
+func (m *ContractFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFeePolicy proto.InternalMessageInfo
+
+// CodeSchema describes the JSON interface of the messages of a code, so that
+// clients can render and validate messages before encrypting them. Either the
+// schema itself is stored, or only its sha256 hash and a URI to fetch it from.
+type CodeSchema struct {
+	// schema is the JSON schema of the instantiate, execute and query messages
+	Schema []byte `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	// schema_hash is the sha256 hash of the schema
+	SchemaHash []byte `protobuf:"bytes,2,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// schema_uri is an https URI of the schema, when it isn't stored on chain
+	SchemaURI string `protobuf:"bytes,3,opt,name=schema_uri,json=schemaUri,proto3" json:"schema_uri,omitempty"`
+}
+
+func (m *CodeSchema) Reset()         { *m = CodeSchema{} }
+func (m *CodeSchema) String() string { return proto.CompactTextString(m) }
+func (*CodeSchema) ProtoMessage()    {}
+func (*CodeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{6}
+}
+func (m *CodeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeSchema.Merge(m, src)
+}
+func (m *CodeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeSchema proto.InternalMessageInfo
+
+// CodeAudit is an attestation by an auditor of the Auditors param that a code
+// was audited
+type CodeAudit struct {
+	// auditor is the address of the auditor that published the attestation
+	Auditor string `protobuf:"bytes,1,opt,name=auditor,proto3" json:"auditor,omitempty"`
+	// report_hash is the sha256 hash of the audit report
+	ReportHash []byte `protobuf:"bytes,2,opt,name=report_hash,json=reportHash,proto3" json:"report_hash,omitempty"`
+	// report_uri is an https URI of the audit report, optional
+	ReportURI string `protobuf:"bytes,3,opt,name=report_uri,json=reportUri,proto3" json:"report_uri,omitempty"`
+	// audited_at is the date of the audit report
+	AuditedAt time.Time `protobuf:"bytes,4,opt,name=audited_at,json=auditedAt,proto3,stdtime" json:"audited_at"`
+	// scope describes what was audited, e.g. the commit of the source and the
+	// features covered
+	Scope string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	// height is the block height at which the attestation was published
+	Height int64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CodeAudit) Reset()         { *m = CodeAudit{} }
+func (m *CodeAudit) String() string { return proto.CompactTextString(m) }
+func (*CodeAudit) ProtoMessage()    {}
+func (*CodeAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{7}
+}
+func (m *CodeAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeAudit.Merge(m, src)
+}
+func (m *CodeAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeAudit proto.InternalMessageInfo
+
+// CodeVerification is a claim by a verifier that a code hash is the
+// reproducible build of the given source, e.g. so that wallets can display a
+// verified build badge for the contracts of that code.
+type CodeVerification struct {
+	// verifier is the address of the account that signed the claim
+	Verifier string `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// source is an https URI of the source repository
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// commit is the revision of the source the code was built from
+	Commit string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
+	// builder is the docker image of the optimizer the code was built with
+	Builder string `protobuf:"bytes,4,opt,name=builder,proto3" json:"builder,omitempty"`
+	// height is the block height at which the claim was made
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CodeVerification) Reset()         { *m = CodeVerification{} }
+func (m *CodeVerification) String() string { return proto.CompactTextString(m) }
+func (*CodeVerification) ProtoMessage()    {}
+func (*CodeVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{8}
+}
+func (m *CodeVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodeVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeVerification.Merge(m, src)
+}
+func (m *CodeVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeVerification proto.InternalMessageInfo
+
+// Cron is a recurring contract execution registered by MsgRegisterCron
+type Cron struct {
+	ID       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Msg      []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Interval int64  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// next_height is the height at the end of which the contract is executed next
+	NextHeight int64 `protobuf:"varint,6,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+	// owner is the account that registered the cron and paid its budget
+	Owner string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// budget is what is left of the prepaid fees of the cron, in the bond denom.
+	// Each execution pays gas_limit times the ScheduledCallGasPrice param from it,
+	// and the cron is removed once the budget can't pay for another execution.
+	Budget types.Coin `protobuf:"bytes,8,opt,name=budget,proto3" json:"budget"`
+}
+
+func (m *Cron) Reset()         { *m = Cron{} }
+func (m *Cron) String() string { return proto.CompactTextString(m) }
+func (*Cron) ProtoMessage()    {}
+func (*Cron) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{9}
+}
+func (m *Cron) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Cron) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Cron.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Cron) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cron.Merge(m, src)
+}
+func (m *Cron) XXX_Size() int {
+	return m.Size()
+}
+func (m *Cron) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cron.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Cron proto.InternalMessageInfo
+
 type AccessTypeParam struct {
 	Value AccessType `protobuf:"varint,1,opt,name=value,proto3,enum=secret.compute.v1beta1.AccessType" json:"value,omitempty" yaml:"value"`
 }
@@ -97,7 +619,7 @@ func (m *AccessTypeParam) Reset()         { *m = AccessTypeParam{} }
 func (m *AccessTypeParam) String() string { return proto.CompactTextString(m) }
 func (*AccessTypeParam) ProtoMessage()    {}
 func (*AccessTypeParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{0}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{10}
 }
 func (m *AccessTypeParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,6 +648,47 @@ func (m *AccessTypeParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AccessTypeParam proto.InternalMessageInfo
 
+// AccessConfig restricts who may instantiate a code. Codes without one may be
+// instantiated by everybody.
+type AccessConfig struct {
+	Permission AccessType `protobuf:"varint,1,opt,name=permission,proto3,enum=secret.compute.v1beta1.AccessType" json:"permission,omitempty" yaml:"permission"`
+	// address is the only address allowed with ONLY_ADDRESS
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *AccessConfig) Reset()         { *m = AccessConfig{} }
+func (m *AccessConfig) String() string { return proto.CompactTextString(m) }
+func (*AccessConfig) ProtoMessage()    {}
+func (*AccessConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{11}
+}
+func (m *AccessConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessConfig.Merge(m, src)
+}
+func (m *AccessConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	CodeHash []byte                                        `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
@@ -138,7 +701,7 @@ func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{1}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{12}
 }
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +740,7 @@ func (m *ContractKey) Reset()         { *m = ContractKey{} }
 func (m *ContractKey) String() string { return proto.CompactTextString(m) }
 func (*ContractKey) ProtoMessage()    {}
 func (*ContractKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{2}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{13}
 }
 func (m *ContractKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -215,7 +778,7 @@ func (m *ContractCustomInfo) Reset()         { *m = ContractCustomInfo{} }
 func (m *ContractCustomInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractCustomInfo) ProtoMessage()    {}
 func (*ContractCustomInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{3}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{14}
 }
 func (m *ContractCustomInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +828,7 @@ func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{4}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{15}
 }
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +869,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{5}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{16}
 }
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +910,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{6}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{17}
 }
 func (m *Model) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,13 +947,16 @@ type ContractCodeHistoryEntry struct {
 	// Updated Tx position when the operation was executed.
 	Updated *AbsoluteTxPosition `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
 	Msg     []byte              `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// TxHash is the hash of the tx that executed the operation. Empty for entries
+	// created outside of a tx, e.g. during genesis import or store migrations.
+	TxHash github_com_tendermint_tendermint_libs_bytes.HexBytes `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3,casttype=github.com/tendermint/tendermint/libs/bytes.HexBytes" json:"tx_hash,omitempty"`
 }
 
 func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEntry{} }
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8ba7f40a6d1951b3, []int{7}
+	return fileDescriptor_8ba7f40a6d1951b3, []int{18}
 }
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,17 +985,68 @@ func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("secret.compute.v1beta1.AccessType", AccessType_name, AccessType_value)
-	proto.RegisterEnum("secret.compute.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
-	proto.RegisterType((*AccessTypeParam)(nil), "secret.compute.v1beta1.AccessTypeParam")
-	proto.RegisterType((*CodeInfo)(nil), "secret.compute.v1beta1.CodeInfo")
-	proto.RegisterType((*ContractKey)(nil), "secret.compute.v1beta1.ContractKey")
-	proto.RegisterType((*ContractCustomInfo)(nil), "secret.compute.v1beta1.ContractCustomInfo")
-	proto.RegisterType((*ContractInfo)(nil), "secret.compute.v1beta1.ContractInfo")
-	proto.RegisterType((*AbsoluteTxPosition)(nil), "secret.compute.v1beta1.AbsoluteTxPosition")
+// ContractGasUsage is the gas used by the calls of a contract over a period
+type ContractGasUsage struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	GasUsed         uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *ContractGasUsage) Reset()         { *m = ContractGasUsage{} }
+func (m *ContractGasUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractGasUsage) ProtoMessage()    {}
+func (*ContractGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{19}
+}
+func (m *ContractGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGasUsage.Merge(m, src)
+}
+func (m *ContractGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractGasUsage proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("secret.compute.v1beta1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("secret.compute.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterType((*Params)(nil), "secret.compute.v1beta1.Params")
+	proto.RegisterType((*QueryPluginGasCosts)(nil), "secret.compute.v1beta1.QueryPluginGasCosts")
+	proto.RegisterType((*Snip20Wrapper)(nil), "secret.compute.v1beta1.Snip20Wrapper")
+	proto.RegisterType((*KeyEpoch)(nil), "secret.compute.v1beta1.KeyEpoch")
+	proto.RegisterType((*ScheduledCall)(nil), "secret.compute.v1beta1.ScheduledCall")
+	proto.RegisterType((*ContractFeePolicy)(nil), "secret.compute.v1beta1.ContractFeePolicy")
+	proto.RegisterType((*CodeSchema)(nil), "secret.compute.v1beta1.CodeSchema")
+	proto.RegisterType((*CodeAudit)(nil), "secret.compute.v1beta1.CodeAudit")
+	proto.RegisterType((*CodeVerification)(nil), "secret.compute.v1beta1.CodeVerification")
+	proto.RegisterType((*Cron)(nil), "secret.compute.v1beta1.Cron")
+	proto.RegisterType((*AccessTypeParam)(nil), "secret.compute.v1beta1.AccessTypeParam")
+	proto.RegisterType((*AccessConfig)(nil), "secret.compute.v1beta1.AccessConfig")
+	proto.RegisterType((*CodeInfo)(nil), "secret.compute.v1beta1.CodeInfo")
+	proto.RegisterType((*ContractKey)(nil), "secret.compute.v1beta1.ContractKey")
+	proto.RegisterType((*ContractCustomInfo)(nil), "secret.compute.v1beta1.ContractCustomInfo")
+	proto.RegisterType((*ContractInfo)(nil), "secret.compute.v1beta1.ContractInfo")
+	proto.RegisterType((*AbsoluteTxPosition)(nil), "secret.compute.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "secret.compute.v1beta1.Model")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "secret.compute.v1beta1.ContractCodeHistoryEntry")
+	proto.RegisterType((*ContractGasUsage)(nil), "secret.compute.v1beta1.ContractGasUsage")
 }
 
 func init() {
@@ -437,83 +1054,174 @@ func init() {
 }
 
 var fileDescriptor_8ba7f40a6d1951b3 = []byte{
-	// 1053 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0x1a, 0x47,
-	0x1f, 0x67, 0x0d, 0x06, 0x33, 0x90, 0x04, 0xcd, 0xe3, 0x27, 0x21, 0x54, 0x02, 0xba, 0xa9, 0x52,
-	0xd7, 0xae, 0x21, 0x4e, 0x7b, 0x88, 0xdc, 0x13, 0x2f, 0x1b, 0x7b, 0xe3, 0x7a, 0x41, 0x03, 0x76,
-	0xe5, 0xaa, 0xd5, 0x6a, 0x5f, 0xc6, 0xb0, 0xf2, 0xb2, 0x83, 0x66, 0x06, 0x97, 0xbd, 0xf5, 0x58,
-	0x71, 0xea, 0xb1, 0x17, 0xa4, 0x4a, 0x8d, 0xa2, 0x7c, 0x81, 0x7e, 0x81, 0x9e, 0x72, 0xcc, 0xb1,
-	0x27, 0xd4, 0xe2, 0x0f, 0x50, 0xa9, 0xc7, 0x9c, 0xaa, 0x1d, 0x16, 0x43, 0x9b, 0x58, 0x76, 0xa5,
-	0x9e, 0xfc, 0x7f, 0xfd, 0xfd, 0xdf, 0x7e, 0x3b, 0x06, 0xc8, 0x0c, 0x5b, 0x14, 0xf3, 0xb2, 0x45,
-	0x7a, 0xfd, 0x01, 0xc7, 0xe5, 0xf3, 0x1d, 0x13, 0x73, 0x63, 0xa7, 0xcc, 0xfd, 0x3e, 0x66, 0xa5,
-	0x3e, 0x25, 0x9c, 0xc0, 0xbb, 0xb3, 0x98, 0x52, 0x18, 0x53, 0x0a, 0x63, 0x72, 0xeb, 0x1d, 0xd2,
-	0x21, 0x22, 0xa4, 0x1c, 0x48, 0xb3, 0x68, 0xd9, 0x02, 0x77, 0x2a, 0x96, 0x85, 0x19, 0x6b, 0xfb,
-	0x7d, 0xdc, 0x34, 0xa8, 0xd1, 0x83, 0xcf, 0xc0, 0xea, 0xb9, 0xe1, 0x0e, 0x70, 0x56, 0x2a, 0x4a,
-	0x1b, 0xb7, 0x1f, 0xcb, 0xa5, 0x77, 0x03, 0x96, 0x16, 0x79, 0xd5, 0xcc, 0x9f, 0x93, 0x42, 0xda,
-	0x37, 0x7a, 0xee, 0xae, 0x2c, 0x52, 0x65, 0x34, 0x83, 0xd8, 0x8d, 0xfd, 0xf0, 0x63, 0x41, 0x92,
-	0x5f, 0x48, 0x60, 0xad, 0x46, 0x6c, 0xac, 0x7a, 0xa7, 0x04, 0xbe, 0x07, 0x92, 0x16, 0xb1, 0xb1,
-	0xde, 0x35, 0x58, 0x57, 0x94, 0x48, 0xa3, 0xb5, 0xc0, 0xb0, 0x6f, 0xb0, 0x2e, 0x3c, 0x00, 0x09,
-	0x8b, 0x62, 0x83, 0x13, 0x9a, 0x5d, 0x09, 0x5c, 0xd5, 0x9d, 0x37, 0x93, 0xc2, 0x76, 0xc7, 0xe1,
-	0xdd, 0x81, 0x19, 0x34, 0x50, 0xb6, 0x08, 0xeb, 0x11, 0x16, 0xfe, 0xd9, 0x66, 0xf6, 0x59, 0x38,
-	0x7b, 0xc5, 0xb2, 0x2a, 0xb6, 0x4d, 0x31, 0x63, 0x68, 0x8e, 0x00, 0xef, 0x82, 0x38, 0x23, 0x03,
-	0x6a, 0xe1, 0x6c, 0xb4, 0x28, 0x6d, 0x24, 0x51, 0xa8, 0xc1, 0x2c, 0x48, 0x98, 0x03, 0xc7, 0xb5,
-	0x31, 0xcd, 0xc6, 0x84, 0x63, 0xae, 0xca, 0xcf, 0x25, 0x90, 0xaa, 0x11, 0x8f, 0x53, 0xc3, 0xe2,
-	0x07, 0xd8, 0x87, 0x0f, 0xc1, 0x1d, 0xd2, 0xd1, 0xad, 0xd0, 0xa2, 0x9f, 0x61, 0x3f, 0xec, 0xf8,
-	0x16, 0xe9, 0x2c, 0xc7, 0x3d, 0x02, 0xeb, 0xd6, 0x80, 0x52, 0xec, 0xf1, 0xbf, 0x07, 0x8b, 0x19,
-	0x10, 0x0c, 0x7d, 0xcb, 0x19, 0x9f, 0x81, 0xdc, 0xbb, 0x32, 0xf4, 0x3e, 0x25, 0xe4, 0x54, 0xf4,
-	0x9b, 0x46, 0xf7, 0xde, 0xce, 0x6b, 0x06, 0x6e, 0xf9, 0x5b, 0x09, 0xc0, 0xb9, 0xb1, 0x36, 0x60,
-	0x9c, 0xf4, 0xc4, 0x66, 0xdb, 0x20, 0x85, 0x3d, 0xcb, 0x35, 0xce, 0xf1, 0x65, 0xa7, 0xa9, 0xc7,
-	0x0f, 0xae, 0x3a, 0xdf, 0x12, 0x6a, 0xf5, 0xf6, 0x74, 0x52, 0x00, 0xca, 0x2c, 0xf7, 0x00, 0xfb,
-	0x08, 0xe0, 0x4b, 0x19, 0xae, 0x83, 0x55, 0xd7, 0x30, 0xb1, 0x2b, 0x86, 0x49, 0xa2, 0x99, 0x22,
-	0xff, 0xb2, 0x02, 0xd2, 0x73, 0x04, 0x51, 0xfc, 0x01, 0x48, 0x88, 0xb3, 0x3a, 0xb6, 0x28, 0x1c,
-	0xab, 0x82, 0xe9, 0xa4, 0x10, 0x17, 0x57, 0xaf, 0xa3, 0x78, 0xe0, 0x52, 0xed, 0xff, 0xf6, 0xbc,
-	0x97, 0x8d, 0xc5, 0x96, 0x1a, 0x83, 0xf5, 0xb0, 0x04, 0xb6, 0xb3, 0xab, 0x62, 0x01, 0x9b, 0x57,
-	0xf2, 0xd7, 0x64, 0xc4, 0x1d, 0x70, 0xdc, 0x1e, 0x36, 0x09, 0x73, 0xb8, 0x43, 0x3c, 0x34, 0x4f,
-	0x85, 0xdb, 0x20, 0xe5, 0x98, 0x96, 0xde, 0x27, 0x94, 0x07, 0x13, 0xc5, 0x83, 0x0a, 0xd5, 0x5b,
-	0xd3, 0x49, 0x21, 0xa9, 0x56, 0x6b, 0x4d, 0x42, 0xb9, 0x5a, 0x47, 0x49, 0xc7, 0xb4, 0x84, 0x68,
-	0x07, 0xad, 0x18, 0x76, 0xcf, 0xf1, 0xb2, 0x89, 0x59, 0x2b, 0x42, 0x81, 0x05, 0x90, 0x12, 0x42,
-	0x78, 0xd4, 0x35, 0x71, 0x54, 0x20, 0x4c, 0xb3, 0x3b, 0x22, 0x00, 0xdf, 0x6e, 0x02, 0xbe, 0x0f,
-	0xd2, 0xa6, 0x4b, 0xac, 0x33, 0xbd, 0x8b, 0x9d, 0x4e, 0x97, 0x8b, 0x75, 0x46, 0x51, 0x4a, 0xd8,
-	0xf6, 0x85, 0x09, 0xde, 0x07, 0x6b, 0x7c, 0xa8, 0x3b, 0x9e, 0x8d, 0x87, 0x62, 0x91, 0x31, 0x94,
-	0xe0, 0x43, 0x35, 0x50, 0x65, 0x07, 0xac, 0x1e, 0x12, 0x1b, 0xbb, 0xf0, 0x19, 0x88, 0x1e, 0xcc,
-	0xf9, 0x5a, 0x7d, 0xf2, 0x66, 0x52, 0xf8, 0x74, 0x69, 0xcf, 0x1c, 0x7b, 0x36, 0xa6, 0x3d, 0xc7,
-	0xe3, 0xcb, 0xa2, 0xeb, 0x98, 0xac, 0x6c, 0xfa, 0x1c, 0xb3, 0xd2, 0x3e, 0x1e, 0x56, 0x03, 0x01,
-	0x45, 0x43, 0x0e, 0x1c, 0x8b, 0x27, 0x61, 0x46, 0xe8, 0x99, 0x22, 0xff, 0x21, 0x81, 0xec, 0x25,
-	0x0d, 0x83, 0x2f, 0xd8, 0x61, 0x9c, 0x50, 0x5f, 0xf1, 0x38, 0xf5, 0xe1, 0x31, 0x48, 0x92, 0x3e,
-	0xa6, 0x46, 0x30, 0x52, 0xf8, 0x92, 0x3c, 0xb9, 0x8e, 0x8a, 0x4b, 0x20, 0x8d, 0x79, 0x6e, 0xf0,
-	0xbe, 0xa0, 0x05, 0xd4, 0x32, 0xcf, 0x56, 0xae, 0xe4, 0x59, 0x1d, 0x24, 0x06, 0x7d, 0x5b, 0x90,
-	0x20, 0xfa, 0xef, 0x49, 0x10, 0xa6, 0xc2, 0x0c, 0x88, 0xf6, 0x58, 0x47, 0xd0, 0x2b, 0x8d, 0x02,
-	0x71, 0xf3, 0x67, 0x09, 0x80, 0xc5, 0xb3, 0x07, 0x1f, 0x82, 0xe4, 0x91, 0x56, 0x57, 0x9e, 0xaa,
-	0x9a, 0x52, 0xcf, 0x44, 0x72, 0xf7, 0x46, 0xe3, 0xe2, 0xff, 0x16, 0xee, 0x23, 0xcf, 0xc6, 0xa7,
-	0x8e, 0x87, 0x6d, 0x58, 0x04, 0x71, 0xad, 0x51, 0x6d, 0xd4, 0x4f, 0x32, 0x52, 0x6e, 0x7d, 0x34,
-	0x2e, 0x66, 0x16, 0x41, 0x1a, 0x31, 0x89, 0xed, 0xc3, 0x2d, 0x90, 0x6e, 0x68, 0x9f, 0x9f, 0xe8,
-	0x95, 0x7a, 0x1d, 0x29, 0xad, 0x56, 0x66, 0x25, 0x77, 0x7f, 0x34, 0x2e, 0xfe, 0x7f, 0x11, 0xd7,
-	0xf0, 0x5c, 0x3f, 0xfc, 0x02, 0x82, 0xb2, 0xca, 0xb1, 0x82, 0x4e, 0x04, 0x62, 0xf4, 0x9f, 0x65,
-	0x95, 0x73, 0x4c, 0xfd, 0x00, 0x34, 0xb7, 0xf6, 0xdd, 0x4f, 0xf9, 0xc8, 0xcb, 0xe7, 0xf9, 0xc8,
-	0xe6, 0x8b, 0x28, 0x28, 0x5e, 0xb7, 0x64, 0x88, 0xc1, 0xa3, 0x5a, 0x43, 0x6b, 0xa3, 0x4a, 0xad,
-	0xad, 0xd7, 0x1a, 0x75, 0x45, 0xdf, 0x57, 0x5b, 0xed, 0x06, 0x3a, 0xd1, 0x1b, 0x4d, 0x05, 0x55,
-	0xda, 0x6a, 0x43, 0xd3, 0xdb, 0x27, 0x4d, 0x45, 0x3f, 0xd2, 0x5a, 0x4d, 0xa5, 0xa6, 0x3e, 0x55,
-	0xc5, 0xd0, 0xe5, 0xd1, 0xb8, 0xb8, 0x75, 0x1d, 0xf6, 0x91, 0xc7, 0xfa, 0xd8, 0x72, 0x4e, 0x1d,
-	0x6c, 0xc3, 0x2f, 0xc0, 0x47, 0x37, 0x2a, 0xa3, 0x6a, 0x6a, 0x3b, 0x23, 0xe5, 0x36, 0x46, 0xe3,
-	0xe2, 0x07, 0xd7, 0xe1, 0xab, 0x9e, 0xc3, 0xe1, 0xd7, 0xe0, 0xe3, 0x1b, 0x01, 0x1f, 0xaa, 0x7b,
-	0xa8, 0xd2, 0x56, 0x32, 0x2b, 0xb9, 0xad, 0xd1, 0xb8, 0xf8, 0xe1, 0x75, 0xd8, 0x87, 0x4e, 0x87,
-	0x1a, 0x1c, 0xdf, 0x18, 0x7e, 0x4f, 0xd1, 0x94, 0x96, 0xda, 0xca, 0x44, 0x6f, 0x06, 0xbf, 0x87,
-	0x3d, 0xcc, 0x1c, 0x96, 0x8b, 0x05, 0xc7, 0xaa, 0x7e, 0xf5, 0xea, 0xf7, 0x7c, 0xe4, 0xe5, 0x34,
-	0x2f, 0xbd, 0x9a, 0xe6, 0xa5, 0xd7, 0xd3, 0xbc, 0xf4, 0xdb, 0x34, 0x2f, 0x7d, 0x7f, 0x91, 0x8f,
-	0xbc, 0xbe, 0xc8, 0x47, 0x7e, 0xbd, 0xc8, 0x47, 0xbe, 0xdc, 0x5d, 0xfa, 0x8a, 0x99, 0x45, 0xb9,
-	0x6b, 0x98, 0xac, 0xdc, 0x12, 0xe4, 0xd6, 0x30, 0xff, 0x86, 0xd0, 0xb3, 0xf2, 0xf0, 0xf2, 0xf7,
-	0x81, 0xe3, 0x71, 0x4c, 0x3d, 0xc3, 0x9d, 0xbd, 0xa2, 0x66, 0x5c, 0xfc, 0xcf, 0xff, 0xe4, 0xaf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x45, 0x65, 0xf6, 0x47, 0x08, 0x00, 0x00,
+	// 2499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x37, 0x2d, 0xd9, 0x96, 0xc6, 0x5f, 0xf2, 0xd8, 0xf1, 0x72, 0x95, 0x46, 0x54, 0x98, 0x66,
+	0xeb, 0x64, 0x13, 0x6b, 0xd7, 0x2d, 0xd0, 0x34, 0x45, 0x0f, 0xd6, 0xc7, 0x7a, 0x95, 0x5d, 0xcb,
+	0xca, 0xc8, 0xde, 0x74, 0x83, 0x16, 0x04, 0x45, 0x8e, 0xe9, 0xa9, 0x49, 0x8e, 0xc2, 0xa1, 0xbc,
+	0x52, 0x4e, 0xbd, 0xb5, 0xf0, 0x29, 0xb7, 0xf6, 0x62, 0xa0, 0x40, 0x83, 0x20, 0xe8, 0xbd, 0xff,
+	0x40, 0x4f, 0x39, 0xe6, 0xd0, 0x43, 0xd1, 0x83, 0xd2, 0x3a, 0x87, 0xde, 0x05, 0x14, 0x28, 0x72,
+	0x2a, 0x66, 0x86, 0x94, 0x28, 0xaf, 0xdc, 0x75, 0xd0, 0x9c, 0x34, 0xef, 0xcd, 0x6f, 0x7e, 0xf3,
+	0x66, 0xde, 0xc7, 0x3c, 0x0a, 0xe8, 0x0c, 0x5b, 0x01, 0x0e, 0x4b, 0x16, 0xf5, 0x3a, 0xdd, 0x10,
+	0x97, 0xce, 0xee, 0xb7, 0x71, 0x68, 0xde, 0x2f, 0x85, 0xfd, 0x0e, 0x66, 0xdb, 0x9d, 0x80, 0x86,
+	0x14, 0x6e, 0x4a, 0xcc, 0x76, 0x84, 0xd9, 0x8e, 0x30, 0xf9, 0x0d, 0x87, 0x3a, 0x54, 0x40, 0x4a,
+	0x7c, 0x24, 0xd1, 0xf9, 0x82, 0x45, 0x99, 0x47, 0x59, 0xa9, 0x6d, 0xb2, 0x31, 0x9d, 0x45, 0x89,
+	0x1f, 0xcd, 0x6b, 0x0e, 0xa5, 0x8e, 0x8b, 0x4b, 0x42, 0x6a, 0x77, 0x8f, 0x4b, 0x21, 0xf1, 0x30,
+	0x0b, 0x4d, 0xaf, 0x23, 0x01, 0xfa, 0x5f, 0x97, 0xc0, 0x7c, 0xd3, 0x0c, 0x4c, 0x8f, 0xc1, 0x0f,
+	0xc0, 0xa6, 0xe9, 0xba, 0xf4, 0x19, 0xb6, 0x0d, 0x1b, 0x77, 0x28, 0x23, 0xa1, 0x61, 0x63, 0x9f,
+	0x7a, 0x4c, 0x55, 0x8a, 0xa9, 0xad, 0x6c, 0xf9, 0xd5, 0xe1, 0x40, 0x7b, 0xa5, 0x6f, 0x7a, 0xee,
+	0xbb, 0xfa, 0x74, 0x9c, 0x8e, 0x36, 0xa2, 0x89, 0xaa, 0xd4, 0x57, 0x85, 0x1a, 0xfa, 0x60, 0x95,
+	0xf9, 0xa4, 0xb3, 0x73, 0xcf, 0x78, 0x16, 0x98, 0x9d, 0x0e, 0x0e, 0x98, 0x3a, 0x5b, 0x4c, 0x6d,
+	0x2d, 0xee, 0xbc, 0xbe, 0x3d, 0xfd, 0xb0, 0xdb, 0x2d, 0x01, 0xff, 0x40, 0xa2, 0xcb, 0x85, 0x2f,
+	0x06, 0xda, 0xcc, 0x70, 0xa0, 0x6d, 0xca, 0xcd, 0xaf, 0x70, 0xe9, 0x68, 0x85, 0x25, 0xe1, 0x0c,
+	0x7e, 0x08, 0xc0, 0x29, 0xee, 0x1b, 0xb8, 0x43, 0xad, 0x13, 0xa6, 0xa6, 0xc4, 0x56, 0xc5, 0xeb,
+	0xb6, 0x7a, 0x84, 0xfb, 0x35, 0x0e, 0x2c, 0xdf, 0x8e, 0x76, 0x59, 0x93, 0xbb, 0x8c, 0x19, 0x74,
+	0x94, 0x3d, 0x8d, 0x40, 0x0c, 0xbe, 0x07, 0xa0, 0x67, 0xf6, 0x0c, 0x2b, 0xa0, 0xbe, 0xe1, 0x98,
+	0xcc, 0x70, 0x89, 0x47, 0x42, 0x35, 0x5d, 0x54, 0xb6, 0xd2, 0xe5, 0x57, 0x86, 0x03, 0xed, 0xb6,
+	0x5c, 0xfd, 0x3c, 0x46, 0x47, 0xab, 0x9e, 0xd9, 0xab, 0x04, 0xd4, 0xdf, 0x33, 0xd9, 0x63, 0xae,
+	0x81, 0xfb, 0x60, 0x3d, 0xc6, 0x31, 0xa3, 0x83, 0x03, 0xa3, 0xed, 0x52, 0xeb, 0x54, 0x9d, 0x2b,
+	0x2a, 0x5b, 0xcb, 0xe5, 0xc2, 0x70, 0xa0, 0xe5, 0x27, 0xc9, 0x12, 0x20, 0x1d, 0xe5, 0x22, 0x36,
+	0xd6, 0xc4, 0x41, 0x99, 0xab, 0xe0, 0x13, 0xb0, 0x39, 0x89, 0xb4, 0xa8, 0x1f, 0x06, 0xa6, 0x15,
+	0xaa, 0xf3, 0x82, 0x31, 0xe1, 0xbf, 0xe9, 0x38, 0x1d, 0xad, 0x27, 0x48, 0x2b, 0x91, 0x16, 0xfe,
+	0x46, 0x01, 0x9b, 0x1f, 0x75, 0x71, 0xd0, 0x37, 0x3a, 0x6e, 0xd7, 0x21, 0xf2, 0x4c, 0x16, 0x65,
+	0x21, 0x53, 0x17, 0x8a, 0xca, 0xd6, 0xe2, 0xce, 0xdd, 0xeb, 0xee, 0xf6, 0x7d, 0xbe, 0xaa, 0x29,
+	0x16, 0xed, 0x99, 0xac, 0xc2, 0x97, 0x94, 0x5f, 0x8f, 0xae, 0x39, 0xb2, 0x64, 0x3a, 0xb1, 0x8e,
+	0xd6, 0x3f, 0x7a, 0x7e, 0x2d, 0xac, 0x01, 0x7e, 0x6a, 0xc3, 0x35, 0xdb, 0xd8, 0x35, 0x5c, 0xec,
+	0x3b, 0xe1, 0x89, 0x9a, 0x11, 0x67, 0x7b, 0x79, 0x38, 0xd0, 0x6e, 0x8d, 0xcf, 0x96, 0x44, 0xe8,
+	0x68, 0xc5, 0x33, 0x7b, 0x8f, 0xb9, 0xe6, 0xb1, 0x50, 0xc0, 0x9f, 0x81, 0x65, 0x09, 0xb0, 0x4e,
+	0xcc, 0x80, 0xe1, 0x50, 0xcd, 0x16, 0x95, 0xad, 0x6c, 0x59, 0x1d, 0x0e, 0xb4, 0x0d, 0xc9, 0x31,
+	0x31, 0xad, 0xa3, 0x25, 0x21, 0x57, 0xa4, 0x18, 0x5b, 0xe1, 0x61, 0x8f, 0x72, 0xd3, 0x4d, 0x07,
+	0x33, 0x15, 0x4c, 0xb3, 0x22, 0x89, 0x90, 0x56, 0xec, 0x0b, 0x4d, 0x93, 0x2b, 0xe0, 0x23, 0x19,
+	0x49, 0x36, 0x61, 0x1d, 0x33, 0xb4, 0x4e, 0x78, 0x2e, 0x85, 0x27, 0xea, 0xa2, 0x20, 0xba, 0x12,
+	0x49, 0x93, 0x18, 0xe9, 0xfb, 0x6a, 0xa4, 0xab, 0x72, 0x15, 0x6c, 0x80, 0xf5, 0x24, 0x10, 0xdb,
+	0x86, 0xc7, 0x1c, 0xa6, 0x2e, 0x4d, 0x0b, 0xa5, 0x2b, 0x20, 0x1d, 0xad, 0x25, 0xe8, 0xb0, 0xbd,
+	0xcf, 0x1c, 0x71, 0xd3, 0x36, 0xf6, 0x89, 0x84, 0x18, 0xa2, 0x3e, 0xa9, 0xcb, 0xa2, 0x0a, 0x24,
+	0xce, 0x78, 0x15, 0xa1, 0xa3, 0x15, 0xa9, 0xda, 0x67, 0xce, 0x21, 0x57, 0xc0, 0x87, 0x60, 0xcd,
+	0xc6, 0x7e, 0xdf, 0x60, 0xa1, 0x79, 0x4a, 0x7c, 0x47, 0x1a, 0xb5, 0x52, 0x54, 0xb6, 0x32, 0xe5,
+	0xef, 0x0d, 0x07, 0x9a, 0x3a, 0xe2, 0x99, 0x84, 0xe8, 0x68, 0x95, 0xeb, 0x5a, 0x52, 0x25, 0x0c,
+	0x2a, 0x81, 0x8c, 0xd9, 0xb5, 0x49, 0x48, 0x03, 0xa6, 0xae, 0x0a, 0x43, 0xd6, 0x87, 0x03, 0x6d,
+	0x35, 0x2a, 0x47, 0xd1, 0x8c, 0x8e, 0x46, 0x20, 0xf8, 0x13, 0xb0, 0x24, 0x7c, 0xc0, 0x1c, 0x83,
+	0x91, 0x8f, 0xb1, 0x9a, 0x13, 0x57, 0x71, 0x6b, 0x38, 0xd0, 0xd6, 0x13, 0x1e, 0x8a, 0x66, 0x75,
+	0x04, 0xb8, 0x77, 0x98, 0xd3, 0x22, 0x1f, 0x63, 0x78, 0xae, 0x00, 0x95, 0xf1, 0xab, 0xe8, 0xba,
+	0xd8, 0x36, 0x2c, 0xd3, 0x75, 0x45, 0x64, 0x76, 0x02, 0x62, 0x61, 0x75, 0x4d, 0xc4, 0xca, 0xfb,
+	0x3c, 0x8a, 0xff, 0x3e, 0xd0, 0xee, 0x38, 0x24, 0x3c, 0xe9, 0xb6, 0x79, 0xe4, 0x97, 0xa2, 0x52,
+	0x2c, 0x7f, 0xde, 0x66, 0xf6, 0x69, 0x54, 0xd7, 0xab, 0xd8, 0x1a, 0x0e, 0x34, 0x4d, 0xee, 0x7a,
+	0x1d, 0xaf, 0x8e, 0x5e, 0x1a, 0x4d, 0x55, 0x4c, 0xd7, 0xdd, 0x33, 0x59, 0x93, 0xeb, 0xa1, 0x0d,
+	0x5e, 0xe6, 0x96, 0x8e, 0xd7, 0x39, 0x66, 0xa2, 0x0e, 0xa8, 0x50, 0x54, 0x9e, 0x3b, 0xc3, 0x81,
+	0xa6, 0x8f, 0x8f, 0x75, 0x0d, 0x58, 0x47, 0xb7, 0x3c, 0xb3, 0xd7, 0x8a, 0x27, 0xf7, 0xcc, 0x71,
+	0xed, 0x68, 0x80, 0xf5, 0xe3, 0xae, 0x6f, 0x1b, 0x01, 0xb6, 0xe8, 0x19, 0xcf, 0x48, 0x1b, 0xbb,
+	0x66, 0x5f, 0x5d, 0x2f, 0x2a, 0x5b, 0xa9, 0x64, 0xfc, 0x4c, 0x01, 0xe9, 0x68, 0x8d, 0x6b, 0x51,
+	0xa4, 0xac, 0x0a, 0xdd, 0x7f, 0x14, 0xb0, 0x3e, 0x25, 0xfb, 0x21, 0x04, 0xe9, 0xb6, 0xe9, 0x9f,
+	0xaa, 0x0a, 0x37, 0x1b, 0x89, 0x31, 0xdc, 0x04, 0xf3, 0x56, 0x97, 0x85, 0xd4, 0x53, 0x67, 0x85,
+	0x36, 0x92, 0xa0, 0x0a, 0x16, 0xa2, 0xa0, 0x50, 0x53, 0x62, 0x22, 0x16, 0x39, 0xcb, 0x33, 0x93,
+	0x79, 0xb2, 0xec, 0x22, 0x31, 0xe6, 0x3a, 0x9b, 0xb0, 0x50, 0x54, 0xcf, 0x34, 0x12, 0x63, 0xae,
+	0xf3, 0x88, 0x2f, 0xeb, 0x5f, 0x1a, 0x89, 0x31, 0xcc, 0x81, 0x94, 0x43, 0xcf, 0x44, 0xe5, 0x4a,
+	0x23, 0x3e, 0x84, 0xb7, 0x41, 0x8a, 0xb4, 0x2d, 0x51, 0x48, 0xd2, 0xe5, 0x85, 0xcb, 0x81, 0x96,
+	0xaa, 0x97, 0x2b, 0x88, 0xeb, 0x60, 0x1e, 0x64, 0x58, 0x68, 0x06, 0x8e, 0x19, 0x62, 0x51, 0x24,
+	0xd2, 0x68, 0x24, 0x73, 0xb3, 0x69, 0x60, 0x5a, 0x2e, 0x16, 0xc9, 0x9f, 0x46, 0x91, 0xa4, 0x37,
+	0xc1, 0xf2, 0xc4, 0xf3, 0x05, 0x37, 0xc0, 0x9c, 0x78, 0x1f, 0xc5, 0xa1, 0xb3, 0x48, 0x0a, 0xf0,
+	0x0d, 0x90, 0x8b, 0xeb, 0xae, 0x61, 0xda, 0x76, 0x80, 0x19, 0x13, 0xe7, 0xcf, 0xa2, 0xd5, 0x58,
+	0xbf, 0x2b, 0xd5, 0x7a, 0x07, 0x64, 0xe2, 0x57, 0x8a, 0x93, 0x89, 0x57, 0x49, 0x90, 0x2d, 0x23,
+	0x29, 0xc0, 0x57, 0xc1, 0x12, 0xb7, 0x2b, 0x34, 0x4e, 0x30, 0x71, 0x4e, 0x42, 0x41, 0x94, 0x42,
+	0x8b, 0x42, 0xf7, 0x50, 0xa8, 0xe0, 0x5d, 0xb0, 0x16, 0x06, 0xa6, 0xcf, 0x48, 0x48, 0xa8, 0x2f,
+	0xe3, 0x81, 0x89, 0x7b, 0x4d, 0xa1, 0xdc, 0x78, 0x42, 0x44, 0x03, 0xd3, 0xbf, 0x9c, 0x05, 0xcb,
+	0xad, 0x64, 0x38, 0xc2, 0x4d, 0x30, 0x4b, 0x6c, 0xe9, 0xb6, 0xf2, 0xfc, 0xe5, 0x40, 0x9b, 0xad,
+	0x57, 0xd1, 0x2c, 0xb1, 0xf9, 0x2d, 0x30, 0xec, 0xdb, 0x38, 0x88, 0x8c, 0x8f, 0x24, 0x7e, 0x73,
+	0xa3, 0xe7, 0x27, 0x25, 0x66, 0x46, 0x32, 0x77, 0x81, 0xc7, 0x1c, 0xe1, 0xbd, 0x25, 0xc4, 0x87,
+	0xf0, 0x57, 0x00, 0x30, 0xec, 0x87, 0x06, 0x0f, 0x24, 0xa6, 0xce, 0x89, 0x17, 0xfb, 0xf6, 0xb6,
+	0xcc, 0xa4, 0x6d, 0xde, 0xdb, 0x8c, 0x9e, 0x94, 0x0a, 0x25, 0x7e, 0xf9, 0x1e, 0xcf, 0xbe, 0x3f,
+	0x7d, 0xa5, 0x6d, 0xdd, 0x20, 0xfb, 0xf8, 0x02, 0x86, 0xb2, 0x9c, 0xfe, 0x01, 0x67, 0x87, 0xaf,
+	0x83, 0x15, 0xdc, 0xc3, 0x56, 0x37, 0xc4, 0xf1, 0x6d, 0xcd, 0x8b, 0x5b, 0x58, 0x8e, 0xb4, 0xd1,
+	0x7d, 0xbd, 0x0c, 0xb2, 0xe3, 0xf7, 0x5d, 0x46, 0x4b, 0xc6, 0x89, 0x5f, 0xee, 0xfb, 0x20, 0x75,
+	0x8c, 0xb1, 0x08, 0x99, 0xff, 0x69, 0x68, 0x9a, 0x1b, 0x8a, 0x38, 0x56, 0xef, 0x83, 0xb5, 0xf8,
+	0x45, 0x7d, 0x80, 0x71, 0x93, 0xba, 0xc4, 0xea, 0x43, 0x1b, 0x2c, 0x78, 0xc4, 0x37, 0x38, 0x97,
+	0xf2, 0xdd, 0x1f, 0x7a, 0xde, 0x23, 0xfe, 0x03, 0x8c, 0x75, 0x06, 0x40, 0x85, 0xda, 0x98, 0x3b,
+	0xd4, 0x33, 0x85, 0xc7, 0xc4, 0x48, 0x78, 0x73, 0x09, 0x45, 0x12, 0xd4, 0xc0, 0xa2, 0x1c, 0x19,
+	0x27, 0x26, 0x3b, 0x11, 0xee, 0x5c, 0x42, 0x40, 0xaa, 0x1e, 0x9a, 0xec, 0x04, 0xbe, 0x05, 0x22,
+	0xc9, 0xe8, 0x06, 0x44, 0x3a, 0xb5, 0xbc, 0x7c, 0x39, 0xd0, 0xb2, 0x92, 0xf8, 0x08, 0xd5, 0x51,
+	0x56, 0x02, 0x8e, 0x02, 0xa2, 0xff, 0x4b, 0x01, 0x59, 0xbe, 0xeb, 0x2e, 0x2f, 0xc8, 0x3c, 0x97,
+	0xa3, 0xca, 0x1c, 0x65, 0x41, 0x2c, 0xf2, 0x6d, 0x03, 0xdc, 0xa1, 0x41, 0x38, 0xb1, 0xad, 0x54,
+	0xc5, 0xdb, 0x46, 0x80, 0x2b, 0xdb, 0x22, 0xa1, 0x15, 0xdb, 0x4a, 0xc0, 0x51, 0x40, 0x60, 0x05,
+	0x00, 0xc1, 0x8c, 0x6d, 0xc3, 0x94, 0x7d, 0xd9, 0xe2, 0x4e, 0x7e, 0x5b, 0x76, 0xc1, 0xdb, 0x71,
+	0x17, 0xbc, 0x7d, 0x18, 0x77, 0xc1, 0xe5, 0x0c, 0xbf, 0xd5, 0x4f, 0xbe, 0xd2, 0x14, 0x94, 0x8d,
+	0xd6, 0xed, 0x86, 0x3c, 0xc9, 0x98, 0x45, 0x3b, 0x58, 0x14, 0x93, 0x2c, 0x92, 0x02, 0xbf, 0xb8,
+	0x89, 0x80, 0x89, 0x24, 0xfd, 0x13, 0x05, 0xe4, 0xf8, 0x49, 0x9f, 0xe0, 0x80, 0x1c, 0x13, 0xcb,
+	0xe4, 0x79, 0xc4, 0xe3, 0xff, 0x4c, 0xc8, 0x38, 0x3e, 0xf1, 0x48, 0x16, 0x1e, 0xa0, 0xdd, 0xc0,
+	0xc2, 0xa3, 0x9c, 0x11, 0x12, 0xd7, 0x5b, 0xd4, 0xe3, 0xf1, 0x26, 0x33, 0x26, 0x92, 0xf8, 0xe5,
+	0xb5, 0xbb, 0xc4, 0xe5, 0x49, 0x96, 0x96, 0x97, 0x17, 0x89, 0x09, 0x93, 0xe6, 0x26, 0x4c, 0xfa,
+	0xb7, 0x02, 0xd2, 0xbc, 0x8f, 0xbb, 0x36, 0x6d, 0x93, 0xe9, 0x39, 0x3b, 0x3d, 0x3d, 0x53, 0xe3,
+	0xf4, 0xcc, 0x83, 0x0c, 0xf1, 0x43, 0x1c, 0x9c, 0x99, 0xae, 0xb0, 0x20, 0x85, 0x46, 0xf2, 0x64,
+	0x9e, 0xcc, 0x5d, 0xc9, 0x13, 0x0d, 0x2c, 0xfa, 0xb8, 0x17, 0x4e, 0x26, 0x1a, 0xe0, 0xaa, 0x28,
+	0xcb, 0x36, 0xc0, 0x1c, 0x7d, 0xe6, 0xe3, 0x40, 0x64, 0x58, 0x16, 0x49, 0x01, 0xfe, 0x18, 0xcc,
+	0xb7, 0xbb, 0xb6, 0x83, 0xc3, 0x9b, 0x66, 0x58, 0x04, 0xd7, 0x2d, 0xb0, 0xba, 0x6b, 0x59, 0x98,
+	0x31, 0xde, 0x7e, 0x88, 0xcf, 0x1a, 0xf8, 0x1e, 0x98, 0x3b, 0x33, 0xdd, 0x2e, 0x16, 0x97, 0xb0,
+	0xb2, 0xa3, 0x5f, 0xd7, 0xab, 0x8e, 0xd7, 0x95, 0x73, 0xc3, 0x81, 0xb6, 0x24, 0xdf, 0x3b, 0xb1,
+	0x54, 0x47, 0x92, 0xe2, 0xdd, 0xf4, 0xef, 0xff, 0xa0, 0x29, 0xfa, 0xef, 0x14, 0xb0, 0x24, 0xd1,
+	0x15, 0xea, 0x1f, 0x13, 0x07, 0x3e, 0x05, 0xa0, 0x83, 0x03, 0x8f, 0x30, 0x46, 0xa8, 0xff, 0x2d,
+	0xf6, 0x79, 0x69, 0xfc, 0xb5, 0x31, 0x5e, 0xaf, 0xa3, 0x04, 0x19, 0x7c, 0x0b, 0x2c, 0x4c, 0x3c,
+	0x0e, 0x65, 0x38, 0x1c, 0x68, 0x2b, 0x72, 0x4d, 0x34, 0xa1, 0xa3, 0x18, 0xa2, 0x7f, 0xa6, 0x80,
+	0x0c, 0x8f, 0xc4, 0xba, 0x7f, 0x4c, 0xb9, 0x63, 0x2c, 0x6a, 0x63, 0x99, 0x56, 0x32, 0xd5, 0x33,
+	0x5c, 0x21, 0x92, 0xea, 0x11, 0x58, 0xb0, 0x02, 0x6c, 0xf2, 0x7c, 0x14, 0x19, 0x57, 0xbe, 0xff,
+	0xcd, 0x40, 0x7b, 0xfb, 0x06, 0x95, 0x65, 0xd7, 0xb2, 0xa2, 0x67, 0x09, 0xc5, 0x0c, 0x89, 0x78,
+	0x4e, 0x4d, 0xc4, 0xf3, 0xb5, 0x71, 0xab, 0x7f, 0xaa, 0x80, 0xc5, 0xb8, 0x1a, 0x3e, 0xc2, 0x7d,
+	0x78, 0x07, 0xac, 0x52, 0x67, 0xf4, 0x1d, 0x62, 0x9c, 0xe2, 0x7e, 0x64, 0xf1, 0x32, 0x75, 0x92,
+	0xb8, 0x7b, 0x60, 0xc3, 0xea, 0x06, 0x01, 0x7f, 0x2a, 0x26, 0xc0, 0xb2, 0x6a, 0xc0, 0x68, 0x2e,
+	0xb9, 0xe2, 0xa7, 0x20, 0x3f, 0x6d, 0x85, 0xd1, 0x09, 0x28, 0x3d, 0x8e, 0x62, 0xfc, 0xd6, 0xf3,
+	0xeb, 0x9a, 0x7c, 0x5a, 0xff, 0xb5, 0x02, 0x60, 0xac, 0xac, 0x88, 0xa6, 0x44, 0xdc, 0xec, 0x21,
+	0x58, 0xc4, 0xbe, 0xe5, 0x9a, 0x67, 0x78, 0x64, 0xe9, 0xe2, 0xce, 0x6b, 0xd7, 0x39, 0x3c, 0xc1,
+	0x5a, 0x5e, 0xb9, 0x1c, 0x68, 0xa0, 0x26, 0xd7, 0x3e, 0xc2, 0x7d, 0x04, 0xf0, 0x68, 0xcc, 0x53,
+	0x41, 0x7c, 0x66, 0x44, 0xf9, 0x28, 0x05, 0xfd, 0x2f, 0xb3, 0x60, 0x29, 0x66, 0x10, 0x9b, 0xbf,
+	0x06, 0x16, 0x84, 0x5b, 0x47, 0x69, 0x0d, 0x2e, 0x07, 0xda, 0xbc, 0xf0, 0x7a, 0x95, 0x57, 0x0c,
+	0x1b, 0xd7, 0xed, 0xef, 0xd6, 0xbd, 0x23, 0xc3, 0xd2, 0x09, 0xc3, 0x60, 0x35, 0xda, 0x02, 0xdb,
+	0x22, 0xeb, 0x17, 0x77, 0xde, 0xbc, 0x36, 0xe2, 0xdb, 0x8c, 0xba, 0xdd, 0x10, 0x1f, 0xf6, 0x9a,
+	0x54, 0x76, 0x19, 0x28, 0x5e, 0x0a, 0xdf, 0x06, 0x8b, 0xa4, 0x6d, 0x19, 0xa2, 0xbc, 0x13, 0x5b,
+	0x9d, 0x1f, 0x57, 0xf7, 0x7a, 0xb9, 0xd2, 0xa4, 0x41, 0x58, 0xaf, 0xa2, 0x2c, 0x69, 0x5b, 0x62,
+	0x68, 0x73, 0x53, 0x4c, 0xdb, 0x23, 0x7e, 0x5c, 0x2e, 0x84, 0xc0, 0xab, 0x8c, 0x18, 0x44, 0x4e,
+	0xcd, 0xc8, 0x27, 0x44, 0xa8, 0xa4, 0x1f, 0x11, 0x80, 0xcf, 0x1b, 0xc1, 0x9b, 0x26, 0xd1, 0x06,
+	0xc5, 0xd5, 0x49, 0x91, 0x4d, 0x93, 0xd0, 0x45, 0xe5, 0xe9, 0x36, 0xc8, 0x84, 0x3d, 0x83, 0xf8,
+	0x36, 0xee, 0x45, 0xcd, 0xe9, 0x42, 0xd8, 0xab, 0x73, 0x51, 0x27, 0x60, 0x6e, 0x9f, 0xda, 0xd8,
+	0x85, 0xef, 0x81, 0xd4, 0xa3, 0x38, 0x5e, 0xcb, 0xef, 0x7c, 0x33, 0xd0, 0x7e, 0x94, 0xb8, 0xe7,
+	0x50, 0x74, 0x43, 0xbc, 0xf1, 0x4c, 0x0e, 0x5d, 0xd2, 0x66, 0xa5, 0x76, 0x3f, 0xc4, 0x6c, 0xfb,
+	0x21, 0xee, 0x95, 0xf9, 0x00, 0xa5, 0xa2, 0x18, 0x78, 0x22, 0x8a, 0x95, 0x0c, 0x68, 0x29, 0xf0,
+	0x18, 0x50, 0x47, 0x61, 0xc8, 0x33, 0x98, 0xb0, 0x90, 0x06, 0xfd, 0x9a, 0x1f, 0x06, 0x7d, 0xf8,
+	0x04, 0x64, 0x69, 0x07, 0x07, 0xe2, 0xd5, 0x89, 0x6a, 0xcf, 0x3b, 0x2f, 0x0a, 0xc5, 0x04, 0xc9,
+	0x41, 0xbc, 0x96, 0x57, 0x24, 0x34, 0xa6, 0x4a, 0xc6, 0xd9, 0xec, 0xb5, 0x71, 0x56, 0x05, 0x0b,
+	0xdd, 0x8e, 0x2d, 0x82, 0x20, 0xf5, 0xed, 0x83, 0x20, 0x5a, 0x3a, 0xa5, 0x1f, 0x7c, 0x1f, 0x2c,
+	0x84, 0x3d, 0x59, 0xb9, 0xe6, 0xfe, 0xcf, 0x7b, 0x9d, 0x0f, 0x7b, 0xbc, 0xe2, 0xe9, 0x3f, 0x07,
+	0xb9, 0xf8, 0xf8, 0x7b, 0x26, 0x3b, 0x62, 0xa6, 0x83, 0xa7, 0xf6, 0xe0, 0xca, 0xd4, 0x1e, 0x9c,
+	0x47, 0x02, 0x7f, 0xe6, 0xba, 0x0c, 0xdb, 0x71, 0x24, 0x38, 0x9c, 0x06, 0xdb, 0x6f, 0xfe, 0x59,
+	0x01, 0x60, 0x5c, 0xd5, 0xe1, 0x1d, 0x90, 0x3d, 0x6a, 0x54, 0x6b, 0x0f, 0xea, 0x8d, 0x5a, 0x35,
+	0x37, 0x93, 0xbf, 0x75, 0x7e, 0x51, 0x5c, 0x1f, 0x4f, 0x1f, 0xf9, 0x36, 0x3e, 0x26, 0x3e, 0xb6,
+	0x61, 0x11, 0xcc, 0x37, 0x0e, 0xca, 0x07, 0xd5, 0xa7, 0x39, 0x25, 0xbf, 0x71, 0x7e, 0x51, 0xcc,
+	0x8d, 0x41, 0x0d, 0xda, 0xa6, 0x76, 0x1f, 0xde, 0x05, 0x4b, 0x07, 0x8d, 0xc7, 0x4f, 0x8d, 0xdd,
+	0x6a, 0x15, 0xd5, 0x5a, 0xad, 0xdc, 0x6c, 0xfe, 0xf6, 0xf9, 0x45, 0xf1, 0xa5, 0x31, 0xee, 0xc0,
+	0x77, 0xfb, 0xb1, 0x81, 0x77, 0x40, 0xb6, 0xf6, 0xa4, 0x86, 0x9e, 0x0a, 0xc6, 0xd4, 0xd5, 0x6d,
+	0x6b, 0xfc, 0xd3, 0x8c, 0x93, 0xe6, 0x33, 0xbf, 0xfd, 0x63, 0x61, 0xe6, 0xf3, 0x4f, 0x0b, 0x33,
+	0x6f, 0x7e, 0x96, 0x02, 0xc5, 0x17, 0x45, 0x04, 0xc4, 0xe0, 0x5e, 0xe5, 0xa0, 0x71, 0x88, 0x76,
+	0x2b, 0x87, 0x46, 0xe5, 0xa0, 0x5a, 0x33, 0x1e, 0xd6, 0x5b, 0x87, 0x07, 0xe8, 0xa9, 0x71, 0xd0,
+	0xac, 0xa1, 0xdd, 0xc3, 0xfa, 0x41, 0xc3, 0x38, 0x7c, 0xda, 0xac, 0x19, 0x47, 0x8d, 0x56, 0xb3,
+	0x56, 0xa9, 0x3f, 0xa8, 0x8b, 0x43, 0x97, 0xce, 0x2f, 0x8a, 0x77, 0x5f, 0xc4, 0x7d, 0xe4, 0xb3,
+	0x0e, 0xb6, 0x78, 0x4b, 0x64, 0xc3, 0x0f, 0xc0, 0x1b, 0x37, 0xda, 0xa6, 0xde, 0xa8, 0x1f, 0xe6,
+	0x94, 0xfc, 0xd6, 0xf9, 0x45, 0xf1, 0xfb, 0x2f, 0xe2, 0xaf, 0xfb, 0x24, 0x84, 0xbf, 0x04, 0x6f,
+	0xdd, 0x88, 0x78, 0xbf, 0xbe, 0x87, 0x76, 0x0f, 0x6b, 0xb9, 0xd9, 0xfc, 0xdd, 0xf3, 0x8b, 0xe2,
+	0x0f, 0x5e, 0xc4, 0xbd, 0x4f, 0x9c, 0x80, 0x7f, 0x04, 0xde, 0x94, 0x7e, 0xaf, 0xd6, 0xa8, 0xb5,
+	0xea, 0xad, 0x5c, 0xea, 0x66, 0xf4, 0x7b, 0xd8, 0xc7, 0x8c, 0xb0, 0x7c, 0x9a, 0x3b, 0xab, 0xfc,
+	0x8b, 0x2f, 0xfe, 0x59, 0x98, 0xf9, 0xfc, 0xb2, 0xa0, 0x7c, 0x71, 0x59, 0x50, 0xbe, 0xbc, 0x2c,
+	0x28, 0xff, 0xb8, 0x2c, 0x28, 0x9f, 0x7c, 0x5d, 0x98, 0xf9, 0xf2, 0xeb, 0xc2, 0xcc, 0xdf, 0xbe,
+	0x2e, 0xcc, 0x7c, 0xf8, 0x6e, 0x22, 0x35, 0x98, 0x15, 0x84, 0xae, 0xd9, 0x66, 0xa5, 0x96, 0xc8,
+	0xc4, 0x06, 0x0e, 0x9f, 0xd1, 0xe0, 0xb4, 0xd4, 0x1b, 0xfd, 0xeb, 0x2c, 0x5a, 0x37, 0xdf, 0x74,
+	0x65, 0xc9, 0x6f, 0xcf, 0x8b, 0xae, 0xf8, 0x87, 0xff, 0x1d, 0x00, 0x05, 0x99, 0x11, 0xc1, 0x9d,
+	0x16, 0x00, 0x00,
 }
 
-func (this *AccessTypeParam) Equal(that interface{}) bool {
+func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AccessTypeParam)
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(AccessTypeParam)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -525,19 +1233,98 @@ func (this *AccessTypeParam) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Value != that1.Value {
+	if len(this.AllowedDepositDenoms) != len(that1.AllowedDepositDenoms) {
+		return false
+	}
+	for i := range this.AllowedDepositDenoms {
+		if this.AllowedDepositDenoms[i] != that1.AllowedDepositDenoms[i] {
+			return false
+		}
+	}
+	if len(this.Snip20Wrappers) != len(that1.Snip20Wrappers) {
+		return false
+	}
+	for i := range this.Snip20Wrappers {
+		if !this.Snip20Wrappers[i].Equal(&that1.Snip20Wrappers[i]) {
+			return false
+		}
+	}
+	if len(this.KeyEpochs) != len(that1.KeyEpochs) {
+		return false
+	}
+	for i := range this.KeyEpochs {
+		if !this.KeyEpochs[i].Equal(&that1.KeyEpochs[i]) {
+			return false
+		}
+	}
+	if this.MaxCronGasLimit != that1.MaxCronGasLimit {
+		return false
+	}
+	if this.MaxCronsPerBlock != that1.MaxCronsPerBlock {
+		return false
+	}
+	if this.MaxCronsPerContract != that1.MaxCronsPerContract {
+		return false
+	}
+	if !this.QueryPluginGasCosts.Equal(&that1.QueryPluginGasCosts) {
+		return false
+	}
+	if this.MaxLabelLength != that1.MaxLabelLength {
+		return false
+	}
+	if this.LabelCharset != that1.LabelCharset {
+		return false
+	}
+	if this.MaxMemoryPages != that1.MaxMemoryPages {
+		return false
+	}
+	if this.MaxDispatchDepth != that1.MaxDispatchDepth {
+		return false
+	}
+	if this.MaxDispatchedMsgs != that1.MaxDispatchedMsgs {
+		return false
+	}
+	if len(this.DeniedMsgTypes) != len(that1.DeniedMsgTypes) {
+		return false
+	}
+	for i := range this.DeniedMsgTypes {
+		if this.DeniedMsgTypes[i] != that1.DeniedMsgTypes[i] {
+			return false
+		}
+	}
+	if this.DenyStakingMsgs != that1.DenyStakingMsgs {
+		return false
+	}
+	if len(this.Auditors) != len(that1.Auditors) {
+		return false
+	}
+	for i := range this.Auditors {
+		if this.Auditors[i] != that1.Auditors[i] {
+			return false
+		}
+	}
+	if this.MaxMsgSize != that1.MaxMsgSize {
+		return false
+	}
+	if !this.ScheduledCallGasPrice.Equal(that1.ScheduledCallGasPrice) {
+		return false
+	}
+	if this.MaxScheduledGasPerBlock != that1.MaxScheduledGasPerBlock {
+		return false
+	}
+	if this.FundRecoveryDelay != that1.FundRecoveryDelay {
 		return false
 	}
 	return true
 }
-func (this *CodeInfo) Equal(that interface{}) bool {
+func (this *QueryPluginGasCosts) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CodeInfo)
+	that1, ok := that.(*QueryPluginGasCosts)
 	if !ok {
-		that2, ok := that.(CodeInfo)
+		that2, ok := that.(QueryPluginGasCosts)
 		if ok {
 			that1 = &that2
 		} else {
@@ -549,28 +1336,46 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+	if this.Bank != that1.Bank {
 		return false
 	}
-	if !bytes.Equal(this.Creator, that1.Creator) {
+	if this.Custom != that1.Custom {
 		return false
 	}
-	if this.Source != that1.Source {
+	if this.Staking != that1.Staking {
 		return false
 	}
-	if this.Builder != that1.Builder {
+	if this.Wasm != that1.Wasm {
+		return false
+	}
+	if this.Dist != that1.Dist {
+		return false
+	}
+	if this.Mint != that1.Mint {
+		return false
+	}
+	if this.Gov != that1.Gov {
+		return false
+	}
+	if this.IBC != that1.IBC {
+		return false
+	}
+	if this.Stargate != that1.Stargate {
+		return false
+	}
+	if this.Oracle != that1.Oracle {
 		return false
 	}
 	return true
 }
-func (this *ContractKey) Equal(that interface{}) bool {
+func (this *Snip20Wrapper) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractKey)
+	that1, ok := that.(*Snip20Wrapper)
 	if !ok {
-		that2, ok := that.(ContractKey)
+		that2, ok := that.(Snip20Wrapper)
 		if ok {
 			that1 = &that2
 		} else {
@@ -582,25 +1387,22 @@ func (this *ContractKey) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.OgContractKey, that1.OgContractKey) {
-		return false
-	}
-	if !bytes.Equal(this.CurrentContractKey, that1.CurrentContractKey) {
+	if this.Denom != that1.Denom {
 		return false
 	}
-	if !bytes.Equal(this.CurrentContractKeyProof, that1.CurrentContractKeyProof) {
+	if this.ContractAddress != that1.ContractAddress {
 		return false
 	}
 	return true
 }
-func (this *ContractCustomInfo) Equal(that interface{}) bool {
+func (this *KeyEpoch) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCustomInfo)
+	that1, ok := that.(*KeyEpoch)
 	if !ok {
-		that2, ok := that.(ContractCustomInfo)
+		that2, ok := that.(KeyEpoch)
 		if ok {
 			that1 = &that2
 		} else {
@@ -612,22 +1414,25 @@ func (this *ContractCustomInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EnclaveKey.Equal(that1.EnclaveKey) {
+	if this.Epoch != that1.Epoch {
 		return false
 	}
-	if this.Label != that1.Label {
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.TransitionBlocks != that1.TransitionBlocks {
 		return false
 	}
 	return true
 }
-func (this *ContractInfo) Equal(that interface{}) bool {
+func (this *ScheduledCall) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractInfo)
+	that1, ok := that.(*ScheduledCall)
 	if !ok {
-		that2, ok := that.(ContractInfo)
+		that2, ok := that.(ScheduledCall)
 		if ok {
 			that1 = &that2
 		} else {
@@ -639,37 +1444,45 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if this.ID != that1.ID {
 		return false
 	}
-	if !bytes.Equal(this.Creator, that1.Creator) {
+	if this.Sender != that1.Sender {
 		return false
 	}
-	if this.Label != that1.Label {
+	if this.Contract != that1.Contract {
 		return false
 	}
-	if !this.Created.Equal(that1.Created) {
+	if !bytes.Equal(this.Msg, that1.Msg) {
 		return false
 	}
-	if this.IBCPortID != that1.IBCPortID {
+	if len(this.SentFunds) != len(that1.SentFunds) {
 		return false
 	}
-	if this.Admin != that1.Admin {
+	for i := range this.SentFunds {
+		if !this.SentFunds[i].Equal(&that1.SentFunds[i]) {
+			return false
+		}
+	}
+	if this.ExecuteHeight != that1.ExecuteHeight {
 		return false
 	}
-	if !bytes.Equal(this.AdminProof, that1.AdminProof) {
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !this.Fee.Equal(&that1.Fee) {
 		return false
 	}
 	return true
 }
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+func (this *ContractFeePolicy) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AbsoluteTxPosition)
+	that1, ok := that.(*ContractFeePolicy)
 	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
+		that2, ok := that.(ContractFeePolicy)
 		if ok {
 			that1 = &that2
 		} else {
@@ -681,22 +1494,24 @@ func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if len(this.MinFee) != len(that1.MinFee) {
 		return false
 	}
-	if this.TxIndex != that1.TxIndex {
-		return false
+	for i := range this.MinFee {
+		if !this.MinFee[i].Equal(&that1.MinFee[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *Model) Equal(that interface{}) bool {
+func (this *CodeSchema) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Model)
+	that1, ok := that.(*CodeSchema)
 	if !ok {
-		that2, ok := that.(Model)
+		that2, ok := that.(CodeSchema)
 		if ok {
 			that1 = &that2
 		} else {
@@ -708,22 +1523,25 @@ func (this *Model) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if !bytes.Equal(this.Schema, that1.Schema) {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if !bytes.Equal(this.SchemaHash, that1.SchemaHash) {
+		return false
+	}
+	if this.SchemaURI != that1.SchemaURI {
 		return false
 	}
 	return true
 }
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+func (this *CodeAudit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
+	that1, ok := that.(*CodeAudit)
 	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
+		that2, ok := that.(CodeAudit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -735,564 +1553,4240 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Operation != that1.Operation {
+	if this.Auditor != that1.Auditor {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if !bytes.Equal(this.ReportHash, that1.ReportHash) {
 		return false
 	}
-	if !this.Updated.Equal(that1.Updated) {
+	if this.ReportURI != that1.ReportURI {
 		return false
 	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
+	if !this.AuditedAt.Equal(that1.AuditedAt) {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if this.Height != that1.Height {
 		return false
 	}
 	return true
 }
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CodeVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	that1, ok := that.(*CodeVerification)
+	if !ok {
+		that2, ok := that.(CodeVerification)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Builder) > 0 {
-		i -= len(m.Builder)
-		copy(dAtA[i:], m.Builder)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
-		i--
-		dAtA[i] = 0x22
+	if this.Verifier != that1.Verifier {
+		return false
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0x1a
+	if this.Source != that1.Source {
+		return false
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if this.Commit != that1.Commit {
+		return false
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+	if this.Builder != that1.Builder {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
 }
+func (this *Cron) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Cron)
+	if !ok {
+		that2, ok := that.(Cron)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentContractKeyProof) > 0 {
-		i -= len(m.CurrentContractKeyProof)
-		copy(dAtA[i:], m.CurrentContractKeyProof)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentContractKeyProof)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.CurrentContractKey) > 0 {
-		i -= len(m.CurrentContractKey)
-		copy(dAtA[i:], m.CurrentContractKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentContractKey)))
-		i--
-		dAtA[i] = 0x12
+	if this.ID != that1.ID {
+		return false
 	}
-	if len(m.OgContractKey) > 0 {
-		i -= len(m.OgContractKey)
-		copy(dAtA[i:], m.OgContractKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OgContractKey)))
-		i--
-		dAtA[i] = 0xa
+	if this.Contract != that1.Contract {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractCustomInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCustomInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractCustomInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x12
+	if this.Interval != that1.Interval {
+		return false
 	}
-	if m.EnclaveKey != nil {
-		{
-			size, err := m.EnclaveKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if this.GasLimit != that1.GasLimit {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NextHeight != that1.NextHeight {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Budget.Equal(&that1.Budget) {
+		return false
+	}
+	return true
 }
+func (this *AccessTypeParam) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminProof) > 0 {
-		i -= len(m.AdminProof)
-		copy(dAtA[i:], m.AdminProof)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.AdminProof)))
-		i--
-		dAtA[i] = 0x42
+	that1, ok := that.(*AccessTypeParam)
+	if !ok {
+		that2, ok := that.(AccessTypeParam)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x3a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
+	if this.Value != that1.Value {
+		return false
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	return true
+}
+func (this *AccessConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfig)
+	if !ok {
+		that2, ok := that.(AccessConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if this.Permission != that1.Permission {
+		return false
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	if this.Address != that1.Address {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
+	that1, ok := that.(*CodeInfo)
+	if !ok {
+		that2, ok := that.(CodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if this.Source != that1.Source {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Builder != that1.Builder {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
+func (this *ContractKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+
+	that1, ok := that.(*ContractKey)
+	if !ok {
+		that2, ok := that.(ContractKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.OgContractKey, that1.OgContractKey) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !bytes.Equal(this.CurrentContractKey, that1.CurrentContractKey) {
+		return false
+	}
+	if !bytes.Equal(this.CurrentContractKeyProof, that1.CurrentContractKeyProof) {
+		return false
+	}
+	return true
 }
+func (this *ContractCustomInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*ContractCustomInfo)
+	if !ok {
+		that2, ok := that.(ContractCustomInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
+	if !this.EnclaveKey.Equal(that1.EnclaveKey) {
+		return false
 	}
-	return n
+	if this.Label != that1.Label {
+		return false
+	}
+	return true
 }
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
 	}
-	l = len(m.Builder)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Label != that1.Label {
+		return false
 	}
-	return n
-}
-
-func (m *ContractKey) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Created.Equal(that1.Created) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.OgContractKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.IBCPortID != that1.IBCPortID {
+		return false
 	}
-	l = len(m.CurrentContractKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Admin != that1.Admin {
+		return false
 	}
-	l = len(m.CurrentContractKeyProof)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.AdminProof, that1.AdminProof) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractCustomInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.EnclaveKey != nil {
-		l = m.EnclaveKey.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.BlockHeight != that1.BlockHeight {
+		return false
 	}
-	return n
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	return true
 }
-
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return true
+}
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.AdminProof)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	return true
 }
+func (this *ContractGasUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ContractGasUsage)
+	if !ok {
+		that2, ok := that.(ContractGasUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+	if m.FundRecoveryDelay != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FundRecoveryDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+	if m.MaxScheduledGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxScheduledGasPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	return n
+	{
+		size := m.ScheduledCallGasPrice.Size()
+		i -= size
+		if _, err := m.ScheduledCallGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.MaxMsgSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMsgSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Auditors) > 0 {
+		for iNdEx := len(m.Auditors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Auditors[iNdEx])
+			copy(dAtA[i:], m.Auditors[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Auditors[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.DenyStakingMsgs {
+		i--
+		if m.DenyStakingMsgs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for iNdEx := len(m.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMsgTypes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxDispatchedMsgs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxDispatchedMsgs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxDispatchDepth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxDispatchDepth))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxMemoryPages != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMemoryPages))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.LabelCharset) > 0 {
+		i -= len(m.LabelCharset)
+		copy(dAtA[i:], m.LabelCharset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LabelCharset)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxLabelLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxLabelLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.QueryPluginGasCosts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxCronsPerContract != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCronsPerContract))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCronsPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCronsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxCronGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCronGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.KeyEpochs) > 0 {
+		for iNdEx := len(m.KeyEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Snip20Wrappers) > 0 {
+		for iNdEx := len(m.Snip20Wrappers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snip20Wrappers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDepositDenoms) > 0 {
+		for iNdEx := len(m.AllowedDepositDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDepositDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDepositDenoms[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedDepositDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPluginGasCosts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPluginGasCosts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPluginGasCosts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Oracle != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Oracle))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.Stargate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Stargate))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.IBC != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IBC))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Gov != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Gov))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Mint != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Mint))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Dist != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Dist))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Wasm != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Wasm))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Staking != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Staking))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Custom != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Custom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bank != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Snip20Wrapper) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Snip20Wrapper) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Snip20Wrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransitionBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TransitionBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SentFunds) > 0 {
+		for iNdEx := len(m.SentFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SchemaURI) > 0 {
+		i -= len(m.SchemaURI)
+		copy(dAtA[i:], m.SchemaURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AuditedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AuditedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ReportURI) > 0 {
+		i -= len(m.ReportURI)
+		copy(dAtA[i:], m.ReportURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReportURI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auditor) > 0 {
+		i -= len(m.Auditor)
+		copy(dAtA[i:], m.Auditor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Auditor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cron) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Cron) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Cron) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentContractKeyProof) > 0 {
+		i -= len(m.CurrentContractKeyProof)
+		copy(dAtA[i:], m.CurrentContractKeyProof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentContractKeyProof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentContractKey) > 0 {
+		i -= len(m.CurrentContractKey)
+		copy(dAtA[i:], m.CurrentContractKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentContractKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OgContractKey) > 0 {
+		i -= len(m.OgContractKey)
+		copy(dAtA[i:], m.OgContractKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OgContractKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCustomInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCustomInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCustomInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EnclaveKey != nil {
+		{
+			size, err := m.EnclaveKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminProof) > 0 {
+		i -= len(m.AdminProof)
+		copy(dAtA[i:], m.AdminProof)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AdminProof)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDepositDenoms) > 0 {
+		for _, s := range m.AllowedDepositDenoms {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Snip20Wrappers) > 0 {
+		for _, e := range m.Snip20Wrappers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.KeyEpochs) > 0 {
+		for _, e := range m.KeyEpochs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxCronGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCronGasLimit))
+	}
+	if m.MaxCronsPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCronsPerBlock))
+	}
+	if m.MaxCronsPerContract != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCronsPerContract))
+	}
+	l = m.QueryPluginGasCosts.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MaxLabelLength != 0 {
+		n += 1 + sovTypes(uint64(m.MaxLabelLength))
+	}
+	l = len(m.LabelCharset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxMemoryPages != 0 {
+		n += 1 + sovTypes(uint64(m.MaxMemoryPages))
+	}
+	if m.MaxDispatchDepth != 0 {
+		n += 1 + sovTypes(uint64(m.MaxDispatchDepth))
+	}
+	if m.MaxDispatchedMsgs != 0 {
+		n += 1 + sovTypes(uint64(m.MaxDispatchedMsgs))
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for _, s := range m.DeniedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.DenyStakingMsgs {
+		n += 2
+	}
+	if len(m.Auditors) > 0 {
+		for _, s := range m.Auditors {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxMsgSize != 0 {
+		n += 2 + sovTypes(uint64(m.MaxMsgSize))
+	}
+	l = m.ScheduledCallGasPrice.Size()
+	n += 2 + l + sovTypes(uint64(l))
+	if m.MaxScheduledGasPerBlock != 0 {
+		n += 2 + sovTypes(uint64(m.MaxScheduledGasPerBlock))
+	}
+	if m.FundRecoveryDelay != 0 {
+		n += 2 + sovTypes(uint64(m.FundRecoveryDelay))
+	}
+	return n
+}
+
+func (m *QueryPluginGasCosts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bank != 0 {
+		n += 1 + sovTypes(uint64(m.Bank))
+	}
+	if m.Custom != 0 {
+		n += 1 + sovTypes(uint64(m.Custom))
+	}
+	if m.Staking != 0 {
+		n += 1 + sovTypes(uint64(m.Staking))
+	}
+	if m.Wasm != 0 {
+		n += 1 + sovTypes(uint64(m.Wasm))
+	}
+	if m.Dist != 0 {
+		n += 1 + sovTypes(uint64(m.Dist))
+	}
+	if m.Mint != 0 {
+		n += 1 + sovTypes(uint64(m.Mint))
+	}
+	if m.Gov != 0 {
+		n += 1 + sovTypes(uint64(m.Gov))
+	}
+	if m.IBC != 0 {
+		n += 1 + sovTypes(uint64(m.IBC))
+	}
+	if m.Stargate != 0 {
+		n += 1 + sovTypes(uint64(m.Stargate))
+	}
+	if m.Oracle != 0 {
+		n += 1 + sovTypes(uint64(m.Oracle))
+	}
+	return n
+}
+
+func (m *Snip20Wrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.TransitionBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.TransitionBlocks))
+	}
+	return n
+}
+
+func (m *ScheduledCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.SentFunds) > 0 {
+		for _, e := range m.SentFunds {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExecuteHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ContractFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SchemaURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Auditor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ReportURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AuditedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CodeVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Cron) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextHeight))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Budget.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OgContractKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CurrentContractKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CurrentContractKeyProof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCustomInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnclaveKey != nil {
+		l = m.EnclaveKey.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.AdminProof)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDepositDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDepositDenoms = append(m.AllowedDepositDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snip20Wrappers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snip20Wrappers = append(m.Snip20Wrappers, Snip20Wrapper{})
+			if err := m.Snip20Wrappers[len(m.Snip20Wrappers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyEpochs = append(m.KeyEpochs, KeyEpoch{})
+			if err := m.KeyEpochs[len(m.KeyEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCronGasLimit", wireType)
+			}
+			m.MaxCronGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCronGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCronsPerBlock", wireType)
+			}
+			m.MaxCronsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCronsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCronsPerContract", wireType)
+			}
+			m.MaxCronsPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCronsPerContract |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryPluginGasCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueryPluginGasCosts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelLength", wireType)
+			}
+			m.MaxLabelLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelCharset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelCharset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoryPages", wireType)
+			}
+			m.MaxMemoryPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoryPages |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDispatchDepth", wireType)
+			}
+			m.MaxDispatchDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDispatchDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDispatchedMsgs", wireType)
+			}
+			m.MaxDispatchedMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDispatchedMsgs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypes = append(m.DeniedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyStakingMsgs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyStakingMsgs = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditors = append(m.Auditors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgSize", wireType)
+			}
+			m.MaxMsgSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCallGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledCallGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledGasPerBlock", wireType)
+			}
+			m.MaxScheduledGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxScheduledGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundRecoveryDelay", wireType)
+			}
+			m.FundRecoveryDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FundRecoveryDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPluginGasCosts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPluginGasCosts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPluginGasCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bank", wireType)
+			}
+			m.Bank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Custom", wireType)
+			}
+			m.Custom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Custom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staking", wireType)
+			}
+			m.Staking = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Staking |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wasm", wireType)
+			}
+			m.Wasm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wasm |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			}
+			m.Dist = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dist |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			m.Mint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
+			}
+			m.Gov = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gov |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBC", wireType)
+			}
+			m.IBC = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IBC |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stargate", wireType)
+			}
+			m.Stargate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stargate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			m.Oracle = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Oracle |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Snip20Wrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Snip20Wrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Snip20Wrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionBlocks", wireType)
+			}
+			m.TransitionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransitionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentFunds = append(m.SentFunds, types.Coin{})
+			if err := m.SentFunds[len(m.SentFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFee = append(m.MinFee, types.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = append(m.Schema[:0], dAtA[iNdEx:postIndex]...)
+			if m.Schema == nil {
+				m.Schema = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = append(m.SchemaHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.SchemaHash == nil {
+				m.SchemaHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeAudit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeAudit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeAudit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auditor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auditor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = append(m.ReportHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReportHash == nil {
+				m.ReportHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AuditedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cron) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cron: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cron: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
-	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1363,6 +5857,107 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2427,6 +7022,141 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractGasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
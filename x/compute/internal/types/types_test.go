@@ -1,10 +1,18 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"strings"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmTypesV010 "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	wasmTypesV1 "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestContractInfoValidateBasic(t *testing.T) {
@@ -111,3 +119,288 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestContractInfoHistoryTxHash(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 7}, false, nil)
+	info := ContractInfoFixture()
+
+	entry := info.InitialHistory(ctx, nil)
+	assert.Empty(t, entry.TxHash)
+
+	txBytes := []byte("tx")
+	expHash := sha256.Sum256(txBytes)
+	ctx = ctx.WithTxBytes(txBytes)
+
+	entry = info.InitialHistory(ctx, nil)
+	assert.Equal(t, expHash[:], []byte(entry.TxHash))
+
+	entry = info.AddMigration(ctx, info.CodeID+1, []byte("{}"))
+	assert.Equal(t, expHash[:], []byte(entry.TxHash))
+	assert.Equal(t, int64(7), entry.Updated.BlockHeight)
+	assert.Equal(t, ContractCodeHistoryOperationTypeMigrate, entry.Operation)
+}
+
+func TestContractResponseEventsOrder(t *testing.T) {
+	contractAddr := sdk.AccAddress(make([]byte, 20))
+
+	logs := []wasmTypesV010.LogAttribute{
+		{Key: "z", Value: "1"},
+		{Key: "a", Value: "2"},
+	}
+	evts := wasmTypesV1.Events{
+		{Type: "transfer", Attributes: []wasmTypesV010.LogAttribute{{Key: "to", Value: "x"}, {Key: "amount", Value: "1"}}},
+		{Type: "counter", Attributes: []wasmTypesV010.LogAttribute{{Key: "count", Value: "2"}}},
+	}
+
+	events, err := ContractResponseEvents(logs, evts, contractAddr)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, CustomEventType, events[0].Type)
+	assert.Equal(t, CustomContractEventPrefix+"transfer", events[1].Type)
+	assert.Equal(t, CustomContractEventPrefix+"counter", events[2].Type)
+
+	keys := func(e sdk.Event) []string {
+		var r []string
+		for _, a := range e.Attributes {
+			r = append(r, string(a.Key))
+		}
+		return r
+	}
+	assert.Equal(t, []string{AttributeKeyContractAddr, "z", "a"}, keys(events[0]))
+	assert.Equal(t, []string{AttributeKeyContractAddr, "to", "amount"}, keys(events[1]))
+
+	// invalid custom events fail the whole response
+	_, err = ContractResponseEvents(logs, wasmTypesV1.Events{{Type: "a"}}, contractAddr)
+	require.Error(t, err)
+}
+
+func TestValidateEncryptedMsg(t *testing.T) {
+	envelope := func(pubKey byte, ciphertextLen int) []byte {
+		msg := make([]byte, EncryptedMsgNonceSize)
+		msg = append(msg, bytes.Repeat([]byte{pubKey}, EncryptedMsgPubKeySize)...)
+		return append(msg, make([]byte, ciphertextLen)...)
+	}
+
+	specs := map[string]struct {
+		src      []byte
+		expError bool
+	}{
+		"valid": {
+			src: envelope(1, 100),
+		},
+		"shortest valid": {
+			src: envelope(1, EncryptedMsgMinSize-EncryptedMsgNonceSize-EncryptedMsgPubKeySize),
+		},
+		"too short": {
+			src:      envelope(1, EncryptedMsgMinSize-EncryptedMsgNonceSize-EncryptedMsgPubKeySize-1),
+			expError: true,
+		},
+		"empty": {
+			src:      nil,
+			expError: true,
+		},
+		"empty public key": {
+			src:      envelope(0, 100),
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := ValidateEncryptedMsg(spec.src)
+			if spec.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContractFeePolicyIsSatisfiedBy(t *testing.T) {
+	policy := ContractFeePolicy{MinFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uscrt", 100))}
+
+	specs := map[string]struct {
+		policy ContractFeePolicy
+		fee    sdk.Coins
+		exp    bool
+	}{
+		"no policy":              {policy: ContractFeePolicy{}, fee: sdk.NewCoins(), exp: true},
+		"first denom covered":    {policy: policy, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), exp: true},
+		"second denom covered":   {policy: policy, fee: sdk.NewCoins(sdk.NewInt64Coin("uscrt", 150)), exp: true},
+		"not enough":             {policy: policy, fee: sdk.NewCoins(sdk.NewInt64Coin("uscrt", 99)), exp: false},
+		"other denom":            {policy: policy, fee: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1000)), exp: false},
+		"no fee":                 {policy: policy, fee: sdk.NewCoins(), exp: false},
+		"partial of both denoms": {policy: policy, fee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 9), sdk.NewInt64Coin("uscrt", 99)), exp: false},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.policy.IsSatisfiedBy(spec.fee))
+		})
+	}
+}
+
+func TestCodeSchemaValidateBasic(t *testing.T) {
+	schema := []byte(`{"execute":{"type":"object"},"query":{"type":"object"}}`)
+	hash := sha256.Sum256(schema)
+
+	specs := map[string]struct {
+		schema   CodeSchema
+		expError bool
+	}{
+		"stored schema":             {schema: CodeSchema{Schema: schema}},
+		"stored schema with hash":   {schema: CodeSchema{Schema: schema, SchemaHash: hash[:]}},
+		"hash and uri":              {schema: CodeSchema{SchemaHash: hash[:], SchemaURI: "https://example.com/schema.json"}},
+		"empty":                     {schema: CodeSchema{}, expError: true},
+		"invalid json":              {schema: CodeSchema{Schema: []byte(`{"execute":`)}, expError: true},
+		"hash doesn't match schema": {schema: CodeSchema{Schema: schema, SchemaHash: make([]byte, sha256.Size)}, expError: true},
+		"hash without uri":          {schema: CodeSchema{SchemaHash: hash[:]}, expError: true},
+		"uri without hash":          {schema: CodeSchema{SchemaURI: "https://example.com/schema.json"}, expError: true},
+		"short hash":                {schema: CodeSchema{SchemaHash: hash[:16], SchemaURI: "https://example.com/schema.json"}, expError: true},
+		"http uri":                  {schema: CodeSchema{SchemaHash: hash[:], SchemaURI: "http://example.com/schema.json"}, expError: true},
+		"schema too big":            {schema: CodeSchema{Schema: []byte(`"` + strings.Repeat("a", MaxCodeSchemaSize) + `"`)}, expError: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.schema.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCodeAuditValidateBasic(t *testing.T) {
+	auditor := sdk.AccAddress(make([]byte, 20)).String()
+	hash := sha256.Sum256([]byte("report"))
+	auditedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	specs := map[string]struct {
+		audit    CodeAudit
+		expError bool
+	}{
+		"valid":           {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], AuditedAt: auditedAt, Scope: "v1.0.0"}},
+		"with report uri": {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], ReportURI: "https://example.com/report.pdf", AuditedAt: auditedAt, Scope: "v1.0.0"}},
+		"invalid auditor": {audit: CodeAudit{Auditor: "foo", ReportHash: hash[:], AuditedAt: auditedAt, Scope: "v1.0.0"}, expError: true},
+		"short hash":      {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:16], AuditedAt: auditedAt, Scope: "v1.0.0"}, expError: true},
+		"http report uri": {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], ReportURI: "http://example.com/report.pdf", AuditedAt: auditedAt, Scope: "v1.0.0"}, expError: true},
+		"missing date":    {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], Scope: "v1.0.0"}, expError: true},
+		"missing scope":   {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], AuditedAt: auditedAt}, expError: true},
+		"scope too long":  {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], AuditedAt: auditedAt, Scope: strings.Repeat("a", MaxAuditScopeSize+1)}, expError: true},
+		"negative height": {audit: CodeAudit{Auditor: auditor, ReportHash: hash[:], AuditedAt: auditedAt, Scope: "v1.0.0", Height: -1}, expError: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.audit.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAccessConfig(t *testing.T) {
+	alice := sdk.AccAddress(make([]byte, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+
+	specs := map[string]struct {
+		config   AccessConfig
+		expError bool
+		allowed  []sdk.AccAddress
+		denied   []sdk.AccAddress
+	}{
+		"everybody":              {config: AccessConfig{Permission: AccessTypeEverybody}, allowed: []sdk.AccAddress{alice, bob}},
+		"nobody":                 {config: AccessConfig{Permission: AccessTypeNobody}, denied: []sdk.AccAddress{alice, bob}},
+		"only address":           {config: AccessConfig{Permission: AccessTypeOnlyAddress, Address: alice.String()}, allowed: []sdk.AccAddress{alice}, denied: []sdk.AccAddress{bob}},
+		"only invalid address":   {config: AccessConfig{Permission: AccessTypeOnlyAddress, Address: "foo"}, expError: true, denied: []sdk.AccAddress{alice}},
+		"everybody with address": {config: AccessConfig{Permission: AccessTypeEverybody, Address: alice.String()}, expError: true, allowed: []sdk.AccAddress{bob}},
+		"undefined":              {config: AccessConfig{}, expError: true, allowed: []sdk.AccAddress{alice, bob}},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.config.ValidateBasic()
+			if spec.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			for _, addr := range spec.allowed {
+				assert.True(t, spec.config.Allowed(addr))
+			}
+			for _, addr := range spec.denied {
+				assert.False(t, spec.config.Allowed(addr))
+			}
+		})
+	}
+}
+
+func TestParseAccessType(t *testing.T) {
+	got, err := ParseAccessType("only_address")
+	require.NoError(t, err)
+	assert.Equal(t, AccessTypeOnlyAddress, got)
+
+	_, err = ParseAccessType("UNDEFINED")
+	require.Error(t, err)
+	_, err = ParseAccessType("somebody")
+	require.Error(t, err)
+}
+
+func TestNormalizeFunds(t *testing.T) {
+	specs := map[string]struct {
+		src      sdk.Coins
+		exp      sdk.Coins
+		expError bool
+	}{
+		"empty": {
+			src: nil,
+			exp: nil,
+		},
+		"already normalized": {
+			src: sdk.Coins{sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("uscrt", 2)},
+			exp: sdk.Coins{sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("uscrt", 2)},
+		},
+		"unsorted": {
+			src: sdk.Coins{sdk.NewInt64Coin("uscrt", 2), sdk.NewInt64Coin("alx", 1)},
+			exp: sdk.Coins{sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("uscrt", 2)},
+		},
+		"duplicate denoms": {
+			src: sdk.Coins{sdk.NewInt64Coin("uscrt", 2), sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("uscrt", 3)},
+			exp: sdk.Coins{sdk.NewInt64Coin("alx", 1), sdk.NewInt64Coin("uscrt", 5)},
+		},
+		"zero amount": {
+			src:      sdk.Coins{sdk.NewInt64Coin("uscrt", 2), sdk.NewInt64Coin("alx", 0)},
+			expError: true,
+		},
+		"negative amount": {
+			src:      sdk.Coins{sdk.NewInt64Coin("uscrt", 2), sdk.Coin{Denom: "alx", Amount: sdk.NewInt(-1)}},
+			expError: true,
+		},
+		"invalid denom": {
+			src:      sdk.Coins{sdk.NewInt64Coin("uscrt", 2), sdk.Coin{Denom: "!", Amount: sdk.NewInt(1)}},
+			expError: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := NormalizeFunds(spec.src)
+			if spec.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestGasUsageDay(t *testing.T) {
+	day := GasUsageDay(time.Date(2023, 5, 17, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, day, GasUsageDay(time.Date(2023, 5, 17, 23, 59, 59, 0, time.UTC)))
+	assert.Equal(t, day+1, GasUsageDay(time.Date(2023, 5, 18, 0, 0, 0, 0, time.UTC)))
+	// days are UTC days, whatever the location of the block time
+	assert.Equal(t, day, GasUsageDay(time.Date(2023, 5, 17, 18, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60))))
+}
@@ -3,7 +3,10 @@ package types
 import (
 	"net/url"
 	"regexp"
+	"unicode"
+	"unicode/utf8"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -27,6 +30,22 @@ const (
 	BuildTagRegexp = "^[a-z0-9][a-z0-9._-]*[a-z0-9](/[a-z0-9][a-z0-9._-]*[a-z0-9])+:[a-zA-Z0-9_][a-zA-Z0-9_.-]*$"
 
 	MaxBuildTagSize = 128
+
+	// MaxCodeSchemaSize is the largest JSON schema that can be stored for a code
+	MaxCodeSchemaSize = 128 * 1024
+
+	// MaxBatchInstances is the most instances that MsgBatchInstantiate can create
+	MaxBatchInstances = 100
+
+	// MaxCommitSize is the longest source revision of a code verification claim
+	MaxCommitSize = 128
+
+	// MaxAuditScopeSize is the longest scope of a code audit attestation
+	MaxAuditScopeSize = 1024
+
+	// MaxMsgSize is the largest encrypted msg that can be sent to a contract. It may be lowered by the
+	// max_msg_size param.
+	MaxMsgSize = 1024 * 1024 // 1MB
 )
 
 func validateSourceURL(source string) error {
@@ -59,6 +78,21 @@ func validateBuilder(buildTag string) error {
 	return nil
 }
 
+func validateCommit(commit string) error {
+	if commit == "" {
+		return sdkerrors.Wrap(ErrEmpty, "is required")
+	}
+	if len(commit) > MaxCommitSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxCommitSize)
+	}
+	for _, r := range commit {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return sdkerrors.Wrapf(ErrInvalid, "must not contain the character %U", r)
+		}
+	}
+	return nil
+}
+
 func validateWasmCode(s []byte) error {
 	if len(s) == 0 {
 		return sdkerrors.Wrap(ErrEmpty, "is required")
@@ -69,12 +103,56 @@ func validateWasmCode(s []byte) error {
 	return nil
 }
 
+func validateMsg(msg []byte) error {
+	if len(msg) > MaxMsgSize {
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxMsgSize)
+	}
+	return nil
+}
+
 func validateLabel(label string) error {
 	if label == "" {
 		return sdkerrors.Wrap(ErrEmpty, "is required")
 	}
 	if len(label) > MaxLabelSize {
-		return sdkerrors.Wrap(ErrLimit, "cannot be longer than 128 characters")
+		return sdkerrors.Wrapf(ErrLimit, "cannot be longer than %d bytes", MaxLabelSize)
+	}
+	if !utf8.ValidString(label) {
+		return sdkerrors.Wrap(ErrInvalid, "must be valid UTF-8")
+	}
+	for _, r := range label {
+		if !unicode.IsPrint(r) {
+			return sdkerrors.Wrapf(ErrInvalid, "must not contain the non-printable character %U", r)
+		}
 	}
 	return nil
 }
+
+// NormalizeFunds sorts the funds sent to a contract and merges the coins of the same denom.
+// It returns an error if a coin has an invalid denom or an amount that is not positive.
+func NormalizeFunds(funds sdk.Coins) (sdk.Coins, error) {
+	if len(funds) == 0 || funds.IsValid() {
+		return funds, nil
+	}
+
+	amounts := make(map[string]sdk.Int, len(funds))
+	for _, coin := range funds {
+		if err := sdk.ValidateDenom(coin.Denom); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+		}
+		if coin.Amount.IsNil() || !coin.Amount.IsPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount of %s must be positive", coin.Denom)
+		}
+		if amount, ok := amounts[coin.Denom]; ok {
+			amounts[coin.Denom] = amount.Add(coin.Amount)
+		} else {
+			amounts[coin.Denom] = coin.Amount
+		}
+	}
+
+	normalized := make(sdk.Coins, 0, len(amounts))
+	for denom, amount := range amounts {
+		normalized = append(normalized, sdk.NewCoin(denom, amount))
+	}
+	return normalized.Sort(), nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/compute/client/rest"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/simulation"
 )
 
 var (
@@ -52,7 +53,7 @@ func (AppModuleBasic) Name() string {
 // module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&GenesisState{
-		// Params: DefaultParams(),
+		Params: DefaultParams(),
 	})
 }
 
@@ -91,14 +92,21 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 // AppModule implements an application module for the compute module.
 type AppModule struct {
 	AppModuleBasic
-	keeper Keeper
+	keeper        Keeper
+	accountKeeper simulation.AccountKeeper
+	bankKeeper    simulation.BankKeeper
+	regKeeper     simulation.RegistrationKeeper
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(keeper Keeper) AppModule {
+// NewAppModule creates a new AppModule object. The account, bank and registration keepers are only used by
+// the simulation operations.
+func NewAppModule(keeper Keeper, accountKeeper simulation.AccountKeeper, bankKeeper simulation.BankKeeper, regKeeper simulation.RegistrationKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		regKeeper:      regKeeper,
 	}
 }
 
@@ -135,7 +143,9 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 }
 
 // RegisterInvariants registers the compute module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the compute module.
 func (am AppModule) Route() sdk.Route {
@@ -207,9 +217,13 @@ func (am AppModule) BeginBlock(ctx sdk.Context, beginBlock abci.RequestBeginBloc
 	}
 }
 
-// EndBlock returns the end blocker for the compute module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the compute module. It executes the scheduled
+// calls and the crons that are due, prunes the old gas usage counters of contracts and
+// returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ExecuteScheduled(ctx)
+	am.keeper.FlushCodeUsage(ctx)
+	am.keeper.PruneGasUsage(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -217,8 +231,12 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the bank module.
-func (AppModule) GenerateGenesisState(simState *module.SimulationState) { //nolint:all
+// GenerateGenesisState creates the default GenState of the compute module, codes and contracts are
+// created by the simulation operations.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simState.GenState[ModuleName] = simState.Cdc.MustMarshalJSON(&GenesisState{
+		Params: DefaultParams(),
+	})
 }
 
 // ProposalContents doesn't return any content functions for governance proposals.
@@ -235,7 +253,7 @@ func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange { //nolin
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) { //nolint:all
 }
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
-func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation { //nolint:all
-	return nil
+// WeightedOperations returns the all the compute module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(&simState, am.accountKeeper, am.bankKeeper, am.regKeeper, am.keeper)
 }
@@ -0,0 +1,48 @@
+package compute
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// NewProposalHandler returns a handler for the governance proposals of the compute module
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RecoverContractFundsProposal:
+			return handleRecoverContractFundsProposal(ctx, k, c)
+		case *types.FlagBrokenCodeProposal:
+			return handleFlagBrokenCodeProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized compute proposal content type: %T", c)
+		}
+	}
+}
+
+func handleRecoverContractFundsProposal(ctx sdk.Context, k Keeper, p *types.RecoverContractFundsProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+
+	_, err = k.RecoverContractFunds(ctx, contractAddress, recipient)
+	return err
+}
+
+func handleFlagBrokenCodeProposal(ctx sdk.Context, k Keeper, p *types.FlagBrokenCodeProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.FlagBrokenCode(ctx, p.CodeID)
+}
@@ -0,0 +1,242 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgStoreCode           = "op_weight_msg_store_code"           //nolint:gosec
+	OpWeightMsgInstantiateContract = "op_weight_msg_instantiate_contract" //nolint:gosec
+	OpWeightMsgExecuteContract     = "op_weight_msg_execute_contract"     //nolint:gosec
+
+	// OpContractPath is the app param with the path of the wasm file of the test contract.
+	// Without it, all the operations are skipped.
+	OpContractPath = "op_compute_contract_path"
+
+	DefaultWeightMsgStoreCode           = 5
+	DefaultWeightMsgInstantiateContract = 20
+	DefaultWeightMsgExecuteContract     = 50
+)
+
+// The test contract is the v1 compute test contract (cosmwasm/contracts/v1/compute-tests/test-compute-contract),
+// whose counter msgs are deterministic
+const (
+	testContractInitMsg    = `{"counter":{"counter":%d,"expires":0}}`
+	testContractExecuteMsg = `{"increment":{"addition":%d}}`
+)
+
+// AccountKeeper defines the account keeper used by the operations
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper defines the bank keeper used by the operations
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// RegistrationKeeper provides the IO master key the msgs to contracts are encrypted with
+type RegistrationKeeper interface {
+	GetMasterKey(ctx sdk.Context, keyType string) *reg.MasterKey
+}
+
+// WeightedOperations returns all the operations of the compute module with their respective weights
+func WeightedOperations(simState *module.SimulationState, ak AccountKeeper, bk BankKeeper, rk RegistrationKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	var (
+		weightMsgStoreCode           int
+		weightMsgInstantiateContract int
+		weightMsgExecuteContract     int
+		contractPath                 string
+	)
+
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
+		func(_ *rand.Rand) { weightMsgStoreCode = DefaultWeightMsgStoreCode },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgInstantiateContract, &weightMsgInstantiateContract, nil,
+		func(_ *rand.Rand) { weightMsgInstantiateContract = DefaultWeightMsgInstantiateContract },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
+		func(_ *rand.Rand) { weightMsgExecuteContract = DefaultWeightMsgExecuteContract },
+	)
+	simState.AppParams.GetOrGenerate(simState.Cdc, OpContractPath, &contractPath, nil,
+		func(_ *rand.Rand) { contractPath = "" },
+	)
+
+	var wasmCode []byte
+	if contractPath != "" {
+		var err error
+		wasmCode, err = os.ReadFile(contractPath)
+		if err != nil {
+			panic(fmt.Sprintf("failed to read the test contract: %s", err))
+		}
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgStoreCode, SimulateMsgStoreCode(ak, bk, wasmCode)),
+		simulation.NewWeightedOperation(weightMsgInstantiateContract, SimulateMsgInstantiateContract(ak, bk, rk, k, wasmCode)),
+		simulation.NewWeightedOperation(weightMsgExecuteContract, SimulateMsgExecuteContract(ak, bk, rk, k, wasmCode)),
+	}
+}
+
+// SimulateMsgStoreCode generates a MsgStoreCode of the test contract with random fees
+func SimulateMsgStoreCode(ak AccountKeeper, bk BankKeeper, wasmCode []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgStoreCode{}.Type()
+		if len(wasmCode) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no test contract"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgStoreCode{
+			Sender:       simAccount.Address,
+			WASMByteCode: wasmCode,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType)
+	}
+}
+
+// SimulateMsgInstantiateContract generates a MsgInstantiateContract of a random code of the test contract,
+// with a random counter and label
+func SimulateMsgInstantiateContract(ak AccountKeeper, bk BankKeeper, rk RegistrationKeeper, k keeper.Keeper, wasmCode []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgInstantiateContract{}.Type()
+		codeIDs := testContractCodeIDs(ctx, k, wasmCode)
+		if len(codeIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no code of the test contract"), nil, nil
+		}
+		codeID := codeIDs[r.Intn(len(codeIDs))]
+
+		label := simtypes.RandStringOfLength(r, 16)
+		if k.GetContractAddress(ctx, label) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "label already exists"), nil, nil
+		}
+
+		initMsg, err := encryptMsg(r, ctx, rk, wasmCode, fmt.Sprintf(testContractInitMsg, r.Intn(1_000_000)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgInstantiateContract{
+			Sender:  simAccount.Address,
+			CodeID:  codeID,
+			Label:   label,
+			InitMsg: initMsg,
+			Admin:   simAccount.Address.String(),
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType)
+	}
+}
+
+// SimulateMsgExecuteContract generates a MsgExecuteContract that increments the counter of a random
+// instance of the test contract
+func SimulateMsgExecuteContract(ak AccountKeeper, bk BankKeeper, rk RegistrationKeeper, k keeper.Keeper, wasmCode []byte) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := types.MsgExecuteContract{}.Type()
+
+		var contracts []sdk.AccAddress
+		for _, codeID := range testContractCodeIDs(ctx, k, wasmCode) {
+			k.IterateContractsByCode(ctx, codeID, func(address sdk.AccAddress) bool {
+				contracts = append(contracts, address)
+				return false
+			})
+		}
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no instance of the test contract"), nil, nil
+		}
+
+		execMsg, err := encryptMsg(r, ctx, rk, wasmCode, fmt.Sprintf(testContractExecuteMsg, r.Intn(1_000)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgExecuteContract{
+			Sender:   simAccount.Address,
+			Contract: contracts[r.Intn(len(contracts))],
+			Msg:      execMsg,
+		}
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msgType)
+	}
+}
+
+// testContractCodeIDs returns the ids of the codes of the test contract, in ascending order
+func testContractCodeIDs(ctx sdk.Context, k keeper.Keeper, wasmCode []byte) []uint64 {
+	if len(wasmCode) == 0 {
+		return nil
+	}
+
+	codeHash := sha256.Sum256(wasmCode)
+	var codeIDs []uint64
+	k.IterateCodeInfos(ctx, func(codeID uint64, info types.CodeInfo) bool {
+		if string(info.CodeHash) == string(codeHash[:]) {
+			codeIDs = append(codeIDs, codeID)
+		}
+		return false
+	})
+	return codeIDs
+}
+
+// encryptMsg encrypts a msg to the test contract. The tx key and nonce are drawn from r, so the
+// ciphertext is the same in every run of a seed.
+func encryptMsg(r *rand.Rand, ctx sdk.Context, rk RegistrationKeeper, wasmCode []byte, msg string) ([]byte, error) {
+	ioKey := rk.GetMasterKey(ctx, reg.MasterIoKeyId)
+	if ioKey == nil {
+		return nil, fmt.Errorf("no IO master key")
+	}
+
+	txSenderPrivKey := make([]byte, 32)
+	r.Read(txSenderPrivKey)
+	nonce := make([]byte, 32)
+	r.Read(nonce)
+
+	codeHash := sha256.Sum256(wasmCode)
+	secretMsg := types.SecretMsg{
+		CodeHash: []byte(hex.EncodeToString(codeHash[:])),
+		Msg:      []byte(msg),
+	}
+	return utils.EncryptWithKeys(ioKey.Bytes, txSenderPrivKey, nonce, secretMsg.Serialize())
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak AccountKeeper, bk BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:           msg,
+		MsgType:       msgType,
+		Context:       ctx,
+		SimAccount:    simAccount,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
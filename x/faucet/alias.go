@@ -0,0 +1,23 @@
+package faucet
+
+import (
+	"github.com/scrtlabs/SecretNetwork/x/faucet/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+const (
+	ModuleName   = types.ModuleName
+	StoreKey     = types.StoreKey
+	QuerierRoute = types.QuerierRoute
+	RouterKey    = types.QuerierRoute
+)
+
+var (
+	NewKeeper        = keeper.NewKeeper
+	NewMsgServerImpl = keeper.NewMsgServerImpl
+)
+
+type (
+	GenesisState = types.GenesisState
+	Keeper       = keeper.Keeper
+)
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "faucet transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		dripCmd(),
+	)
+	return txCmd
+}
+
+// dripCmd requests funds from the faucet
+func dripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drip [recipient]",
+		Short: "Request funds from the faucet",
+		Long:  "Request funds from the faucet. The recipient defaults to the sender, who pays the fee of the tx.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient := clientCtx.GetFromAddress()
+			if len(args) == 1 {
+				recipient, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgDrip(clientCtx.GetFromAddress(), recipient)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
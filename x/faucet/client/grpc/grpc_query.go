@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/client"
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Params(grpcCtx context.Context,
+	req *types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Params(ctx, *req)
+}
+
+func (q Querier) NextDrip(grpcCtx context.Context,
+	req *types.NextDripRequest,
+) (*types.NextDripResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	res, err := q.Q.NextDrip(ctx, *req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+type Querier struct {
+	K keeper.Keeper
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	_ types.ParamsRequest,
+) (*types.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+func (q Querier) NextDrip(ctx sdk.Context,
+	req types.NextDripRequest,
+) (*types.NextDripResponse, error) {
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &types.NextDripResponse{Height: q.K.NextDripHeight(ctx, address)}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+// InitGenesis initializes the x/faucet module's state from a provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+	for _, drip := range genState.LastDrips {
+		k.setLastDrip(ctx, sdk.MustAccAddressFromBech32(drip.Address), drip.Height)
+	}
+}
+
+// ExportGenesis returns the x/faucet module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var lastDrips []types.LastDrip
+	k.IterateLastDrips(ctx, func(recipient sdk.AccAddress, height int64) bool {
+		lastDrips = append(lastDrips, types.LastDrip{Address: recipient.String(), Height: height})
+		return false
+	})
+
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		LastDrips: lastDrips,
+	}
+}
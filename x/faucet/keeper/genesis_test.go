@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+
+	first, second := newRecipient(), newRecipient()
+	_, err := in.keeper.Drip(in.ctx.WithBlockHeight(98), first)
+	require.NoError(t, err)
+	_, err = in.keeper.Drip(in.ctx.WithBlockHeight(99), second)
+	require.NoError(t, err)
+
+	exported := in.keeper.ExportGenesis(in.ctx)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, in.keeper.GetParams(in.ctx), exported.Params)
+	require.ElementsMatch(t, []types.LastDrip{
+		{Address: first.String(), Height: 98},
+		{Address: second.String(), Height: 99},
+	}, exported.LastDrips)
+
+	imported := createTestInput(t)
+	imported.keeper.InitGenesis(imported.ctx, *exported)
+	require.Equal(t, exported, imported.keeper.ExportGenesis(imported.ctx))
+
+	// the imported drips keep limiting their recipients, and are pruned once their cooldown is over
+	require.Equal(t, int64(103), imported.keeper.NextDripHeight(imported.ctx, first))
+	imported.keeper.PruneLastDrips(imported.ctx.WithBlockHeight(103))
+	require.Equal(t, []types.LastDrip{{Address: second.String(), Height: 99}}, imported.keeper.ExportGenesis(imported.ctx).LastDrips)
+}
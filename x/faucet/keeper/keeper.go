@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	bankKeeper bankkeeper.Keeper
+	paramSpace paramtypes.Subspace
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey sdk.StoreKey,
+	bankKeeper bankkeeper.Keeper,
+	paramSpace paramtypes.Subspace,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:   storeKey,
+		cdc:        cdc,
+		bankKeeper: bankKeeper,
+		paramSpace: paramSpace,
+	}
+}
+
+// Drip sends the drip amount from the faucet module account to recipient.
+// It fails if the faucet is disabled, if the recipient got a drip less than CooldownBlocks ago,
+// or if MaxDripsPerBlock drips were already sent in the current block.
+func (k Keeper) Drip(ctx sdk.Context, recipient sdk.AccAddress) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil, types.ErrDisabled
+	}
+
+	if next := k.NextDripHeight(ctx, recipient); ctx.BlockHeight() < next {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimited, "%s may receive a drip at height %d", recipient, next)
+	}
+
+	count := k.blockDrips(ctx)
+	if count >= params.MaxDripsPerBlock {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimited, "%d drips were already sent in this block", count)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, params.DripAmount); err != nil {
+		return nil, err
+	}
+
+	k.setLastDrip(ctx, recipient, ctx.BlockHeight())
+	k.setBlockDrips(ctx, count+1)
+	return params.DripAmount, nil
+}
+
+// NextDripHeight returns the first height at which recipient may receive a drip
+func (k Keeper) NextDripHeight(ctx sdk.Context, recipient sdk.AccAddress) int64 {
+	last, found := k.GetLastDrip(ctx, recipient)
+	if !found {
+		return 0
+	}
+	return last + k.GetParams(ctx).CooldownBlocks
+}
+
+// GetLastDrip returns the height of the last drip to recipient
+func (k Keeper) GetLastDrip(ctx sdk.Context, recipient sdk.AccAddress) (int64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetLastDripKey(recipient))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// IterateLastDrips iterates the heights of the last drips of all recipients
+func (k Keeper) IterateLastDrips(ctx sdk.Context, cb func(recipient sdk.AccAddress, height int64) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LastDripPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		recipient := sdk.AccAddress(iter.Key()[len(types.LastDripPrefix):])
+		if cb(recipient, int64(binary.BigEndian.Uint64(iter.Value()))) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setLastDrip(ctx sdk.Context, recipient sdk.AccAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	if last, found := k.GetLastDrip(ctx, recipient); found {
+		store.Delete(types.GetDripHeightKey(last, recipient))
+	}
+	store.Set(types.GetLastDripKey(recipient), sdk.Uint64ToBigEndian(uint64(height)))
+	store.Set(types.GetDripHeightKey(height, recipient), []byte{})
+}
+
+// PruneLastDrips deletes the last drips whose cooldown is over, as they no longer limit their recipients.
+// The cooldown is the current one, so a longer cooldown doesn't apply to the drips that were already pruned.
+func (k Keeper) PruneLastDrips(ctx sdk.Context) {
+	end := ctx.BlockHeight() - k.GetParams(ctx).CooldownBlocks
+	if end < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.DripHeightPrefix, types.GetDripHeightPrefix(end+1))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		// the key is the prefix, an 8 byte height and the recipient
+		recipient := sdk.AccAddress(key[len(types.DripHeightPrefix)+8:])
+		store.Delete(types.GetLastDripKey(recipient))
+		store.Delete(key)
+	}
+}
+
+// blockDrips returns the number of drips sent in the current block. The count is stored
+// with the height it was counted at, so it resets on the first drip of every block.
+func (k Keeper) blockDrips(ctx sdk.Context) uint32 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockDripsKey)
+	if bz == nil || int64(binary.BigEndian.Uint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+	return binary.BigEndian.Uint32(bz[8:])
+}
+
+func (k Keeper) setBlockDrips(ctx sdk.Context, count uint32) {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz[:8], uint64(ctx.BlockHeight()))
+	binary.BigEndian.PutUint32(bz[8:], count)
+	ctx.KVStore(k.storeKey).Set(types.BlockDripsKey, bz)
+}
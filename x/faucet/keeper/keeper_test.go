@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+const testDenom = "uscrt"
+
+type testInput struct {
+	ctx        sdk.Context
+	keeper     Keeper
+	bankKeeper bankkeeper.Keeper
+}
+
+func createTestInput(t *testing.T) testInput {
+	keyAuth := sdk.NewKVStoreKey(authtypes.StoreKey)
+	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	keyFaucet := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	for _, key := range []sdk.StoreKey{keyAuth, keyBank, keyParams, keyFaucet} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	}
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 100}, false, log.NewNopLogger())
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+	amino := codec.NewLegacyAmino()
+
+	paramsKeeper := paramskeeper.NewKeeper(cdc, amino, keyParams, tkeyParams)
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc, keyAuth, paramsKeeper.Subspace(authtypes.ModuleName), authtypes.ProtoBaseAccount,
+		// the faucet is funded by minting in the tests
+		map[string][]string{types.ModuleName: {authtypes.Minter}},
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(cdc, keyBank, accountKeeper, paramsKeeper.Subspace(banktypes.ModuleName), nil)
+	bankKeeper.SetParams(ctx, banktypes.DefaultParams())
+
+	keeper := NewKeeper(cdc, keyFaucet, bankKeeper, paramsKeeper.Subspace(types.ModuleName))
+	keeper.SetParams(ctx, types.NewParams(true, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10)), 5, 2))
+
+	return testInput{ctx: ctx, keeper: keeper, bankKeeper: bankKeeper}
+}
+
+func (in testInput) fundFaucet(t *testing.T, amount int64) {
+	require.NoError(t, in.bankKeeper.MintCoins(in.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(testDenom, amount))))
+}
+
+func newRecipient() sdk.AccAddress {
+	return sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+}
+
+func TestDrip(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+	recipient := newRecipient()
+
+	amount, err := in.keeper.Drip(in.ctx, recipient)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 10)), amount)
+	require.Equal(t, amount, in.bankKeeper.GetAllBalances(in.ctx, recipient))
+
+	height, found := in.keeper.GetLastDrip(in.ctx, recipient)
+	require.True(t, found)
+	require.Equal(t, in.ctx.BlockHeight(), height)
+}
+
+func TestDripDisabled(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+	params := in.keeper.GetParams(in.ctx)
+	params.Enabled = false
+	in.keeper.SetParams(in.ctx, params)
+	recipient := newRecipient()
+
+	_, err := in.keeper.Drip(in.ctx, recipient)
+	require.ErrorIs(t, err, types.ErrDisabled)
+	require.True(t, in.bankKeeper.GetAllBalances(in.ctx, recipient).IsZero())
+	_, found := in.keeper.GetLastDrip(in.ctx, recipient)
+	require.False(t, found)
+}
+
+func TestDripCooldown(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+	recipient := newRecipient()
+
+	_, err := in.keeper.Drip(in.ctx, recipient)
+	require.NoError(t, err)
+	require.Equal(t, in.ctx.BlockHeight()+5, in.keeper.NextDripHeight(in.ctx, recipient))
+
+	// the cooldown is 5 blocks
+	for _, height := range []int64{100, 101, 104} {
+		_, err = in.keeper.Drip(in.ctx.WithBlockHeight(height), recipient)
+		require.ErrorIs(t, err, types.ErrRateLimited, "height %d", height)
+	}
+
+	_, err = in.keeper.Drip(in.ctx.WithBlockHeight(105), recipient)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), in.bankKeeper.GetBalance(in.ctx, recipient, testDenom).Amount.Int64())
+
+	// other recipients are not limited by the cooldown of recipient
+	_, err = in.keeper.Drip(in.ctx.WithBlockHeight(105), newRecipient())
+	require.NoError(t, err)
+}
+
+func TestDripMaxPerBlock(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+
+	// at most 2 drips per block
+	for i := 0; i < 2; i++ {
+		_, err := in.keeper.Drip(in.ctx, newRecipient())
+		require.NoError(t, err)
+	}
+	recipient := newRecipient()
+	_, err := in.keeper.Drip(in.ctx, recipient)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	_, found := in.keeper.GetLastDrip(in.ctx, recipient)
+	require.False(t, found)
+
+	// the count resets in the next block
+	next := in.ctx.WithBlockHeight(in.ctx.BlockHeight() + 1)
+	for i := 0; i < 2; i++ {
+		_, err = in.keeper.Drip(next, newRecipient())
+		require.NoError(t, err)
+	}
+	_, err = in.keeper.Drip(next, newRecipient())
+	require.ErrorIs(t, err, types.ErrRateLimited)
+}
+
+func TestDripInsufficientFunds(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 15)
+
+	_, err := in.keeper.Drip(in.ctx, newRecipient())
+	require.NoError(t, err)
+
+	recipient := newRecipient()
+	_, err = in.keeper.Drip(in.ctx, recipient)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.True(t, in.bankKeeper.GetAllBalances(in.ctx, recipient).IsZero())
+
+	// a failed drip neither starts the cooldown of the recipient nor counts in the block
+	_, found := in.keeper.GetLastDrip(in.ctx, recipient)
+	require.False(t, found)
+	in.fundFaucet(t, 5)
+	_, err = in.keeper.Drip(in.ctx, recipient)
+	require.NoError(t, err)
+}
+
+func TestPruneLastDrips(t *testing.T) {
+	in := createTestInput(t)
+	in.fundFaucet(t, 1000)
+	early, late := newRecipient(), newRecipient()
+
+	_, err := in.keeper.Drip(in.ctx.WithBlockHeight(100), early)
+	require.NoError(t, err)
+	_, err = in.keeper.Drip(in.ctx.WithBlockHeight(102), late)
+	require.NoError(t, err)
+
+	lastDrips := func(height int64) map[string]int64 {
+		drips := make(map[string]int64)
+		in.keeper.IterateLastDrips(in.ctx.WithBlockHeight(height), func(recipient sdk.AccAddress, height int64) bool {
+			drips[recipient.String()] = height
+			return false
+		})
+		return drips
+	}
+
+	// the cooldown of a drip at height 100 is over at height 105
+	in.keeper.PruneLastDrips(in.ctx.WithBlockHeight(104))
+	require.Equal(t, map[string]int64{early.String(): 100, late.String(): 102}, lastDrips(104))
+
+	in.keeper.PruneLastDrips(in.ctx.WithBlockHeight(105))
+	require.Equal(t, map[string]int64{late.String(): 102}, lastDrips(105))
+
+	// a new drip moves the recipient in the index, so the old drip doesn't prune it
+	_, err = in.keeper.Drip(in.ctx.WithBlockHeight(107), late)
+	require.NoError(t, err)
+	in.keeper.PruneLastDrips(in.ctx.WithBlockHeight(111))
+	require.Equal(t, map[string]int64{late.String(): 107}, lastDrips(111))
+
+	in.keeper.PruneLastDrips(in.ctx.WithBlockHeight(112))
+	require.Empty(t, lastDrips(112))
+	require.Equal(t, int64(0), in.keeper.NextDripHeight(in.ctx.WithBlockHeight(112), late))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/faucet/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	keeper Keeper
+}
+
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{keeper}
+}
+
+func (m msgServer) Drip(goCtx context.Context, msg *types.MsgDrip) (*types.MsgDripResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := m.keeper.Drip(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDrip,
+		sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+
+	return &types.MsgDripResponse{}, nil
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrDisabled    = sdkerrors.Register(ModuleName, 1, "faucet is disabled")
+	ErrRateLimited = sdkerrors.Register(ModuleName, 2, "faucet rate limit reached")
+)
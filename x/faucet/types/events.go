@@ -0,0 +1,7 @@
+package types
+
+const (
+	EventTypeDrip = "faucet_drip"
+
+	AttributeKeyRecipient = "recipient"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis creates a default GenesisState object.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.LastDrips))
+	for _, drip := range gs.LastDrips {
+		if _, err := sdk.AccAddressFromBech32(drip.Address); err != nil {
+			return err
+		}
+		if seen[drip.Address] {
+			return fmt.Errorf("duplicate last drip of %s", drip.Address)
+		}
+		seen[drip.Address] = true
+	}
+	return nil
+}
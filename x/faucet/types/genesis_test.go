@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	specs := map[string]struct {
+		genesis GenesisState
+		expErr  bool
+	}{
+		"default": {
+			genesis: *DefaultGenesis(),
+		},
+		"last drips": {
+			genesis: GenesisState{
+				Params:    DefaultParams(),
+				LastDrips: []LastDrip{{Address: recipient, Height: 1}, {Address: sdk.AccAddress("other_recipient_____").String(), Height: 1}},
+			},
+		},
+		"invalid params": {
+			genesis: GenesisState{Params: NewParams(true, sdk.NewCoins(), -1, 1)},
+			expErr:  true,
+		},
+		"invalid address": {
+			genesis: GenesisState{
+				Params:    DefaultParams(),
+				LastDrips: []LastDrip{{Address: "invalid", Height: 1}},
+			},
+			expErr: true,
+		},
+		"duplicate recipient": {
+			genesis: GenesisState{
+				Params:    DefaultParams(),
+				LastDrips: []LastDrip{{Address: recipient, Height: 1}, {Address: recipient, Height: 2}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.genesis.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
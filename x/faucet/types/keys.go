@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "faucet"
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+)
+
+// RouterKey is the message route. Can only contain
+// alphanumeric characters.
+var RouterKey = QuerierRoute
+
+var (
+	// LastDripPrefix prefixes the height of the last drip of each recipient
+	LastDripPrefix = []byte{0x01}
+	// BlockDripsKey holds the height of the current block and the number of drips in it
+	BlockDripsKey = []byte{0x02}
+	// DripHeightPrefix indexes the recipients by the height of their last drip, to prune the drips
+	// whose cooldown is over
+	DripHeightPrefix = []byte{0x03}
+)
+
+// GetLastDripKey returns the key of the height of the last drip to a recipient
+func GetLastDripKey(recipient sdk.AccAddress) []byte {
+	return append(LastDripPrefix, recipient...)
+}
+
+// GetDripHeightKey returns the key of a recipient in the index of the last drips by height
+func GetDripHeightKey(height int64, recipient sdk.AccAddress) []byte {
+	return append(GetDripHeightPrefix(height), recipient...)
+}
+
+// GetDripHeightPrefix returns the prefix of the recipients whose last drip was at the given height
+func GetDripHeightPrefix(height int64) []byte {
+	return append(DripHeightPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgDrip = "drip"
+)
+
+var _ sdk.Msg = &MsgDrip{}
+
+// NewMsgDrip creates a message that sends the drip amount to recipient
+func NewMsgDrip(sender, recipient sdk.AccAddress) *MsgDrip {
+	return &MsgDrip{Sender: sender.String(), Recipient: recipient.String()}
+}
+
+// Route returns the RouterKey of the faucet module.
+func (m MsgDrip) Route() string { return RouterKey }
+
+// Type returns the drip message type.
+func (m MsgDrip) Type() string { return TypeMsgDrip }
+
+// ValidateBasic checks that the addresses of the drip message are valid.
+func (m MsgDrip) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "recipient")
+	}
+	return nil
+}
+
+// GetSignBytes takes a drip message and turns it into a byte array.
+func (m MsgDrip) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the sender, who pays the fee of the drip.
+func (m MsgDrip) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
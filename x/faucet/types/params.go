@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	KeyEnabled          = []byte("Enabled")
+	KeyDripAmount       = []byte("DripAmount")
+	KeyCooldownBlocks   = []byte("CooldownBlocks")
+	KeyMaxDripsPerBlock = []byte("MaxDripsPerBlock")
+)
+
+// Default limits of the faucet
+const (
+	DefaultCooldownBlocks   int64  = 14400
+	DefaultMaxDripsPerBlock uint32 = 10
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table for the faucet module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates faucet params
+func NewParams(enabled bool, dripAmount sdk.Coins, cooldownBlocks int64, maxDripsPerBlock uint32) Params {
+	return Params{
+		Enabled:          enabled,
+		DripAmount:       dripAmount,
+		CooldownBlocks:   cooldownBlocks,
+		MaxDripsPerBlock: maxDripsPerBlock,
+	}
+}
+
+// DefaultParams returns the default params, with the faucet disabled.
+// Testnets enable it in their genesis.
+func DefaultParams() Params {
+	return NewParams(false, sdk.NewCoins(), DefaultCooldownBlocks, DefaultMaxDripsPerBlock)
+}
+
+// Validate validates the faucet params
+func (p Params) Validate() error {
+	if err := validateDripAmount(p.DripAmount); err != nil {
+		return err
+	}
+	if err := validateCooldownBlocks(p.CooldownBlocks); err != nil {
+		return err
+	}
+	return validateMaxDripsPerBlock(p.MaxDripsPerBlock)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyDripAmount, &p.DripAmount, validateDripAmount),
+		paramtypes.NewParamSetPair(KeyCooldownBlocks, &p.CooldownBlocks, validateCooldownBlocks),
+		paramtypes.NewParamSetPair(KeyMaxDripsPerBlock, &p.MaxDripsPerBlock, validateMaxDripsPerBlock),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type for enabled: %T", i)
+	}
+	return nil
+}
+
+func validateDripAmount(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for drip amount: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateCooldownBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for cooldown blocks: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("cooldown blocks must not be negative: %d", v)
+	}
+	return nil
+}
+
+func validateMaxDripsPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type for max drips per block: %T", i)
+	}
+	return nil
+}
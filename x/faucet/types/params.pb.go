@@ -0,0 +1,457 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/faucet/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the faucet module.
+type Params struct {
+	// enabled turns the faucet on. It should only be set on testnets.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// drip_amount is sent to the recipient of each drip
+	DripAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=drip_amount,json=dripAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"drip_amount"`
+	// cooldown_blocks is the number of blocks an address must wait between drips
+	CooldownBlocks int64 `protobuf:"varint,3,opt,name=cooldown_blocks,json=cooldownBlocks,proto3" json:"cooldown_blocks,omitempty"`
+	// max_drips_per_block limits the drips of all addresses in a block
+	MaxDripsPerBlock uint32 `protobuf:"varint,4,opt,name=max_drips_per_block,json=maxDripsPerBlock,proto3" json:"max_drips_per_block,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d48cdef66088cb0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetDripAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DripAmount
+	}
+	return nil
+}
+
+func (m *Params) GetCooldownBlocks() int64 {
+	if m != nil {
+		return m.CooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDripsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxDripsPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "secret.faucet.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("secret/faucet/v1beta1/params.proto", fileDescriptor_8d48cdef66088cb0)
+}
+
+var fileDescriptor_8d48cdef66088cb0 = []byte{
+	// 330 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0x87, 0xe3, 0xdb, 0xab, 0xde, 0xab, 0x54, 0xfc, 0x51, 0x00, 0x29, 0x74, 0x48, 0xa3, 0x2e,
+	0x64, 0xa9, 0xdd, 0xc2, 0x13, 0x50, 0x98, 0x18, 0x50, 0x15, 0x36, 0x96, 0xc8, 0x76, 0x4c, 0x89,
+	0x9a, 0xe4, 0x44, 0xb6, 0x4b, 0xcb, 0x5b, 0xf0, 0x1c, 0x3c, 0x49, 0xc7, 0x8e, 0x4c, 0x80, 0xda,
+	0x85, 0xc7, 0x40, 0xb1, 0x1b, 0xc4, 0x64, 0xfb, 0x9c, 0xef, 0x7c, 0xfa, 0xe9, 0xd8, 0xed, 0x2b,
+	0xc1, 0xa5, 0xd0, 0xe4, 0x81, 0xce, 0xb9, 0xd0, 0xe4, 0x69, 0xc4, 0x84, 0xa6, 0x23, 0x52, 0x51,
+	0x49, 0x0b, 0x85, 0x2b, 0x09, 0x1a, 0xbc, 0x13, 0xcb, 0x60, 0xcb, 0xe0, 0x1d, 0xd3, 0x3d, 0x9e,
+	0xc2, 0x14, 0x0c, 0x41, 0xea, 0x9b, 0x85, 0xbb, 0x01, 0x07, 0x55, 0x80, 0x22, 0x8c, 0x2a, 0xf1,
+	0xa3, 0xe3, 0x90, 0x95, 0xb6, 0xdf, 0xff, 0x42, 0x6e, 0x7b, 0x62, 0xec, 0x9e, 0xef, 0xfe, 0x13,
+	0x25, 0x65, 0xb9, 0x48, 0x7d, 0x14, 0xa2, 0xe8, 0x7f, 0xdc, 0x3c, 0xbd, 0xdc, 0xed, 0xa4, 0x32,
+	0xab, 0x12, 0x5a, 0xc0, 0xbc, 0xd4, 0xfe, 0x9f, 0xb0, 0x15, 0x75, 0xce, 0x4f, 0xb1, 0x55, 0xe3,
+	0x5a, 0xdd, 0xa4, 0xc0, 0x57, 0x90, 0x95, 0xe3, 0xe1, 0xea, 0xbd, 0xe7, 0xbc, 0x7e, 0xf4, 0xa2,
+	0x69, 0xa6, 0x1f, 0xe7, 0x0c, 0x73, 0x28, 0xc8, 0x2e, 0x87, 0x3d, 0x06, 0x2a, 0x9d, 0x11, 0xfd,
+	0x5c, 0x09, 0x65, 0x06, 0x54, 0xec, 0xd6, 0xfe, 0x4b, 0xa3, 0xf7, 0xce, 0xdc, 0x03, 0x0e, 0x90,
+	0xa7, 0xb0, 0x28, 0x13, 0x96, 0x03, 0x9f, 0x29, 0xbf, 0x15, 0xa2, 0xa8, 0x15, 0xef, 0x37, 0xe5,
+	0xb1, 0xa9, 0x7a, 0x03, 0xf7, 0xa8, 0xa0, 0xcb, 0xa4, 0x1e, 0x55, 0x49, 0x25, 0xa4, 0xa5, 0xfd,
+	0xbf, 0x21, 0x8a, 0xf6, 0xe2, 0xc3, 0x82, 0x2e, 0xaf, 0xeb, 0xce, 0x44, 0x48, 0xc3, 0x8f, 0x6f,
+	0x56, 0x9b, 0x00, 0xad, 0x37, 0x01, 0xfa, 0xdc, 0x04, 0xe8, 0x65, 0x1b, 0x38, 0xeb, 0x6d, 0xe0,
+	0xbc, 0x6d, 0x03, 0xe7, 0x7e, 0xf8, 0x2b, 0xa7, 0xe2, 0x52, 0xe7, 0x94, 0x29, 0x72, 0x67, 0xb6,
+	0x7c, 0x2b, 0xf4, 0x02, 0xe4, 0x8c, 0x2c, 0x9b, 0x2f, 0x31, 0xa9, 0x59, 0xdb, 0x6c, 0xef, 0xe2,
+	0x7b, 0x00, 0xc3, 0x28, 0x10, 0xdf, 0xb0, 0x01, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDripsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDripsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CooldownBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CooldownBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DripAmount) > 0 {
+		for iNdEx := len(m.DripAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DripAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.DripAmount) > 0 {
+		for _, e := range m.DripAmount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.CooldownBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CooldownBlocks))
+	}
+	if m.MaxDripsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxDripsPerBlock))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DripAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DripAmount = append(m.DripAmount, types.Coin{})
+			if err := m.DripAmount[len(m.DripAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownBlocks", wireType)
+			}
+			m.CooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CooldownBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDripsPerBlock", wireType)
+			}
+			m.MaxDripsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDripsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
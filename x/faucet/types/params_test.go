@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	dripAmount := sdk.NewCoins(sdk.NewInt64Coin("uscrt", 10))
+
+	specs := map[string]struct {
+		params Params
+		expErr bool
+	}{
+		"default": {
+			params: DefaultParams(),
+		},
+		"enabled": {
+			params: NewParams(true, dripAmount, 100, 5),
+		},
+		"no cooldown": {
+			params: NewParams(true, dripAmount, 0, 5),
+		},
+		"negative cooldown": {
+			params: NewParams(true, dripAmount, -1, 5),
+			expErr: true,
+		},
+		"invalid drip amount denom": {
+			params: NewParams(true, sdk.Coins{sdk.Coin{Denom: "1nvalid", Amount: sdk.NewInt(10)}}, 100, 5),
+			expErr: true,
+		},
+		"zero drip amount": {
+			params: NewParams(true, sdk.Coins{sdk.Coin{Denom: "uscrt", Amount: sdk.ZeroInt()}}, 100, 5),
+			expErr: true,
+		},
+		"unsorted drip amount": {
+			params: NewParams(true, sdk.Coins{sdk.NewInt64Coin("uscrt", 1), sdk.NewInt64Coin("uatom", 1)}, 100, 5),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.params.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,11 +1,19 @@
 package mauth
 
 import (
+	"encoding/json"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 	"github.com/scrtlabs/SecretNetwork/x/mauth/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/mauth/types"
 
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
@@ -16,11 +24,11 @@ var _ porttypes.IBCModule = IBCModule{}
 
 // IBCModule implements the ICS26 interface for interchain accounts controller chains
 type IBCModule struct {
-	keeper keeper.Keeper
+	keeper *keeper.Keeper
 }
 
 // NewIBCModule creates a new IBCModule given the keeper
-func NewIBCModule(k keeper.Keeper) IBCModule {
+func NewIBCModule(k *keeper.Keeper) IBCModule {
 	return IBCModule{
 		keeper: k,
 	}
@@ -108,25 +116,135 @@ func (im IBCModule) OnRecvPacket(
 	return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot receive packet via interchain accounts authentication module"))
 }
 
-// OnAcknowledgementPacket implements the IBCModule interface
+// OnAcknowledgementPacket implements the IBCModule interface.
+// If the interchain account is owned by a contract, the contract is notified of the ack.
 func (im IBCModule) OnAcknowledgementPacket(
-	_ sdk.Context,
-	_ channeltypes.Packet,
-	_ []byte,
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
 	_ sdk.AccAddress,
 ) error {
+	ackAsJSON, err := json.Marshal(acknowledgement)
+	if err != nil {
+		return err
+	}
+
+	im.callbackContract(ctx, packet, ibcLifecycleComplete{
+		ibcLifecycleCompleteContainer{
+			Ack: &ibcLifecycleCompleteAck{
+				Channel:  packet.SourceChannel,
+				Sequence: packet.Sequence,
+				Ack:      string(ackAsJSON),
+				Success:  !ibchooks.IsAckError(acknowledgement),
+			},
+		},
+	}, wasmtypes.HandleTypeIbcWasmHooksOutgoingTransferAck)
 	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface.
+// If the interchain account is owned by a contract, the contract is notified of the timeout.
 func (im IBCModule) OnTimeoutPacket(
-	_ sdk.Context,
-	_ channeltypes.Packet,
+	ctx sdk.Context,
+	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
+	im.callbackContract(ctx, packet, ibcLifecycleComplete{
+		ibcLifecycleCompleteContainer{
+			Timeout: &ibcLifecycleCompleteTimeout{
+				Channel:  packet.SourceChannel,
+				Sequence: packet.Sequence,
+			},
+		},
+	}, wasmtypes.HandleTypeIbcWasmHooksOutgoingTransferTimeout)
 	return nil
 }
 
+// The callbacks have the same format as the ibc-hooks callbacks of outgoing transfers
+type (
+	ibcLifecycleComplete struct {
+		ibcLifecycleCompleteContainer `json:"ibc_lifecycle_complete"`
+	}
+
+	ibcLifecycleCompleteContainer struct {
+		Ack     *ibcLifecycleCompleteAck     `json:"ibc_ack,omitempty"`
+		Timeout *ibcLifecycleCompleteTimeout `json:"ibc_timeout,omitempty"`
+	}
+
+	ibcLifecycleCompleteAck struct {
+		Channel  string `json:"channel"`
+		Sequence uint64 `json:"sequence"`
+		Ack      string `json:"ack"`
+		Success  bool   `json:"success"`
+	}
+
+	ibcLifecycleCompleteTimeout struct {
+		Channel  string `json:"channel"`
+		Sequence uint64 `json:"sequence"`
+	}
+)
+
+// callbackContract sends the sudo callback to the contract owning the packet's controller port, if any.
+// A failing callback doesn't fail the ack or timeout, as that would block the channel, so the callback
+// runs in a cached context with at most CallbackGasLimit gas, and its state changes are only kept if it succeeds.
+func (im IBCModule) callbackContract(ctx sdk.Context, packet channeltypes.Packet, callback ibcLifecycleComplete, handleType wasmtypes.HandleType) {
+	contractKeeper := im.keeper.ContractKeeper()
+	if contractKeeper == nil {
+		return
+	}
+
+	owner := strings.TrimPrefix(packet.SourcePort, icatypes.PortPrefix)
+	contractAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil || contractKeeper.GetContractInfo(ctx, contractAddr) == nil {
+		// Not owned by a contract
+		return
+	}
+
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		// We are in the mempool, the light client isn't updated yet so the enclave would fail this call
+		return
+	}
+
+	msg, err := json.Marshal(callback)
+	if err != nil {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(types.CallbackGasLimit)
+	err = executeCallback(cacheCtx.WithGasMeter(gasMeter), contractKeeper, contractAddr, msg, handleType)
+	ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "ica callback")
+	if err == nil {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	} else {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				"ica-callback-error",
+				sdk.NewAttribute("contract", contractAddr.String()),
+				sdk.NewAttribute("message", string(msg)),
+				sdk.NewAttribute("error", err.Error()),
+			),
+		)
+	}
+}
+
+// executeCallback executes the contract, and returns an error if it runs out of gas
+func executeCallback(ctx sdk.Context, contractKeeper types.ContractKeeper, contractAddr sdk.AccAddress, msg []byte, handleType wasmtypes.HandleType) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "callback hit gas limit")
+		}
+	}()
+
+	// Sender is ignored by the enclave, the contract sees a null msg.sender
+	_, err = contractKeeper.Execute(ctx, contractAddr, compute.ZeroSender, msg, sdk.NewCoins(), nil, handleType)
+	return err
+}
+
 // NegotiateAppVersion implements the IBCModule interface
 func (im IBCModule) NegotiateAppVersion(
 	_ sdk.Context,
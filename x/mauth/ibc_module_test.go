@@ -0,0 +1,126 @@
+package mauth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	"github.com/scrtlabs/SecretNetwork/x/mauth/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/mauth/types"
+)
+
+// mockContractKeeper writes to its store and uses gas on each execution of its contract
+type mockContractKeeper struct {
+	storeKey sdk.StoreKey
+	contract sdk.AccAddress
+	gas      uint64
+	err      error
+	calls    int
+}
+
+func (m *mockContractKeeper) GetContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) *compute.ContractInfo {
+	if !contractAddress.Equals(m.contract) {
+		return nil
+	}
+	return &compute.ContractInfo{}
+}
+
+func (m *mockContractKeeper) Execute(ctx sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, msg []byte, _ sdk.Coins, _ []byte, _ wasmtypes.HandleType) (*sdk.Result, error) {
+	m.calls++
+	// the store is used without gas, so that only the gas used by the contract is charged
+	ctx.MultiStore().GetKVStore(m.storeKey).Set([]byte("callback"), msg)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("callback"))
+	ctx.GasMeter().ConsumeGas(m.gas, "callback")
+	return &sdk.Result{}, m.err
+}
+
+func setupCallbackTest(t *testing.T, contractKeeper *mockContractKeeper) (sdk.Context, IBCModule, channeltypes.Packet) {
+	storeKey := sdk.NewKVStoreKey("callback")
+	contractKeeper.storeKey = storeKey
+	contractKeeper.contract = sdk.AccAddress("contract____________")
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, tmproto.Header{Height: 1}, false, log.NewNopLogger()).WithGasMeter(sdk.NewGasMeter(10 * types.CallbackGasLimit))
+
+	k := keeper.Keeper{}
+	k.SetContractKeeper(contractKeeper)
+	packet := channeltypes.Packet{
+		SourcePort:    icatypes.PortPrefix + contractKeeper.contract.String(),
+		SourceChannel: "channel-0",
+		Sequence:      1,
+	}
+	return ctx, NewIBCModule(&k), packet
+}
+
+func callbackErrors(ctx sdk.Context) []string {
+	var errs []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "ica-callback-error" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == "error" {
+				errs = append(errs, string(attr.Value))
+			}
+		}
+	}
+	return errs
+}
+
+func TestCallbackContract(t *testing.T) {
+	contractKeeper := &mockContractKeeper{gas: 1000}
+	ctx, module, packet := setupCallbackTest(t, contractKeeper)
+
+	require.NoError(t, module.OnTimeoutPacket(ctx, packet, nil))
+	require.Equal(t, 1, contractKeeper.calls)
+	require.Empty(t, callbackErrors(ctx))
+
+	// the state changes and events of the callback are kept, and its gas is charged
+	require.Contains(t, string(ctx.MultiStore().GetKVStore(contractKeeper.storeKey).Get([]byte("callback"))), "ibc_timeout")
+	require.Equal(t, "callback", ctx.EventManager().Events()[0].Type)
+	require.Equal(t, uint64(1000), ctx.GasMeter().GasConsumed())
+
+	// packets of accounts not owned by a contract don't call back
+	packet.SourcePort = icatypes.PortPrefix + sdk.AccAddress("not a contract______").String()
+	require.NoError(t, module.OnTimeoutPacket(ctx, packet, nil))
+	require.Equal(t, 1, contractKeeper.calls)
+}
+
+func TestCallbackContractFailure(t *testing.T) {
+	contractKeeper := &mockContractKeeper{gas: 1000, err: errors.New("contract failed")}
+	ctx, module, packet := setupCallbackTest(t, contractKeeper)
+
+	// a failing callback doesn't fail the ack, and its state changes are discarded
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	require.NoError(t, module.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil))
+	require.Equal(t, 1, contractKeeper.calls)
+	require.Equal(t, []string{"contract failed"}, callbackErrors(ctx))
+	require.Nil(t, ctx.MultiStore().GetKVStore(contractKeeper.storeKey).Get([]byte("callback")))
+	require.Equal(t, uint64(1000), ctx.GasMeter().GasConsumed())
+}
+
+func TestCallbackContractOutOfGas(t *testing.T) {
+	contractKeeper := &mockContractKeeper{gas: types.CallbackGasLimit + 1}
+	ctx, module, packet := setupCallbackTest(t, contractKeeper)
+
+	// the callback may not use more than CallbackGasLimit, whatever the gas left in the tx
+	require.NoError(t, module.OnTimeoutPacket(ctx, packet, nil))
+	errs := callbackErrors(ctx)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "callback hit gas limit")
+	require.Nil(t, ctx.MultiStore().GetKVStore(contractKeeper.storeKey).Get([]byte("callback")))
+	require.Equal(t, types.CallbackGasLimit, ctx.GasMeter().GasConsumed())
+}
@@ -7,6 +7,8 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/controller/keeper"
+
+	"github.com/scrtlabs/SecretNetwork/x/mauth/types"
 )
 
 type Keeper struct {
@@ -16,6 +18,7 @@ type Keeper struct {
 
 	scopedKeeper        capabilitykeeper.ScopedKeeper
 	icaControllerKeeper icacontrollerkeeper.Keeper
+	contractKeeper      types.ContractKeeper
 }
 
 func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, iaKeeper icacontrollerkeeper.Keeper, scopedKeeper capabilitykeeper.ScopedKeeper) Keeper {
@@ -28,6 +31,17 @@ func NewKeeper(cdc codec.Codec, storeKey sdk.StoreKey, iaKeeper icacontrollerkee
 	}
 }
 
+// SetContractKeeper sets the compute keeper used to notify contracts of the acks and timeouts
+// of their interchain account packets. It is set after the compute keeper is created.
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
+// ContractKeeper returns the compute keeper, nil if it isn't set
+func (k Keeper) ContractKeeper() types.ContractKeeper {
+	return k.contractKeeper
+}
+
 // ClaimCapability claims the channel capability passed via the OnOpenChanInit callback
 func (k *Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
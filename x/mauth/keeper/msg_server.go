@@ -67,10 +67,10 @@ func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*typ
 		Data: data,
 	}
 
-	_, err = k.icaControllerKeeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, packetData, ^uint64(0))
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, msg.ConnectionId, portID, packetData, ^uint64(0))
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSubmitTxResponse{}, nil
+	return &types.MsgSubmitTxResponse{Sequence: sequence}, nil
 }
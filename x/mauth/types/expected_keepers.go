@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+)
+
+// ContractKeeper is the part of the compute keeper used to call back contracts that own an interchain account
+type ContractKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *compute.ContractInfo
+	Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, callbackSig []byte, handleType wasmtypes.HandleType) (*sdk.Result, error)
+}
@@ -9,3 +9,6 @@ const (
 
 	QuerierRoute = ModuleName
 )
+
+// CallbackGasLimit is the most gas the callback of a contract on the ack or timeout of its packet may use
+const CallbackGasLimit uint64 = 1_000_000
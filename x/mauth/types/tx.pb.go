@@ -153,6 +153,8 @@ var xxx_messageInfo_MsgSubmitTx proto.InternalMessageInfo
 
 // MsgSubmitTxResponse defines the MsgSubmitTx response type
 type MsgSubmitTxResponse struct {
+	// sequence of the ICA packet, it is used to match the packet with its ack or timeout callback
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *MsgSubmitTxResponse) Reset()         { *m = MsgSubmitTxResponse{} }
@@ -198,39 +200,39 @@ func init() {
 func init() { proto.RegisterFile("secret/intertx/v1beta1/tx.proto", fileDescriptor_40e9982773ad08e4) }
 
 var fileDescriptor_40e9982773ad08e4 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0x46, 0xfc, 0xbb, 0x16, 0x21, 0x99, 0x80, 0x8c, 0x55, 0x39, 0xc5, 0x48, 0x80,
-	0x40, 0xb9, 0x53, 0xc2, 0x86, 0xc4, 0x90, 0x2c, 0x88, 0x21, 0x48, 0xb8, 0x4c, 0x2c, 0xd5, 0xf9,
-	0x7c, 0x5c, 0xad, 0xc6, 0x77, 0xd1, 0xbd, 0xe7, 0xd6, 0x59, 0x18, 0xe0, 0x0b, 0x20, 0xb1, 0x31,
-	0x32, 0xf1, 0x25, 0xd8, 0x3b, 0x56, 0x62, 0x61, 0xaa, 0x20, 0xe1, 0x13, 0x30, 0x32, 0xa1, 0xd8,
-	0x67, 0x13, 0x5a, 0x84, 0x3a, 0x30, 0xd9, 0x8f, 0x9e, 0x9f, 0xee, 0x7d, 0xde, 0x3f, 0xa8, 0x0b,
-	0x9c, 0x69, 0x6e, 0x48, 0x2a, 0x0d, 0xd7, 0xa6, 0x20, 0xfb, 0xfd, 0x98, 0x1b, 0xda, 0x27, 0xa6,
-	0xc0, 0x53, 0xad, 0x8c, 0x72, 0xaf, 0x57, 0x00, 0xb6, 0x00, 0xb6, 0x80, 0xdf, 0x11, 0x4a, 0xa8,
-	0x12, 0x21, 0xcb, 0xbf, 0x8a, 0xf6, 0x6f, 0x08, 0xa5, 0xc4, 0x84, 0x93, 0x52, 0xc5, 0xf9, 0x4b,
-	0x42, 0xe5, 0xcc, 0x5a, 0x9b, 0xd6, 0xa2, 0xd3, 0x94, 0x50, 0x29, 0x95, 0xa1, 0x26, 0x55, 0x12,
-	0xac, 0x1b, 0x30, 0x05, 0x99, 0x02, 0x12, 0x53, 0xe0, 0x4d, 0x08, 0xa6, 0x52, 0x59, 0x3f, 0x5c,
-	0xf9, 0x3b, 0x55, 0xc5, 0x4a, 0x54, 0x56, 0xf8, 0xc6, 0x41, 0xee, 0x18, 0x44, 0xc4, 0x45, 0x0a,
-	0x86, 0xeb, 0x21, 0x63, 0x2a, 0x97, 0xc6, 0xed, 0xa0, 0x73, 0xea, 0x40, 0x72, 0xed, 0x39, 0x5b,
-	0xce, 0xdd, 0x4b, 0x51, 0x25, 0xdc, 0x47, 0xe8, 0x32, 0x53, 0x52, 0x72, 0xb6, 0x2c, 0xbe, 0x93,
-	0x26, 0xde, 0xda, 0xd2, 0x1d, 0x79, 0x3f, 0x8e, 0xbb, 0x9d, 0x19, 0xcd, 0x26, 0x0f, 0xc3, 0x3f,
-	0xec, 0x30, 0xda, 0xf8, 0xad, 0x9f, 0x24, 0xae, 0x87, 0x2e, 0xec, 0x73, 0x0d, 0xa9, 0x92, 0x5e,
-	0xbb, 0x7c, 0xb6, 0x96, 0xe1, 0x26, 0xf2, 0x4f, 0x87, 0x88, 0x38, 0x4c, 0x95, 0x04, 0x1e, 0x7e,
-	0x72, 0xd0, 0xfa, 0x18, 0xc4, 0x76, 0x1e, 0x67, 0xa9, 0x79, 0x5e, 0xb8, 0x8f, 0x57, 0xc3, 0x6d,
-	0x8c, 0xfa, 0x3f, 0x8f, 0xbb, 0x3d, 0x91, 0x9a, 0xdd, 0x3c, 0xc6, 0x4c, 0x65, 0xb6, 0x3f, 0xfb,
-	0xe9, 0x41, 0xb2, 0x47, 0xcc, 0x6c, 0xca, 0x01, 0x0f, 0x19, 0x1b, 0x26, 0x89, 0xe6, 0x00, 0xff,
-	0xa9, 0x9f, 0xdb, 0xa8, 0x9d, 0x81, 0x28, 0x7b, 0x59, 0x1f, 0x74, 0x70, 0xb5, 0x22, 0x5c, 0x6f,
-	0x0f, 0x0f, 0xe5, 0x2c, 0x5a, 0x02, 0xe1, 0x35, 0x74, 0x75, 0x25, 0x7e, 0xdd, 0xd6, 0xe0, 0xc3,
-	0x1a, 0x6a, 0x8f, 0x41, 0xb8, 0xef, 0x1d, 0x74, 0xe5, 0xe4, 0xfc, 0xef, 0xe1, 0xbf, 0x5f, 0x0e,
-	0x3e, 0x3d, 0x26, 0x7f, 0x70, 0x76, 0xb6, 0x19, 0xe9, 0x9d, 0xd7, 0x9f, 0xbf, 0xbf, 0x5b, 0xbb,
-	0x19, 0x76, 0x49, 0x46, 0x73, 0xb3, 0xdb, 0x1c, 0x8d, 0xb6, 0x7c, 0x8f, 0xda, 0x20, 0xaf, 0xd0,
-	0xc5, 0x66, 0xee, 0xb7, 0xfe, 0x51, 0xa8, 0x86, 0xfc, 0xfb, 0x67, 0x80, 0x9a, 0x18, 0x5b, 0x65,
-	0x0c, 0x3f, 0xf4, 0x4e, 0xc4, 0x80, 0x12, 0xec, 0x99, 0x62, 0xf4, 0xec, 0xf0, 0x5b, 0xd0, 0xfa,
-	0x38, 0x0f, 0x9c, 0xc3, 0x79, 0xe0, 0x1c, 0xcd, 0x03, 0xe7, 0xeb, 0x3c, 0x70, 0xde, 0x2e, 0x82,
-	0xd6, 0xd1, 0x22, 0x68, 0x7d, 0x59, 0x04, 0xad, 0x17, 0x64, 0x65, 0xf5, 0xc0, 0xb4, 0x99, 0xd0,
-	0x18, 0xc8, 0x76, 0x99, 0xe1, 0x29, 0x37, 0x07, 0x4a, 0xef, 0x91, 0xc2, 0x3e, 0x5f, 0xde, 0x41,
-	0x7c, 0xbe, 0xdc, 0xd0, 0x83, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xcd, 0xbc, 0xa6, 0xbe,
-	0x03, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x84, 0x1f, 0xe5, 0x5a, 0x84, 0x64, 0x22, 0x64, 0xac, 0xca, 0x29, 0x46, 0x02,
+	0x04, 0xca, 0x9d, 0x12, 0x36, 0x24, 0x86, 0x64, 0x41, 0x0c, 0x41, 0xc2, 0x65, 0x62, 0xa9, 0xce,
+	0x97, 0xe3, 0x6a, 0x35, 0xbe, 0x0b, 0xf7, 0xce, 0x6d, 0xb2, 0x30, 0xc0, 0x3f, 0x80, 0xc4, 0xc6,
+	0xc8, 0xc4, 0x3f, 0xc1, 0xde, 0xb1, 0x12, 0x0b, 0x53, 0x05, 0x09, 0x7f, 0x01, 0x23, 0x13, 0xca,
+	0xf9, 0x62, 0x42, 0x8b, 0x50, 0x07, 0x26, 0xfb, 0xab, 0xef, 0x47, 0xef, 0x7d, 0xdf, 0xbd, 0x87,
+	0x5a, 0xc0, 0x99, 0xe6, 0x86, 0x64, 0xd2, 0x70, 0x6d, 0x26, 0x64, 0xbf, 0x93, 0x72, 0x43, 0x3b,
+	0xc4, 0x4c, 0xf0, 0x58, 0x2b, 0xa3, 0xfc, 0x6b, 0x25, 0x80, 0x1d, 0x80, 0x1d, 0x10, 0x36, 0x85,
+	0x12, 0xca, 0x22, 0x64, 0xf1, 0x57, 0xd2, 0xe1, 0x75, 0xa1, 0x94, 0x18, 0x71, 0x62, 0x55, 0x5a,
+	0xbc, 0x20, 0x54, 0x4e, 0x9d, 0xb5, 0xe9, 0x2c, 0x3a, 0xce, 0x08, 0x95, 0x52, 0x19, 0x6a, 0x32,
+	0x25, 0xc1, 0xb9, 0x11, 0x53, 0x90, 0x2b, 0x20, 0x29, 0x05, 0x5e, 0x85, 0x60, 0x2a, 0x93, 0xcb,
+	0xc2, 0xa5, 0xbf, 0x53, 0x76, 0x2c, 0x45, 0x69, 0xc5, 0x6f, 0x3c, 0xe4, 0x0f, 0x40, 0x24, 0x5c,
+	0x64, 0x60, 0xb8, 0xee, 0x31, 0xa6, 0x0a, 0x69, 0xfc, 0x26, 0x3a, 0xaf, 0x0e, 0x24, 0xd7, 0x81,
+	0xb7, 0xe5, 0xdd, 0xb9, 0x94, 0x94, 0xc2, 0x7f, 0x88, 0x2e, 0x33, 0x25, 0x25, 0x67, 0x8b, 0xe6,
+	0x3b, 0xd9, 0x30, 0xa8, 0x2f, 0xdc, 0x7e, 0xf0, 0xe3, 0xb8, 0xd5, 0x9c, 0xd2, 0x7c, 0xf4, 0x20,
+	0xfe, 0xc3, 0x8e, 0x93, 0x8d, 0xdf, 0xfa, 0xf1, 0xd0, 0x0f, 0xd0, 0xc5, 0x7d, 0xae, 0x21, 0x53,
+	0x32, 0x68, 0xd8, 0xb2, 0x4b, 0x19, 0x6f, 0xa2, 0xf0, 0x74, 0x88, 0x84, 0xc3, 0x58, 0x49, 0xe0,
+	0xf1, 0x27, 0x0f, 0xad, 0x0f, 0x40, 0x6c, 0x17, 0x69, 0x9e, 0x99, 0x67, 0x13, 0xff, 0xd1, 0x6a,
+	0xb8, 0x8d, 0x7e, 0xe7, 0xe7, 0x71, 0xab, 0x2d, 0x32, 0xb3, 0x5b, 0xa4, 0x98, 0xa9, 0xdc, 0xcd,
+	0xe7, 0x3e, 0x6d, 0x18, 0xee, 0x11, 0x33, 0x1d, 0x73, 0xc0, 0x3d, 0xc6, 0x7a, 0xc3, 0xa1, 0xe6,
+	0x00, 0xff, 0x69, 0x9e, 0x5b, 0xa8, 0x91, 0x83, 0xb0, 0xb3, 0xac, 0x77, 0x9b, 0xb8, 0x5c, 0x11,
+	0x5e, 0x6e, 0x0f, 0xf7, 0xe4, 0x34, 0x59, 0x00, 0x71, 0x07, 0x5d, 0x5d, 0x89, 0xbf, 0x1c, 0xcb,
+	0x0f, 0xd1, 0x1a, 0xf0, 0x97, 0x05, 0x97, 0x8c, 0xdb, 0x49, 0xce, 0x25, 0x95, 0xee, 0x7e, 0xa8,
+	0xa3, 0xc6, 0x00, 0x84, 0xff, 0xde, 0x43, 0x57, 0x4e, 0xee, 0xe6, 0x2e, 0xfe, 0xfb, 0x55, 0xe1,
+	0xd3, 0x4f, 0x18, 0x76, 0xcf, 0xce, 0x56, 0xcf, 0x7d, 0xfb, 0xf5, 0xe7, 0xef, 0xef, 0xea, 0x37,
+	0xe2, 0x16, 0xc9, 0x69, 0x61, 0x76, 0xab, 0x83, 0xd2, 0x8e, 0x6f, 0x53, 0x17, 0xe4, 0x15, 0x5a,
+	0xab, 0x76, 0x72, 0xf3, 0x1f, 0x8d, 0x96, 0x50, 0x78, 0xef, 0x0c, 0x50, 0x15, 0x63, 0xcb, 0xc6,
+	0x08, 0xe3, 0xe0, 0x44, 0x0c, 0xb0, 0x60, 0xdb, 0x4c, 0xfa, 0x4f, 0x0f, 0xbf, 0x45, 0xb5, 0x8f,
+	0xb3, 0xc8, 0x3b, 0x9c, 0x45, 0xde, 0xd1, 0x2c, 0xf2, 0xbe, 0xce, 0x22, 0xef, 0xed, 0x3c, 0xaa,
+	0x1d, 0xcd, 0xa3, 0xda, 0x97, 0x79, 0x54, 0x7b, 0x4e, 0x56, 0xce, 0x02, 0x98, 0x36, 0x23, 0x9a,
+	0x02, 0xd9, 0xb6, 0x19, 0x9e, 0x70, 0x73, 0xa0, 0xf4, 0x1e, 0x99, 0xb8, 0xf2, 0xf6, 0x46, 0xd2,
+	0x0b, 0x76, 0x7b, 0xf7, 0x7f, 0x0d, 0x00, 0xf9, 0xd8, 0xc1, 0xfe, 0xda, 0x03, 0x00, 0x00,
 }
 
 func (this *MsgRegisterAccount) Equal(that interface{}) bool {
@@ -333,6 +335,9 @@ func (this *MsgSubmitTxResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
 	return true
 }
 
@@ -590,6 +595,11 @@ func (m *MsgSubmitTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -661,6 +671,9 @@ func (m *MsgSubmitTxResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -1047,6 +1060,25 @@ func (m *MsgSubmitTxResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgSubmitTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
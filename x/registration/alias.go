@@ -49,6 +49,7 @@ var (
 	IsHexString                 = keeper.IsHexString
 	GetApiKey                   = types.GetApiKey
 	GetSpid                     = types.GetSpid
+	DefaultParams               = types.DefaultParams
 	// variable aliases
 	ModuleCdc               = types.ModuleCdc
 	DefaultCodespace        = types.DefaultCodespace
@@ -68,4 +69,5 @@ type (
 	MasterKey            = types.MasterKey
 	Key                  = types.Key
 	RegistrationNodeInfo = types.RegistrationNodeInfo //nolint:all
+	EnclaveEvidence      = types.EnclaveEvidence
 )
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
@@ -29,6 +30,9 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdEncryptedSeed(),
 		GetCmdMasterParams(),
+		GetCmdAttestationStatus(),
+		GetCmdSeedExchangeStatus(),
+		GetCmdNetworkKey(),
 	)
 	return queryCmd
 }
@@ -107,6 +111,129 @@ func GetCmdMasterParams() *cobra.Command {
 	return cmd
 }
 
+// GetCmdAttestationStatus shows the age and expiry of a node's attestation
+func GetCmdAttestationStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestation-status [node-id]",
+		Short: "Get the age and expiry of a node's attestation",
+		Long:  "Get the registration height and time of a node, and the age and expiry of its attestation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeId := args[0]
+			if len(nodeId) != types.PublicKeyLength {
+				return fmt.Errorf("invalid Node ID format (req: hex string of length %d)", types.PublicKeyLength)
+			}
+			pubKey, err := hex.DecodeString(nodeId)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AttestationStatus(
+				context.Background(),
+				&types.QueryAttestationStatusRequest{
+					PubKey: pubKey,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSeedExchangeStatus shows whether a node completed the encrypted seed exchange
+func GetCmdSeedExchangeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seed-exchange-status [node-id]",
+		Short: "Get whether a node completed the encrypted seed exchange",
+		Long: `Get whether a node completed the encrypted seed exchange and at which height.
+Exits with a non-zero code if the exchange wasn't completed, so it can be used to gate
+the start of a node on its registration.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid Node ID format (req: hex string): %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SeedExchangeStatus(
+				context.Background(),
+				&types.QuerySeedExchangeStatusRequest{
+					PubKey: pubKey,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+			if !res.Completed {
+				return errors.New("seed exchange not completed")
+			}
+			return nil
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdNetworkKey shows the IO master key with the attestation of a registered node holding it
+func GetCmdNetworkKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "network-key [node-id, optional]",
+		Short: "Get the IO master key with the attestation of a node holding it",
+		Long: `Get the IO master key, which txs to contracts are encrypted to, with the attestation certificate
+and enclave measurements of a registered node, by default the node that registered last. Every enclave
+that got the consensus seed derives the same key, so verifying the certificate and the measurements
+validates the key without trusting the node serving the query.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nodeID []byte
+			if len(args) == 1 {
+				nodeID, err = hex.DecodeString(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid Node ID format (req: hex string): %w", err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NetworkKey(
+				context.Background(),
+				&types.QueryNetworkKeyRequest{
+					NodeID: nodeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
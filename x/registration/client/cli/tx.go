@@ -1,15 +1,20 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	"github.com/spf13/cobra"
 )
 
+const flagHeight = "height"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -21,6 +26,8 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		AuthenticateNodeCmd(),
+		RevokeNodeCmd(),
+		SubmitEnclaveEvidenceCmd(),
 	)
 	return txCmd
 }
@@ -60,3 +67,89 @@ func AuthenticateNodeCmd() *cobra.Command {
 
 	return cmd
 }
+
+// RevokeNodeCmd revokes the registration of a node registered by the sender
+func RevokeNodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [node-id]",
+		Short: "Revoke the registration of a node you registered",
+		Long: `Revoke the registration of a node you registered. Its encrypted seed is deleted and the
+node can't register again. Nodes registered by other accounts can be revoked by governance
+through the revoked_nodes param.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid Node ID format (req: hex string): %w", err)
+			}
+
+			msg := types.MsgRevokeNodeRegistration{
+				Sender: clientCtx.GetFromAddress(),
+				PubKey: pubKey,
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SubmitEnclaveEvidenceCmd submits evidence that the enclave of a registered node isn't trusted anymore
+func SubmitEnclaveEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-evidence [node-id] [cert file, optional]",
+		Short: "Submit evidence that the enclave of a registered node is not trusted",
+		Long: `Submit evidence that the enclave of a registered node is not trusted, which revokes its
+registration. Without a certificate, the evidence is against the certificate the node registered
+with, which must fail verification or be of an enclave that isn't accepted by the params. With a
+certificate, it must be a valid attestation of an enclave that holds the key of the node, and
+isn't accepted by the params.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nodeID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid Node ID format (req: hex string): %w", err)
+			}
+
+			evidence := types.EnclaveEvidence{NodeID: nodeID}
+			if len(args) > 1 {
+				evidence.Certificate, err = os.ReadFile(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			evidence.Height, _ = cmd.Flags().GetInt64(flagHeight)
+
+			msg, err := evidencetypes.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), &evidence)
+			if err != nil {
+				return err
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "height at which the misbehavior was observed")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -27,6 +28,9 @@ func NewHandler(k Keeper) sdk.Handler {
 		case *MsgRaAuthenticate:
 			return handleRaAuthenticate(ctx, k, msg)
 
+		case *types.MsgRevokeNodeRegistration:
+			return handleRevokeNodeRegistration(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -34,6 +38,11 @@ func NewHandler(k Keeper) sdk.Handler {
 	}
 }
 
+// NewEvidenceHandler returns the x/evidence handler of EnclaveEvidence
+func NewEvidenceHandler(k Keeper) evidencetypes.Handler {
+	return k.HandleEnclaveEvidence
+}
+
 // filterMessageEvents returns the same events with all of type == EventTypeMessage removed.
 // this is so only our top-level message event comes through
 func filteredMessageEvents(manager *sdk.EventManager) []abci.Event {
@@ -58,7 +67,7 @@ func handleRaAuthenticate(ctx sdk.Context, k Keeper, msg *types.RaAuthenticate)
 		return nil, err
 	}
 
-	encSeed, err := k.RegisterNode(ctx, msg.Certificate)
+	encSeed, err := k.RegisterNode(ctx, msg.Certificate, msg.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -78,3 +87,28 @@ func handleRaAuthenticate(ctx sdk.Context, k Keeper, msg *types.RaAuthenticate)
 		Events: ctx.EventManager().ABCIEvents(),
 	}, nil
 }
+
+func handleRevokeNodeRegistration(ctx sdk.Context, k Keeper, msg *types.MsgRevokeNodeRegistration) (*sdk.Result, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RevokeNodeRegistration(ctx, msg.PubKey, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(AttributeSigner, msg.Sender.String()),
+			sdk.NewAttribute(AttributeNodeID, fmt.Sprintf("0x%s", hex.EncodeToString(msg.PubKey))),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().ABCIEvents(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// AttestationStatus returns the age and expiry of a registered node's attestation
+func (k Keeper) AttestationStatus(ctx sdk.Context, publicKey types.NodeID) (*types.QueryAttestationStatusResponse, error) {
+	regInfo := k.getRegistrationInfo(ctx, publicKey)
+	if regInfo == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "node is not registered")
+	}
+
+	return k.attestationStatus(ctx, *regInfo), nil
+}
+
+func (k Keeper) attestationStatus(ctx sdk.Context, regInfo types.RegistrationNodeInfo) *types.QueryAttestationStatusResponse {
+	status := &types.QueryAttestationStatusResponse{
+		RegistrationHeight: regInfo.RegistrationHeight,
+		RegistrationTime:   regInfo.RegistrationTime,
+	}
+
+	// The registration time isn't known for nodes that registered before it was recorded
+	if regInfo.RegistrationHeight == 0 {
+		return status
+	}
+
+	status.Age = ctx.BlockTime().Sub(regInfo.RegistrationTime)
+	if expiresAt, ok := k.GetParams(ctx).AttestationExpiry(regInfo.RegistrationTime); ok {
+		status.ExpiresAt = &expiresAt
+		status.Expired = !ctx.BlockTime().Before(expiresAt)
+	}
+
+	return status
+}
+
+// NetworkKey returns the IO master key with the attestation of a registered node, by default the
+// node that registered last. The key itself isn't attested, but every enclave that got the consensus
+// seed derives it, so the attestation of any of them vouches for it.
+func (k Keeper) NetworkKey(ctx sdk.Context, nodeID types.NodeID) (*types.QueryNetworkKeyResponse, error) {
+	ioKey := k.GetMasterKey(ctx, types.MasterIoKeyId)
+	if ioKey == nil {
+		return nil, sdkerrors.Wrap(types.ErrNotFound, "chain has not been initialized yet")
+	}
+	res := &types.QueryNetworkKeyResponse{IoMasterKey: ioKey.Bytes}
+
+	var regInfo *types.RegistrationNodeInfo
+	if len(nodeID) != 0 {
+		regInfo = k.getRegistrationInfo(ctx, nodeID)
+		if regInfo == nil {
+			return nil, sdkerrors.Wrap(types.ErrNotFound, "node is not registered")
+		}
+		res.NodeID = nodeID
+	} else {
+		k.ListRegistrationInfo(ctx, func(id []byte, info types.RegistrationNodeInfo) bool {
+			if k.IsNodeRevoked(ctx, id) {
+				return false
+			}
+			if regInfo == nil || info.RegistrationHeight > regInfo.RegistrationHeight {
+				info := info
+				regInfo = &info
+				res.NodeID = append([]byte{}, id...)
+			}
+			return false
+		})
+		if regInfo == nil {
+			return res, nil
+		}
+	}
+
+	res.Certificate = regInfo.Certificate
+	res.RegistrationHeight = regInfo.RegistrationHeight
+
+	// certificates of nodes that registered before combined certificates have no measurements to extract
+	measurements, err := ra.ExtractMeasurements(regInfo.Certificate)
+	if err == nil && measurements != nil {
+		res.Measurements = &types.EnclaveMeasurements{
+			MrEnclave: hex.EncodeToString(measurements.MrEnclave),
+			MrSigner:  hex.EncodeToString(measurements.MrSigner),
+			IsvSvn:    uint32(measurements.IsvSvn),
+		}
+	}
+
+	return res, nil
+}
+
+// SetAttestationGauges reports the age and remaining validity of the local node's attestation,
+// so operators can re-attest before it expires
+func (k Keeper) SetAttestationGauges(ctx sdk.Context) {
+	if k.nodeID == nil {
+		return
+	}
+
+	regInfo := k.getRegistrationInfo(ctx, k.nodeID)
+	if regInfo == nil || regInfo.RegistrationHeight == 0 {
+		return
+	}
+
+	status := k.attestationStatus(ctx, *regInfo)
+	telemetry.SetGauge(float32(status.Age.Seconds()), types.ModuleName, "attestation", "age_seconds")
+	if status.ExpiresAt != nil {
+		telemetry.SetGauge(float32(status.ExpiresAt.Sub(ctx.BlockTime()).Seconds()), types.ModuleName, "attestation", "expires_in_seconds")
+	}
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// EventTypeEnclaveEvidence is emitted when the registration of a node is revoked on evidence
+const EventTypeEnclaveEvidence = "enclave_evidence"
+
+// HandleEnclaveEvidence is the x/evidence handler of EnclaveEvidence. It revokes the registration of the node
+// if the evidence holds, and returns an error otherwise.
+func (k Keeper) HandleEnclaveEvidence(ctx sdk.Context, evidence exported.Evidence) error {
+	e, ok := evidence.(*types.EnclaveEvidence)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidType, "unexpected evidence type %T", evidence)
+	}
+
+	regInfo := k.getRegistrationInfo(ctx, e.NodeID)
+	if regInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "node is not registered")
+	}
+
+	reason, err := k.enclaveMisbehavior(ctx, e, *regInfo)
+	if err != nil {
+		return err
+	}
+
+	k.revokeNode(ctx, e.NodeID)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeEnclaveEvidence,
+		sdk.NewAttribute("node_id", hex.EncodeToString(e.NodeID)),
+		sdk.NewAttribute("reason", reason),
+	))
+	return nil
+}
+
+// enclaveMisbehavior returns why the enclave of the node isn't trusted anymore, or an error if the evidence
+// doesn't show it
+func (k Keeper) enclaveMisbehavior(ctx sdk.Context, e *types.EnclaveEvidence, regInfo types.RegistrationNodeInfo) (string, error) {
+	if len(e.Certificate) == 0 {
+		// the evidence is against the certificate the node registered with
+		if _, err := ra.VerifyCombinedCert(regInfo.Certificate); err != nil {
+			return fmt.Sprintf("registration certificate is invalid: %s", err), nil
+		}
+		if err := k.verifyAcceptedEnclave(ctx, regInfo.Certificate); err != nil {
+			return fmt.Sprintf("registration certificate is not accepted: %s", err), nil
+		}
+		return "", sdkerrors.Wrap(types.ErrInvalid, "the registration certificate of the node is valid and accepted")
+	}
+
+	// the certificate must be a valid attestation of an enclave holding the key of the node
+	publicKey, err := ra.VerifyCombinedCert(e.Certificate)
+	if err != nil {
+		return "", sdkerrors.Wrap(types.ErrCertificateInvalid, err.Error())
+	}
+	if !bytes.Equal(publicKey, e.NodeID) {
+		return "", sdkerrors.Wrap(types.ErrInvalid, "the certificate is not of the key of the node")
+	}
+	if err := k.verifyAcceptedEnclave(ctx, e.Certificate); err != nil {
+		return fmt.Sprintf("key of the node is held by an enclave that is not accepted: %s", err), nil
+	}
+	return "", sdkerrors.Wrap(types.ErrInvalid, "the certificate is of an accepted enclave")
+}
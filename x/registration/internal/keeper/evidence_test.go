@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleEnclaveEvidence(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, regKeeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	invalidCert, err := os.ReadFile("../../testdata/attestation_cert_invalid")
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+
+	_, err = regKeeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
+	require.NoError(t, err)
+
+	// a node registered with an invalid certificate, e.g. of an enclave whose attestation is not valid anymore
+	otherNode := types.NodeID("other node public key")
+	regKeeper.SetRegistrationInfo_Verified(ctx, types.RegistrationNodeInfo{Certificate: invalidCert, EncryptedSeed: []byte("seed")}, otherNode)
+
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: []byte("unknown")})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// the certificate the node registered with is valid and accepted
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: publicKey})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// a submitted certificate of the key of the node, of an accepted enclave
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: publicKey, Certificate: cert})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// a submitted certificate that isn't a valid attestation
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: publicKey, Certificate: invalidCert})
+	require.ErrorIs(t, err, types.ErrCertificateInvalid)
+
+	// a submitted certificate of another key
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: otherNode, Certificate: cert})
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	require.False(t, regKeeper.IsNodeRevoked(ctx, publicKey))
+	require.False(t, regKeeper.IsNodeRevoked(ctx, otherNode))
+
+	// the registration certificate of the other node doesn't hold
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: otherNode}))
+	require.True(t, regKeeper.IsNodeRevoked(ctx, otherNode))
+	require.Nil(t, regKeeper.getRegistrationInfo(ctx, otherNode))
+	require.Empty(t, regKeeper.GetParams(ctx).RevokedNodes)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, EventTypeEnclaveEvidence, events[0].Type)
+
+	// the node isn't registered anymore
+	err = regKeeper.HandleEnclaveEvidence(ctx, &types.EnclaveEvidence{NodeID: otherNode})
+	require.ErrorIs(t, err, types.ErrNotFound)
+	require.False(t, regKeeper.IsNodeRevoked(ctx, publicKey))
+}
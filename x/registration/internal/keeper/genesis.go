@@ -13,9 +13,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	if data.IoMasterKey != nil && data.NodeExchMasterKey != nil {
 		keeper.SetMasterKey(ctx, *data.IoMasterKey, types.MasterIoKeyId)
 		keeper.SetMasterKey(ctx, *data.NodeExchMasterKey, types.MasterNodeKeyId)
+		keeper.SetParams(ctx, data.Params)
 		for _, storedRegInfo := range data.Registration {
 			keeper.SetRegistrationInfo(ctx, *storedRegInfo)
 		}
+		for _, publicKey := range data.RevokedNodes {
+			keeper.revokeNode(ctx, publicKey)
+		}
 	} else {
 		panic("Cannot start without MasterKey set")
 	}
@@ -27,12 +31,18 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 
 	genState.NodeExchMasterKey = keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
 	genState.IoMasterKey = keeper.GetMasterKey(ctx, types.MasterIoKeyId)
+	genState.Params = keeper.GetParams(ctx)
 
 	keeper.ListRegistrationInfo(ctx, func(pubkey []byte, regInfo types.RegistrationNodeInfo) bool {
 		genState.Registration = append(genState.Registration, &regInfo)
 		return false
 	})
 
+	keeper.IterateRevokedNodes(ctx, func(publicKey types.NodeID) bool {
+		genState.RevokedNodes = append(genState.RevokedNodes, publicKey)
+		return false
+	})
+
 	return &genState
 }
 
@@ -71,3 +71,33 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, string(data.NodeExchMasterKey.Bytes), string(data2.NodeExchMasterKey.Bytes))
 	require.Equal(t, data2.Registration, data2.Registration)
 }
+
+func TestGenesisRevokedNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	key, err := FetchRawPubKeyFromLegacyCert(cert)
+	require.NoError(t, err)
+
+	revoked := []byte("revoked node public key")
+	data := types.GenesisState{
+		IoMasterKey:       &types.MasterKey{Bytes: key},
+		NodeExchMasterKey: &types.MasterKey{Bytes: key},
+		Params:            types.DefaultParams(),
+		RevokedNodes:      [][]byte{revoked},
+	}
+	require.NoError(t, types.ValidateGenesis(data))
+
+	InitGenesis(ctx, keeper, data)
+	require.True(t, keeper.IsNodeRevoked(ctx, revoked))
+	require.False(t, keeper.IsNodeRevoked(ctx, key))
+
+	data2 := ExportGenesis(ctx, keeper)
+	require.Equal(t, data.RevokedNodes, data2.RevokedNodes)
+	require.Empty(t, data2.Params.RevokedNodes)
+}
@@ -12,29 +12,41 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 )
 
 // Keeper will have a reference to Wasmer with it's own data directory.
 type Keeper struct {
-	storeKey sdk.StoreKey
-	cdc      codec.BinaryCodec
-	enclave  EnclaveInterface
-	router   sdk.Router
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+	enclave    EnclaveInterface
+	router     sdk.Router
+	// public key of the local node's enclave, nil if it isn't known
+	nodeID types.NodeID
 }
 
 // NewKeeper creates a new contract Keeper instance
-func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, router sdk.Router, enclave EnclaveInterface, homeDir string, bootstrap bool) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, router sdk.Router, enclave EnclaveInterface, homeDir string, bootstrap bool) Keeper {
+	var nodeID types.NodeID
 	if !bootstrap {
 		InitializeNode(homeDir, enclave)
+		nodeID = localNodeID()
+	}
+
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		storeKey: storeKey,
-		cdc:      cdc,
-		router:   router,
-		enclave:  enclave,
+		storeKey:   storeKey,
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		router:     router,
+		enclave:    enclave,
+		nodeID:     nodeID,
 	}
 }
 
@@ -161,12 +173,7 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 
 	if !fileExists(legacySeedPath) {
-		sgxSecretsFolder := os.Getenv("SCRT_SGX_STORAGE")
-		if sgxSecretsFolder == "" {
-			sgxSecretsFolder = os.ExpandEnv("/opt/secret/.sgx_secrets")
-		}
-
-		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder, types.AttestationCertPath)
+		sgxAttestationCertPath := filepath.Join(sgxSecretsFolder(), types.AttestationCertPath)
 		if !fileExists(sgxAttestationCertPath) {
 			fmt.Printf("Failed to create legacy seed file. Attestation certificate does not exist in %s. Try to re-initialize the enclave\n", sgxAttestationCertPath)
 			return
@@ -189,7 +196,7 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 }
 
-func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byte, error) {
+func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate, sender sdk.AccAddress) ([]byte, error) {
 	// fmt.Println("RegisterNode")
 	var encSeed []byte
 	var publicKey []byte
@@ -206,6 +213,14 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 
 		publicKey = publicKey_
 
+		if err := k.verifyAcceptedEnclave(ctx, certificate); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrAuthenticateFailed, err.Error())
+		}
+
+		if k.IsNodeRevoked(ctx, publicKey) {
+			return nil, sdkerrors.Wrap(types.ErrAuthenticateFailed, "node registration was revoked")
+		}
+
 		isAuth, err := k.isNodeAuthenticated(ctx, publicKey)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrAuthenticateFailed, err.Error())
@@ -223,8 +238,11 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	fmt.Println("Done RegisterNode")
 	fmt.Println("Got seed: ", hex.EncodeToString(encSeed))
 	regInfo := types.RegistrationNodeInfo{
-		Certificate:   certificate,
-		EncryptedSeed: encSeed,
+		Certificate:        certificate,
+		EncryptedSeed:      encSeed,
+		RegistrationHeight: ctx.BlockHeight(),
+		RegistrationTime:   ctx.BlockTime(),
+		RegisteredBy:       sender,
 	}
 
 	if isSimulationMode(ctx) {
@@ -236,6 +254,31 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate) ([]byt
 	return encSeed, nil
 }
 
+// verifyAcceptedEnclave checks the measurements of the attested enclave against the ones accepted by governance
+func (k Keeper) verifyAcceptedEnclave(ctx sdk.Context, certificate ra.Certificate) error {
+	params := k.GetParams(ctx)
+	if len(params.AcceptedEnclaves) == 0 {
+		return nil
+	}
+
+	measurements, err := ra.ExtractMeasurements(certificate)
+	if err != nil {
+		return err
+	}
+	if measurements == nil {
+		// software mode, there is nothing to check
+		return nil
+	}
+
+	if !params.IsEnclaveAccepted(measurements.MrEnclave, measurements.MrSigner, measurements.IsvSvn) {
+		return fmt.Errorf(
+			"enclave is not accepted: mr_enclave %s, mr_signer %s, isv_svn %d",
+			hex.EncodeToString(measurements.MrEnclave), hex.EncodeToString(measurements.MrSigner), measurements.IsvSvn,
+		)
+	}
+	return nil
+}
+
 // returns true when simulation mode used by gas=auto queries
 func isSimulationMode(ctx sdk.Context) bool {
 	return ctx.GasMeter().Limit() == 0 && ctx.BlockHeight() != 0
@@ -274,6 +317,30 @@ func (k Keeper) handleSdkMessage(ctx sdk.Context, contractAddr sdk.Address, msg
 	return nil
 }
 
+// sgxSecretsFolder returns the folder where the enclave keeps its sealed files and attestation
+func sgxSecretsFolder() string {
+	folder := os.Getenv("SCRT_SGX_STORAGE")
+	if folder == "" {
+		folder = os.ExpandEnv("/opt/secret/.sgx_secrets")
+	}
+	return folder
+}
+
+// localNodeID reads the public key of the local enclave from its last attestation, or returns nil
+func localNodeID() types.NodeID {
+	cert, err := os.ReadFile(filepath.Join(sgxSecretsFolder(), types.AttestationCombinedPath))
+	if err != nil {
+		return nil
+	}
+
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	if err != nil {
+		return nil
+	}
+
+	return publicKey
+}
+
 func fetchPubKeyFromLegacyCert(cert []byte) ([]byte, error) {
 	pk, err := FetchRawPubKeyFromLegacyCert(cert)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	eng "github.com/scrtlabs/SecretNetwork/types"
@@ -87,6 +88,83 @@ func TestKeeper_RegisterNode(t *testing.T) {
 
 	regKeeper.SetRegistrationInfo(ctx, regInfo)
 
-	_, err = regKeeper.RegisterNode(ctx, cert)
+	_, err = regKeeper.RegisterNode(ctx, cert, sdk.AccAddress("sender"))
 	require.NoError(t, err)
 }
+
+func TestKeeper_AttestationStatus(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, regKeeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyRaCert(cert)
+	require.NoError(t, err)
+
+	registrationTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	regKeeper.SetRegistrationInfo(ctx, types.RegistrationNodeInfo{
+		Certificate:        cert,
+		EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		RegistrationHeight: 10,
+		RegistrationTime:   registrationTime,
+	})
+
+	ctx = ctx.WithBlockTime(registrationTime.Add(24 * time.Hour))
+
+	// attestations don't expire by default
+	status, err := regKeeper.AttestationStatus(ctx, publicKey)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), status.RegistrationHeight)
+	require.Equal(t, 24*time.Hour, status.Age)
+	require.Nil(t, status.ExpiresAt)
+	require.False(t, status.Expired)
+
+	regKeeper.SetParams(ctx, types.Params{AttestationValidityPeriod: 48 * time.Hour})
+	status, err = regKeeper.AttestationStatus(ctx, publicKey)
+	require.NoError(t, err)
+	require.Equal(t, registrationTime.Add(48*time.Hour), *status.ExpiresAt)
+	require.False(t, status.Expired)
+
+	ctx = ctx.WithBlockTime(registrationTime.Add(48 * time.Hour))
+	status, err = regKeeper.AttestationStatus(ctx, publicKey)
+	require.NoError(t, err)
+	require.True(t, status.Expired)
+
+	_, err = regKeeper.AttestationStatus(ctx, make([]byte, 32))
+	require.Error(t, err)
+}
+
+func TestKeeper_RevokeNodeRegistration(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, regKeeper := CreateTestInput(t, false, tempDir, true)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+
+	sender := sdk.AccAddress("sender")
+	_, err = regKeeper.RegisterNode(ctx, cert, sender)
+	require.NoError(t, err)
+	require.NotNil(t, regKeeper.getRegistrationInfo(ctx, publicKey))
+
+	err = regKeeper.RevokeNodeRegistration(ctx, publicKey, sdk.AccAddress("other"))
+	require.Error(t, err)
+
+	err = regKeeper.RevokeNodeRegistration(ctx, publicKey, sender)
+	require.NoError(t, err)
+	require.Nil(t, regKeeper.getRegistrationInfo(ctx, publicKey))
+	require.True(t, regKeeper.IsNodeRevoked(ctx, publicKey))
+	// the revocation is not a param, a param change can't undo it
+	require.False(t, regKeeper.GetParams(ctx).IsNodeRevoked(publicKey))
+	regKeeper.SetParams(ctx, types.DefaultParams())
+	require.True(t, regKeeper.IsNodeRevoked(ctx, publicKey))
+
+	// a revoked node can't register again
+	_, err = regKeeper.RegisterNode(ctx, cert, sender)
+	require.Error(t, err)
+}
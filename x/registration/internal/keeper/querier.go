@@ -60,6 +60,39 @@ func (q GrpcQuerier) EncryptedSeed(c context.Context, req *types.QueryEncryptedS
 	return &types.QueryEncryptedSeedResponse{EncryptedSeed: rsp}, nil
 }
 
+func (q GrpcQuerier) AttestationStatus(c context.Context, req *types.QueryAttestationStatusRequest) (*types.QueryAttestationStatusResponse, error) {
+	if req.PubKey == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "public key")
+	}
+	return q.keeper.AttestationStatus(sdk.UnwrapSDKContext(c), req.PubKey)
+}
+
+func (q GrpcQuerier) SeedExchangeStatus(c context.Context, req *types.QuerySeedExchangeStatusRequest) (*types.QuerySeedExchangeStatusResponse, error) {
+	if req.PubKey == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "public key")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QuerySeedExchangeStatusResponse{
+		Revoked: q.keeper.IsNodeRevoked(ctx, req.PubKey),
+	}
+	if regInfo := q.keeper.getRegistrationInfo(ctx, req.PubKey); regInfo != nil {
+		res.Completed = regInfo.EncryptedSeed != nil
+		res.Height = regInfo.RegistrationHeight
+	}
+	return res, nil
+}
+
+func (q GrpcQuerier) NetworkKey(c context.Context, req *types.QueryNetworkKeyRequest) (*types.QueryNetworkKeyResponse, error) {
+	return q.keeper.NetworkKey(sdk.UnwrapSDKContext(c), req.NodeID)
+}
+
+func (q GrpcQuerier) Params(c context.Context, _ *empty.Empty) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: q.keeper.GetParams(sdk.UnwrapSDKContext(c)),
+	}, nil
+}
+
 func queryMasterKey(ctx sdk.Context, keeper Keeper) (*types.GenesisState, error) {
 	ioKey := keeper.GetMasterKey(ctx, types.MasterIoKeyId)
 	nodeKey := keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
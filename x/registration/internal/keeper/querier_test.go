@@ -8,6 +8,7 @@ import (
 	"os"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
@@ -102,3 +103,89 @@ func TestNewQuerier(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, string(binResult), string(expectedSecretParams))
 }
+
+func TestGrpcQuerierSeedExchangeStatus(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	querier := NewQuerier(keeper)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyRaCert(cert)
+	require.NoError(t, err)
+
+	res, err := querier.SeedExchangeStatus(sdk.WrapSDKContext(ctx), &types.QuerySeedExchangeStatusRequest{PubKey: publicKey})
+	require.NoError(t, err)
+	require.False(t, res.Completed)
+
+	keeper.SetRegistrationInfo(ctx, types.RegistrationNodeInfo{
+		Certificate:        cert,
+		EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		RegistrationHeight: 7,
+	})
+
+	res, err = querier.SeedExchangeStatus(sdk.WrapSDKContext(ctx), &types.QuerySeedExchangeStatusRequest{PubKey: publicKey})
+	require.NoError(t, err)
+	require.True(t, res.Completed)
+	require.Equal(t, int64(7), res.Height)
+	require.False(t, res.Revoked)
+
+	keeper.SetParams(ctx, types.Params{RevokedNodes: []string{hex.EncodeToString(publicKey)}})
+
+	res, err = querier.SeedExchangeStatus(sdk.WrapSDKContext(ctx), &types.QuerySeedExchangeStatusRequest{PubKey: publicKey})
+	require.NoError(t, err)
+	require.False(t, res.Completed)
+	require.True(t, res.Revoked)
+}
+
+func TestGrpcQuerierNetworkKey(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	querier := NewQuerier(keeper)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	publicKey, err := ra.VerifyRaCert(cert)
+	require.NoError(t, err)
+
+	ioKey := []byte("io master key")
+	keeper.SetMasterKey(ctx, types.MasterKey{Bytes: ioKey}, types.MasterIoKeyId)
+
+	// no node registered yet
+	res, err := querier.NetworkKey(sdk.WrapSDKContext(ctx), &types.QueryNetworkKeyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, ioKey, res.IoMasterKey)
+	require.Empty(t, res.Certificate)
+
+	keeper.SetRegistrationInfo(ctx, types.RegistrationNodeInfo{
+		Certificate:        cert,
+		EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		RegistrationHeight: 7,
+	})
+
+	res, err = querier.NetworkKey(sdk.WrapSDKContext(ctx), &types.QueryNetworkKeyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, ioKey, res.IoMasterKey)
+	require.Equal(t, publicKey, res.NodeID)
+	require.Equal(t, cert, []byte(res.Certificate))
+	require.Equal(t, int64(7), res.RegistrationHeight)
+
+	res, err = querier.NetworkKey(sdk.WrapSDKContext(ctx), &types.QueryNetworkKeyRequest{NodeID: publicKey})
+	require.NoError(t, err)
+	require.Equal(t, publicKey, res.NodeID)
+
+	_, err = querier.NetworkKey(sdk.WrapSDKContext(ctx), &types.QueryNetworkKeyRequest{NodeID: []byte("unknown")})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// revoked nodes aren't used by default
+	keeper.SetParams(ctx, types.Params{RevokedNodes: []string{hex.EncodeToString(publicKey)}})
+
+	res, err = querier.NetworkKey(sdk.WrapSDKContext(ctx), &types.QueryNetworkKeyRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.NodeID)
+	require.Empty(t, res.Certificate)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 )
@@ -30,7 +31,12 @@ func (k Keeper) isMasterCertificateDefined(ctx sdk.Context, keyType string) bool
 	return regInfo != nil
 }
 
+// getRegistrationInfo returns the registration of a node, or nil if it isn't registered or its registration was revoked
 func (k Keeper) getRegistrationInfo(ctx sdk.Context, publicKey types.NodeID) *types.RegistrationNodeInfo {
+	if k.IsNodeRevoked(ctx, publicKey) {
+		return nil
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	var nodeInfo types.RegistrationNodeInfo
 	// fmt.Println("pubkey", hex.EncodeToString(publicKey))
@@ -82,3 +88,48 @@ func (k Keeper) isNodeAuthenticated(ctx sdk.Context, publicKey types.NodeID) (bo
 	}
 	return true, nil
 }
+
+// RevokeNodeRegistration deletes the registration and encrypted seed of a node, and prevents it from
+// registering again. Only the account that registered the node may revoke it.
+func (k Keeper) RevokeNodeRegistration(ctx sdk.Context, publicKey types.NodeID, sender sdk.AccAddress) error {
+	regInfo := k.getRegistrationInfo(ctx, publicKey)
+	if regInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "node is not registered")
+	}
+
+	if regInfo.RegisteredBy.Empty() || !regInfo.RegisteredBy.Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the account that registered the node may revoke it")
+	}
+
+	k.revokeNode(ctx, publicKey)
+	return nil
+}
+
+// revokeNode deletes the registration of a node and adds it to the revoked nodes
+func (k Keeper) revokeNode(ctx sdk.Context, publicKey types.NodeID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RegistrationKeyPrefix(publicKey))
+	store.Set(types.RevokedNodeKey(publicKey), []byte{1})
+}
+
+// IsNodeRevoked returns true if the registration of the node with the given public key was revoked,
+// by the account that registered it, on evidence or by governance through the RevokedNodes param
+func (k Keeper) IsNodeRevoked(ctx sdk.Context, publicKey []byte) bool {
+	if ctx.KVStore(k.storeKey).Has(types.RevokedNodeKey(publicKey)) {
+		return true
+	}
+	return k.GetParams(ctx).IsNodeRevoked(publicKey)
+}
+
+// IterateRevokedNodes calls cb with the public key of each node revoked by the account that registered it
+// or on evidence, until cb returns true. The nodes revoked by governance are in the RevokedNodes param.
+func (k Keeper) IterateRevokedNodes(ctx sdk.Context, cb func(publicKey types.NodeID) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevokedNodePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			break
+		}
+	}
+}
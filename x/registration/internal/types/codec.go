@@ -6,18 +6,26 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	// "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
 // RegisterCodec registers the account types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RaAuthenticate{}, "reg/authenticate", nil)
+	cdc.RegisterConcrete(&MsgRevokeNodeRegistration{}, "reg/revoke", nil)
+	cdc.RegisterConcrete(&EnclaveEvidence{}, "reg/EnclaveEvidence", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&RaAuthenticate{},
+		&MsgRevokeNodeRegistration{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Evidence)(nil),
+		&EnclaveEvidence{},
 	)
 }
 
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// TypeEnclaveEvidence is the type of EnclaveEvidence in x/evidence
+const TypeEnclaveEvidence = "enclave"
+
+var _ exported.Evidence = &EnclaveEvidence{}
+
+// Route returns the x/evidence route of EnclaveEvidence, which is handled by this module
+func (e *EnclaveEvidence) Route() string {
+	return RouterKey
+}
+
+func (e *EnclaveEvidence) Type() string {
+	return TypeEnclaveEvidence
+}
+
+// Hash returns the hash of the evidence, which x/evidence uses to reject duplicates
+func (e *EnclaveEvidence) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+func (e *EnclaveEvidence) ValidateBasic() error {
+	if len(e.NodeID) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "node id cannot be empty")
+	}
+	if e.Height < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "height cannot be negative")
+	}
+	return nil
+}
+
+func (e *EnclaveEvidence) GetHeight() int64 {
+	return e.Height
+}
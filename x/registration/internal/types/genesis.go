@@ -1,5 +1,7 @@
 package types
 
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error { //nolint:all
@@ -12,5 +14,11 @@ func ValidateGenesis(data GenesisState) error { //nolint:all
 	//	return ErrCertificateInvalid
 	//}
 
-	return nil
+	for _, publicKey := range data.RevokedNodes {
+		if len(publicKey) == 0 {
+			return sdkerrors.Wrap(ErrInvalid, "empty revoked node public key")
+		}
+	}
+
+	return data.Params.ValidateBasic()
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -27,6 +28,10 @@ type GenesisState struct {
 	Registration      []*RegistrationNodeInfo `protobuf:"bytes,1,rep,name=registration,proto3" json:"reg_info"`
 	NodeExchMasterKey *MasterKey              `protobuf:"bytes,2,opt,name=node_exch_master_key,json=nodeExchMasterKey,proto3" json:"node_exch_key"`
 	IoMasterKey       *MasterKey              `protobuf:"bytes,3,opt,name=io_master_key,json=ioMasterKey,proto3" json:"io_exch_key"`
+	Params            Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// public keys of the nodes revoked by the account that registered them or on
+	// evidence. Nodes revoked by governance are in the revoked_nodes param.
+	RevokedNodes [][]byte `protobuf:"bytes,5,rep,name=revoked_nodes,json=revokedNodes,proto3" json:"revoked_nodes,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,29 +76,32 @@ func init() {
 }
 
 var fileDescriptor_ce4400b3c39a810a = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x6a, 0xea, 0x40,
-	0x14, 0xc6, 0x13, 0x85, 0xcb, 0x25, 0x51, 0x2e, 0x06, 0x17, 0xe2, 0x85, 0x51, 0x2e, 0xdc, 0xd6,
-	0x6e, 0x12, 0xb4, 0x0f, 0x50, 0x10, 0x4a, 0x29, 0xa5, 0x2e, 0x74, 0xd7, 0x2e, 0xc2, 0x24, 0x1e,
-	0xc7, 0xa9, 0x3a, 0x47, 0x66, 0xa6, 0xad, 0x79, 0x8b, 0x3e, 0x46, 0x1f, 0xa2, 0x0f, 0xe0, 0xd2,
-	0x65, 0x57, 0xd2, 0xc6, 0x9d, 0x4f, 0x51, 0x4c, 0xa4, 0xc6, 0x4d, 0xa0, 0xbb, 0xf9, 0xf3, 0x3b,
-	0xdf, 0xef, 0xc0, 0x67, 0x9d, 0x29, 0x08, 0x25, 0x68, 0x4f, 0x02, 0xe3, 0x4a, 0x4b, 0xaa, 0x39,
-	0x0a, 0xef, 0xa9, 0x1d, 0x80, 0xa6, 0x6d, 0x8f, 0x81, 0x00, 0xc5, 0x95, 0x3b, 0x97, 0xa8, 0xd1,
-	0xf9, 0x9b, 0xa2, 0x6e, 0x16, 0x75, 0xf7, 0x68, 0xbd, 0xca, 0x90, 0x61, 0xc2, 0x79, 0xbb, 0x53,
-	0x3a, 0x52, 0x3f, 0xcd, 0x4b, 0xd7, 0xd1, 0x1c, 0xf6, 0xd9, 0xf5, 0xff, 0x79, 0xe0, 0x4c, 0xb1,
-	0x14, 0xfb, 0xf7, 0x56, 0xb0, 0x4a, 0x57, 0xe9, 0x52, 0x03, 0x4d, 0x35, 0x38, 0xa1, 0x55, 0xca,
-	0x8e, 0xd4, 0xcc, 0x66, 0xb1, 0x65, 0x77, 0xda, 0x6e, 0xce, 0xaa, 0x6e, 0x3f, 0xf3, 0xd8, 0xc3,
-	0x21, 0x5c, 0x8b, 0x11, 0x76, 0x4b, 0xdb, 0x75, 0xe3, 0xb7, 0x04, 0xe6, 0x73, 0x31, 0xc2, 0xfe,
-	0x51, 0xa8, 0xf3, 0x60, 0x55, 0x05, 0x0e, 0xc1, 0x87, 0x45, 0x38, 0xf6, 0x67, 0x54, 0x69, 0x90,
-	0xfe, 0x04, 0xa2, 0x5a, 0xa1, 0x69, 0xb6, 0xec, 0xce, 0x49, 0xae, 0xec, 0x36, 0xc1, 0x6f, 0x20,
-	0xea, 0x56, 0xb6, 0xeb, 0x46, 0xf9, 0x90, 0x33, 0x81, 0xa8, 0x5f, 0xd9, 0x5d, 0x2f, 0x17, 0xe1,
-	0xf8, 0x9b, 0x72, 0xee, 0xad, 0x32, 0xc7, 0xac, 0xa4, 0xf8, 0x23, 0xc9, 0x9f, 0xed, 0xba, 0x61,
-	0x73, 0x3c, 0x28, 0x6c, 0x8e, 0x87, 0x5f, 0xba, 0xfc, 0x24, 0xc6, 0x6b, 0x4c, 0xcc, 0x65, 0x4c,
-	0xcc, 0x55, 0x4c, 0xcc, 0x8f, 0x98, 0x98, 0x2f, 0x1b, 0x62, 0xac, 0x36, 0xc4, 0x78, 0xdf, 0x10,
-	0xe3, 0xee, 0x82, 0x71, 0x3d, 0x7e, 0x0c, 0xdc, 0x10, 0x67, 0x9e, 0x0a, 0xa5, 0x9e, 0xd2, 0x40,
-	0x79, 0x83, 0x44, 0xdd, 0x03, 0xfd, 0x8c, 0x72, 0xe2, 0x2d, 0x8e, 0x4b, 0xe2, 0x42, 0x83, 0x14,
-	0x74, 0x9a, 0xd6, 0x19, 0xfc, 0x4a, 0x8a, 0x3a, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8,
-	0xf1, 0x91, 0x58, 0x02, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcd, 0xca, 0xd3, 0x40,
+	0x14, 0x86, 0x13, 0xf3, 0x59, 0x24, 0x49, 0x91, 0x86, 0x2e, 0x42, 0x85, 0x49, 0xb1, 0xa8, 0x75,
+	0x93, 0xd0, 0x7a, 0x01, 0x62, 0x40, 0x44, 0xc4, 0x22, 0xe9, 0x4e, 0x17, 0x61, 0x92, 0x9e, 0xa6,
+	0x63, 0x9a, 0x4c, 0x99, 0x19, 0x6b, 0x73, 0x17, 0x5e, 0x84, 0x0b, 0x2f, 0xa5, 0xcb, 0x2e, 0x5d,
+	0x05, 0x4d, 0x77, 0xbd, 0x0a, 0xc9, 0x0f, 0x36, 0xdd, 0x04, 0xbe, 0x5d, 0x72, 0x78, 0xce, 0xf3,
+	0xbe, 0x0c, 0x47, 0x7d, 0xc9, 0x21, 0x64, 0x20, 0x1c, 0x06, 0x11, 0xe1, 0x82, 0x61, 0x41, 0x68,
+	0xea, 0xec, 0x67, 0x01, 0x08, 0x3c, 0x73, 0x22, 0x48, 0x81, 0x13, 0x6e, 0xef, 0x18, 0x15, 0xd4,
+	0x78, 0x52, 0xa3, 0x76, 0x1b, 0xb5, 0x1b, 0x74, 0x34, 0x8c, 0x68, 0x44, 0x2b, 0xce, 0x29, 0xbf,
+	0xea, 0x95, 0xd1, 0x8b, 0x2e, 0xbb, 0xc8, 0x76, 0xd0, 0xb8, 0x47, 0xcf, 0xba, 0xc0, 0x84, 0x47,
+	0x35, 0xf6, 0xf4, 0xa7, 0xa2, 0xea, 0xef, 0xea, 0x52, 0x4b, 0x81, 0x05, 0x18, 0xa1, 0xaa, 0xb7,
+	0x57, 0x4c, 0x79, 0xac, 0x4c, 0xb5, 0xf9, 0xcc, 0xee, 0xa8, 0x6a, 0x7b, 0xad, 0xe1, 0x82, 0xae,
+	0xe0, 0x7d, 0xba, 0xa6, 0xae, 0x7e, 0xc9, 0xad, 0x47, 0x0c, 0x22, 0x9f, 0xa4, 0x6b, 0xea, 0xdd,
+	0x48, 0x8d, 0xaf, 0xea, 0x30, 0xa5, 0x2b, 0xf0, 0xe1, 0x10, 0x6e, 0xfc, 0x04, 0x73, 0x01, 0xcc,
+	0x8f, 0x21, 0x33, 0x1f, 0x8c, 0xe5, 0xa9, 0x36, 0x7f, 0xde, 0x19, 0xf6, 0xb1, 0xc2, 0x3f, 0x40,
+	0xe6, 0x0e, 0x2e, 0xb9, 0xd5, 0xbf, 0x7a, 0x62, 0xc8, 0xbc, 0x41, 0xf9, 0xfb, 0xf6, 0x10, 0x6e,
+	0xfe, 0x53, 0xc6, 0x17, 0xb5, 0x4f, 0x68, 0x3b, 0x44, 0xb9, 0x57, 0xc8, 0xe3, 0x4b, 0x6e, 0x69,
+	0x84, 0x5e, 0x23, 0x34, 0x42, 0xaf, 0xf2, 0x37, 0x6a, 0x6f, 0x87, 0x19, 0x4e, 0xb8, 0x79, 0x57,
+	0x59, 0x27, 0x9d, 0xd6, 0x4f, 0x15, 0xea, 0xde, 0x1d, 0x73, 0x4b, 0xf2, 0x9a, 0x45, 0x63, 0xa2,
+	0xf6, 0x19, 0xec, 0x69, 0x0c, 0x2b, 0xbf, 0x2c, 0xcf, 0xcd, 0x87, 0x63, 0x65, 0xaa, 0x7b, 0x7a,
+	0x33, 0x2c, 0xdf, 0x93, 0xbb, 0xf8, 0xf8, 0x17, 0x49, 0xbf, 0x0a, 0x24, 0x1f, 0x0b, 0x24, 0x9f,
+	0x0a, 0x24, 0xff, 0x29, 0x90, 0xfc, 0xe3, 0x8c, 0xa4, 0xd3, 0x19, 0x49, 0xbf, 0xcf, 0x48, 0xfa,
+	0xfc, 0x3a, 0x22, 0x62, 0xf3, 0x2d, 0xb0, 0x43, 0x9a, 0x38, 0x3c, 0x64, 0x62, 0x8b, 0x03, 0xee,
+	0x2c, 0xab, 0x32, 0x0b, 0x10, 0xdf, 0x29, 0x8b, 0x9d, 0xc3, 0xed, 0x31, 0x90, 0x54, 0x00, 0x4b,
+	0xf1, 0xb6, 0x3e, 0x9b, 0xa0, 0x57, 0x1d, 0xc4, 0xab, 0x7f, 0x03, 0x00, 0x61, 0xf0, 0x42, 0x03,
+	0xc0, 0x02, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -129,6 +137,17 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.IoMasterKey.Equal(that1.IoMasterKey) {
 		return false
 	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	if len(this.RevokedNodes) != len(that1.RevokedNodes) {
+		return false
+	}
+	for i := range this.RevokedNodes {
+		if !bytes.Equal(this.RevokedNodes[i], that1.RevokedNodes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +170,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevokedNodes) > 0 {
+		for iNdEx := len(m.RevokedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedNodes[iNdEx])
+			copy(dAtA[i:], m.RevokedNodes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RevokedNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.IoMasterKey != nil {
 		{
 			size, err := m.IoMasterKey.MarshalToSizedBuffer(dAtA[:i])
@@ -223,6 +261,14 @@ func (m *GenesisState) Size() (n int) {
 		l = m.IoMasterKey.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RevokedNodes) > 0 {
+		for _, b := range m.RevokedNodes {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -367,6 +413,71 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedNodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedNodes = append(m.RevokedNodes, make([]byte, postIndex-iNdEx))
+			copy(m.RevokedNodes[len(m.RevokedNodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
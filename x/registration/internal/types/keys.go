@@ -20,6 +20,7 @@ const (
 var (
 	RegistrationStorePrefix     = []byte{0x01}
 	RegistrationMasterKeyPrefix = []byte{0x02}
+	RevokedNodePrefix           = []byte{0x03}
 )
 
 func RegistrationKeyPrefix(key []byte) []byte {
@@ -29,3 +30,8 @@ func RegistrationKeyPrefix(key []byte) []byte {
 func MasterKeyPrefix(key string) []byte {
 	return append(RegistrationMasterKeyPrefix, []byte(key)...)
 }
+
+// RevokedNodeKey returns the key of the revocation of the node with the given public key
+func RevokedNodeKey(publicKey []byte) []byte {
+	return append(RevokedNodePrefix, publicKey...)
+}
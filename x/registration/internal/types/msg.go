@@ -36,6 +36,34 @@ func (msg RaAuthenticate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgRevokeNodeRegistration) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeNodeRegistration) Type() string {
+	return "node-revoke"
+}
+
+func (msg MsgRevokeNodeRegistration) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return err
+	}
+
+	if len(msg.PubKey) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "node public key cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeNodeRegistration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRevokeNodeRegistration) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
 func validateCertificate(cert ra.Certificate) error {
 	// todo: add public key verification
 	_, err := ra.VerifyCombinedCert(cert)
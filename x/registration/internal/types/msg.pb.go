@@ -64,6 +64,47 @@ func (m *RaAuthenticate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RaAuthenticate proto.InternalMessageInfo
 
+// MsgRevokeNodeRegistration revokes the registration of a node. It must be sent by the account that
+// registered the node, other nodes can be revoked by governance through the revoked_nodes param or
+// on evidence.
+type MsgRevokeNodeRegistration struct {
+	Sender github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	PubKey []byte                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *MsgRevokeNodeRegistration) Reset()         { *m = MsgRevokeNodeRegistration{} }
+func (m *MsgRevokeNodeRegistration) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeNodeRegistration) ProtoMessage()    {}
+func (*MsgRevokeNodeRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{1}
+}
+func (m *MsgRevokeNodeRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeNodeRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeNodeRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeNodeRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeNodeRegistration.Merge(m, src)
+}
+func (m *MsgRevokeNodeRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeNodeRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeNodeRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeNodeRegistration proto.InternalMessageInfo
+
 type MasterKey struct {
 	Bytes []byte `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
 }
@@ -72,7 +113,7 @@ func (m *MasterKey) Reset()         { *m = MasterKey{} }
 func (m *MasterKey) String() string { return proto.CompactTextString(m) }
 func (*MasterKey) ProtoMessage()    {}
 func (*MasterKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91e653c4cfa6dfea, []int{1}
+	return fileDescriptor_91e653c4cfa6dfea, []int{2}
 }
 func (m *MasterKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,7 +150,7 @@ func (m *Key) Reset()         { *m = Key{} }
 func (m *Key) String() string { return proto.CompactTextString(m) }
 func (*Key) ProtoMessage()    {}
 func (*Key) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91e653c4cfa6dfea, []int{2}
+	return fileDescriptor_91e653c4cfa6dfea, []int{3}
 }
 func (m *Key) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,6 +181,7 @@ var xxx_messageInfo_Key proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*RaAuthenticate)(nil), "secret.registration.v1beta1.RaAuthenticate")
+	proto.RegisterType((*MsgRevokeNodeRegistration)(nil), "secret.registration.v1beta1.MsgRevokeNodeRegistration")
 	proto.RegisterType((*MasterKey)(nil), "secret.registration.v1beta1.MasterKey")
 	proto.RegisterType((*Key)(nil), "secret.registration.v1beta1.Key")
 }
@@ -149,29 +191,31 @@ func init() {
 }
 
 var fileDescriptor_91e653c4cfa6dfea = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbf, 0x4e, 0xeb, 0x30,
-	0x14, 0x87, 0xe3, 0x5b, 0xdd, 0x56, 0xd7, 0xf7, 0xea, 0x0e, 0x51, 0x87, 0x02, 0x92, 0x53, 0x2a,
-	0x21, 0xb1, 0x34, 0x56, 0xc5, 0x03, 0xa0, 0x96, 0x09, 0x21, 0x40, 0x0a, 0x1b, 0x03, 0x95, 0xe3,
-	0x1c, 0xd2, 0xa8, 0x4d, 0x5c, 0xd9, 0xa7, 0x40, 0x36, 0x1e, 0x81, 0xc7, 0xe0, 0x51, 0x3a, 0x76,
-	0x64, 0x8a, 0x20, 0xdd, 0xfa, 0x08, 0x9d, 0x50, 0xfe, 0x48, 0x94, 0x91, 0xc5, 0xf6, 0xd1, 0xf9,
-	0xf9, 0x3b, 0xfa, 0x6c, 0x7a, 0x64, 0x40, 0x6a, 0x40, 0xae, 0x21, 0x8c, 0x0c, 0x6a, 0x81, 0x91,
-	0x4a, 0xf8, 0xc3, 0xc0, 0x07, 0x14, 0x03, 0x1e, 0x9b, 0xd0, 0x9d, 0x6b, 0x85, 0xca, 0x3e, 0xa8,
-	0x62, 0xee, 0x6e, 0xcc, 0xad, 0x63, 0xfb, 0xed, 0x50, 0x85, 0xaa, 0xcc, 0xf1, 0xe2, 0x54, 0x5d,
-	0xe9, 0x65, 0x84, 0xfe, 0xf7, 0xc4, 0x70, 0x81, 0x13, 0x48, 0x30, 0x92, 0x02, 0xc1, 0x3e, 0xa7,
-	0x4d, 0x03, 0x49, 0x00, 0xba, 0x43, 0xba, 0xe4, 0xf8, 0xdf, 0x68, 0xb0, 0xcd, 0x9c, 0x7e, 0x18,
-	0xe1, 0x64, 0xe1, 0xbb, 0x52, 0xc5, 0x5c, 0x2a, 0x13, 0x2b, 0x53, 0x6f, 0x7d, 0x13, 0x4c, 0x39,
-	0xa6, 0x73, 0x30, 0xee, 0x50, 0xca, 0x61, 0x10, 0x68, 0x30, 0xc6, 0xab, 0x01, 0xf6, 0x33, 0xa1,
-	0x7f, 0x25, 0x68, 0x8c, 0xee, 0x4b, 0x74, 0xe7, 0x57, 0x09, 0xbc, 0xdb, 0x64, 0x4e, 0x4b, 0x8b,
-	0x71, 0xd1, 0xd9, 0x66, 0xce, 0xf5, 0x0e, 0xdb, 0x48, 0x8d, 0x33, 0xe1, 0x1b, 0x7e, 0x53, 0x9a,
-	0x5c, 0x01, 0x3e, 0x2a, 0x3d, 0xe5, 0x4f, 0xdf, 0xcd, 0x35, 0xc4, 0x0a, 0x61, 0x2c, 0x10, 0xc1,
-	0x60, 0x65, 0x79, 0xf6, 0x35, 0xc5, 0xdb, 0x1d, 0xd9, 0x3b, 0xa4, 0x7f, 0x2e, 0x85, 0x41, 0xd0,
-	0x17, 0x90, 0xda, 0x6d, 0xfa, 0xdb, 0x4f, 0x11, 0x4c, 0x65, 0xe6, 0x55, 0x45, 0xaf, 0x4b, 0x1b,
-	0x45, 0x73, 0x8f, 0x36, 0xa6, 0x90, 0xd6, 0xd2, 0xad, 0x4d, 0xe6, 0x14, 0xa5, 0x57, 0x2c, 0x23,
-	0xb1, 0xfc, 0x60, 0xd6, 0x6b, 0xce, 0xc8, 0x32, 0x67, 0x64, 0x95, 0x33, 0xf2, 0x9e, 0x33, 0xf2,
-	0xb2, 0x66, 0xd6, 0x6a, 0xcd, 0xac, 0xb7, 0x35, 0xb3, 0x6e, 0x4f, 0x7f, 0x2c, 0x11, 0x25, 0x08,
-	0x3a, 0x11, 0xb3, 0xea, 0xf5, 0xfc, 0x66, 0xf9, 0x1f, 0x27, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xc1, 0x35, 0x10, 0xbc, 0xeb, 0x01, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x86, 0xd7, 0x54, 0xec, 0x0a, 0x83, 0x38, 0x44, 0x95, 0x68, 0x41, 0x72, 0x4a, 0x24, 0x24,
+	0x2e, 0x8d, 0xb5, 0xe2, 0x01, 0xd0, 0x96, 0x13, 0xaa, 0x5a, 0x24, 0x73, 0xe3, 0xc0, 0xca, 0x71,
+	0x86, 0x34, 0x4a, 0x13, 0x47, 0x9e, 0x49, 0x21, 0x27, 0x78, 0x04, 0x1e, 0x83, 0x47, 0xe9, 0xb1,
+	0x47, 0x4e, 0x11, 0x64, 0x6f, 0x7d, 0x84, 0x3d, 0xa1, 0xc4, 0x2b, 0x11, 0x8e, 0x48, 0xbd, 0xd8,
+	0x1e, 0xcd, 0x3f, 0xff, 0xef, 0xcf, 0x32, 0x7f, 0x81, 0x60, 0x1c, 0x90, 0x74, 0x90, 0xe5, 0x48,
+	0x4e, 0x53, 0x6e, 0x2b, 0x79, 0xb5, 0x4c, 0x80, 0xf4, 0x52, 0x96, 0x98, 0xc5, 0xb5, 0xb3, 0x64,
+	0x83, 0x67, 0x5e, 0x16, 0x4f, 0x65, 0xf1, 0x4e, 0xf6, 0x74, 0x3f, 0xb3, 0x99, 0x1d, 0x75, 0x72,
+	0x38, 0xf9, 0x91, 0xa8, 0x63, 0xfc, 0xb1, 0xd2, 0xab, 0x86, 0x2e, 0xa0, 0xa2, 0xdc, 0x68, 0x82,
+	0xe0, 0x2d, 0x9f, 0x23, 0x54, 0x29, 0xb8, 0x03, 0x76, 0xc4, 0x5e, 0x3e, 0x3a, 0x59, 0x6e, 0xbb,
+	0xf0, 0x38, 0xcb, 0xe9, 0xa2, 0x49, 0x62, 0x63, 0x4b, 0x69, 0x2c, 0x96, 0x16, 0x77, 0xdb, 0x31,
+	0xa6, 0x85, 0xa4, 0xb6, 0x06, 0x8c, 0x57, 0xc6, 0xac, 0xd2, 0xd4, 0x01, 0xa2, 0xda, 0x19, 0x04,
+	0xdf, 0x18, 0x7f, 0x68, 0xc0, 0x51, 0xfe, 0x69, 0xb4, 0x3e, 0xb8, 0x37, 0x1a, 0x7e, 0xbc, 0xed,
+	0xc2, 0x85, 0xd3, 0xeb, 0xa1, 0xb3, 0xed, 0xc2, 0x77, 0x13, 0x6f, 0x34, 0x8e, 0x2e, 0x75, 0x82,
+	0xf2, 0xfd, 0x48, 0x72, 0x0e, 0xf4, 0xd9, 0xba, 0x42, 0x7e, 0xf9, 0x97, 0xdc, 0x41, 0x69, 0x09,
+	0xd6, 0x9a, 0x08, 0x90, 0x3c, 0xe5, 0x9b, 0xbf, 0x29, 0x6a, 0x1a, 0x19, 0x7d, 0xe5, 0x87, 0x67,
+	0x98, 0x29, 0xb8, 0xb2, 0x05, 0x9c, 0xdb, 0x14, 0xd4, 0xc4, 0xe8, 0x2e, 0x51, 0x9f, 0xf0, 0x45,
+	0xdd, 0x24, 0xeb, 0x02, 0x5a, 0x4f, 0xa9, 0xe6, 0x75, 0x93, 0x9c, 0x42, 0x1b, 0x3d, 0xe7, 0x0f,
+	0xce, 0x34, 0x12, 0xb8, 0x53, 0x68, 0x83, 0x7d, 0x7e, 0x3f, 0x69, 0x09, 0xd0, 0xe7, 0x29, 0x5f,
+	0x44, 0x47, 0x7c, 0x6f, 0x68, 0x1e, 0xf2, 0xbd, 0x61, 0xdc, 0x5f, 0x65, 0x71, 0xdb, 0x85, 0x43,
+	0xa9, 0x86, 0xe5, 0x44, 0x5f, 0xff, 0x16, 0xb3, 0x1f, 0xbd, 0x60, 0xd7, 0xbd, 0x60, 0x37, 0xbd,
+	0x60, 0xbf, 0x7a, 0xc1, 0xbe, 0x6f, 0xc4, 0xec, 0x66, 0x23, 0x66, 0x3f, 0x37, 0x62, 0xf6, 0xe1,
+	0xf5, 0x7f, 0xbf, 0x62, 0x5e, 0x11, 0xb8, 0x4a, 0x5f, 0x7a, 0xa6, 0x64, 0x3e, 0x7e, 0x88, 0x57,
+	0x7f, 0x06, 0x00, 0x64, 0x3f, 0x2d, 0x12, 0x6c, 0x02, 0x00, 0x00,
 }
 
 func (this *RaAuthenticate) Equal(that interface{}) bool {
@@ -201,6 +245,33 @@ func (this *RaAuthenticate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRevokeNodeRegistration) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeNodeRegistration)
+	if !ok {
+		that2, ok := that.(MsgRevokeNodeRegistration)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
+}
 func (this *MasterKey) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -286,6 +357,43 @@ func (m *RaAuthenticate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeNodeRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeNodeRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeNodeRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MasterKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -374,6 +482,23 @@ func (m *RaAuthenticate) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeNodeRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
 func (m *MasterKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -524,6 +649,124 @@ func (m *RaAuthenticate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeNodeRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeNodeRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeNodeRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MasterKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -97,3 +97,29 @@ func TestMsgSendGetSigners(t *testing.T) {
 	// TODO: fix this !
 	require.Equal(t, fmt.Sprintf("%v", res), "[71776C6E6D786A377072707838727973786D3275]")
 }
+
+func TestEnclaveEvidenceValidateBasic(t *testing.T) {
+	cases := []struct {
+		evidence EnclaveEvidence
+		valid    bool
+	}{
+		{EnclaveEvidence{NodeID: []byte("node"), Height: 10}, true},
+		{EnclaveEvidence{NodeID: []byte("node"), Certificate: []byte("cert")}, true},
+		{EnclaveEvidence{Height: 10}, false},
+		{EnclaveEvidence{NodeID: []byte("node"), Height: -1}, false},
+	}
+
+	for _, tc := range cases {
+		err := tc.evidence.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	evidence := EnclaveEvidence{NodeID: []byte("node"), Height: 10}
+	require.Equal(t, RouterKey, evidence.Route())
+	require.Equal(t, evidence.Hash(), (&EnclaveEvidence{NodeID: []byte("node"), Height: 10}).Hash())
+	require.NotEqual(t, evidence.Hash(), (&EnclaveEvidence{NodeID: []byte("node"), Height: 11}).Hash())
+}
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	KeyAttestationValidityPeriod = []byte("AttestationValidityPeriod")
+	KeyAcceptedEnclaves          = []byte("AcceptedEnclaves")
+	KeyRevokedNodes              = []byte("RevokedNodes")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table for the registration module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default registration module params, with attestations that never expire
+// and no restriction on the enclaves that may register
+func DefaultParams() Params {
+	return Params{
+		AttestationValidityPeriod: 0,
+		AcceptedEnclaves:          []AcceptedEnclave{},
+		RevokedNodes:              []string{},
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAttestationValidityPeriod, &p.AttestationValidityPeriod, validateAttestationValidityPeriod),
+		paramtypes.NewParamSetPair(KeyAcceptedEnclaves, &p.AcceptedEnclaves, validateAcceptedEnclaves),
+		paramtypes.NewParamSetPair(KeyRevokedNodes, &p.RevokedNodes, validateRevokedNodes),
+	}
+}
+
+// ValidateBasic performs basic validation on registration module params
+func (p Params) ValidateBasic() error {
+	if err := validateAttestationValidityPeriod(p.AttestationValidityPeriod); err != nil {
+		return err
+	}
+	if err := validateAcceptedEnclaves(p.AcceptedEnclaves); err != nil {
+		return err
+	}
+	return validateRevokedNodes(p.RevokedNodes)
+}
+
+// IsNodeRevoked returns true if the registration of the node with the given public key was revoked
+func (p Params) IsNodeRevoked(publicKey []byte) bool {
+	nodeID := hex.EncodeToString(publicKey)
+	for _, revoked := range p.RevokedNodes {
+		if strings.EqualFold(revoked, nodeID) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEnclaveAccepted returns true if an enclave with the given measurements may register.
+// An empty list accepts all enclaves.
+func (p Params) IsEnclaveAccepted(mrEnclave, mrSigner []byte, isvSvn uint16) bool {
+	if len(p.AcceptedEnclaves) == 0 {
+		return true
+	}
+	for _, accepted := range p.AcceptedEnclaves {
+		if accepted.Matches(mrEnclave, mrSigner, isvSvn) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the given measurements match this entry
+func (a AcceptedEnclave) Matches(mrEnclave, mrSigner []byte, isvSvn uint16) bool {
+	if a.MrEnclave != "" {
+		expected, err := hex.DecodeString(a.MrEnclave)
+		if err != nil || !bytes.Equal(expected, mrEnclave) {
+			return false
+		}
+	}
+	if a.MrSigner != "" {
+		expected, err := hex.DecodeString(a.MrSigner)
+		if err != nil || !bytes.Equal(expected, mrSigner) {
+			return false
+		}
+	}
+	return uint32(isvSvn) >= a.MinIsvSvn
+}
+
+// AttestationExpiry returns when an attestation made at registrationTime expires, and false if
+// attestations don't expire
+func (p Params) AttestationExpiry(registrationTime time.Time) (time.Time, bool) {
+	if p.AttestationValidityPeriod == 0 {
+		return time.Time{}, false
+	}
+	return registrationTime.Add(p.AttestationValidityPeriod), true
+}
+
+func validateAttestationValidityPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < 0 {
+		return fmt.Errorf("attestation validity period must not be negative: %s", period)
+	}
+	return nil
+}
+
+func validateAcceptedEnclaves(i interface{}) error {
+	enclaves, ok := i.([]AcceptedEnclave)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, enclave := range enclaves {
+		if enclave.MrEnclave == "" && enclave.MrSigner == "" {
+			return fmt.Errorf("accepted enclave must set mr_enclave or mr_signer")
+		}
+		for _, measurement := range []string{enclave.MrEnclave, enclave.MrSigner} {
+			if measurement == "" {
+				continue
+			}
+			if bz, err := hex.DecodeString(measurement); err != nil || len(bz) != 32 {
+				return fmt.Errorf("invalid measurement %s: must be 32 hex encoded bytes", measurement)
+			}
+		}
+		if enclave.MinIsvSvn > math.MaxUint16 {
+			return fmt.Errorf("invalid min_isv_svn %d", enclave.MinIsvSvn)
+		}
+	}
+	return nil
+}
+
+func validateRevokedNodes(i interface{}) error {
+	nodes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		if _, err := hex.DecodeString(node); err != nil || len(node) == 0 {
+			return fmt.Errorf("invalid node id %s: must be a hex string", node)
+		}
+		if seen[strings.ToLower(node)] {
+			return fmt.Errorf("duplicate node id %s", node)
+		}
+		seen[strings.ToLower(node)] = true
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateAcceptedEnclaves(t *testing.T) {
+	mrEnclave := hex.EncodeToString(bytes.Repeat([]byte{1}, 32))
+
+	require.NoError(t, DefaultParams().ValidateBasic())
+	require.NoError(t, Params{AcceptedEnclaves: []AcceptedEnclave{{MrEnclave: mrEnclave, MinIsvSvn: 3}}}.ValidateBasic())
+
+	require.Error(t, Params{AcceptedEnclaves: []AcceptedEnclave{{MinIsvSvn: 3}}}.ValidateBasic())
+	require.Error(t, Params{AcceptedEnclaves: []AcceptedEnclave{{MrSigner: "abcd"}}}.ValidateBasic())
+	require.Error(t, Params{AcceptedEnclaves: []AcceptedEnclave{{MrSigner: "zz"}}}.ValidateBasic())
+	require.Error(t, Params{AcceptedEnclaves: []AcceptedEnclave{{MrEnclave: mrEnclave, MinIsvSvn: 1 << 16}}}.ValidateBasic())
+	require.Error(t, Params{AttestationValidityPeriod: -1}.ValidateBasic())
+}
+
+func TestParamsIsEnclaveAccepted(t *testing.T) {
+	mrEnclave := bytes.Repeat([]byte{1}, 32)
+	otherMrEnclave := bytes.Repeat([]byte{2}, 32)
+	mrSigner := bytes.Repeat([]byte{3}, 32)
+
+	// no restriction by default
+	require.True(t, DefaultParams().IsEnclaveAccepted(otherMrEnclave, mrSigner, 0))
+
+	params := Params{AcceptedEnclaves: []AcceptedEnclave{
+		{MrEnclave: hex.EncodeToString(mrEnclave)},
+		{MrSigner: hex.EncodeToString(mrSigner), MinIsvSvn: 5},
+	}}
+
+	require.True(t, params.IsEnclaveAccepted(mrEnclave, otherMrEnclave, 0))
+	require.True(t, params.IsEnclaveAccepted(otherMrEnclave, mrSigner, 5))
+	require.False(t, params.IsEnclaveAccepted(otherMrEnclave, mrSigner, 4))
+	require.False(t, params.IsEnclaveAccepted(otherMrEnclave, otherMrEnclave, 10))
+}
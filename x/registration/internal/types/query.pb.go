@@ -10,6 +10,9 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -18,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -105,9 +110,345 @@ func (m *QueryEncryptedSeedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEncryptedSeedResponse proto.InternalMessageInfo
 
+type QueryAttestationStatusRequest struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QueryAttestationStatusRequest) Reset()         { *m = QueryAttestationStatusRequest{} }
+func (m *QueryAttestationStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationStatusRequest) ProtoMessage()    {}
+func (*QueryAttestationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{2}
+}
+func (m *QueryAttestationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationStatusRequest.Merge(m, src)
+}
+func (m *QueryAttestationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationStatusRequest proto.InternalMessageInfo
+
+type QueryAttestationStatusResponse struct {
+	RegistrationHeight int64     `protobuf:"varint,1,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	RegistrationTime   time.Time `protobuf:"bytes,2,opt,name=registration_time,json=registrationTime,proto3,stdtime" json:"registration_time"`
+	// time elapsed since the registration
+	Age time.Duration `protobuf:"bytes,3,opt,name=age,proto3,stdduration" json:"age"`
+	// unset if attestations don't expire
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Expired   bool       `protobuf:"varint,5,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (m *QueryAttestationStatusResponse) Reset()         { *m = QueryAttestationStatusResponse{} }
+func (m *QueryAttestationStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationStatusResponse) ProtoMessage()    {}
+func (*QueryAttestationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{3}
+}
+func (m *QueryAttestationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationStatusResponse.Merge(m, src)
+}
+func (m *QueryAttestationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationStatusResponse proto.InternalMessageInfo
+
+type QuerySeedExchangeStatusRequest struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *QuerySeedExchangeStatusRequest) Reset()         { *m = QuerySeedExchangeStatusRequest{} }
+func (m *QuerySeedExchangeStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySeedExchangeStatusRequest) ProtoMessage()    {}
+func (*QuerySeedExchangeStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{4}
+}
+func (m *QuerySeedExchangeStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeedExchangeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeedExchangeStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeedExchangeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeedExchangeStatusRequest.Merge(m, src)
+}
+func (m *QuerySeedExchangeStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeedExchangeStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeedExchangeStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeedExchangeStatusRequest proto.InternalMessageInfo
+
+type QuerySeedExchangeStatusResponse struct {
+	// true once the node is registered and its encrypted seed is available
+	Completed bool `protobuf:"varint,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	// height at which the node registered, 0 if it isn't registered or registered before it was recorded
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// true if the node's registration was revoked
+	Revoked bool `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *QuerySeedExchangeStatusResponse) Reset()         { *m = QuerySeedExchangeStatusResponse{} }
+func (m *QuerySeedExchangeStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySeedExchangeStatusResponse) ProtoMessage()    {}
+func (*QuerySeedExchangeStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{5}
+}
+func (m *QuerySeedExchangeStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySeedExchangeStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySeedExchangeStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySeedExchangeStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySeedExchangeStatusResponse.Merge(m, src)
+}
+func (m *QuerySeedExchangeStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySeedExchangeStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySeedExchangeStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySeedExchangeStatusResponse proto.InternalMessageInfo
+
+type QueryNetworkKeyRequest struct {
+	// public key of the registered node whose attestation is returned, optional.
+	// The node that registered last is used by default.
+	NodeID []byte `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *QueryNetworkKeyRequest) Reset()         { *m = QueryNetworkKeyRequest{} }
+func (m *QueryNetworkKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkKeyRequest) ProtoMessage()    {}
+func (*QueryNetworkKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{6}
+}
+func (m *QueryNetworkKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkKeyRequest.Merge(m, src)
+}
+func (m *QueryNetworkKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkKeyRequest proto.InternalMessageInfo
+
+// QueryNetworkKeyResponse holds the IO master key, which txs to contracts are
+// encrypted to, and the attestation of a registered node. Every enclave that
+// got the consensus seed derives the same IO master key, so clients that verify
+// the certificate and trust the measurements of the enclave can trust the key.
+type QueryNetworkKeyResponse struct {
+	IoMasterKey []byte `protobuf:"bytes,1,opt,name=io_master_key,json=ioMasterKey,proto3" json:"io_master_key,omitempty"`
+	// public key of the node whose attestation is returned, empty if no node is
+	// registered
+	NodeID []byte `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// attestation certificate of the node, with the certificate chain of the
+	// report signed by Intel or the DCAP quote
+	Certificate github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,3,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
+	// measurements of the attested enclave, unset in software mode and for
+	// certificates of the legacy format
+	Measurements       *EnclaveMeasurements `protobuf:"bytes,4,opt,name=measurements,proto3" json:"measurements,omitempty"`
+	RegistrationHeight int64                `protobuf:"varint,5,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+}
+
+func (m *QueryNetworkKeyResponse) Reset()         { *m = QueryNetworkKeyResponse{} }
+func (m *QueryNetworkKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkKeyResponse) ProtoMessage()    {}
+func (*QueryNetworkKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{7}
+}
+func (m *QueryNetworkKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkKeyResponse.Merge(m, src)
+}
+func (m *QueryNetworkKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkKeyResponse proto.InternalMessageInfo
+
+// EnclaveMeasurements identify the enclave that produced an attestation
+type EnclaveMeasurements struct {
+	// hex encoded MRENCLAVE
+	MrEnclave string `protobuf:"bytes,1,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// hex encoded MRSIGNER
+	MrSigner string `protobuf:"bytes,2,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	IsvSvn   uint32 `protobuf:"varint,3,opt,name=isv_svn,json=isvSvn,proto3" json:"isv_svn,omitempty"`
+}
+
+func (m *EnclaveMeasurements) Reset()         { *m = EnclaveMeasurements{} }
+func (m *EnclaveMeasurements) String() string { return proto.CompactTextString(m) }
+func (*EnclaveMeasurements) ProtoMessage()    {}
+func (*EnclaveMeasurements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{8}
+}
+func (m *EnclaveMeasurements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveMeasurements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveMeasurements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveMeasurements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveMeasurements.Merge(m, src)
+}
+func (m *EnclaveMeasurements) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveMeasurements) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveMeasurements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveMeasurements proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryEncryptedSeedRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedRequest")
 	proto.RegisterType((*QueryEncryptedSeedResponse)(nil), "secret.registration.v1beta1.QueryEncryptedSeedResponse")
+	proto.RegisterType((*QueryAttestationStatusRequest)(nil), "secret.registration.v1beta1.QueryAttestationStatusRequest")
+	proto.RegisterType((*QueryAttestationStatusResponse)(nil), "secret.registration.v1beta1.QueryAttestationStatusResponse")
+	proto.RegisterType((*QuerySeedExchangeStatusRequest)(nil), "secret.registration.v1beta1.QuerySeedExchangeStatusRequest")
+	proto.RegisterType((*QuerySeedExchangeStatusResponse)(nil), "secret.registration.v1beta1.QuerySeedExchangeStatusResponse")
+	proto.RegisterType((*QueryNetworkKeyRequest)(nil), "secret.registration.v1beta1.QueryNetworkKeyRequest")
+	proto.RegisterType((*QueryNetworkKeyResponse)(nil), "secret.registration.v1beta1.QueryNetworkKeyResponse")
+	proto.RegisterType((*EnclaveMeasurements)(nil), "secret.registration.v1beta1.EnclaveMeasurements")
+	proto.RegisterType((*QueryParamsResponse)(nil), "secret.registration.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -115,34 +456,71 @@ func init() {
 }
 
 var fileDescriptor_7ee71413f073b37c = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xcd, 0x8a, 0xd4, 0x40,
-	0x18, 0x4c, 0x94, 0x5d, 0xa1, 0x71, 0x15, 0x1a, 0xf1, 0x27, 0xbb, 0x34, 0xcb, 0xe0, 0xea, 0x7a,
-	0xd8, 0x6e, 0x57, 0x65, 0x3d, 0x0a, 0xca, 0x9e, 0x16, 0x04, 0x67, 0x3d, 0x79, 0x59, 0x92, 0xcc,
-	0x67, 0x1b, 0x66, 0xa6, 0xbb, 0xa7, 0xbb, 0xa3, 0x13, 0x06, 0x2f, 0x3e, 0x81, 0xe0, 0x4b, 0xcc,
-	0x23, 0xf8, 0x08, 0x73, 0x1c, 0xf0, 0xe2, 0x51, 0x33, 0x3e, 0x88, 0xa4, 0xd3, 0x23, 0x19, 0x88,
-	0x01, 0xf1, 0x96, 0xf4, 0x57, 0x5f, 0x55, 0x7d, 0x55, 0xe8, 0xbe, 0x81, 0x54, 0x83, 0x65, 0x1a,
-	0x78, 0x66, 0xac, 0x8e, 0x6d, 0x26, 0x05, 0x7b, 0x7f, 0x9c, 0x80, 0x8d, 0x8f, 0xd9, 0x24, 0x07,
-	0x5d, 0x50, 0xa5, 0xa5, 0x95, 0x78, 0xb7, 0x06, 0xd2, 0x26, 0x90, 0x7a, 0x60, 0x74, 0x83, 0x4b,
-	0x2e, 0x1d, 0x8e, 0x55, 0x5f, 0xf5, 0x4a, 0xb4, 0xcb, 0xa5, 0xe4, 0x23, 0x60, 0xee, 0x2f, 0xc9,
-	0xdf, 0x32, 0x18, 0x2b, 0xeb, 0xf9, 0xa2, 0x3d, 0x3f, 0x8c, 0x55, 0xc6, 0x62, 0x21, 0xa4, 0x75,
-	0x8c, 0xc6, 0x4f, 0x0f, 0xba, 0x6c, 0x8d, 0x0d, 0xf7, 0xb0, 0x07, 0x5d, 0x30, 0x0e, 0x02, 0x4c,
-	0xe6, 0x19, 0x7b, 0x4f, 0xd0, 0x9d, 0x57, 0xd5, 0x39, 0xa7, 0x22, 0xd5, 0x85, 0xb2, 0x30, 0x38,
-	0x07, 0x18, 0xf4, 0x61, 0x92, 0x83, 0xb1, 0xf8, 0x16, 0xba, 0xa2, 0xf2, 0xe4, 0x62, 0x08, 0xc5,
-	0xed, 0x70, 0x3f, 0x3c, 0xbc, 0xda, 0xdf, 0x56, 0x79, 0x72, 0x06, 0x45, 0xef, 0x05, 0x8a, 0xda,
-	0xb6, 0x8c, 0x92, 0xc2, 0x00, 0x3e, 0x40, 0xd7, 0x60, 0x3d, 0xb8, 0x30, 0x00, 0x03, 0xbf, 0xbd,
-	0x03, 0x4d, 0xf8, 0xa3, 0xf9, 0x65, 0xb4, 0xe5, 0x58, 0x30, 0x47, 0x5b, 0xaf, 0xa7, 0x67, 0x50,
-	0xe0, 0x9b, 0xb4, 0x3e, 0x9f, 0xae, 0xb3, 0xa1, 0xa7, 0x55, 0x36, 0xd1, 0x3e, 0xed, 0x88, 0x99,
-	0x56, 0x8e, 0xee, 0x7e, 0xfa, 0xf6, 0xeb, 0xcb, 0x25, 0x82, 0xf7, 0xda, 0x8f, 0xb6, 0xd3, 0xa3,
-	0x21, 0x14, 0x78, 0x86, 0xae, 0xf7, 0x1b, 0xe3, 0xff, 0x93, 0xa4, 0x4e, 0xf2, 0x10, 0xdf, 0x6b,
-	0x97, 0x6c, 0x3e, 0x3a, 0xf1, 0xaf, 0x21, 0xda, 0xd9, 0x08, 0x0c, 0x9f, 0x74, 0x6a, 0xfc, 0xb5,
-	0x97, 0xe8, 0xe9, 0x3f, 0xef, 0xd5, 0xcd, 0xf4, 0x4e, 0x9c, 0xe5, 0x87, 0x98, 0xb6, 0x5b, 0xfe,
-	0xd3, 0xcf, 0x51, 0xd5, 0x1a, 0x9b, 0xf9, 0xf2, 0x3f, 0x3e, 0x8f, 0x17, 0x3f, 0x49, 0x30, 0x2f,
-	0x49, 0xb8, 0x28, 0x49, 0xb8, 0x2c, 0x49, 0xf8, 0xa3, 0x24, 0xe1, 0xe7, 0x15, 0x09, 0x96, 0x2b,
-	0x12, 0x7c, 0x5f, 0x91, 0xe0, 0xcd, 0x33, 0x9e, 0xd9, 0x77, 0x79, 0x42, 0x53, 0x39, 0x66, 0x26,
-	0xd5, 0x76, 0x14, 0x27, 0x86, 0x9d, 0x3b, 0x97, 0x2f, 0xc1, 0x7e, 0x90, 0x7a, 0xc8, 0xa6, 0x9b,
-	0xa2, 0x99, 0xb0, 0xa0, 0x45, 0x3c, 0x62, 0xb6, 0x50, 0x60, 0x92, 0x6d, 0x97, 0xff, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x62, 0xa4, 0x1c, 0x7a, 0x03, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x72, 0x1b, 0xc5,
+	0x13, 0xf6, 0xca, 0xb6, 0x2c, 0x8d, 0xed, 0xdf, 0x8f, 0x8c, 0x29, 0x47, 0x59, 0x3b, 0x2b, 0xb3,
+	0x26, 0xe0, 0x1c, 0xbc, 0xeb, 0x38, 0xc6, 0x40, 0x08, 0x95, 0xb2, 0x13, 0x57, 0x91, 0x72, 0x25,
+	0x90, 0x95, 0x0f, 0x14, 0x97, 0xad, 0x91, 0xb6, 0xb3, 0x5e, 0xac, 0xfd, 0xe3, 0x99, 0x59, 0x21,
+	0x55, 0x8a, 0x0b, 0x4f, 0x90, 0x2a, 0x2e, 0x9c, 0x39, 0xf1, 0x04, 0x14, 0x8f, 0xe0, 0x0b, 0x55,
+	0xa9, 0xe2, 0xc2, 0xc9, 0x04, 0x99, 0xa7, 0xe0, 0x44, 0xed, 0xec, 0x08, 0xad, 0x22, 0xad, 0x14,
+	0x87, 0x9b, 0x66, 0xbb, 0xbf, 0xaf, 0xbf, 0xee, 0x9e, 0xee, 0x11, 0x7a, 0x9f, 0x41, 0x83, 0x02,
+	0x37, 0x29, 0xb8, 0x1e, 0xe3, 0x94, 0x70, 0x2f, 0x0c, 0xcc, 0xd6, 0xad, 0x3a, 0x70, 0x72, 0xcb,
+	0x3c, 0x8d, 0x81, 0x76, 0x8c, 0x88, 0x86, 0x3c, 0xc4, 0x2b, 0xa9, 0xa3, 0x91, 0x75, 0x34, 0xa4,
+	0xa3, 0xfa, 0xb6, 0x1b, 0xba, 0xa1, 0xf0, 0x33, 0x93, 0x5f, 0x29, 0x44, 0x5d, 0x71, 0xc3, 0xd0,
+	0x6d, 0x82, 0x29, 0x4e, 0xf5, 0xf8, 0xa9, 0x09, 0x7e, 0xc4, 0x25, 0x9f, 0xaa, 0xbd, 0x6a, 0x74,
+	0x62, 0x49, 0x9a, 0xda, 0xab, 0xaf, 0xda, 0xb9, 0xe7, 0x03, 0xe3, 0xc4, 0x8f, 0xa4, 0xc3, 0xaa,
+	0x74, 0x20, 0x91, 0x67, 0x92, 0x20, 0x08, 0xb9, 0x40, 0x33, 0x69, 0xbd, 0x31, 0x2e, 0x2f, 0x9f,
+	0xb9, 0xd2, 0xed, 0xe6, 0x38, 0x37, 0x17, 0x02, 0x60, 0x5e, 0x8f, 0x71, 0x6c, 0xa5, 0x78, 0x27,
+	0x02, 0xe9, 0xa8, 0xef, 0xa0, 0x6b, 0x4f, 0x92, 0xc2, 0x1d, 0x04, 0x0d, 0xda, 0x89, 0x38, 0x38,
+	0x35, 0x00, 0xc7, 0x82, 0xd3, 0x18, 0x18, 0xc7, 0x57, 0xd1, 0x5c, 0x14, 0xd7, 0xed, 0x13, 0xe8,
+	0x54, 0x94, 0x35, 0x65, 0x63, 0xc1, 0x2a, 0x46, 0x71, 0xfd, 0x10, 0x3a, 0xfa, 0x7d, 0xa4, 0x8e,
+	0x42, 0xb1, 0x28, 0x0c, 0x18, 0xe0, 0x1b, 0xe8, 0x7f, 0xd0, 0x33, 0xd8, 0x0c, 0xc0, 0x91, 0xe8,
+	0x45, 0xc8, 0xba, 0xeb, 0x1f, 0xa1, 0xeb, 0x82, 0x64, 0x8f, 0xf3, 0xa4, 0x56, 0x89, 0xc4, 0x1a,
+	0x27, 0x3c, 0x66, 0x13, 0xc3, 0xff, 0x5c, 0x40, 0x5a, 0x1e, 0x54, 0x6a, 0x30, 0xd1, 0x52, 0x36,
+	0x77, 0xfb, 0x18, 0x3c, 0xf7, 0x98, 0x0b, 0x9e, 0x69, 0x0b, 0x67, 0x4d, 0x9f, 0x09, 0x0b, 0x7e,
+	0x82, 0xae, 0x0c, 0x00, 0x92, 0x0e, 0x56, 0x0a, 0x6b, 0xca, 0xc6, 0xfc, 0xb6, 0x6a, 0xa4, 0xdd,
+	0x33, 0x7a, 0xed, 0x35, 0x8e, 0x7a, 0xed, 0xdd, 0x2f, 0x9d, 0x9d, 0x57, 0xa7, 0x9e, 0xff, 0x51,
+	0x55, 0xac, 0xb7, 0xb2, 0xf0, 0xc4, 0x01, 0x7f, 0x80, 0xa6, 0x89, 0x0b, 0x95, 0x69, 0x41, 0x72,
+	0x6d, 0x88, 0xe4, 0x81, 0xbc, 0x43, 0x29, 0xc7, 0x0f, 0x09, 0x47, 0xe2, 0x8f, 0xef, 0x21, 0x04,
+	0xed, 0xc8, 0xa3, 0xc0, 0x6c, 0xc2, 0x2b, 0x33, 0x13, 0x25, 0xcc, 0x88, 0xf0, 0x65, 0x89, 0xd9,
+	0xe3, 0xb8, 0x82, 0xe6, 0xd2, 0x83, 0x53, 0x99, 0x5d, 0x53, 0x36, 0x4a, 0x56, 0xef, 0xa8, 0x7f,
+	0x2c, 0xeb, 0x96, 0xd4, 0xff, 0xa0, 0xdd, 0x38, 0x26, 0x81, 0x0b, 0xaf, 0x59, 0xf3, 0x53, 0x54,
+	0xcd, 0x85, 0xca, 0x9a, 0xaf, 0xa2, 0x72, 0x23, 0xf4, 0xa3, 0x26, 0x70, 0xd9, 0xf2, 0x92, 0xd5,
+	0xff, 0x80, 0x97, 0x51, 0x51, 0x36, 0xa1, 0x20, 0x9a, 0x20, 0x4f, 0x89, 0x5a, 0x0a, 0xad, 0xf0,
+	0x04, 0x1c, 0x51, 0xa9, 0x92, 0xd5, 0x3b, 0xea, 0x9f, 0xa2, 0x65, 0x11, 0xf2, 0x31, 0xf0, 0x6f,
+	0x42, 0x7a, 0x72, 0x08, 0x9d, 0x9e, 0xca, 0x75, 0x34, 0x17, 0x84, 0x0e, 0xd8, 0x9e, 0xbc, 0x5a,
+	0xfb, 0xa8, 0x7b, 0x5e, 0x2d, 0x3e, 0x0e, 0x1d, 0x78, 0xf8, 0xc0, 0x2a, 0x26, 0xa6, 0x87, 0x8e,
+	0xfe, 0xb2, 0x80, 0xae, 0x0e, 0xe1, 0xa5, 0x54, 0x1d, 0x2d, 0x7a, 0xa1, 0xed, 0x13, 0xc6, 0x81,
+	0x66, 0x92, 0x9d, 0xf7, 0xc2, 0x47, 0xe2, 0xdb, 0x21, 0x74, 0xb2, 0x41, 0x0a, 0x79, 0x41, 0x70,
+	0x8c, 0xe6, 0x1b, 0x40, 0xb9, 0xf7, 0xd4, 0x6b, 0x10, 0x9e, 0xf6, 0x7a, 0x61, 0xbf, 0xf6, 0xf7,
+	0x79, 0xf5, 0x73, 0xd7, 0xe3, 0xc7, 0x71, 0xdd, 0x68, 0x84, 0xbe, 0xc9, 0x1a, 0x94, 0x37, 0x49,
+	0x9d, 0x99, 0x35, 0x31, 0x95, 0x52, 0x8f, 0xd9, 0x1e, 0x1c, 0x4f, 0x0a, 0x7e, 0xc8, 0xc1, 0x26,
+	0xfd, 0x3b, 0x6d, 0xdc, 0xef, 0x53, 0x5b, 0xd9, 0x38, 0xf8, 0x08, 0x2d, 0xf8, 0x40, 0x58, 0x4c,
+	0xc1, 0x87, 0x80, 0x33, 0x79, 0x4b, 0xb6, 0x8c, 0x31, 0x7b, 0xcf, 0x38, 0x08, 0x1a, 0x4d, 0xd2,
+	0x82, 0x47, 0x19, 0x9c, 0x35, 0xc0, 0x92, 0x37, 0x34, 0xb3, 0x79, 0x43, 0xa3, 0x7f, 0x8d, 0x96,
+	0x46, 0xb0, 0xe2, 0xeb, 0x08, 0xf9, 0xd4, 0x86, 0xd4, 0x22, 0x4a, 0x5b, 0xb6, 0xca, 0x3e, 0x95,
+	0xae, 0x78, 0x05, 0x95, 0x7d, 0x6a, 0x33, 0xcf, 0x0d, 0x80, 0x8a, 0xd2, 0x96, 0xad, 0x92, 0x4f,
+	0x6b, 0xe2, 0x9c, 0x5c, 0x40, 0x8f, 0xb5, 0x6c, 0xd6, 0x0a, 0x44, 0x31, 0x17, 0xad, 0xa2, 0xc7,
+	0x5a, 0xb5, 0x56, 0xa0, 0x7f, 0x89, 0x96, 0x44, 0x37, 0xbf, 0x20, 0x94, 0xf8, 0xfd, 0x4b, 0xb7,
+	0x87, 0x8a, 0x91, 0xf8, 0x22, 0xe2, 0xcc, 0x6f, 0xaf, 0x8f, 0xad, 0x41, 0x0a, 0xde, 0x9f, 0x49,
+	0x26, 0xce, 0x92, 0xc0, 0xed, 0x6e, 0x09, 0xcd, 0x0a, 0x6a, 0xec, 0xa2, 0xd9, 0xa3, 0x76, 0xd2,
+	0xfb, 0xe5, 0xa1, 0x79, 0x3b, 0x48, 0x9e, 0x03, 0x75, 0x6d, 0x2c, 0x7b, 0x32, 0x27, 0xef, 0x7e,
+	0xf7, 0xdb, 0x5f, 0xdf, 0x17, 0x34, 0xbc, 0x9a, 0xb3, 0x7b, 0xdb, 0x9b, 0x27, 0xd0, 0xc1, 0xcf,
+	0xd0, 0xff, 0xad, 0x8c, 0xf9, 0xbf, 0x85, 0x34, 0x44, 0xc8, 0x0d, 0xfc, 0xde, 0xe8, 0x90, 0xd9,
+	0x8f, 0x22, 0xf8, 0x2f, 0x0a, 0x5a, 0x1c, 0xd8, 0xdc, 0x78, 0x77, 0x6c, 0x8c, 0xdc, 0x07, 0x42,
+	0xfd, 0xf0, 0xd2, 0xb8, 0xb4, 0x6b, 0xfa, 0xae, 0x90, 0xbc, 0x85, 0x8d, 0xd1, 0x92, 0xff, 0x7d,
+	0x28, 0x36, 0x19, 0x80, 0x63, 0x3e, 0x93, 0x2b, 0xe9, 0x5b, 0x7c, 0xa6, 0xa0, 0x2b, 0x43, 0x4b,
+	0x1f, 0xdf, 0x99, 0x2c, 0x23, 0xef, 0x91, 0x51, 0x3f, 0x79, 0x23, 0xac, 0x4c, 0xe3, 0x8e, 0x48,
+	0x63, 0x07, 0x6f, 0x8f, 0x4e, 0x23, 0x33, 0xca, 0x9b, 0x4c, 0x20, 0x33, 0xa9, 0xfc, 0xaa, 0x20,
+	0x3c, 0xbc, 0x4c, 0xf1, 0x6b, 0xe8, 0xc9, 0xdd, 0xde, 0xea, 0xdd, 0x37, 0x03, 0xcb, 0x6c, 0xee,
+	0x8a, 0x6c, 0x76, 0xf1, 0xce, 0xe8, 0x6c, 0x92, 0x56, 0x6c, 0x82, 0x84, 0x0e, 0xe7, 0xf3, 0xa3,
+	0x82, 0x50, 0x7f, 0xd3, 0xe2, 0xdb, 0x93, 0xa5, 0x0c, 0xed, 0x75, 0x75, 0xe7, 0x72, 0x20, 0xa9,
+	0xfb, 0xa6, 0xd0, 0xbd, 0x8e, 0xdf, 0x19, 0xad, 0x3b, 0x48, 0x11, 0xe2, 0xea, 0xb7, 0x51, 0x31,
+	0x5d, 0x01, 0xb9, 0xe3, 0xb6, 0x35, 0x59, 0xc2, 0xe0, 0x06, 0x9a, 0x34, 0xf1, 0xe9, 0x92, 0xd9,
+	0x27, 0x67, 0x7f, 0x6a, 0x53, 0x3f, 0x75, 0x35, 0xe5, 0xac, 0xab, 0x29, 0x2f, 0xba, 0x9a, 0xf2,
+	0xb2, 0xab, 0x29, 0xcf, 0x2f, 0xb4, 0xa9, 0x17, 0x17, 0xda, 0xd4, 0xef, 0x17, 0xda, 0xd4, 0x57,
+	0xf7, 0x2e, 0xfd, 0x62, 0x78, 0x01, 0x07, 0x1a, 0x90, 0x66, 0xfa, 0x8f, 0xae, 0x5e, 0x14, 0xa9,
+	0xdc, 0xfe, 0x67, 0x00, 0xde, 0x8d, 0xc4, 0xb5, 0x27, 0x0b, 0x00, 0x00,
 }
 
 func (this *QueryEncryptedSeedRequest) Equal(that interface{}) bool {
@@ -193,203 +571,587 @@ func (this *QueryEncryptedSeedResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Returns the key used for transactions
-	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *QueryAttestationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryAttestationStatusRequest)
+	if !ok {
+		that2, ok := that.(QueryAttestationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
-	out := new(QueryEncryptedSeedResponse)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Returns the key used for transactions
-	TxKey(context.Context, *emptypb.Empty) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
 }
+func (this *QueryAttestationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (*UnimplementedQueryServer) TxKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TxKey not implemented")
-}
-func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistrationKey not implemented")
-}
-func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
+	that1, ok := that.(*QueryAttestationStatusResponse)
+	if !ok {
+		that2, ok := that.(QueryAttestationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	if !this.RegistrationTime.Equal(that1.RegistrationTime) {
+		return false
+	}
+	if this.Age != that1.Age {
+		return false
+	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if this.Expired != that1.Expired {
+		return false
+	}
+	return true
 }
+func (this *QuerySeedExchangeStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+	that1, ok := that.(*QuerySeedExchangeStatusRequest)
+	if !ok {
+		that2, ok := that.(QuerySeedExchangeStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
 }
+func (this *QuerySeedExchangeStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_TxKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QuerySeedExchangeStatusResponse)
+	if !ok {
+		that2, ok := that.(QuerySeedExchangeStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TxKey(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/TxKey",
+	if this.Completed != that1.Completed {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TxKey(ctx, req.(*emptypb.Empty))
+	if this.Height != that1.Height {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Revoked != that1.Revoked {
+		return false
+	}
+	return true
 }
-
-func _Query_RegistrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *QueryNetworkKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegistrationKey(ctx, in)
+
+	that1, ok := that.(*QueryNetworkKeyRequest)
+	if !ok {
+		that2, ok := that.(QueryNetworkKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/RegistrationKey",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegistrationKey(ctx, req.(*emptypb.Empty))
+	if !bytes.Equal(this.NodeID, that1.NodeID) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *QueryNetworkKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEncryptedSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*QueryNetworkKeyResponse)
+	if !ok {
+		that2, ok := that.(QueryNetworkKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EncryptedSeed(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/EncryptedSeed",
+	if !bytes.Equal(this.IoMasterKey, that1.IoMasterKey) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EncryptedSeed(ctx, req.(*QueryEncryptedSeedRequest))
+	if !bytes.Equal(this.NodeID, that1.NodeID) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	if !this.Measurements.Equal(that1.Measurements) {
+		return false
+	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	return true
 }
+func (this *EnclaveMeasurements) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "secret.registration.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TxKey",
-			Handler:    _Query_TxKey_Handler,
-		},
-		{
-			MethodName: "RegistrationKey",
-			Handler:    _Query_RegistrationKey_Handler,
-		},
-		{
-			MethodName: "EncryptedSeed",
-			Handler:    _Query_EncryptedSeed_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "secret/registration/v1beta1/query.proto",
+	that1, ok := that.(*EnclaveMeasurements)
+	if !ok {
+		that2, ok := that.(EnclaveMeasurements)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MrEnclave != that1.MrEnclave {
+		return false
+	}
+	if this.MrSigner != that1.MrSigner {
+		return false
+	}
+	if this.IsvSvn != that1.IsvSvn {
+		return false
+	}
+	return true
 }
+func (this *QueryParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryEncryptedSeedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*QueryParamsResponse)
+	if !ok {
+		that2, ok := that.(QueryParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
 }
 
-func (m *QueryEncryptedSeedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Returns the key used for transactions
+	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+	// Returns the age and expiry of a registered node's attestation by public key
+	AttestationStatus(ctx context.Context, in *QueryAttestationStatusRequest, opts ...grpc.CallOption) (*QueryAttestationStatusResponse, error)
+	// Returns whether a node completed the encrypted seed exchange by public key
+	SeedExchangeStatus(ctx context.Context, in *QuerySeedExchangeStatusRequest, opts ...grpc.CallOption) (*QuerySeedExchangeStatusResponse, error)
+	// Returns the IO master key with the attestation of a registered node holding it
+	NetworkKey(ctx context.Context, in *QueryNetworkKeyRequest, opts ...grpc.CallOption) (*QueryNetworkKeyResponse, error)
+	// Returns the registration module params
+	Params(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryEncryptedSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryEncryptedSeedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
+}
+
+func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
+	out := new(QueryEncryptedSeedResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationStatus(ctx context.Context, in *QueryAttestationStatusRequest, opts ...grpc.CallOption) (*QueryAttestationStatusResponse, error) {
+	out := new(QueryAttestationStatusResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/AttestationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SeedExchangeStatus(ctx context.Context, in *QuerySeedExchangeStatusRequest, opts ...grpc.CallOption) (*QuerySeedExchangeStatusResponse, error) {
+	out := new(QuerySeedExchangeStatusResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/SeedExchangeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetworkKey(ctx context.Context, in *QueryNetworkKeyRequest, opts ...grpc.CallOption) (*QueryNetworkKeyResponse, error) {
+	out := new(QueryNetworkKeyResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/NetworkKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Returns the key used for transactions
+	TxKey(context.Context, *emptypb.Empty) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
+	// Returns the age and expiry of a registered node's attestation by public key
+	AttestationStatus(context.Context, *QueryAttestationStatusRequest) (*QueryAttestationStatusResponse, error)
+	// Returns whether a node completed the encrypted seed exchange by public key
+	SeedExchangeStatus(context.Context, *QuerySeedExchangeStatusRequest) (*QuerySeedExchangeStatusResponse, error)
+	// Returns the IO master key with the attestation of a registered node holding it
+	NetworkKey(context.Context, *QueryNetworkKeyRequest) (*QueryNetworkKeyResponse, error)
+	// Returns the registration module params
+	Params(context.Context, *emptypb.Empty) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TxKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxKey not implemented")
+}
+func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationKey not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
+}
+func (*UnimplementedQueryServer) AttestationStatus(ctx context.Context, req *QueryAttestationStatusRequest) (*QueryAttestationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationStatus not implemented")
+}
+func (*UnimplementedQueryServer) SeedExchangeStatus(ctx context.Context, req *QuerySeedExchangeStatusRequest) (*QuerySeedExchangeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SeedExchangeStatus not implemented")
+}
+func (*UnimplementedQueryServer) NetworkKey(ctx context.Context, req *QueryNetworkKeyRequest) (*QueryNetworkKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkKey not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *emptypb.Empty) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TxKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/TxKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegistrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegistrationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegistrationKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegistrationKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/EncryptedSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedSeed(ctx, req.(*QueryEncryptedSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/AttestationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationStatus(ctx, req.(*QueryAttestationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SeedExchangeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeedExchangeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SeedExchangeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/SeedExchangeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SeedExchangeStatus(ctx, req.(*QuerySeedExchangeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NetworkKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/NetworkKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkKey(ctx, req.(*QueryNetworkKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.registration.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxKey",
+			Handler:    _Query_TxKey_Handler,
+		},
+		{
+			MethodName: "RegistrationKey",
+			Handler:    _Query_RegistrationKey_Handler,
+		},
+		{
+			MethodName: "EncryptedSeed",
+			Handler:    _Query_EncryptedSeed_Handler,
+		},
+		{
+			MethodName: "AttestationStatus",
+			Handler:    _Query_AttestationStatus_Handler,
+		},
+		{
+			MethodName: "SeedExchangeStatus",
+			Handler:    _Query_SeedExchangeStatus_Handler,
+		},
+		{
+			MethodName: "NetworkKey",
+			Handler:    _Query_NetworkKey_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/registration/v1beta1/query.proto",
+}
+
+func (m *QueryEncryptedSeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedSeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedSeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *QueryEncryptedSeedResponse) MarshalTo(dAtA []byte) (int, error) {
@@ -412,11 +1174,349 @@ func (m *QueryEncryptedSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *QueryAttestationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintQuery(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x22
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Age, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Age):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RegistrationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RegistrationTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeedExchangeStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeedExchangeStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeedExchangeStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySeedExchangeStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySeedExchangeStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySeedExchangeStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Measurements != nil {
+		{
+			size, err := m.Measurements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IoMasterKey) > 0 {
+		i -= len(m.IoMasterKey)
+		copy(dAtA[i:], m.IoMasterKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IoMasterKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveMeasurements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveMeasurements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveMeasurements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsvSvn != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IsvSvn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -433,29 +1533,888 @@ func (m *QueryEncryptedSeedRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryEncryptedSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RegistrationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RegistrationTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Age)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Expired {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySeedExchangeStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySeedExchangeStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Completed {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNetworkKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetworkKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IoMasterKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Measurements != nil {
+		l = m.Measurements.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RegistrationHeight))
+	}
+	return n
+}
+
+func (m *EnclaveMeasurements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IsvSvn != 0 {
+		n += 1 + sovQuery(uint64(m.IsvSvn))
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RegistrationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Age, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeedExchangeStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeedExchangeStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeedExchangeStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySeedExchangeStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySeedExchangeStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySeedExchangeStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = append(m.NodeID[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeID == nil {
+				m.NodeID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryEncryptedSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNetworkKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,15 +2437,15 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNetworkKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNetworkKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IoMasterKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -513,11 +2472,267 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.IoMasterKey = append(m.IoMasterKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IoMasterKey == nil {
+				m.IoMasterKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = append(m.NodeID[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeID == nil {
+				m.NodeID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Measurements == nil {
+				m.Measurements = &EnclaveMeasurements{}
+			}
+			if err := m.Measurements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnclaveMeasurements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnclaveMeasurements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnclaveMeasurements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MrSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsvSvn", wireType)
+			}
+			m.IsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -539,7 +2754,7 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,17 +2777,17 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -582,24 +2797,23 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSeed == nil {
-				m.EncryptedSeed = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
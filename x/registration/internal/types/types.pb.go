@@ -6,18 +6,23 @@ package types
 import (
 	bytes "bytes"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -105,6 +110,12 @@ var xxx_messageInfo_LegacySeedConfig proto.InternalMessageInfo
 type RegistrationNodeInfo struct {
 	Certificate   github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,1,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
 	EncryptedSeed []byte                                                                          `protobuf:"bytes,2,opt,name=encrypted_seed,json=encryptedSeed,proto3" json:"encrypted_seed,omitempty"`
+	// height of the block in which the node registered, 0 for nodes registered before it was recorded
+	RegistrationHeight int64 `protobuf:"varint,3,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	// time of the block in which the node registered
+	RegistrationTime time.Time `protobuf:"bytes,4,opt,name=registration_time,json=registrationTime,proto3,stdtime" json:"registration_time"`
+	// account that sent the registration, empty for nodes registered before it was recorded
+	RegisteredBy github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=registered_by,json=registeredBy,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"registered_by,omitempty"`
 }
 
 func (m *RegistrationNodeInfo) Reset()         { *m = RegistrationNodeInfo{} }
@@ -140,10 +151,147 @@ func (m *RegistrationNodeInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegistrationNodeInfo proto.InternalMessageInfo
 
+// Params defines the parameters of the registration module
+type Params struct {
+	// how long an attestation is considered valid after registration, 0 means it never expires
+	AttestationValidityPeriod time.Duration `protobuf:"bytes,1,opt,name=attestation_validity_period,json=attestationValidityPeriod,proto3,stdduration" json:"attestation_validity_period"`
+	// enclaves accepted for registration, empty means the check is left to the enclave
+	AcceptedEnclaves []AcceptedEnclave `protobuf:"bytes,2,rep,name=accepted_enclaves,json=acceptedEnclaves,proto3" json:"accepted_enclaves"`
+	// hex encoded public keys of nodes whose registration was revoked by
+	// governance. The nodes revoked by the account that registered them or on
+	// evidence are kept in the store, and stay revoked whatever this param is.
+	RevokedNodes []string `protobuf:"bytes,3,rep,name=revoked_nodes,json=revokedNodes,proto3" json:"revoked_nodes,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{3}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+// AcceptedEnclave matches the measurements of an enclave. Empty fields match any value.
+type AcceptedEnclave struct {
+	// hex encoded MRENCLAVE
+	MrEnclave string `protobuf:"bytes,1,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// hex encoded MRSIGNER
+	MrSigner string `protobuf:"bytes,2,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	// minimum ISV security version number
+	MinIsvSvn uint32 `protobuf:"varint,3,opt,name=min_isv_svn,json=minIsvSvn,proto3" json:"min_isv_svn,omitempty"`
+}
+
+func (m *AcceptedEnclave) Reset()         { *m = AcceptedEnclave{} }
+func (m *AcceptedEnclave) String() string { return proto.CompactTextString(m) }
+func (*AcceptedEnclave) ProtoMessage()    {}
+func (*AcceptedEnclave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{4}
+}
+func (m *AcceptedEnclave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedEnclave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedEnclave.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptedEnclave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedEnclave.Merge(m, src)
+}
+func (m *AcceptedEnclave) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedEnclave) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedEnclave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedEnclave proto.InternalMessageInfo
+
+// EnclaveEvidence is submitted through x/evidence against a registered node whose enclave is
+// not trusted anymore. It holds if the certificate the node registered with fails verification
+// or isn't accepted by the params, or if the given certificate proves the key of the node is
+// held by an enclave that isn't accepted. The registration of the node is then revoked.
+type EnclaveEvidence struct {
+	// public key of the registered node
+	NodeID []byte `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// optional attestation certificate of an enclave holding the key of the node
+	Certificate github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,2,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
+	// height at which the misbehavior was observed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *EnclaveEvidence) Reset()         { *m = EnclaveEvidence{} }
+func (m *EnclaveEvidence) String() string { return proto.CompactTextString(m) }
+func (*EnclaveEvidence) ProtoMessage()    {}
+func (*EnclaveEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3db05f1d182f4de, []int{5}
+}
+func (m *EnclaveEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnclaveEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnclaveEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnclaveEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnclaveEvidence.Merge(m, src)
+}
+func (m *EnclaveEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnclaveEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnclaveEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnclaveEvidence proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SeedConfig)(nil), "secret.registration.v1beta1.SeedConfig")
 	proto.RegisterType((*LegacySeedConfig)(nil), "secret.registration.v1beta1.LegacySeedConfig")
 	proto.RegisterType((*RegistrationNodeInfo)(nil), "secret.registration.v1beta1.RegistrationNodeInfo")
+	proto.RegisterType((*Params)(nil), "secret.registration.v1beta1.Params")
+	proto.RegisterType((*AcceptedEnclave)(nil), "secret.registration.v1beta1.AcceptedEnclave")
+	proto.RegisterType((*EnclaveEvidence)(nil), "secret.registration.v1beta1.EnclaveEvidence")
 }
 
 func init() {
@@ -151,32 +299,55 @@ func init() {
 }
 
 var fileDescriptor_f3db05f1d182f4de = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6e, 0xd4, 0x40,
-	0x14, 0x80, 0x3d, 0x89, 0xb4, 0x51, 0x66, 0x37, 0x08, 0x59, 0x29, 0x22, 0x90, 0xc6, 0xd1, 0x4a,
-	0x21, 0xa9, 0x3c, 0x0a, 0x1c, 0x00, 0xc9, 0x5b, 0xa1, 0xa0, 0x20, 0x79, 0x3b, 0x9a, 0x68, 0x3c,
-	0x7e, 0x6b, 0x46, 0xde, 0x9d, 0xb1, 0x66, 0x5e, 0x02, 0xbe, 0x03, 0x05, 0xc7, 0xe0, 0x00, 0x1c,
-	0x22, 0x65, 0x4a, 0x2a, 0x0b, 0xbc, 0x9d, 0x8f, 0x40, 0x85, 0xfc, 0x13, 0xd6, 0x94, 0xe9, 0x9e,
-	0xec, 0xef, 0xcd, 0xfb, 0xde, 0x0f, 0x3d, 0x77, 0x20, 0x2d, 0x20, 0xb7, 0x90, 0x29, 0x87, 0x56,
-	0xa0, 0x32, 0x9a, 0xdf, 0x5d, 0x26, 0x80, 0xe2, 0x92, 0x63, 0x59, 0x80, 0x0b, 0x0b, 0x6b, 0xd0,
-	0xf8, 0x2f, 0x7b, 0x30, 0x1c, 0x83, 0xe1, 0x00, 0xbe, 0x38, 0xce, 0x4c, 0x66, 0x3a, 0x8e, 0xb7,
-	0x51, 0x9f, 0x32, 0xff, 0x4a, 0x28, 0x5d, 0x02, 0xa4, 0x0b, 0xa3, 0x57, 0x2a, 0xf3, 0x5f, 0x51,
-	0xba, 0x11, 0x0e, 0xc1, 0xde, 0xe4, 0x50, 0x9e, 0x90, 0x53, 0x72, 0x71, 0x18, 0x1d, 0x34, 0x55,
-	0xb0, 0x5f, 0xe4, 0xaf, 0xe3, 0xc3, 0xfe, 0xd7, 0x15, 0x94, 0x3e, 0xa7, 0x47, 0xa0, 0xa5, 0x2d,
-	0x0b, 0x84, 0xb4, 0x43, 0xf7, 0x3a, 0x94, 0x36, 0x55, 0x30, 0x01, 0x2d, 0xaf, 0xa0, 0x8c, 0x67,
-	0xff, 0x80, 0x36, 0xe1, 0x8c, 0x1e, 0xdc, 0x81, 0x75, 0xca, 0xe8, 0x93, 0xfd, 0x53, 0x72, 0x71,
-	0x14, 0x4d, 0x9b, 0x2a, 0x78, 0xfc, 0x14, 0x3f, 0x06, 0xf3, 0x35, 0x7d, 0xfe, 0x1e, 0x32, 0x21,
-	0xcb, 0x91, 0xd3, 0x39, 0x9d, 0x0e, 0x4e, 0x12, 0x2c, 0x0e, 0x52, 0x93, 0xa6, 0x0a, 0xf6, 0x8a,
-	0x3c, 0x1e, 0x74, 0x17, 0x60, 0xf1, 0xc9, 0x52, 0xf3, 0x1f, 0x84, 0x1e, 0xc7, 0xa3, 0x59, 0x5d,
-	0x9b, 0x14, 0xde, 0xe9, 0x95, 0xf1, 0x6f, 0xe9, 0xb4, 0xad, 0xa5, 0x56, 0x4a, 0x0a, 0x84, 0xae,
-	0xe4, 0x2c, 0x5a, 0xfe, 0xa9, 0x82, 0x0f, 0x99, 0xc2, 0x4f, 0xb7, 0x49, 0x28, 0xcd, 0x86, 0x3b,
-	0x69, 0x71, 0x2d, 0x12, 0xc7, 0x97, 0xdd, 0xd4, 0xaf, 0x01, 0x3f, 0x1b, 0x9b, 0xf3, 0x2f, 0xff,
-	0xef, 0xc9, 0xc2, 0xc6, 0x20, 0xdc, 0x08, 0x44, 0x70, 0xd8, 0x6f, 0x64, 0xb1, 0x7b, 0x3a, 0x1e,
-	0xd7, 0xf1, 0xcf, 0xe8, 0xb3, 0x5d, 0x03, 0x0e, 0x20, 0xed, 0x3a, 0x98, 0xc5, 0xbb, 0xb6, 0xda,
-	0xb1, 0x44, 0xe2, 0xfe, 0x37, 0xf3, 0xbe, 0xd7, 0x8c, 0xdc, 0xd7, 0x8c, 0x3c, 0xd4, 0x8c, 0xfc,
-	0xaa, 0x19, 0xf9, 0xb6, 0x65, 0xde, 0xc3, 0x96, 0x79, 0x3f, 0xb7, 0xcc, 0xfb, 0xf8, 0xf6, 0xc9,
-	0x9a, 0x4a, 0x23, 0x58, 0x2d, 0xd6, 0xfd, 0x3d, 0x25, 0x93, 0xee, 0x3a, 0xde, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xcd, 0xcc, 0xb9, 0x28, 0x7b, 0x02, 0x00, 0x00,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0x25, 0xdd, 0x4c, 0x52, 0xb6, 0x6b, 0x56, 0x28, 0xdb, 0x0a, 0x3b, 0xca, 0x6a,
+	0xd9, 0x1c, 0x58, 0x5b, 0x0d, 0x3f, 0x00, 0x35, 0xdd, 0x95, 0xa8, 0x16, 0x2d, 0x65, 0x82, 0x7a,
+	0xe0, 0x62, 0x4d, 0x66, 0x5e, 0xdc, 0x51, 0x62, 0x4f, 0x34, 0x33, 0x31, 0xf8, 0x3f, 0x70, 0xe8,
+	0x91, 0x9f, 0xc0, 0x1f, 0x41, 0xea, 0xb1, 0x47, 0x4e, 0x06, 0xd2, 0x5b, 0xae, 0xdc, 0x7a, 0x42,
+	0x1e, 0x3b, 0xc4, 0x29, 0x12, 0x52, 0x2f, 0x7b, 0xb2, 0x67, 0xde, 0xf7, 0xde, 0xfb, 0xe6, 0x7b,
+	0xdf, 0x43, 0xaf, 0x14, 0x50, 0x09, 0xda, 0x97, 0x10, 0x72, 0xa5, 0x25, 0xd1, 0x5c, 0xc4, 0x7e,
+	0x72, 0x3c, 0x01, 0x4d, 0x8e, 0x7d, 0x9d, 0x2e, 0x40, 0x79, 0x0b, 0x29, 0xb4, 0xb0, 0x8f, 0x0a,
+	0xa0, 0x57, 0x05, 0x7a, 0x25, 0xf0, 0xf0, 0x59, 0x28, 0x42, 0x61, 0x70, 0x7e, 0xfe, 0x57, 0xa4,
+	0x1c, 0x3a, 0xa1, 0x10, 0xe1, 0x1c, 0x7c, 0x73, 0x9a, 0x2c, 0xa7, 0x3e, 0x5b, 0x96, 0x79, 0x45,
+	0xdc, 0xbd, 0x1f, 0xd7, 0x3c, 0x02, 0xa5, 0x49, 0xb4, 0x28, 0x00, 0xfd, 0x9f, 0x2d, 0x84, 0xc6,
+	0x00, 0xec, 0x54, 0xc4, 0x53, 0x1e, 0xda, 0x9f, 0x23, 0x14, 0x11, 0xa5, 0x41, 0x06, 0x33, 0x48,
+	0xbb, 0x56, 0xcf, 0x1a, 0xb4, 0x46, 0x7b, 0xeb, 0xcc, 0x6d, 0x2c, 0x66, 0x43, 0xdc, 0x2a, 0x42,
+	0xef, 0x20, 0xb5, 0x7d, 0xb4, 0x0f, 0x31, 0x95, 0xe9, 0x42, 0x03, 0x33, 0xd0, 0xba, 0x81, 0xa2,
+	0x75, 0xe6, 0x36, 0x21, 0xa6, 0xef, 0x20, 0xc5, 0x9d, 0x7f, 0x01, 0x79, 0xc2, 0x4b, 0xb4, 0x97,
+	0x80, 0x54, 0x5c, 0xc4, 0xdd, 0x46, 0xcf, 0x1a, 0xec, 0x8f, 0xda, 0xeb, 0xcc, 0xdd, 0x5c, 0xe1,
+	0xcd, 0x4f, 0x7f, 0x8e, 0x0e, 0xbe, 0x81, 0x90, 0xd0, 0xb4, 0xc2, 0xe9, 0x15, 0x6a, 0x97, 0x9c,
+	0x28, 0x48, 0x5d, 0x92, 0x6a, 0xae, 0x33, 0xb7, 0xbe, 0x98, 0xe1, 0x92, 0xee, 0x29, 0x48, 0xfd,
+	0x60, 0x52, 0xfd, 0xab, 0x06, 0x7a, 0x86, 0x2b, 0x62, 0xbf, 0x17, 0x0c, 0xce, 0xe2, 0xa9, 0xb0,
+	0x97, 0xa8, 0x9d, 0xf7, 0xe2, 0x53, 0x4e, 0x89, 0x06, 0xd3, 0xb2, 0x33, 0x1a, 0xdf, 0x65, 0xee,
+	0xb7, 0x21, 0xd7, 0x97, 0xcb, 0x89, 0x47, 0x45, 0xe4, 0x2b, 0x2a, 0xf5, 0x9c, 0x4c, 0x94, 0x3f,
+	0x36, 0x63, 0x7b, 0x0f, 0xfa, 0x47, 0x21, 0x67, 0xfe, 0x4f, 0xbb, 0x83, 0x96, 0x10, 0x09, 0x0d,
+	0x01, 0xd1, 0x3a, 0xd7, 0xdd, 0x8c, 0xe6, 0x74, 0x5b, 0x1a, 0x57, 0xfb, 0xd8, 0x2f, 0xd1, 0xc7,
+	0xdb, 0x07, 0x28, 0x00, 0x66, 0x5e, 0xd0, 0xc1, 0xdb, 0x67, 0xe5, 0xb2, 0xd8, 0x3e, 0xfa, 0xa4,
+	0xda, 0x22, 0xb8, 0x04, 0x1e, 0x5e, 0x6a, 0xa3, 0x6b, 0x03, 0xdb, 0xd5, 0xd0, 0xd7, 0x26, 0x62,
+	0x7f, 0x87, 0x9e, 0xee, 0x24, 0xe4, 0x26, 0xe8, 0x3e, 0xea, 0x59, 0x83, 0xf6, 0xf0, 0xd0, 0x2b,
+	0x1c, 0xe2, 0x6d, 0x1c, 0xe2, 0x7d, 0xbf, 0x71, 0xc8, 0xe8, 0xf1, 0x75, 0xe6, 0xd6, 0xae, 0xfe,
+	0x70, 0x2d, 0x7c, 0x50, 0x4d, 0xcf, 0x01, 0xf6, 0x05, 0xda, 0x2f, 0xee, 0x40, 0x02, 0x0b, 0x26,
+	0x69, 0xf7, 0x23, 0xa3, 0xd1, 0xf1, 0x5d, 0xe6, 0xbe, 0xae, 0x68, 0x44, 0x85, 0x8a, 0x84, 0x2a,
+	0x3f, 0xaf, 0x15, 0x9b, 0x95, 0x86, 0x3f, 0xa1, 0xf4, 0x84, 0x31, 0x09, 0x4a, 0xe1, 0xce, 0xb6,
+	0xce, 0x28, 0xed, 0xff, 0x6d, 0xa1, 0xe6, 0x39, 0x91, 0x24, 0x52, 0x36, 0x45, 0x47, 0x15, 0xd5,
+	0x82, 0x84, 0xcc, 0x39, 0xe3, 0x3a, 0x0d, 0x16, 0x20, 0xb9, 0x60, 0x66, 0x28, 0xed, 0xe1, 0xf3,
+	0xff, 0xf0, 0x7f, 0x53, 0x6e, 0x40, 0x41, 0xff, 0x97, 0x9c, 0xfe, 0xf3, 0x4a, 0x9d, 0x8b, 0xb2,
+	0xcc, 0xb9, 0xa9, 0x62, 0x07, 0xe8, 0x29, 0xa1, 0x14, 0x8c, 0xe2, 0x10, 0xd3, 0x39, 0x49, 0x40,
+	0x75, 0xeb, 0xbd, 0xc6, 0xa0, 0x3d, 0xfc, 0xc2, 0xfb, 0x9f, 0x7d, 0xf4, 0x4e, 0xca, 0xac, 0xb7,
+	0x45, 0xd2, 0xe8, 0x51, 0xde, 0x0d, 0x1f, 0x90, 0xdd, 0x6b, 0x65, 0xbf, 0xc8, 0x85, 0x4a, 0xc4,
+	0x0c, 0x58, 0x10, 0x0b, 0x06, 0xaa, 0xdb, 0xe8, 0x35, 0x06, 0x2d, 0xdc, 0x29, 0x2f, 0x73, 0xcb,
+	0xa9, 0x7e, 0x84, 0x9e, 0xdc, 0xab, 0x67, 0x7f, 0x86, 0x50, 0x24, 0x37, 0x94, 0x0a, 0xd3, 0xe3,
+	0x56, 0x24, 0x37, 0xe1, 0x23, 0xd4, 0x8a, 0x64, 0xa0, 0x78, 0x18, 0x83, 0x2c, 0x7c, 0x8e, 0x1f,
+	0x47, 0x72, 0x6c, 0xce, 0xb6, 0x83, 0xda, 0x11, 0x8f, 0x03, 0xae, 0x92, 0x40, 0x25, 0xe5, 0xc2,
+	0xe1, 0x56, 0xc4, 0xe3, 0x33, 0x95, 0x8c, 0x93, 0xb8, 0xff, 0x9b, 0x85, 0x9e, 0x94, 0x85, 0xde,
+	0x26, 0x9c, 0x41, 0x4c, 0xc1, 0x7e, 0x81, 0xf6, 0x72, 0x7e, 0x01, 0x67, 0xa5, 0xdd, 0xd1, 0x2a,
+	0x73, 0x9b, 0x66, 0x23, 0xde, 0xe0, 0x66, 0x1e, 0x3a, 0x63, 0xf7, 0xf7, 0xa2, 0xfe, 0x81, 0xf6,
+	0xe2, 0x53, 0xd4, 0xdc, 0xf1, 0x78, 0x79, 0x1a, 0x91, 0xeb, 0xbf, 0x9c, 0xda, 0xaf, 0x2b, 0xc7,
+	0xba, 0x5e, 0x39, 0xd6, 0xcd, 0xca, 0xb1, 0xfe, 0x5c, 0x39, 0xd6, 0xd5, 0xad, 0x53, 0xbb, 0xb9,
+	0x75, 0x6a, 0xbf, 0xdf, 0x3a, 0xb5, 0x1f, 0xbe, 0x7a, 0x30, 0x2f, 0x1e, 0x6b, 0x90, 0x31, 0x99,
+	0x17, 0x46, 0x9d, 0x34, 0x8d, 0xaf, 0xbe, 0xfc, 0x67, 0x00, 0x28, 0xaf, 0x66, 0xf9, 0xc5, 0x05,
+	0x00, 0x00,
 }
 
 func (this *SeedConfig) Equal(that interface{}) bool {
@@ -261,6 +432,115 @@ func (this *RegistrationNodeInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
 		return false
 	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	if !this.RegistrationTime.Equal(that1.RegistrationTime) {
+		return false
+	}
+	if !bytes.Equal(this.RegisteredBy, that1.RegisteredBy) {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AttestationValidityPeriod != that1.AttestationValidityPeriod {
+		return false
+	}
+	if len(this.AcceptedEnclaves) != len(that1.AcceptedEnclaves) {
+		return false
+	}
+	for i := range this.AcceptedEnclaves {
+		if !this.AcceptedEnclaves[i].Equal(&that1.AcceptedEnclaves[i]) {
+			return false
+		}
+	}
+	if len(this.RevokedNodes) != len(that1.RevokedNodes) {
+		return false
+	}
+	for i := range this.RevokedNodes {
+		if this.RevokedNodes[i] != that1.RevokedNodes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *AcceptedEnclave) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcceptedEnclave)
+	if !ok {
+		that2, ok := that.(AcceptedEnclave)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MrEnclave != that1.MrEnclave {
+		return false
+	}
+	if this.MrSigner != that1.MrSigner {
+		return false
+	}
+	if this.MinIsvSvn != that1.MinIsvSvn {
+		return false
+	}
+	return true
+}
+func (this *EnclaveEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnclaveEvidence)
+	if !ok {
+		that2, ok := that.(EnclaveEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NodeID, that1.NodeID) {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
 	return true
 }
 func (m *SeedConfig) Marshal() (dAtA []byte, err error) {
@@ -362,6 +642,26 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RegisteredBy) > 0 {
+		i -= len(m.RegisteredBy)
+		copy(dAtA[i:], m.RegisteredBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RegisteredBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RegistrationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RegistrationTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EncryptedSeed) > 0 {
 		i -= len(m.EncryptedSeed)
 		copy(dAtA[i:], m.EncryptedSeed)
@@ -379,6 +679,144 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedNodes) > 0 {
+		for iNdEx := len(m.RevokedNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedNodes[iNdEx])
+			copy(dAtA[i:], m.RevokedNodes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RevokedNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AcceptedEnclaves) > 0 {
+		for iNdEx := len(m.AcceptedEnclaves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedEnclaves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AttestationValidityPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationValidityPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedEnclave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedEnclave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedEnclave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinIsvSvn != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinIsvSvn))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnclaveEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnclaveEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnclaveEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeID) > 0 {
+		i -= len(m.NodeID)
+		copy(dAtA[i:], m.NodeID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.NodeID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -424,33 +862,556 @@ func (m *LegacySeedConfig) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *RegistrationNodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RegistrationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RegistrationTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RegisteredBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AttestationValidityPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.AcceptedEnclaves) > 0 {
+		for _, e := range m.AcceptedEnclaves {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RevokedNodes) > 0 {
+		for _, s := range m.RevokedNodes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedEnclave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MinIsvSvn != 0 {
+		n += 1 + sovTypes(uint64(m.MinIsvSvn))
+	}
+	return n
+}
+
+func (m *EnclaveEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SeedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacySeedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacySeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MasterCert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MasterCert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationNodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RegistrationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredBy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredBy = append(m.RegisteredBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegisteredBy == nil {
+				m.RegisteredBy = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *RegistrationNodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Certificate)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SeedConfig) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -473,17 +1434,17 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationValidityPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -493,29 +1454,30 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterKey = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AttestationValidityPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedEnclaves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -525,29 +1487,31 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			m.AcceptedEnclaves = append(m.AcceptedEnclaves, AcceptedEnclave{})
+			if err := m.AcceptedEnclaves[len(m.AcceptedEnclaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedNodes", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -557,11 +1521,24 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedNodes = append(m.RevokedNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -583,7 +1560,7 @@ func (m *SeedConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
+func (m *AcceptedEnclave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -606,15 +1583,15 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacySeedConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedEnclave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacySeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedEnclave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MasterCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -642,11 +1619,11 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MasterCert = string(dAtA[iNdEx:postIndex])
+			m.MrEnclave = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -674,8 +1651,27 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedKey = string(dAtA[iNdEx:postIndex])
+			m.MrSigner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIsvSvn", wireType)
+			}
+			m.MinIsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -697,7 +1693,7 @@ func (m *LegacySeedConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
+func (m *EnclaveEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,15 +1716,15 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegistrationNodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnclaveEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegistrationNodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnclaveEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -755,14 +1751,14 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Certificate == nil {
-				m.Certificate = []byte{}
+			m.NodeID = append(m.NodeID[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeID == nil {
+				m.NodeID = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -789,11 +1785,30 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSeed == nil {
-				m.EncryptedSeed = []byte{}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
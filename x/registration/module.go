@@ -54,6 +54,7 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&GenesisState{
 		NodeExchMasterKey: &MasterKey{},
 		IoMasterKey:       &MasterKey{},
+		Params:            DefaultParams(),
 	})
 }
 
@@ -141,7 +142,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // BeginBlock returns the begin blocker for the compute module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.SetAttestationGauges(ctx)
+}
 
 // EndBlock returns the end blocker for the compute module. It returns no validator
 // updates.
@@ -0,0 +1,83 @@
+package remote_attestation
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// size of the sgx_quote_t header that precedes the report body
+	quoteHeaderSize = 48
+	// offsets in sgx_report_body_t
+	reportBodyMrEnclaveOffset = 64
+	reportBodyMrSignerOffset  = 128
+	reportBodyIsvSvnOffset    = 258
+	reportBodyMinSize         = reportBodyIsvSvnOffset + 2
+)
+
+// EnclaveMeasurements identify the enclave that produced an attestation
+type EnclaveMeasurements struct {
+	MrEnclave []byte
+	MrSigner  []byte
+	IsvSvn    uint16
+}
+
+// ExtractMeasurements returns the measurements of the enclave that produced a combined certificate.
+// The certificate must already have been verified with VerifyCombinedCert.
+// In software mode certificates contain no quote, and nil is returned.
+func ExtractMeasurements(blob []byte) (*EnclaveMeasurements, error) {
+	epidCert, dcapQuote, err := splitCombinedCert(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(epidCert) > 0 {
+		if !isSgxHardwareMode() {
+			return nil, nil
+		}
+
+		_, payload, err := unmarshalCert(epidCert)
+		if err != nil {
+			return nil, err
+		}
+
+		attnReportRaw, err := verifyCert(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		var qr QuoteReport
+		if err := json.Unmarshal(attnReportRaw, &qr); err != nil {
+			return nil, err
+		}
+
+		quote, err := base64.StdEncoding.DecodeString(qr.IsvEnclaveQuoteBody)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseReportBody(quote)
+	}
+
+	if len(dcapQuote) > 0 {
+		return parseReportBody(dcapQuote)
+	}
+
+	return nil, errors.New("No valid attestatoin found")
+}
+
+func parseReportBody(quote []byte) (*EnclaveMeasurements, error) {
+	if len(quote) < quoteHeaderSize+reportBodyMinSize {
+		return nil, errors.New("quote too small")
+	}
+
+	body := quote[quoteHeaderSize:]
+	return &EnclaveMeasurements{
+		MrEnclave: body[reportBodyMrEnclaveOffset : reportBodyMrEnclaveOffset+32],
+		MrSigner:  body[reportBodyMrSignerOffset : reportBodyMrSignerOffset+32],
+		IsvSvn:    binary.LittleEndian.Uint16(body[reportBodyIsvSvnOffset:]),
+	}, nil
+}
@@ -27,41 +27,23 @@ type DcapQuote struct {
 }
 
 func VerifyCombinedCert(blob []byte) ([]byte, error) {
-	var hdr CombinedHdr
-
-	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
-		return nil, errors.New("Combined hdr too small")
-	}
-
-	{
-		buf := bytes.NewReader(blob)
-		err := binary.Read(buf, binary.LittleEndian, &hdr)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	idx0 := unsafe.Sizeof(hdr)
-	idx1 := idx0 + uintptr(hdr.M_CombinedSizes[0])
-	idx2 := idx1 + uintptr(hdr.M_CombinedSizes[1])
-	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
-
-	if uintptr(len(blob)) < idx3 {
-		return nil, errors.New("combined hdr invalid")
+	epidCert, dcapQuote, err := splitCombinedCert(blob)
+	if err != nil {
+		return nil, err
 	}
 
-	if idx1 > idx0 {
-		ret_pk, ret_err := VerifyRaCert(blob[idx0:idx1])
+	if len(epidCert) > 0 {
+		ret_pk, ret_err := VerifyRaCert(epidCert)
 		if ret_pk != nil {
 			fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
 		}
 		return ret_pk, ret_err
 	}
 
-	if idx2 > idx1 {
+	if len(dcapQuote) > 0 {
 		var quote DcapQuote
 
-		buf := bytes.NewReader(blob[idx1:idx2])
+		buf := bytes.NewReader(dcapQuote)
 		err := binary.Read(buf, binary.LittleEndian, &quote)
 		if err != nil {
 			return nil, err
@@ -74,6 +56,35 @@ func VerifyCombinedCert(blob []byte) ([]byte, error) {
 	return nil, errors.New("No valid attestatoin found")
 }
 
+// splitCombinedCert returns the EPID certificate and the DCAP quote of a combined certificate.
+// Either may be empty.
+func splitCombinedCert(blob []byte) ([]byte, []byte, error) {
+	var hdr CombinedHdr
+
+	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
+		return nil, nil, errors.New("Combined hdr too small")
+	}
+
+	{
+		buf := bytes.NewReader(blob)
+		err := binary.Read(buf, binary.LittleEndian, &hdr)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	idx0 := unsafe.Sizeof(hdr)
+	idx1 := idx0 + uintptr(hdr.M_CombinedSizes[0])
+	idx2 := idx1 + uintptr(hdr.M_CombinedSizes[1])
+	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
+
+	if uintptr(len(blob)) < idx3 {
+		return nil, nil, errors.New("combined hdr invalid")
+	}
+
+	return blob[idx0:idx1], blob[idx1:idx2], nil
+}
+
 /*
 	 Verifies the remote attestation certificate, which is comprised of a the attestation report, intel signature, and enclave signature
 